@@ -0,0 +1,16 @@
+action "idsec_pcloud_account_change_credentials" "rotate" {
+  config {
+    account_id = idsec_pcloud_account.example_account.account_id
+  }
+}
+
+resource "terraform_data" "rotation_schedule" {
+  input = var.rotation_epoch
+
+  lifecycle {
+    action_trigger {
+      events  = [after_update]
+      actions = [action.idsec_pcloud_account_change_credentials.rotate]
+    }
+  }
+}
@@ -0,0 +1,11 @@
+data "idsec_effective_access" "alice" {
+  principals = [
+    provider::idsec::principal("user", "alice@example.com"),
+    provider::idsec::principal("role", "DBAdmins"),
+  ]
+  statuses = ["Active"]
+}
+
+output "alice_target_categories" {
+  value = data.idsec_effective_access.alice.target_categories
+}
@@ -0,0 +1,7 @@
+data "idsec_provider_info" "current" {
+  minimum_version = "1.4.0"
+}
+
+output "idsec_sdk_version" {
+  value = data.idsec_provider_info.current.sdk_version
+}
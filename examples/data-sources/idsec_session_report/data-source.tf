@@ -0,0 +1,9 @@
+data "idsec_session_report" "january" {
+  start_time = "2026-01-01T00:00:00Z"
+  end_time   = "2026-02-01T00:00:00Z"
+  search     = "protocol IN SSH,RDP"
+}
+
+output "failed_sessions" {
+  value = data.idsec_session_report.january.failed_count
+}
@@ -0,0 +1,8 @@
+data "idsec_wait_for" "account_onboarded" {
+  data_source    = "idsec_cce_aws_account"
+  input          = { id = idsec_cce_aws_account.example.id }
+  attribute      = "status"
+  expected_value = "Completely added"
+  timeout        = "10m"
+  poll_interval  = "15s"
+}
@@ -0,0 +1,3 @@
+data "idsec_effective_access" "db_admins" {
+  principals = [provider::idsec::principal("role", "DBAdmins")]
+}
@@ -0,0 +1,7 @@
+list "idsec_pcloud_safe" "all_safes" {
+  provider = idsec
+
+  config {
+    search = "example"
+  }
+}
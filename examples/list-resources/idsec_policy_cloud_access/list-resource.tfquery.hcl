@@ -0,0 +1,7 @@
+list "idsec_policy_cloud_access" "all_policies" {
+  provider = idsec
+
+  config {
+    text_search = "example"
+  }
+}
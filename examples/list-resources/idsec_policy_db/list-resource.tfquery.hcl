@@ -0,0 +1,7 @@
+list "idsec_policy_db" "all_policies" {
+  provider = idsec
+
+  config {
+    text_search = "example"
+  }
+}
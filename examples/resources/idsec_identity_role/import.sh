@@ -1 +1 @@
-terraform import idsec_identity_role example role-id-123
+terraform import idsec_identity_role.myrole role-id-123
@@ -1 +1 @@
-terraform import idsec_identity_role_attributes_schema.example
+terraform import idsec_identity_role_attributes_schema.myrole_attributes_schema singleton
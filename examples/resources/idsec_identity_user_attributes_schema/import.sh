@@ -1 +1 @@
-terraform import idsec_identity_user_attributes.example user-id-123
+terraform import idsec_identity_user_attributes_schema.myuser_attributes_schema singleton
@@ -0,0 +1 @@
+terraform import idsec_pcloud_target_platform.example id-123
@@ -0,0 +1 @@
+terraform import idsec_sia_db_strong_accounts.pam_account strong-account-id-123
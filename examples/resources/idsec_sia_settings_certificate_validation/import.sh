@@ -0,0 +1 @@
+terraform import idsec_sia_settings_certificate_validation.example singleton
@@ -0,0 +1 @@
+terraform import idsec_sia_settings_k8s_mfa_caching.example singleton
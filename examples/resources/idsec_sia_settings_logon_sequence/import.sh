@@ -0,0 +1 @@
+terraform import idsec_sia_settings_logon_sequence.example singleton
@@ -0,0 +1 @@
+terraform import idsec_sia_settings_rdp_file_transfer.example singleton
@@ -0,0 +1 @@
+terraform import idsec_sia_settings_rdp_kerberos_auth_mode.example singleton
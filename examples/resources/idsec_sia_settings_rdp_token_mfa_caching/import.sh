@@ -0,0 +1 @@
+terraform import idsec_sia_settings_rdp_token_mfa_caching.example singleton
@@ -0,0 +1 @@
+terraform import idsec_sia_settings_self_hosted_pam.example singleton
@@ -0,0 +1 @@
+terraform import idsec_sia_settings_ssh_recording.example singleton
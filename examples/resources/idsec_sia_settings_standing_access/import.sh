@@ -0,0 +1 @@
+terraform import idsec_sia_settings_standing_access.example singleton
@@ -0,0 +1 @@
+terraform import idsec_sia_settings_validate_fingerprint_for_ssh_zero_standing.example singleton
@@ -3,6 +3,8 @@
 
 package actions
 
+import "time"
+
 // IdsecServiceActionOperation defines the operation type for an Idsec service action, such as create, read, update, delete, or state.
 type IdsecServiceActionOperation string
 
@@ -48,6 +50,14 @@ type IdsecServiceBaseTerraformActionDefinition struct {
 	ComputedAttributes        []string
 	HistoryComputedAttributes []string
 	CaseInsensitiveAttributes []string
+	// EmptyAsNullAttributes lists the top-level string attributes for which the API does not tell an empty
+	// string from a missing value. An empty string returned for an attribute left null in the configuration
+	// is stored as null, and a null returned for an attribute configured as "" is stored as "", so neither
+	// shows as a diff.
+	EmptyAsNullAttributes []string
+	// ForceNewAttributes lists the attributes whose change replaces the resource. What the API destroys
+	// with it is described by the replace_impact tag of the model field.
+	ForceNewAttributes []string
 }
 
 // IdsecServiceTerraformResourceActionDefinition is a struct that defines the structure of a resource action in the Idsec Terraform provider.
@@ -59,10 +69,109 @@ type IdsecServiceTerraformResourceActionDefinition struct {
 	SupportedOperations []IdsecServiceActionOperation
 	ActionsMappings     map[IdsecServiceActionOperation]string
 	ImportID            string
+	// ImportIDDelimiter separates the values of a composite import ID of several ImportID attributes,
+	// e.g. "/" to import "safe_id:member_name" as "safe-1/alice". Defaults to ":".
+	ImportIDDelimiter string
+	// KnownAfterApplyAllowlist lists the top-level attributes the API may set or change on its own. When
+	// set, every other Optional attribute without a default is generated as plain Optional instead of
+	// Optional+Computed, so it stays null in plans rather than showing as "known after apply", and its
+	// state follows the configuration. Leave nil to keep all optional attributes Computed.
+	KnownAfterApplyAllowlist []string
+	// ComputedOnlyOutputs marks attributes that only exist on the state schema as purely Computed
+	// instead of Optional+Computed, so users cannot set values for read-only outputs.
+	ComputedOnlyOutputs bool
+	// ChangeReasonAttribute names the input attribute of the create, update and delete operations that
+	// records why a change was made, e.g. "reason" or "comment". When the user leaves it unset, the
+	// provider level change_reason is sent in its place.
+	ChangeReasonAttribute string
+	// ListAction names the SDK action used to list existing instances of the resource, e.g. "list-by".
+	// When set together with ImportID, the resource is exposed as a list resource for `terraform query`
+	// and gains a resource identity built from its ImportID attributes.
+	ListAction string
+	// ListDisplayNameAttribute is the attribute shown as the name of the instances listed by ListAction,
+	// e.g. "safe_name". Dotted paths address nested attributes, e.g. "metadata.name". When empty, the
+	// name or display_name attribute is used, and otherwise the import ID.
+	ListDisplayNameAttribute string
+	// BlockAttributes lists nested attributes that are generated as HCL blocks (ListNestedBlock,
+	// SetNestedBlock or SingleNestedBlock) instead of nested attributes, for users preferring block
+	// syntax for repeated structures. Dotted names address attributes nested in another block. Only
+	// required attributes are converted: optional ones are computed from the API, which blocks cannot be.
+	BlockAttributes []string
+	// PlanModifiers attaches plan modifiers registered by name with schemas.RegisterPlanModifier to the
+	// attributes at the given paths, e.g. {"cidr": {"normalize_cidr"}, "rules.host": {"lowercase"}}.
+	// Dotted paths address attributes nested in another attribute.
+	PlanModifiers map[string][]string
+	// ReferenceAttributes maps input attributes to the object they reference on another service, as
+	// "service.action" or "service.action.input_field", e.g. "pcloud-safes.get.safe_id". It complements
+	// `ref` struct tags on SDK models; with validate_references enabled the references are looked up
+	// while planning.
+	ReferenceAttributes map[string]string
+	// IDTemplate builds a computed `id` attribute for resources whose models have no top-level id, from
+	// placeholders naming state attributes, e.g. "{safe_name}/{account_name}". It is ignored when the
+	// models already declare an id.
+	IDTemplate string
+	// CreatedAtAttribute and LastModifiedAtAttribute name the state attributes holding the creation and last
+	// modification times returned by the API, e.g. "creation_time". When set, computed `created_at` and
+	// `last_modified_at` attributes are added, normalized to RFC 3339 in UTC, so automation can age out
+	// stale objects. Models already declaring these attributes keep their own.
+	CreatedAtAttribute      string
+	LastModifiedAtAttribute string
+	// NamePrefixAttribute names the top-level string attribute naming the object, e.g. "safe_name". A
+	// `<name>_prefix` attribute is added, from which a unique name is generated on create when the name
+	// is not configured, for objects created in bulk from the same configuration.
+	NamePrefixAttribute string
+	// ProviderDefaultAttributes lists the top-level string attributes scoping the object that are often the
+	// same across resources, e.g. "safe_name". When not configured, they inherit the value set for them in
+	// the `defaults` of the provider; required ones become optional, and fail the plan of a create when
+	// neither sets them.
+	ProviderDefaultAttributes []string
+	// UniqueNameAttributes maps the attributes whose value is unique across the tenant, e.g. "safe_name", to
+	// the action looking an object up by it, as "service.action" or "service.action.input_field". With
+	// validate_unique_names enabled, new values are looked up while planning and fail the plan when an
+	// object already has them.
+	UniqueNameAttributes map[string]string
+	// ContentDigestAttributes lists the top-level string attributes holding content such as scripts or
+	// certificates, e.g. "certificate_body". A computed `<name>_sha256` attribute holding the SHA-256 digest
+	// of the content is added for each, so updates can be triggered from the digest of a local file.
+	ContentDigestAttributes []string
+	// OperationTimeouts sets the default timeouts of the operations of the resource, for operations that
+	// are known to be slow, e.g. provisioning a connector. They seed the defaults of the `timeouts` block;
+	// operations not listed default to schemas.DefaultOperationTimeout. The SDK calls are not cancelable, so
+	// a timeout bounds the wait for an operation to start and its retries, not a call in flight.
+	OperationTimeouts map[IdsecServiceActionOperation]time.Duration
+	// ConfigConstraints are constraints between attributes the API enforces, e.g. "len(rules) <= max_rules",
+	// checked while planning so a violation fails the plan instead of the apply.
+	ConfigConstraints []IdsecConfigConstraint
+}
+
+// IdsecConfigConstraint is a constraint on the planned values of a resource, as an HCL expression over its
+// attributes that must be true. Expressions may use the len, sum, min, max, contains and distinct
+// functions and splat expressions, e.g. "sum(rules[*].weight) == 100".
+type IdsecConfigConstraint struct {
+	// Expression is the constraint, e.g. "len(members) <= max_members".
+	Expression string
+	// Message explains the constraint to users when it is violated, e.g. "A group holds at most
+	// max_members members". When empty, the expression is reported.
+	Message string
 }
 
 // IdsecServiceTerraformDataSourceActionDefinition is a struct that defines the structure of a data source action in the Idsec Terraform provider.
 type IdsecServiceTerraformDataSourceActionDefinition struct {
 	IdsecServiceBaseTerraformActionDefinition
 	DataSourceAction string
+	// AttributeProjection adds the attribute_projection argument, storing only the selected top-level
+	// attributes of the result in state, for data sources reading large objects, e.g. policies with
+	// thousands of rules.
+	AttributeProjection bool
+	// LookupKeys lists the input attributes identifying the object to read, e.g. "role_id" and "role_name",
+	// of which at least one must be set in the configuration.
+	LookupKeys []string
+}
+
+// IdsecServiceTerraformImperativeActionDefinition is a struct that defines the structure of an imperative action in the Idsec Terraform provider.
+// Imperative actions, such as rotating a credential, do not fit the CRUD lifecycle and are exposed as Terraform actions,
+// invoked through an `action_trigger` of a resource lifecycle or with `terraform apply -invoke`.
+type IdsecServiceTerraformImperativeActionDefinition struct {
+	IdsecServiceBaseTerraformActionDefinition
+	InvokeAction string
 }
@@ -6,11 +6,12 @@ package actions
 import "fmt"
 
 // TerraformServiceConfig holds the Terraform-specific configuration for a service,
-// including its resources and data sources.
+// including its resources, data sources and imperative actions.
 type TerraformServiceConfig struct {
 	ServiceName string
 	Resources   []*IdsecServiceTerraformResourceActionDefinition
 	DataSources []*IdsecServiceTerraformDataSourceActionDefinition
+	Actions     []*IdsecServiceTerraformImperativeActionDefinition
 }
 
 var terraformRegistry []TerraformServiceConfig
@@ -61,6 +62,11 @@ func filterEnabledActions(config TerraformServiceConfig) TerraformServiceConfig
 			filtered.DataSources = append(filtered.DataSources, d)
 		}
 	}
+	for _, a := range config.Actions {
+		if a.IsEnabled() {
+			filtered.Actions = append(filtered.Actions, a)
+		}
+	}
 
 	return filtered
 }
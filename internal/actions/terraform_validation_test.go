@@ -58,6 +58,89 @@ func TestAllImportIDAttributesExist(t *testing.T) {
 	}
 }
 
+// TestAllIDTemplateAttributesExist validates that every IDTemplate placeholder references a valid StateSchema field.
+func TestAllIDTemplateAttributesExist(t *testing.T) {
+	allConfigs := actions.AllTerraformConfigs()
+
+	if len(allConfigs) == 0 {
+		t.Skip("No Terraform service configurations registered")
+	}
+
+	for _, config := range allConfigs {
+		for _, resourceDef := range config.Resources {
+			if resourceDef.IDTemplate == "" {
+				continue
+			}
+			t.Run(config.ServiceName+"/"+resourceDef.ActionName, func(t *testing.T) {
+				attributes, err := schemas.IDTemplateAttributes(resourceDef.IDTemplate)
+				if err != nil {
+					t.Errorf("IDTemplate of resource '%s' in service '%s' is invalid: %v", resourceDef.ActionName, config.ServiceName, err)
+					return
+				}
+				for _, fieldName := range attributes {
+					if err := schemas.ValidateStateSchemaImportAttribute(resourceDef.StateSchema, fieldName); err != nil {
+						t.Errorf("IDTemplate field '%s' is invalid for resource '%s' in service '%s': %v",
+							fieldName, resourceDef.ActionName, config.ServiceName, err)
+					}
+				}
+			})
+		}
+	}
+}
+
+// TestAllKnownAfterApplyAllowlistAttributesExist validates that all KnownAfterApplyAllowlist entries reference state schema fields.
+func TestAllKnownAfterApplyAllowlistAttributesExist(t *testing.T) {
+	allConfigs := actions.AllTerraformConfigs()
+
+	if len(allConfigs) == 0 {
+		t.Skip("No Terraform service configurations registered")
+	}
+
+	for _, config := range allConfigs {
+		for _, resourceDef := range config.Resources {
+			if len(resourceDef.KnownAfterApplyAllowlist) == 0 {
+				continue
+			}
+			t.Run(config.ServiceName+"/"+resourceDef.ActionName, func(t *testing.T) {
+				for _, fieldName := range resourceDef.KnownAfterApplyAllowlist {
+					if err := schemas.ValidateStateSchemaImportAttribute(resourceDef.StateSchema, fieldName); err != nil {
+						t.Errorf("KnownAfterApplyAllowlist field '%s' is invalid for resource '%s' in service '%s': %v",
+							fieldName, resourceDef.ActionName, config.ServiceName, err)
+					}
+				}
+			})
+		}
+	}
+}
+
+// TestAllLifecycleMetaAttributesExist validates that CreatedAtAttribute and LastModifiedAtAttribute reference state schema fields.
+func TestAllLifecycleMetaAttributesExist(t *testing.T) {
+	allConfigs := actions.AllTerraformConfigs()
+
+	if len(allConfigs) == 0 {
+		t.Skip("No Terraform service configurations registered")
+	}
+
+	for _, config := range allConfigs {
+		for _, resourceDef := range config.Resources {
+			if resourceDef.CreatedAtAttribute == "" && resourceDef.LastModifiedAtAttribute == "" {
+				continue
+			}
+			t.Run(config.ServiceName+"/"+resourceDef.ActionName, func(t *testing.T) {
+				for _, fieldName := range []string{resourceDef.CreatedAtAttribute, resourceDef.LastModifiedAtAttribute} {
+					if fieldName == "" {
+						continue
+					}
+					if err := schemas.ValidateStateSchemaImportAttribute(resourceDef.StateSchema, fieldName); err != nil {
+						t.Errorf("lifecycle timestamp field '%s' is invalid for resource '%s' in service '%s': %v",
+							fieldName, resourceDef.ActionName, config.ServiceName, err)
+					}
+				}
+			})
+		}
+	}
+}
+
 // TestAllExtraRequiredAttributesExist validates that all ExtraRequiredAttributes reference valid schema fields.
 func TestAllExtraRequiredAttributesExist(t *testing.T) {
 	allConfigs := actions.AllTerraformConfigs()
@@ -116,6 +199,120 @@ func TestAllExtraRequiredAttributesExist(t *testing.T) {
 	}
 }
 
+// TestAllLookupKeysExist validates that all data source LookupKeys reference fields of the data source input schema.
+func TestAllLookupKeysExist(t *testing.T) {
+	allConfigs := actions.AllTerraformConfigs()
+
+	if len(allConfigs) == 0 {
+		t.Skip("No Terraform service configurations registered")
+	}
+
+	for _, config := range allConfigs {
+		for _, dataSourceDef := range config.DataSources {
+			if len(dataSourceDef.LookupKeys) == 0 {
+				continue
+			}
+			t.Run(config.ServiceName+"/"+dataSourceDef.ActionName+"_datasource", func(t *testing.T) {
+				inputSchema, hasInputSchema := dataSourceDef.Schemas[dataSourceDef.DataSourceAction]
+				if !hasInputSchema || inputSchema == nil {
+					t.Errorf("LookupKeys configured but DataSource schema '%s' not defined for data_source '%s' in service '%s'",
+						dataSourceDef.DataSourceAction, dataSourceDef.ActionName, config.ServiceName)
+					return
+				}
+				inputSchema, _ = modelsactions.UnwrapSchema(inputSchema)
+
+				validateAttributeList(t, config.ServiceName, dataSourceDef.ActionName, "data_source",
+					dataSourceDef.LookupKeys, inputSchema, "LookupKeys")
+			})
+		}
+	}
+}
+
+// TestNoAttributeNameCollisions validates that no resource or data source model declares two fields
+// mapping to the same snake_case attribute name.
+func TestNoAttributeNameCollisions(t *testing.T) {
+	allConfigs := actions.AllTerraformConfigs()
+
+	if len(allConfigs) == 0 {
+		t.Skip("No Terraform service configurations registered")
+	}
+
+	for _, config := range allConfigs {
+		for _, resourceDef := range config.Resources {
+			models := []interface{}{resourceDef.StateSchema}
+			for _, actionSchema := range resourceDef.Schemas {
+				unwrapped, _ := modelsactions.UnwrapSchema(actionSchema)
+				models = append(models, unwrapped)
+			}
+			for _, collision := range schemas.FindAttributeNameCollisions(models...) {
+				t.Errorf("Resource '%s' in service '%s': %s", resourceDef.ActionName, config.ServiceName, collision.String())
+			}
+		}
+		for _, dataSourceDef := range config.DataSources {
+			models := []interface{}{dataSourceDef.StateSchema}
+			for _, actionSchema := range dataSourceDef.Schemas {
+				unwrapped, _ := modelsactions.UnwrapSchema(actionSchema)
+				models = append(models, unwrapped)
+			}
+			for _, collision := range schemas.FindAttributeNameCollisions(models...) {
+				t.Errorf("Data source '%s' in service '%s': %s", dataSourceDef.ActionName, config.ServiceName, collision.String())
+			}
+		}
+	}
+}
+
+// TestAllReferenceAttributesResolve validates that every ReferenceAttributes entry names a registered
+// service, an action of that service and an input field of that action.
+func TestAllReferenceAttributesResolve(t *testing.T) {
+	allConfigs := actions.AllTerraformConfigs()
+
+	if len(allConfigs) == 0 {
+		t.Skip("No Terraform service configurations registered")
+	}
+
+	serviceSchemas := map[string]map[string]interface{}{}
+	for _, config := range allConfigs {
+		for _, resourceDef := range config.Resources {
+			serviceSchemas[config.ServiceName] = resourceDef.Schemas
+		}
+		for _, dataSourceDef := range config.DataSources {
+			serviceSchemas[config.ServiceName] = dataSourceDef.Schemas
+		}
+	}
+
+	for _, config := range allConfigs {
+		for _, resourceDef := range config.Resources {
+			if len(resourceDef.ReferenceAttributes) == 0 {
+				continue
+			}
+			t.Run(config.ServiceName+"/"+resourceDef.ActionName, func(t *testing.T) {
+				for _, reference := range schemas.AttributeReferences(nil, resourceDef.ReferenceAttributes) {
+					actionSchemas, exists := serviceSchemas[reference.Service]
+					if !exists {
+						t.Errorf("Reference of '%s' in resource '%s' names unknown service '%s'",
+							reference.Attribute, resourceDef.ActionName, reference.Service)
+						continue
+					}
+					actionSchema, exists := actionSchemas[reference.Action]
+					if !exists {
+						t.Errorf("Reference of '%s' in resource '%s' names action '%s' missing from the schemas of service '%s'",
+							reference.Attribute, resourceDef.ActionName, reference.Action, reference.Service)
+						continue
+					}
+					unwrapped, _ := modelsactions.UnwrapSchema(actionSchema)
+					if _, found := schemas.LookupAttributeValue(unwrapped, reference.InputField); !found {
+						t.Errorf("Reference of '%s' in resource '%s' names input field '%s' missing from action '%s'",
+							reference.Attribute, resourceDef.ActionName, reference.InputField, reference.Action)
+					}
+				}
+				if len(schemas.AttributeReferences(nil, resourceDef.ReferenceAttributes)) != len(resourceDef.ReferenceAttributes) {
+					t.Errorf("Resource '%s' declares malformed ReferenceAttributes: %v", resourceDef.ActionName, resourceDef.ReferenceAttributes)
+				}
+			})
+		}
+	}
+}
+
 // TestAllServiceActionMappingsHaveSchemas validates that all ActionsMappings have corresponding schema entries.
 func TestAllServiceActionMappingsHaveSchemas(t *testing.T) {
 	allConfigs := actions.AllTerraformConfigs()
@@ -133,6 +330,12 @@ func TestAllServiceActionMappingsHaveSchemas(t *testing.T) {
 							config.ServiceName, actionString, operation, resourceDef.ActionName)
 					}
 				}
+				if resourceDef.ListAction != "" {
+					if _, exists := resourceDef.Schemas[resourceDef.ListAction]; !exists {
+						t.Errorf("Service '%s': ListAction '%s' in resource '%s' is missing from schemas",
+							config.ServiceName, resourceDef.ListAction, resourceDef.ActionName)
+					}
+				}
 			})
 		}
 
@@ -146,6 +349,15 @@ func TestAllServiceActionMappingsHaveSchemas(t *testing.T) {
 				}
 			})
 		}
+
+		for _, actionDef := range config.Actions {
+			t.Run(config.ServiceName+"/"+actionDef.ActionName+"_action", func(t *testing.T) {
+				if _, exists := actionDef.Schemas[actionDef.InvokeAction]; !exists {
+					t.Errorf("Service '%s': InvokeAction '%s' in action '%s' is missing from schemas",
+						config.ServiceName, actionDef.InvokeAction, actionDef.ActionName)
+				}
+			})
+		}
 	}
 }
 
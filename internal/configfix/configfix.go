@@ -0,0 +1,320 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+// Package configfix rewrites Terraform configurations for attributes renamed between provider versions.
+// It edits the HCL syntax tree rather than the text, so the rest of a file, comments included, is kept.
+package configfix
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+)
+
+// Kinds of the schemas a rename applies to, as named by the top-level block declaring them.
+const (
+	KindResource   = "resource"
+	KindDataSource = "data"
+)
+
+// Rename is an attribute renamed in a release of the provider.
+type Rename struct {
+	// Kind is KindResource or KindDataSource.
+	Kind string `json:"kind"`
+	// Type is the type of the resource or data source, e.g. "idsec_pcloud_safe".
+	Type string `json:"type"`
+	// From is the path of the attribute before the rename, nested attributes and blocks joining names
+	// with dots, e.g. "metadata.policy_name".
+	From string `json:"from"`
+	// To is the new name of the attribute, which stays under the same parent, e.g. "name".
+	To string `json:"to"`
+	// Version is the version of the provider renaming the attribute, e.g. "1.4.0".
+	Version string `json:"version"`
+}
+
+// Validate checks the rename is complete and its version is valid.
+func (r Rename) Validate() error {
+	if r.Kind != KindResource && r.Kind != KindDataSource {
+		return fmt.Errorf("rename of %s.%s: kind must be %q or %q, got %q", r.Type, r.From, KindResource, KindDataSource, r.Kind)
+	}
+	if r.Type == "" || r.From == "" || r.To == "" {
+		return fmt.Errorf("rename of %s.%s: type, from and to are required", r.Type, r.From)
+	}
+	if strings.Contains(r.To, ".") {
+		return fmt.Errorf("rename of %s.%s: to must be a name, not a path, got %q", r.Type, r.From, r.To)
+	}
+	if _, err := version.NewVersion(r.Version); err != nil {
+		return fmt.Errorf("rename of %s.%s: invalid version %q: %w", r.Type, r.From, r.Version, err)
+	}
+	return nil
+}
+
+// path returns the names of the path of the renamed attribute.
+func (r Rename) path() []string {
+	return strings.Split(r.From, ".")
+}
+
+// Since returns the renames of releases later than fromVersion, in release order, for configurations
+// written for that version. All renames are returned when fromVersion is empty.
+func Since(renames []Rename, fromVersion string) ([]Rename, error) {
+	var from *version.Version
+	if fromVersion != "" {
+		parsed, err := version.NewVersion(fromVersion)
+		if err != nil {
+			return nil, fmt.Errorf("invalid version %q: %w", fromVersion, err)
+		}
+		from = parsed
+	}
+	var selected []Rename
+	for _, rename := range renames {
+		if err := rename.Validate(); err != nil {
+			return nil, err
+		}
+		if from == nil || version.Must(version.NewVersion(rename.Version)).GreaterThan(from) {
+			selected = append(selected, rename)
+		}
+	}
+	slices.SortStableFunc(selected, func(a, b Rename) int {
+		return version.Must(version.NewVersion(a.Version)).Compare(version.Must(version.NewVersion(b.Version)))
+	})
+	return selected, nil
+}
+
+// Fix applies renames to the content of a .tf file: the attributes, nested blocks and object keys of the
+// resources and data sources of the renamed types, their lifecycle ignore_changes, and the references
+// to the renamed attributes anywhere in the file. References from other files are fixed with those
+// files, so Fix must be given the resources and data sources of the whole module, as returned by
+// Declarations. It returns the fixed content and the number of changes made.
+func Fix(filename string, src []byte, renames []Rename, declared []Declaration) ([]byte, int, error) {
+	file, diags := hclwrite.ParseConfig(src, filename, hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, 0, diags
+	}
+	changes := 0
+	for _, rename := range renames {
+		for _, block := range file.Body().Blocks() {
+			if !declares(block, rename) {
+				continue
+			}
+			changes += renameInBody(block.Body(), rename.path(), rename.To)
+			for _, lifecycle := range block.Body().Blocks() {
+				if lifecycle.Type() != "lifecycle" {
+					continue
+				}
+				if attribute := lifecycle.Body().GetAttribute("ignore_changes"); attribute != nil {
+					changes += renameTraversals(attribute.Expr().BuildTokens(nil), nil, rename.path(), rename.To)
+				}
+			}
+		}
+		for _, declaration := range declared {
+			if declaration.Kind != rename.Kind || declaration.Type != rename.Type {
+				continue
+			}
+			changes += renameTraversals(file.BuildTokens(nil), declaration.address(), rename.path(), rename.To)
+		}
+	}
+	if changes == 0 {
+		return src, 0, nil
+	}
+	return hclwrite.Format(file.Bytes()), changes, nil
+}
+
+// Declaration is a resource or data source declared by a configuration.
+type Declaration struct {
+	Kind string
+	Type string
+	Name string
+}
+
+// address returns the names of the address of the declaration in references, e.g. "data",
+// "idsec_pcloud_safe" and "main".
+func (d Declaration) address() []string {
+	if d.Kind == KindDataSource {
+		return []string{KindDataSource, d.Type, d.Name}
+	}
+	return []string{d.Type, d.Name}
+}
+
+// Declarations returns the resources and data sources declared by the content of a .tf file.
+func Declarations(filename string, src []byte) ([]Declaration, error) {
+	file, diags := hclwrite.ParseConfig(src, filename, hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, diags
+	}
+	var declared []Declaration
+	for _, block := range file.Body().Blocks() {
+		labels := block.Labels()
+		if (block.Type() == KindResource || block.Type() == KindDataSource) && len(labels) == 2 {
+			declared = append(declared, Declaration{Kind: block.Type(), Type: labels[0], Name: labels[1]})
+		}
+	}
+	return declared, nil
+}
+
+// declares reports whether a top-level block declares a resource or data source the rename applies to.
+func declares(block *hclwrite.Block, rename Rename) bool {
+	labels := block.Labels()
+	return block.Type() == rename.Kind && len(labels) == 2 && labels[0] == rename.Type
+}
+
+// renameInBody renames the attribute or nested blocks at path in a body, descending through nested
+// blocks, dynamic blocks and object expressions, and returns the number of renames.
+func renameInBody(body *hclwrite.Body, path []string, to string) int {
+	changes := 0
+	name := path[0]
+	if len(path) == 1 {
+		if body.RenameAttribute(name, to) {
+			changes++
+		}
+	}
+	for _, block := range body.Blocks() {
+		switch {
+		case block.Type() == name && len(path) == 1:
+			block.SetType(to)
+			changes++
+		case block.Type() == name:
+			changes += renameInBody(block.Body(), path[1:], to)
+		case block.Type() == "dynamic" && slices.Equal(block.Labels(), []string{name}):
+			if len(path) == 1 {
+				block.SetLabels([]string{to})
+				changes++
+				continue
+			}
+			for _, content := range block.Body().Blocks() {
+				if content.Type() == "content" {
+					changes += renameInBody(content.Body(), path[1:], to)
+				}
+			}
+		}
+	}
+	if attribute := body.GetAttribute(name); attribute != nil && len(path) > 1 {
+		changes += renameObjectKeys(attribute.Expr().BuildTokens(nil), path[1:], to)
+	}
+	return changes
+}
+
+// keyFrame is an object, tuple or parenthesized expression opened while scanning tokens, with the path
+// of object keys leading to it.
+type keyFrame struct {
+	path   []string
+	object bool
+}
+
+// renameObjectKeys renames the keys at path of the objects of an expression, including objects nested
+// in lists, and returns the number of renames. Tokens are renamed in place.
+func renameObjectKeys(tokens hclwrite.Tokens, path []string, to string) int {
+	changes := 0
+	stack := []keyFrame{{}}
+	lastKey := ""
+	for i, token := range tokens {
+		top := stack[len(stack)-1]
+		switch token.Type {
+		case hclsyntax.TokenOBrace, hclsyntax.TokenOBrack, hclsyntax.TokenOParen:
+			framePath := top.path
+			if top.object {
+				framePath = append(slices.Clone(top.path), lastKey)
+			}
+			stack = append(stack, keyFrame{path: framePath, object: token.Type == hclsyntax.TokenOBrace})
+			lastKey = ""
+		case hclsyntax.TokenCBrace, hclsyntax.TokenCBrack, hclsyntax.TokenCParen:
+			if len(stack) > 1 {
+				stack = stack[:len(stack)-1]
+			}
+		case hclsyntax.TokenIdent, hclsyntax.TokenQuotedLit:
+			if !top.object || !isObjectKey(tokens, i) {
+				continue
+			}
+			lastKey = string(token.Bytes)
+			if slices.Equal(append(slices.Clone(top.path), lastKey), path) {
+				token.Bytes = []byte(to)
+				changes++
+			}
+		}
+	}
+	return changes
+}
+
+// isObjectKey reports whether the identifier or quoted literal at index i of an object is a key.
+func isObjectKey(tokens hclwrite.Tokens, i int) bool {
+	next := i + 1
+	if tokens[i].Type == hclsyntax.TokenQuotedLit {
+		if i == 0 || tokens[i-1].Type != hclsyntax.TokenOQuote || next >= len(tokens) || tokens[next].Type != hclsyntax.TokenCQuote {
+			return false
+		}
+		next++
+	}
+	return next < len(tokens) && (tokens[next].Type == hclsyntax.TokenEqual || tokens[next].Type == hclsyntax.TokenColon)
+}
+
+// renameTraversals renames the last name of the references to the attribute at path of the object at
+// address, e.g. idsec_pcloud_safe.main.safe_name, allowing index and splat steps between names, as in
+// idsec_pcloud_safe.main[0].safe_name. An empty address matches references relative to the object, as
+// in ignore_changes. Tokens are renamed in place; it returns the number of renames.
+func renameTraversals(tokens hclwrite.Tokens, address []string, path []string, to string) int {
+	names := append(slices.Clone(address), path...)
+	changes := 0
+	for i, token := range tokens {
+		if token.Type != hclsyntax.TokenIdent || string(token.Bytes) != names[0] {
+			continue
+		}
+		if i > 0 && tokens[i-1].Type == hclsyntax.TokenDot {
+			continue
+		}
+		if last, ok := matchTraversal(tokens, i, names); ok {
+			tokens[last].Bytes = []byte(to)
+			changes++
+		}
+	}
+	return changes
+}
+
+// matchTraversal reports whether the tokens from start are a traversal through names, and returns the
+// index of the token of the last name.
+func matchTraversal(tokens hclwrite.Tokens, start int, names []string) (int, bool) {
+	i := start
+	for _, name := range names[1:] {
+		i = skipIndexSteps(tokens, i+1)
+		if i+1 >= len(tokens) || tokens[i].Type != hclsyntax.TokenDot || tokens[i+1].Type != hclsyntax.TokenIdent || string(tokens[i+1].Bytes) != name {
+			return 0, false
+		}
+		i++
+	}
+	if next := i + 1; next < len(tokens) && (tokens[next].Type == hclsyntax.TokenEqual || tokens[next].Type == hclsyntax.TokenColon) {
+		// An object key named like the traversal, not a reference.
+		return 0, false
+	}
+	return i, true
+}
+
+// skipIndexSteps returns the index of the first token from i that is not part of an index step, such
+// as [0], ["key"], [*], .0 or .*.
+func skipIndexSteps(tokens hclwrite.Tokens, i int) int {
+	for i < len(tokens) {
+		switch {
+		case tokens[i].Type == hclsyntax.TokenOBrack:
+			depth := 0
+			for ; i < len(tokens); i++ {
+				if tokens[i].Type == hclsyntax.TokenOBrack {
+					depth++
+				} else if tokens[i].Type == hclsyntax.TokenCBrack {
+					depth--
+					if depth == 0 {
+						break
+					}
+				}
+			}
+			i++
+		case tokens[i].Type == hclsyntax.TokenDot && i+1 < len(tokens) &&
+			(tokens[i+1].Type == hclsyntax.TokenNumberLit || tokens[i+1].Type == hclsyntax.TokenStar):
+			i += 2
+		default:
+			return i
+		}
+	}
+	return i
+}
@@ -0,0 +1,232 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package configfix
+
+import (
+	"testing"
+)
+
+func TestFix(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		src     string
+		renames []Rename
+		want    string
+		changes int
+	}{
+		{
+			name: "top_level_attribute_and_references",
+			src: `resource "idsec_pcloud_safe" "main" {
+  safe_name = "crown-jewels" # the Safe of the team
+  description = "Team Safe"
+
+  lifecycle {
+    ignore_changes = [safe_name, description]
+  }
+}
+
+resource "idsec_pcloud_safe" "other" {
+  count     = 2
+  safe_name = "other-${count.index}"
+}
+
+output "name" {
+  value = "${idsec_pcloud_safe.main.safe_name}-${idsec_pcloud_safe.other[0].safe_name}"
+}
+
+output "names" {
+  value = idsec_pcloud_safe.other[*].safe_name
+}
+`,
+			renames: []Rename{{Kind: KindResource, Type: "idsec_pcloud_safe", From: "safe_name", To: "name", Version: "1.4.0"}},
+			want: `resource "idsec_pcloud_safe" "main" {
+  name        = "crown-jewels" # the Safe of the team
+  description = "Team Safe"
+
+  lifecycle {
+    ignore_changes = [name, description]
+  }
+}
+
+resource "idsec_pcloud_safe" "other" {
+  count = 2
+  name  = "other-${count.index}"
+}
+
+output "name" {
+  value = "${idsec_pcloud_safe.main.name}-${idsec_pcloud_safe.other[0].name}"
+}
+
+output "names" {
+  value = idsec_pcloud_safe.other[*].name
+}
+`,
+			changes: 6,
+		},
+		{
+			name: "nested_object_keys_and_blocks",
+			src: `resource "idsec_policy_vm" "main" {
+  metadata = {
+    policy_name = "vm"
+    status = {
+      policy_name = "unchanged"
+    }
+  }
+  principals = [{ policy_name = "alice" }]
+
+  rule {
+    policy_name = "in block"
+  }
+  dynamic "rule" {
+    for_each = var.rules
+    content {
+      policy_name = rule.value
+    }
+  }
+}
+
+output "name" {
+  value = idsec_policy_vm.main.metadata.policy_name
+}
+`,
+			renames: []Rename{
+				{Kind: KindResource, Type: "idsec_policy_vm", From: "metadata.policy_name", To: "name", Version: "1.4.0"},
+				{Kind: KindResource, Type: "idsec_policy_vm", From: "rule.policy_name", To: "name", Version: "1.4.0"},
+			},
+			want: `resource "idsec_policy_vm" "main" {
+  metadata = {
+    name = "vm"
+    status = {
+      policy_name = "unchanged"
+    }
+  }
+  principals = [{ policy_name = "alice" }]
+
+  rule {
+    name = "in block"
+  }
+  dynamic "rule" {
+    for_each = var.rules
+    content {
+      name = rule.value
+    }
+  }
+}
+
+output "name" {
+  value = idsec_policy_vm.main.metadata.name
+}
+`,
+			changes: 4,
+		},
+		{
+			name: "data_source_only",
+			src: `data "idsec_pcloud_safe" "main" {
+  safe_name = "crown-jewels"
+}
+
+resource "idsec_pcloud_safe" "main" {
+  safe_name = data.idsec_pcloud_safe.main.safe_name
+}
+`,
+			renames: []Rename{{Kind: KindDataSource, Type: "idsec_pcloud_safe", From: "safe_name", To: "name", Version: "1.4.0"}},
+			want: `data "idsec_pcloud_safe" "main" {
+  name = "crown-jewels"
+}
+
+resource "idsec_pcloud_safe" "main" {
+  safe_name = data.idsec_pcloud_safe.main.name
+}
+`,
+			changes: 2,
+		},
+		{
+			name: "no_match",
+			src: `resource "idsec_cmgr_pool" "main" {
+  name  = "pool"
+}
+`,
+			renames: []Rename{{Kind: KindResource, Type: "idsec_pcloud_safe", From: "safe_name", To: "name", Version: "1.4.0"}},
+			want: `resource "idsec_cmgr_pool" "main" {
+  name  = "pool"
+}
+`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			declared, err := Declarations("main.tf", []byte(tt.src))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			got, changes, err := Fix("main.tf", []byte(tt.src), tt.renames, declared)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("unexpected fixed file:\n%s\nexpected:\n%s", got, tt.want)
+			}
+			if changes != tt.changes {
+				t.Errorf("expected %d changes, got %d", tt.changes, changes)
+			}
+		})
+	}
+}
+
+func TestFixReferencesAcrossFiles(t *testing.T) {
+	t.Parallel()
+
+	declared, err := Declarations("main.tf", []byte(`resource "idsec_pcloud_safe" "main" {
+  safe_name = "crown-jewels"
+}
+`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	renames := []Rename{{Kind: KindResource, Type: "idsec_pcloud_safe", From: "safe_name", To: "name", Version: "1.4.0"}}
+	got, changes, err := Fix("outputs.tf", []byte("output \"safe\" {\n  value = idsec_pcloud_safe.main.safe_name\n}\n"), renames, declared)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "output \"safe\" {\n  value = idsec_pcloud_safe.main.name\n}\n"; string(got) != want || changes != 1 {
+		t.Errorf("expected the reference of outputs.tf to be renamed once, got %d changes:\n%s", changes, got)
+	}
+}
+
+func TestSince(t *testing.T) {
+	t.Parallel()
+
+	renames := []Rename{
+		{Kind: KindResource, Type: "idsec_pcloud_safe", From: "title", To: "label", Version: "1.5.0"},
+		{Kind: KindResource, Type: "idsec_pcloud_safe", From: "safe_name", To: "name", Version: "1.3.0"},
+		{Kind: KindResource, Type: "idsec_pcloud_safe", From: "name", To: "title", Version: "1.4.0"},
+	}
+	selected, err := Since(renames, "1.3.0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(selected) != 2 || selected[0].Version != "1.4.0" || selected[1].Version != "1.5.0" {
+		t.Errorf("expected the renames of 1.4.0 and 1.5.0 in release order, got %+v", selected)
+	}
+	if all, err := Since(renames, ""); err != nil || len(all) != 3 || all[0].Version != "1.3.0" {
+		t.Errorf("expected all the renames in release order, got %+v, %v", all, err)
+	}
+	if _, err := Since([]Rename{{Kind: "module", Type: "x", From: "a", To: "b", Version: "1.0.0"}}, ""); err == nil {
+		t.Error("expected an error for an invalid kind")
+	}
+	if _, err := Since([]Rename{{Kind: KindResource, Type: "x", From: "a", To: "b.c", Version: "1.0.0"}}, ""); err == nil {
+		t.Error("expected an error for a path as new name")
+	}
+}
+
+func TestRenamesAreValid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := Since(Renames, ""); err != nil {
+		t.Errorf("invalid bundled rename: %v", err)
+	}
+}
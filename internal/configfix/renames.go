@@ -0,0 +1,9 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package configfix
+
+// Renames lists the attributes renamed by releases of the provider, which tools/configfix applies to the
+// configurations of users upgrading. Add an entry to it in the change renaming an attribute, with the
+// version of the release the change ships in.
+var Renames = []Rename{}
@@ -0,0 +1,204 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+// Package enumcheck compares the `choices` tags of the SDK models with the string constants the SDK
+// declares for the same values, to surface an enum gaining or losing a value without the validation of
+// the schema following. Constants are not visible through reflection, so they are read from the SDK
+// source.
+package enumcheck
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ConstGroup is a const declaration of string values, such as
+//
+//	const (
+//		OSTypeWindows = "windows"
+//		OSTypeLinux   = "linux"
+//	)
+type ConstGroup struct {
+	// Package is the import path of the package declaring the constants.
+	Package string
+	// Position is the file and line of the declaration.
+	Position string
+	// Names are the names of the constants.
+	Names []string
+	// Values are the values of the constants, in declaration order.
+	Values []string
+}
+
+// ConstGroups returns the const declarations of at least two string literals of the Go packages under
+// dir, the root of the module of path modulePath. Test files are skipped.
+func ConstGroups(dir string, modulePath string) ([]ConstGroup, error) {
+	var groups []ConstGroup
+	fileSet := token.NewFileSet()
+	err := filepath.WalkDir(dir, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() && (entry.Name() == "testdata" || strings.HasPrefix(entry.Name(), ".")) && filePath != dir {
+			return filepath.SkipDir
+		}
+		if entry.IsDir() || !strings.HasSuffix(filePath, ".go") || strings.HasSuffix(filePath, "_test.go") {
+			return nil
+		}
+		file, err := parser.ParseFile(fileSet, filePath, nil, parser.SkipObjectResolution)
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, filepath.Dir(filePath))
+		if err != nil {
+			return err
+		}
+		importPath := path.Join(modulePath, filepath.ToSlash(rel))
+		for _, decl := range file.Decls {
+			if group, ok := constGroup(fileSet, decl, importPath); ok {
+				groups = append(groups, group)
+			}
+		}
+		return nil
+	})
+	return groups, err
+}
+
+// constGroup returns the string constants of a declaration, when it is a const declaration of at least
+// two of them.
+func constGroup(fileSet *token.FileSet, decl ast.Decl, importPath string) (ConstGroup, bool) {
+	genDecl, ok := decl.(*ast.GenDecl)
+	if !ok || genDecl.Tok != token.CONST {
+		return ConstGroup{}, false
+	}
+	group := ConstGroup{Package: importPath, Position: fileSet.Position(genDecl.Pos()).String()}
+	for _, spec := range genDecl.Specs {
+		valueSpec := spec.(*ast.ValueSpec)
+		for i, name := range valueSpec.Names {
+			if i >= len(valueSpec.Values) || !name.IsExported() {
+				continue
+			}
+			literal, ok := valueSpec.Values[i].(*ast.BasicLit)
+			if !ok || literal.Kind != token.STRING {
+				continue
+			}
+			value, err := strconv.Unquote(literal.Value)
+			if err != nil {
+				continue
+			}
+			group.Names = append(group.Names, name.Name)
+			group.Values = append(group.Values, value)
+		}
+	}
+	return group, len(group.Values) >= 2
+}
+
+// Field is a field of an SDK model with a `choices` tag.
+type Field struct {
+	// Package is the import path of the package declaring the struct.
+	Package string
+	// Struct is the name of the struct.
+	Struct string
+	// Name is the name of the field.
+	Name string
+	// Choices are the values of the tag.
+	Choices []string
+}
+
+// ChoicesFields returns the fields with a `choices` tag of the given structs and the structs they
+// reference, each field once.
+func ChoicesFields(types ...reflect.Type) []Field {
+	var fields []Field
+	visited := map[reflect.Type]bool{}
+	var walk func(reflect.Type)
+	walk = func(t reflect.Type) {
+		for t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice || t.Kind() == reflect.Array || t.Kind() == reflect.Map {
+			t = t.Elem()
+		}
+		if t.Kind() != reflect.Struct || visited[t] {
+			return
+		}
+		visited[t] = true
+		for i := range t.NumField() {
+			field := t.Field(i)
+			if choices := field.Tag.Get("choices"); choices != "" {
+				fields = append(fields, Field{Package: t.PkgPath(), Struct: t.Name(), Name: field.Name, Choices: strings.Split(choices, ",")})
+			}
+			walk(field.Type)
+		}
+	}
+	for _, t := range types {
+		walk(t)
+	}
+	return fields
+}
+
+// Drift is a field whose choices differ from the constants declaring the same enum.
+type Drift struct {
+	Field Field
+	Group ConstGroup
+	// Undeclared are the choices with no constant in the group, typically values the SDK removed.
+	Undeclared []string
+	// Unchoosable are the constants of the group missing from the choices, typically values the SDK
+	// added and the schema rejects.
+	Unchoosable []string
+}
+
+// Check compares each field with the const group of its package sharing the most values with its
+// choices, the smallest group winning a tie as groups of related enums often overlap, and returns the
+// fields differing from it. Fields sharing less than two values with every group of their package have
+// no enum constants and are not checked.
+func Check(fields []Field, groups []ConstGroup) []Drift {
+	var drifts []Drift
+	for _, field := range fields {
+		best, bestShared := -1, 1
+		for i, group := range groups {
+			if group.Package != field.Package {
+				continue
+			}
+			shared := len(intersect(field.Choices, group.Values))
+			if shared > bestShared || (shared == bestShared && best >= 0 && len(group.Values) < len(groups[best].Values)) {
+				best, bestShared = i, shared
+			}
+		}
+		if best < 0 {
+			continue
+		}
+		group := groups[best]
+		undeclared := difference(field.Choices, group.Values)
+		unchoosable := difference(group.Values, field.Choices)
+		if len(undeclared) > 0 || len(unchoosable) > 0 {
+			drifts = append(drifts, Drift{Field: field, Group: group, Undeclared: undeclared, Unchoosable: unchoosable})
+		}
+	}
+	return drifts
+}
+
+// intersect returns the values of a that are also in b.
+func intersect(a []string, b []string) []string {
+	var values []string
+	for _, value := range a {
+		if slices.Contains(b, value) {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// difference returns the values of a that are not in b.
+func difference(a []string, b []string) []string {
+	var values []string
+	for _, value := range a {
+		if !slices.Contains(b, value) {
+			values = append(values, value)
+		}
+	}
+	return values
+}
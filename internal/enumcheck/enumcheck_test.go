@@ -0,0 +1,74 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package enumcheck
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+)
+
+func TestConstGroups(t *testing.T) {
+	t.Parallel()
+
+	groups, err := ConstGroups("testdata/sdk", "example.com/sdk")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(groups) != 2 {
+		t.Fatalf("expected the 2 groups of exported string constants, got %+v", groups)
+	}
+	if groups[0].Package != "example.com/sdk/models" {
+		t.Errorf("unexpected package %q", groups[0].Package)
+	}
+	if !slices.Equal(groups[0].Values, []string{"EXACTLY", "WILDCARD", "PREFIX"}) || groups[0].Names[2] != "OperatorPrefix" {
+		t.Errorf("unexpected group %+v", groups[0])
+	}
+}
+
+type rule struct {
+	Operator string `choices:"EXACTLY,WILDCARD"`
+	Targets  []*ipRule
+}
+
+type ipRule struct {
+	Operator string `choices:"EXACTLY,WILDCARD"`
+	Name     string
+}
+
+func TestChoicesFields(t *testing.T) {
+	t.Parallel()
+
+	fields := ChoicesFields(reflect.TypeOf(&rule{}), reflect.TypeOf(ipRule{}))
+	if len(fields) != 2 || fields[0].Struct != "rule" || fields[1].Struct != "ipRule" {
+		t.Fatalf("expected the fields of both structs once, got %+v", fields)
+	}
+	if !slices.Equal(fields[1].Choices, []string{"EXACTLY", "WILDCARD"}) || fields[1].Package != reflect.TypeOf(rule{}).PkgPath() {
+		t.Errorf("unexpected field %+v", fields[1])
+	}
+}
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	groups := []ConstGroup{
+		{Package: "sdk/models", Values: []string{"EXACTLY", "WILDCARD", "PREFIX"}},
+		{Package: "sdk/models", Values: []string{"EXACTLY", "WILDCARD"}},
+		{Package: "sdk/models", Values: []string{"Active", "Suspended", "Expired"}},
+		{Package: "sdk/other", Values: []string{"windows", "linux"}},
+	}
+	fields := []Field{
+		{Package: "sdk/models", Name: "IPOperator", Choices: []string{"EXACTLY", "WILDCARD"}},
+		{Package: "sdk/models", Name: "Status", Choices: []string{"Active", "Suspended", "Deleted"}},
+		{Package: "sdk/models", Name: "Mode", Choices: []string{"Active", "Passive"}},
+		{Package: "sdk/models", Name: "OSType", Choices: []string{"windows", "darwin"}},
+	}
+	drifts := Check(fields, groups)
+	if len(drifts) != 1 {
+		t.Fatalf("expected only the drift of Status, got %+v", drifts)
+	}
+	if drifts[0].Field.Name != "Status" || !slices.Equal(drifts[0].Undeclared, []string{"Deleted"}) || !slices.Equal(drifts[0].Unchoosable, []string{"Expired"}) {
+		t.Errorf("unexpected drift %+v", drifts[0])
+	}
+}
@@ -0,0 +1,20 @@
+package models
+
+// Possible operators of the rules.
+const (
+	OperatorExactly  = "EXACTLY"
+	OperatorWildcard = "WILDCARD"
+	OperatorPrefix   = "PREFIX"
+)
+
+// Possible operators of the IP rules.
+const (
+	IPOperatorExactly  = "EXACTLY"
+	IPOperatorWildcard = "WILDCARD"
+)
+
+const (
+	defaultPageSize = "100"
+	MaxRetries      = 3
+	LoneConstant    = "lone"
+)
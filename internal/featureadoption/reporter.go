@@ -29,6 +29,23 @@ const (
 	TagKeyTFResource = "tfr"
 )
 
+// correlationID overrides the SDK correlation ID reported in custom_data when set.
+var correlationID string
+
+// SetCorrelationID overrides the correlation ID reported to FAS, so reports match the correlation ID
+// configured on the provider. An empty value falls back to the SDK correlation ID.
+func SetCorrelationID(id string) {
+	correlationID = id
+}
+
+// disabled turns the reports off, for providers configured for environments without outbound access.
+var disabled bool
+
+// SetDisabled turns the FAS reports of all operations off, or back on.
+func SetDisabled(value bool) {
+	disabled = value
+}
+
 // ReportOptions holds optional parameters for FAS reporting. Extensible for future tags (e.g. operation_duration_ms).
 type ReportOptions struct {
 	// OperationDuration is how long the operation took. If set, adds "time" to custom_data (milliseconds).
@@ -83,12 +100,15 @@ func diagErrorSummaries(diagnostics *diag.Diagnostics) string {
 }
 
 // ReportAsync sends a feature adoption report to FAS (synchronous so logs are emitted before handler returns).
-// The token is resolved from idsecAPI internally by the SDK. Skips if FAS URL is unset, telemetry is disabled,
-// no auth configured, or token is not JWT (PVWA).
+// The token is resolved from idsecAPI internally by the SDK. Skips if reports are disabled, FAS URL is unset,
+// telemetry is disabled, no auth configured, or token is not JWT (PVWA).
 // For resources use isDataSource=false (adds terraform_resource tag); for data sources use isDataSource=true (adds terraform_data_source tag).
 // opts can be nil; when provided, OperationDuration/OperationStatus/Message are merged into custom_data
 // and ExtraTags are merged into report tags.
 func ReportAsync(ctx context.Context, idsecAPI *api.IdsecAPI, opts *ReportOptions) {
+	if disabled {
+		return
+	}
 	tags := buildTags(opts)
 	customData := buildCustomData(opts)
 
@@ -123,6 +143,9 @@ func buildTags(opts *ReportOptions) (tags map[string]string) {
 func buildCustomData(opts *ReportOptions) (customData map[string]interface{}) {
 	customData = make(map[string]interface{})
 	customData["correlation_id"] = config.CorrelationID()
+	if correlationID != "" {
+		customData["correlation_id"] = correlationID
+	}
 
 	if opts == nil {
 		return customData
@@ -0,0 +1,96 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+// Package profiling exposes pprof and heap summaries of the provider process when it runs in debug
+// mode, so users can attach profiles to reports of excessive memory or CPU usage.
+package profiling
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	// IdsecPprofAddressEnvVar Environment variable overriding the address of the pprof endpoint in debug mode.
+	IdsecPprofAddressEnvVar = "IDSEC_PPROF_ADDRESS"
+	// DefaultAddress is the address of the pprof endpoint when IDSEC_PPROF_ADDRESS is not set.
+	DefaultAddress = "localhost:6060"
+	// DefaultHeapSummaryInterval is the minimum interval between two heap summaries.
+	DefaultHeapSummaryInterval = 30 * time.Second
+)
+
+// Serve starts the pprof endpoint on address, which must be a loopback address since profiles expose
+// the memory of the process. It returns the address listened on and a function stopping the endpoint.
+func Serve(address string) (string, func() error, error) {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid pprof address %q: %w", address, err)
+	}
+	if host != "localhost" {
+		ip := net.ParseIP(host)
+		if ip == nil || !ip.IsLoopback() {
+			return "", nil, fmt.Errorf("pprof address %q must be a loopback address", address)
+		}
+	}
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to listen on %s: %w", address, err)
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	server := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	return listener.Addr().String(), server.Close, nil
+}
+
+// HeapSummary describes the memory usage of the process in a single line.
+func HeapSummary() string {
+	var stats runtime.MemStats
+	runtime.ReadMemStats(&stats)
+	return fmt.Sprintf("heap_alloc=%dMiB heap_inuse=%dMiB heap_objects=%d sys=%dMiB num_gc=%d goroutines=%d",
+		stats.HeapAlloc>>20, stats.HeapInuse>>20, stats.HeapObjects, stats.Sys>>20, stats.NumGC, runtime.NumGoroutine())
+}
+
+// HeapReporter logs heap summaries at most once per interval. Summaries are logged with the logger of
+// the context of provider operations, so they are reported alongside the operations causing them.
+// A nil reporter logs nothing.
+type HeapReporter struct {
+	interval time.Duration
+	mu       sync.Mutex
+	last     time.Time
+}
+
+// NewHeapReporter creates a reporter logging at most one heap summary per interval.
+func NewHeapReporter(interval time.Duration) *HeapReporter {
+	return &HeapReporter{interval: interval}
+}
+
+// Report logs a heap summary when the interval elapsed since the last one.
+func (r *HeapReporter) Report(ctx context.Context) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	now := time.Now()
+	if !r.last.IsZero() && now.Sub(r.last) < r.interval {
+		r.mu.Unlock()
+		return
+	}
+	r.last = now
+	r.mu.Unlock()
+	tflog.Debug(ctx, fmt.Sprintf("Heap summary: %s", HeapSummary()))
+}
@@ -0,0 +1,59 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package profiling
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestServe(t *testing.T) {
+	address, stop, err := Serve("127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer func() { _ = stop() }()
+
+	response, err := http.Get("http://" + address + "/debug/pprof/heap?debug=1")
+	if err != nil {
+		t.Fatalf("failed to fetch heap profile: %v", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode != http.StatusOK {
+		t.Errorf("expected status 200, got %d", response.StatusCode)
+	}
+}
+
+func TestServeRejectsNonLoopbackAddress(t *testing.T) {
+	for _, address := range []string{"0.0.0.0:6060", "example.com:6060", "6060"} {
+		if _, _, err := Serve(address); err == nil {
+			t.Errorf("expected address %q to be rejected", address)
+		}
+	}
+}
+
+func TestHeapSummary(t *testing.T) {
+	summary := HeapSummary()
+	for _, field := range []string{"heap_alloc=", "heap_inuse=", "goroutines="} {
+		if !strings.Contains(summary, field) {
+			t.Errorf("expected summary to contain %s, got %q", field, summary)
+		}
+	}
+}
+
+func TestHeapReporterInterval(t *testing.T) {
+	var nilReporter *HeapReporter
+	nilReporter.Report(context.Background())
+
+	reporter := NewHeapReporter(time.Hour)
+	reporter.Report(context.Background())
+	first := reporter.last
+	reporter.Report(context.Background())
+	if !reporter.last.Equal(first) {
+		t.Error("expected no second summary within the interval")
+	}
+}
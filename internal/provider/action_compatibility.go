@@ -0,0 +1,123 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	api "github.com/cyberark/idsec-sdk-golang/pkg"
+	"github.com/cyberark/terraform-provider-idsec/internal/actions"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+// actionCompatibilityErrors caches the result of checkActionCompatibility by service and action names,
+// since the check only depends on the SDK the provider was built with.
+var actionCompatibilityErrors sync.Map
+
+// methodByNameFold returns the method of t named name, compared case-insensitively like the lookups of
+// schemas.FindMethodByName at runtime.
+func methodByNameFold(t reflect.Type, name string) (reflect.Method, bool) {
+	if method, ok := t.MethodByName(name); ok {
+		return method, true
+	}
+	for i := 0; i < t.NumMethod(); i++ {
+		if method := t.Method(i); strings.EqualFold(method.Name, name) {
+			return method, true
+		}
+	}
+	return reflect.Method{}, false
+}
+
+// actionMethodName converts an SDK action name such as "list-by" to the name of its service method.
+func actionMethodName(actionName string) string {
+	return strings.ReplaceAll(cases.Title(language.English).String(actionName), "-", "")
+}
+
+// serviceType returns the type of the SDK service named serviceName, as returned by its accessor on the
+// IdsecAPI, without creating the service.
+func serviceType(serviceName string) (reflect.Type, error) {
+	helper := IdsecServiceHelper{}
+	accessorName := helper.serviceNameTitled(serviceName)
+	accessor, ok := methodByNameFold(reflect.TypeOf(&api.IdsecAPI{}), accessorName)
+	if !ok {
+		return nil, fmt.Errorf("the SDK has no service %s", serviceName)
+	}
+	if accessor.Type.NumOut() < 1 {
+		return nil, fmt.Errorf("the SDK accessor of service %s returns no service", serviceName)
+	}
+	return accessor.Type.Out(0), nil
+}
+
+// checkActionCompatibility verifies that the SDK service named serviceName has a method for each of the
+// action names, so definitions that do not match the SDK the provider is built with are reported before
+// any API call rather than in the middle of an apply.
+func checkActionCompatibility(serviceName string, actionNames []string) error {
+	cacheKey := serviceName + "|" + strings.Join(actionNames, ",")
+	if cached, ok := actionCompatibilityErrors.Load(cacheKey); ok {
+		err, _ := cached.(error)
+		return err
+	}
+	err := findMissingActionMethods(serviceName, actionNames)
+	actionCompatibilityErrors.Store(cacheKey, err)
+	return err
+}
+
+func findMissingActionMethods(serviceName string, actionNames []string) error {
+	service, err := serviceType(serviceName)
+	if err != nil {
+		return err
+	}
+	var missing []string
+	for _, actionName := range actionNames {
+		if _, ok := methodByNameFold(service, actionMethodName(actionName)); !ok {
+			missing = append(missing, actionName)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	sort.Strings(missing)
+	return fmt.Errorf("the SDK service %s has no method for the actions %s", serviceName, strings.Join(missing, ", "))
+}
+
+// resourceActionNames returns the SDK actions called by a resource.
+func resourceActionNames(definition *actions.IdsecServiceTerraformResourceActionDefinition) []string {
+	var names []string
+	for _, operation := range definition.SupportedOperations {
+		if actionName, ok := definition.ActionsMappings[operation]; ok {
+			names = append(names, actionName)
+		}
+	}
+	if definition.ListAction != "" {
+		names = append(names, definition.ListAction)
+	}
+	return names
+}
+
+// logActionCompatibility logs the definitions whose actions are missing from the SDK while the provider
+// registers them, so a provider built against a mismatching SDK is spotted from its startup logs.
+func logActionCompatibility(ctx context.Context, kind string, actionName string, serviceName string, actionNames []string) {
+	if err := checkActionCompatibility(serviceName, actionNames); err != nil {
+		tflog.Error(ctx, fmt.Sprintf("The %s %s is incompatible with the SDK: %s", kind, actionName, err.Error()))
+	}
+}
+
+// addActionCompatibilityError raises an error diagnostic when the actions of a definition are missing from
+// the SDK. It is called while validating configurations, so plans fail before any change is applied.
+func addActionCompatibilityError(typeName string, serviceName string, actionNames []string, diagnostics *diag.Diagnostics) {
+	if err := checkActionCompatibility(serviceName, actionNames); err != nil {
+		diagnostics.AddError(
+			"Incompatible Provider Build",
+			fmt.Sprintf("%s cannot be used with this provider build: %s. Upgrade the provider to a build matching its SDK.", typeName, err.Error()),
+		)
+	}
+}
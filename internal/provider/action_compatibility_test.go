@@ -0,0 +1,51 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/cyberark/terraform-provider-idsec/internal/actions"
+)
+
+func TestCheckActionCompatibility(t *testing.T) {
+	if err := checkActionCompatibility("pcloud-safes", []string{"create", "get", "list-by"}); err != nil {
+		t.Errorf("expected existing actions to be compatible, got %v", err)
+	}
+	err := checkActionCompatibility("pcloud-safes", []string{"get", "archive-forever"})
+	if err == nil || !strings.Contains(err.Error(), "archive-forever") {
+		t.Errorf("expected the missing action to be reported, got %v", err)
+	}
+	if err := checkActionCompatibility("no-such-service", []string{"get"}); err == nil {
+		t.Error("expected an unknown service to be reported")
+	}
+	var diagnostics diag.Diagnostics
+	addActionCompatibilityError("idsec_pcloud_safe", "pcloud-safes", []string{"archive-forever"}, &diagnostics)
+	if diagnostics.ErrorsCount() != 1 {
+		t.Errorf("expected one error diagnostic, got %v", diagnostics)
+	}
+}
+
+// TestAllActionDefinitionsCompatibleWithSDK validates that every registered definition only maps actions the SDK services implement.
+func TestAllActionDefinitionsCompatibleWithSDK(t *testing.T) {
+	for _, config := range actions.AllTerraformConfigs() {
+		for _, resourceDef := range config.Resources {
+			if err := checkActionCompatibility(config.ServiceName, resourceActionNames(resourceDef)); err != nil {
+				t.Errorf("resource %s: %v", resourceDef.ActionName, err)
+			}
+		}
+		for _, dataSourceDef := range config.DataSources {
+			if err := checkActionCompatibility(config.ServiceName, []string{dataSourceDef.DataSourceAction}); err != nil {
+				t.Errorf("data source %s: %v", dataSourceDef.ActionName, err)
+			}
+		}
+		for _, actionDef := range config.Actions {
+			if err := checkActionCompatibility(config.ServiceName, []string{actionDef.InvokeAction}); err != nil {
+				t.Errorf("action %s: %v", actionDef.ActionName, err)
+			}
+		}
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"reflect"
+
+	"github.com/cyberark/idsec-sdk-golang/pkg/services"
+	"github.com/cyberark/terraform-provider-idsec/internal/schemas"
+)
+
+// actionInvoker resolves the methods implementing the actions of a service, such as the Create method of
+// the Safes service of the SDK. The methods are called with the decoded input of the operation, and return
+// the API response and an error, or only an error.
+type actionInvoker interface {
+	// actionMethod returns the method of the action of the given name, in TitleCase such as "AddMember".
+	actionMethod(actionName string) (*reflect.Value, error)
+}
+
+// serviceActionInvoker resolves the action methods of an SDK service by reflection.
+type serviceActionInvoker struct {
+	service services.IdsecService
+}
+
+// actionMethod returns the method of the service matching the action name, case-insensitively.
+func (i serviceActionInvoker) actionMethod(actionName string) (*reflect.Value, error) {
+	return schemas.FindMethodByName(reflect.ValueOf(i.service), actionName)
+}
+
+// getActionInvoker returns the invoker of the actions of the service: the one set on the helper, which
+// unit tests set to stand in for the SDK, or else one over the configured service. It returns nil when
+// the service is not configured.
+func (h *IdsecServiceHelper) getActionInvoker() actionInvoker {
+	if h.invoker != nil {
+		return h.invoker
+	}
+	if service := h.getServiceInstance(); service != nil {
+		return serviceActionInvoker{service: service}
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/cyberark/terraform-provider-idsec/internal/actions"
+	"github.com/cyberark/terraform-provider-idsec/internal/schemas"
+)
+
+// alreadyExistsErrorPatterns are the error fragments returned by the Idsec APIs when a create fails
+// because the object already exists. A plain 409 is not one of them: it is returned as well for
+// conflicts such as a locked object, which adopting would hide.
+var alreadyExistsErrorPatterns = []string{
+	"already exist",
+}
+
+// isAlreadyExistsDiagnostic reports whether an error diagnostic reports an object that already exists.
+func isAlreadyExistsDiagnostic(diagnostic diag.Diagnostic) bool {
+	return matchesErrorPatterns(errors.New(diagnostic.Detail()), alreadyExistsErrorPatterns)
+}
+
+// addAdoptExistingAttribute adds the adopt_existing attribute to the schema of resources that can be both
+// created and read, unless the models already declare an attribute of that name.
+func (s *IdsecResource) addAdoptExistingAttribute(resourceSchema *schema.Schema) {
+	if !slices.Contains(s.actionDefinition.SupportedOperations, actions.CreateOperation) ||
+		!slices.Contains(s.actionDefinition.SupportedOperations, actions.ReadOperation) {
+		return
+	}
+	if _, exists := resourceSchema.Attributes[schemas.AdoptExistingAttributeName]; exists {
+		return
+	}
+	resourceSchema.Attributes[schemas.AdoptExistingAttributeName] = schemas.AdoptExistingAttribute()
+}
+
+// adoptsExisting reports whether adopt_existing is true in the plan.
+func adoptsExisting(ctx context.Context, plan tfsdk.Plan) bool {
+	if plan.Raw.IsNull() {
+		return false
+	}
+	var adopt types.Bool
+	if diags := plan.GetAttribute(ctx, path.Root(schemas.AdoptExistingAttributeName), &adopt); diags.HasError() {
+		return false
+	}
+	return adopt.ValueBool()
+}
+
+// adoptionState returns the state the existing object is read from to adopt it. Like the state of an
+// import, it holds only the attributes of the import ID, taken from the plan. The second return value is
+// false when the resource has no import ID or the plan does not configure all of its attributes, in
+// which case the object is not adopted, as reading it from other planned values could adopt another one.
+func (s *IdsecResource) adoptionState(ctx context.Context, plan tfsdk.Plan, diagnostics *diag.Diagnostics) (tfsdk.State, bool) {
+	state := tfsdk.State{Schema: plan.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)}
+	attributes := schemas.SplitImportIDAttributes(s.getImportID())
+	if len(attributes) == 0 {
+		return state, false
+	}
+	for _, attribute := range attributes {
+		attrPath, err := schemas.ParseImportAttributePath(attribute)
+		if err != nil {
+			return state, false
+		}
+		var value types.String
+		if diags := plan.GetAttribute(ctx, attrPath, &value); diags.HasError() || value.IsNull() || value.IsUnknown() || value.ValueString() == "" {
+			return state, false
+		}
+		if diags := state.SetAttribute(ctx, attrPath, value); diags.HasError() {
+			diagnostics.Append(diags...)
+			return state, false
+		}
+	}
+	return state, true
+}
+
+// createOrAdopt creates the resource. When the create fails because the object already exists and
+// adopt_existing is true, the existing object is read from the attributes of its import ID configured in
+// the plan and, where the resource supports updates, updated to the plan, instead of failing.
+func (s *IdsecResource) createOrAdopt(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var createDiagnostics diag.Diagnostics
+	s.triggerOperation(ctx, actions.CreateOperation, &createDiagnostics, &req.Plan, nil, nil, &resp.State, nil)
+	if !createDiagnostics.HasError() || !adoptsExisting(ctx, req.Plan) || !slices.ContainsFunc(createDiagnostics.Errors(), isAlreadyExistsDiagnostic) {
+		resp.Diagnostics.Append(createDiagnostics...)
+		return
+	}
+	resp.Diagnostics.Append(createDiagnostics.Warnings()...)
+	typeName := s.getTerraformTypeName(s.actionDefinition.ActionName)
+	tflog.Info(ctx, fmt.Sprintf("The %s already exists, adopting it", typeName))
+
+	existing, ok := s.adoptionState(ctx, req.Plan, &resp.Diagnostics)
+	if !ok {
+		resp.Diagnostics.Append(createDiagnostics.Errors()...)
+		resp.Diagnostics.AddAttributeError(
+			path.Root(schemas.AdoptExistingAttributeName),
+			"Existing Object Not Adopted",
+			fmt.Sprintf("The %s already exists but is not adopted, as the attributes of its import ID are not all configured. Configure them, or import it instead.", typeName),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	var readDiagnostics diag.Diagnostics
+	s.triggerOperation(ctx, actions.ReadOperation, &readDiagnostics, nil, &existing, nil, &resp.State, nil)
+	if readDiagnostics.HasError() || resp.State.Raw.IsNull() {
+		resp.Diagnostics.Append(createDiagnostics.Errors()...)
+		resp.Diagnostics.Append(readDiagnostics...)
+		resp.Diagnostics.AddAttributeError(
+			path.Root(schemas.AdoptExistingAttributeName),
+			"Existing Object Not Adopted",
+			fmt.Sprintf("The %s already exists but could not be read to adopt it. Import it instead.", typeName),
+		)
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	resp.Diagnostics.Append(readDiagnostics...)
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root(schemas.AdoptExistingAttributeName),
+		"Existing Object Adopted",
+		fmt.Sprintf("The %s already existed and was adopted instead of created. Destroying the resource deletes it.", typeName),
+	)
+	if !slices.Contains(s.actionDefinition.SupportedOperations, actions.UpdateOperation) {
+		if err := applyPlannedValues(req.Plan, &resp.State); err != nil {
+			resp.Diagnostics.AddError("State Conversion Error", fmt.Sprintf("Failed to build the state of the adopted object: %s", err.Error()))
+		}
+		return
+	}
+	adopted := tfsdk.State{Schema: resp.State.Schema, Raw: resp.State.Raw.Copy()}
+	s.triggerOperation(ctx, actions.UpdateOperation, &resp.Diagnostics, &req.Plan, &adopted, &req.Config, &resp.State, nil)
+}
+
+// applyPlannedValues sets the top-level attributes of state whose planned value is known to it, as the
+// state of a create must match the plan where it is known, and the state read from the adopted object
+// lacks the attributes not returned by the API, such as adopt_existing.
+func applyPlannedValues(plan tfsdk.Plan, state *tfsdk.State) error {
+	var planned, current map[string]tftypes.Value
+	if err := plan.Raw.As(&planned); err != nil {
+		return err
+	}
+	if err := state.Raw.As(&current); err != nil {
+		return err
+	}
+	for name, value := range planned {
+		if value.IsFullyKnown() {
+			current[name] = value
+		}
+	}
+	state.Raw = tftypes.NewValue(state.Raw.Type(), current)
+	return nil
+}
@@ -0,0 +1,127 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/cyberark/terraform-provider-idsec/internal/actions"
+	"github.com/cyberark/terraform-provider-idsec/internal/schemas"
+)
+
+func TestIsAlreadyExistsDiagnostic(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		detail   string
+		expected bool
+	}{
+		{detail: "Unable to call action method: safe MySafe already exists", expected: true},
+		{detail: "Unable to call action method: failed to add role - [409] - [Role Admins already exists]", expected: true},
+		{detail: "Unable to call action method: failed to update safe - [409] - [the safe is locked]", expected: false},
+		{detail: "Unable to call action method: Duplicate values in the members list", expected: false},
+		{detail: "Unable to call action method: [404] not found", expected: false},
+	}
+	for _, tt := range tests {
+		if actual := isAlreadyExistsDiagnostic(diag.NewErrorDiagnostic("Action Error", tt.detail)); actual != tt.expected {
+			t.Errorf("expected %v for %q, got %v", tt.expected, tt.detail, actual)
+		}
+	}
+}
+
+func TestIdsecResource_AdoptExistingAttribute(t *testing.T) {
+	tests := []struct {
+		name       string
+		operations []actions.IdsecServiceActionOperation
+		expected   bool
+	}{
+		{name: "create and read", operations: []actions.IdsecServiceActionOperation{actions.CreateOperation, actions.ReadOperation}, expected: true},
+		{name: "create without read", operations: []actions.IdsecServiceActionOperation{actions.CreateOperation}, expected: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, schemaResp := deleteProtectionTestResource(t, tt.operations)
+			attribute, exists := schemaResp.Schema.Attributes[schemas.AdoptExistingAttributeName]
+			if exists != tt.expected {
+				t.Fatalf("expected adopt_existing to exist: %v, got %v", tt.expected, exists)
+			}
+			if exists && (!attribute.IsOptional() || attribute.IsComputed()) {
+				t.Errorf("expected an optional, non-computed adopt_existing attribute")
+			}
+		})
+	}
+}
+
+func TestAdoptsExisting(t *testing.T) {
+	_, schemaResp := deleteProtectionTestResource(t, deleteProtectionOperations)
+	for _, adopt := range []interface{}{true, false, nil} {
+		values := map[string]tftypes.Value{schemas.AdoptExistingAttributeName: tftypes.NewValue(tftypes.Bool, adopt)}
+		plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: CreateTestResourceValue(schemaResp, values)}
+		if actual := adoptsExisting(context.Background(), plan); actual != (adopt == true) {
+			t.Errorf("expected %v for adopt_existing %v, got %v", adopt == true, adopt, actual)
+		}
+	}
+	if adoptsExisting(context.Background(), tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(schemaResp.Schema.Type().TerraformType(context.Background()), nil)}) {
+		t.Error("expected a null plan not to adopt")
+	}
+}
+
+// adoptTestResource returns a fake safe resource identified, and imported, by safe_name, whose create
+// fails as the safe finance already exists.
+func adoptTestResource(t *testing.T, importID string) (*IdsecResource, *fakeActionInvoker, resource.SchemaResponse) {
+	t.Helper()
+	idsecRes, _, schemaResp := fakeOperationsTestResource(t)
+	idsecRes.actionDefinition.Schemas["safe"] = &fakeSafeModel{}
+	idsecRes.actionDefinition.ImportID = importID
+	invoker := newFakeActionInvoker(idsecRes.actionDefinition, "safe_name")
+	invoker.objects["finance"] = map[string]interface{}{"safe_id": "safe-1", "safe_name": "finance", "description": "Created outside Terraform"}
+	invoker.failOn(actions.CreateOperation, errors.New("failed to add safe - [409] - [Safe finance already exists]"))
+	idsecRes.invoker = invoker
+	return idsecRes, invoker, schemaResp
+}
+
+func TestIdsecResource_CreateOrAdopt(t *testing.T) {
+	ctx := context.Background()
+	values := fakeOperationsTestSafe(tftypes.UnknownValue, "Finance team")
+	values[schemas.AdoptExistingAttributeName] = tftypes.NewValue(tftypes.Bool, true)
+
+	t.Run("adopts_by_import_id", func(t *testing.T) {
+		idsecRes, invoker, schemaResp := adoptTestResource(t, "safe_name")
+		plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: CreateTestResourceValue(schemaResp, values)}
+		req := resource.CreateRequest{Plan: plan, Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: plan.Raw}}
+		resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)}}
+
+		idsecRes.createOrAdopt(ctx, req, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+		}
+		if safeID := fakeOperationsTestAttribute(t, resp.State, "safe_id"); safeID.ValueString() != "safe-1" {
+			t.Errorf("expected the ID of the existing safe in state, got %v", safeID)
+		}
+		if stored := invoker.object("finance"); stored["description"] != "Finance team" {
+			t.Errorf("expected the existing safe to be updated to the plan, got %v", stored)
+		}
+	})
+
+	t.Run("refuses_without_configured_import_id", func(t *testing.T) {
+		idsecRes, invoker, schemaResp := adoptTestResource(t, "safe_id")
+		plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: CreateTestResourceValue(schemaResp, values)}
+		req := resource.CreateRequest{Plan: plan, Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: plan.Raw}}
+		resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(plan.Raw.Type(), nil)}}
+
+		idsecRes.createOrAdopt(ctx, req, resp)
+		if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[len(resp.Diagnostics.Errors())-1].Summary() != "Existing Object Not Adopted" {
+			t.Fatalf("expected the adoption to be refused, got %v", resp.Diagnostics)
+		}
+		if calls := invoker.operationCalls(); len(calls) != 1 {
+			t.Errorf("expected no read of the existing safe, got the calls %v", calls)
+		}
+	})
+}
@@ -0,0 +1,108 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/cyberark/idsec-sdk-golang/pkg/auth"
+	"github.com/cyberark/idsec-sdk-golang/pkg/common/keyring"
+	"github.com/cyberark/idsec-sdk-golang/pkg/models"
+	authmodels "github.com/cyberark/idsec-sdk-golang/pkg/models/auth"
+)
+
+// Backends storing the cached authentication tokens.
+const (
+	// authCacheBackendAuto uses the OS credential store when the environment provides one, and the
+	// encrypted file otherwise.
+	authCacheBackendAuto = "auto"
+	// authCacheBackendKeyring uses the OS credential store: macOS Keychain, Windows Credential Manager,
+	// or the Secret Service (libsecret) of the D-Bus session on Linux.
+	authCacheBackendKeyring = "keyring"
+	// authCacheBackendFile uses the encrypted file under ~/.idsec/cache/keyring, or IDSEC_KEYRING_FOLDER.
+	authCacheBackendFile = "file"
+)
+
+// authCacheBackends lists the valid values of auth_cache_backend.
+var authCacheBackends = []string{authCacheBackendAuto, authCacheBackendKeyring, authCacheBackendFile}
+
+// osKeyringAvailable reports whether the SDK stores cached tokens in the OS credential store. It is not
+// available in containers, in WSL or on Linux without a D-Bus session, where the file is used instead.
+func osKeyringAvailable() bool {
+	impl, err := keyring.NewIdsecKeyring("idsec").GetKeyring(false)
+	if err != nil {
+		return false
+	}
+	_, ok := impl.(*keyring.IdsecOSProvidedKeyring)
+	return ok
+}
+
+// configureAuthCacheBackend prepares the backend the SDK caches authentication tokens in, which
+// useAuthCacheBackend then applies to each authentication. The keyring backend falls back to the file
+// with a warning when the environment has no OS credential store.
+func configureAuthCacheBackend(ctx context.Context, backend string, diagnostics *diag.Diagnostics) {
+	if err := setAuthCacheFolder(); err != nil {
+		diagnostics.AddError("Invalid Configuration", fmt.Sprintf("Failed to select the authentication cache folder: %s.", err.Error()))
+		return
+	}
+	if backend == authCacheBackendKeyring && !osKeyringAvailable() {
+		diagnostics.AddWarning(
+			"Authentication Cache Keyring Unavailable",
+			"auth_cache_backend is set to keyring but no OS credential store is available in this environment, "+
+				"e.g. in a container, on Linux without a D-Bus session or with IDSEC_BASIC_KEYRING set. Authentication is cached in the encrypted file instead.",
+		)
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Caching authentication with the %s backend", backend))
+}
+
+// fileAuthCacheKeyring caches the tokens of an authentication in the encrypted file whatever the
+// environment provides, by enforcing the basic keyring on each call to the keyring of the SDK.
+type fileAuthCacheKeyring struct {
+	keyring.IdsecKeyringInterface
+}
+
+// SaveToken saves the token in the encrypted file.
+func (k fileAuthCacheKeyring) SaveToken(profile *models.IdsecProfile, token *authmodels.IdsecToken, postfix string, _ bool) error {
+	return k.IdsecKeyringInterface.SaveToken(profile, token, postfix, true)
+}
+
+// LoadToken loads the token from the encrypted file.
+func (k fileAuthCacheKeyring) LoadToken(profile *models.IdsecProfile, postfix string, _ bool) (*authmodels.IdsecToken, error) {
+	return k.IdsecKeyringInterface.LoadToken(profile, postfix, true)
+}
+
+// useAuthCacheBackend passes the backend of the tokens cached by an authentication to its keyring, without
+// changing the environment of the process. The auto and keyring backends keep the keyring of the SDK,
+// which uses the OS credential store when the environment provides one. The SDK selects the keyring of
+// the login sessions its identity and PVWA authenticators cache on their own from the environment only,
+// so they follow IDSEC_BASIC_KEYRING whatever the backend.
+func useAuthCacheBackend(authBase *auth.IdsecAuthBase, backend string) {
+	if authBase == nil || authBase.CacheKeyring == nil || backend != authCacheBackendFile {
+		return
+	}
+	if _, enforced := authBase.CacheKeyring.(fileAuthCacheKeyring); !enforced {
+		authBase.CacheKeyring = fileAuthCacheKeyring{authBase.CacheKeyring}
+	}
+}
+
+// setAuthCacheFolder points the file backend of the SDK at the cache folder of the home directory of
+// the OS, unless IDSEC_KEYRING_FOLDER already selects one. The SDK joins its default folder to HOME,
+// which is not set on Windows or in some sandboxed build environments, leaving the cache relative to
+// the working directory of Terraform.
+func setAuthCacheFolder() error {
+	if folder := os.Getenv(keyring.IdsecBasicKeyringFolderEnvVar); folder != "" {
+		return nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return fmt.Errorf("failed to resolve the home directory, set %s: %w", keyring.IdsecBasicKeyringFolderEnvVar, err)
+	}
+	return os.Setenv(keyring.IdsecBasicKeyringFolderEnvVar, filepath.Join(home, filepath.FromSlash(keyring.DefaultBasicKeyringFolder)))
+}
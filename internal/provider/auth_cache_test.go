@@ -0,0 +1,130 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/cyberark/idsec-sdk-golang/pkg/auth"
+	"github.com/cyberark/idsec-sdk-golang/pkg/common/keyring"
+	"github.com/cyberark/idsec-sdk-golang/pkg/models"
+	authmodels "github.com/cyberark/idsec-sdk-golang/pkg/models/auth"
+)
+
+func TestConfigureAuthCacheBackend(t *testing.T) {
+	tests := []struct {
+		name       string
+		backend    string
+		initialEnv string
+	}{
+		{name: "file_keeps_environment", backend: authCacheBackendFile},
+		{name: "keyring_keeps_basic_keyring_override", backend: authCacheBackendKeyring, initialEnv: "true"},
+		{name: "auto_keeps_environment", backend: authCacheBackendAuto, initialEnv: "1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(keyring.IdsecBasicKeyringFolderEnvVar, t.TempDir())
+			t.Setenv(keyring.IdsecBasicKeyringOverrideEnvVar, tt.initialEnv)
+			if tt.initialEnv == "" {
+				os.Unsetenv(keyring.IdsecBasicKeyringOverrideEnvVar)
+			}
+			var diags diag.Diagnostics
+			configureAuthCacheBackend(context.Background(), tt.backend, &diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			value, ok := os.LookupEnv(keyring.IdsecBasicKeyringOverrideEnvVar)
+			if ok != (tt.initialEnv != "") || value != tt.initialEnv {
+				t.Errorf("expected %s to be left as %q, got %q (set: %v)", keyring.IdsecBasicKeyringOverrideEnvVar, tt.initialEnv, value, ok)
+			}
+			if tt.backend == authCacheBackendKeyring {
+				if wantWarning := !osKeyringAvailable(); (diags.WarningsCount() > 0) != wantWarning {
+					t.Errorf("expected a fallback warning only without an OS keyring, got %v", diags)
+				}
+			} else if diags.WarningsCount() > 0 {
+				t.Errorf("unexpected warnings: %v", diags)
+			}
+		})
+	}
+}
+
+// recordingKeyring records whether the basic keyring was enforced on its last call.
+type recordingKeyring struct {
+	enforced bool
+}
+
+func (k *recordingKeyring) SaveToken(_ *models.IdsecProfile, _ *authmodels.IdsecToken, _ string, enforceBasicKeyring bool) error {
+	k.enforced = enforceBasicKeyring
+	return nil
+}
+
+func (k *recordingKeyring) LoadToken(_ *models.IdsecProfile, _ string, enforceBasicKeyring bool) (*authmodels.IdsecToken, error) {
+	k.enforced = enforceBasicKeyring
+	return nil, nil
+}
+
+func TestUseAuthCacheBackend(t *testing.T) {
+	tests := []struct {
+		name         string
+		backend      string
+		wantEnforced bool
+	}{
+		{name: "file_enforces_basic_keyring", backend: authCacheBackendFile, wantEnforced: true},
+		{name: "keyring_keeps_sdk_keyring", backend: authCacheBackendKeyring},
+		{name: "auto_keeps_sdk_keyring", backend: authCacheBackendAuto},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recorder := &recordingKeyring{}
+			authBase := &auth.IdsecAuthBase{CacheKeyring: recorder}
+			useAuthCacheBackend(authBase, tt.backend)
+			useAuthCacheBackend(authBase, tt.backend)
+			if err := authBase.CacheKeyring.SaveToken(nil, nil, "", false); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if recorder.enforced != tt.wantEnforced {
+				t.Errorf("expected the basic keyring enforced on save to be %v, got %v", tt.wantEnforced, recorder.enforced)
+			}
+			if _, err := authBase.CacheKeyring.LoadToken(nil, "", false); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if recorder.enforced != tt.wantEnforced {
+				t.Errorf("expected the basic keyring enforced on load to be %v, got %v", tt.wantEnforced, recorder.enforced)
+			}
+			if wrapped, ok := authBase.CacheKeyring.(fileAuthCacheKeyring); ok {
+				if _, nested := wrapped.IdsecKeyringInterface.(fileAuthCacheKeyring); nested {
+					t.Error("expected the keyring to be wrapped once")
+				}
+			}
+		})
+	}
+
+	useAuthCacheBackend(&auth.IdsecAuthBase{}, authCacheBackendFile)
+}
+
+func TestSetAuthCacheFolder(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("USERPROFILE", home)
+	t.Setenv(keyring.IdsecBasicKeyringFolderEnvVar, "")
+	if err := setAuthCacheFolder(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if folder := os.Getenv(keyring.IdsecBasicKeyringFolderEnvVar); folder != filepath.Join(home, ".idsec", "cache", "keyring") {
+		t.Errorf("expected the cache folder of the home directory, got %q", folder)
+	}
+
+	configured := filepath.Join(t.TempDir(), "keyring")
+	t.Setenv(keyring.IdsecBasicKeyringFolderEnvVar, configured)
+	if err := setAuthCacheFolder(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if folder := os.Getenv(keyring.IdsecBasicKeyringFolderEnvVar); folder != configured {
+		t.Errorf("expected the configured folder to be kept, got %q", folder)
+	}
+}
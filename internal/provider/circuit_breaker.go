@@ -0,0 +1,119 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// serviceOutageErrorPatterns are the error fragments of calls failing because a service is down or
+// unreachable, as opposed to rejecting the request itself. A 500 is not one of them: services return it
+// for requests they fail to process as well, while up.
+var serviceOutageErrorPatterns = []string{
+	"[502]",
+	"[503]",
+	"[504]",
+	"connection refused",
+	"connection reset",
+	"no such host",
+	"i/o timeout",
+	"tls handshake timeout",
+	"client.timeout exceeded",
+}
+
+// isServiceOutageError reports whether err belongs to the service outage error class.
+func isServiceOutageError(err error) bool {
+	return matchesErrorPatterns(err, serviceOutageErrorPatterns)
+}
+
+// circuitBreakerCooldown is how long the circuit of a service stays open before one operation is let
+// through to find out whether the service is back.
+const circuitBreakerCooldown = 30 * time.Second
+
+// circuitState is the state of the circuit of a service. openedAt is zero while the circuit is closed,
+// and otherwise the time it opened or last let an operation through.
+type circuitState struct {
+	failures int64
+	openedAt time.Time
+}
+
+// circuitBreaker tracks the consecutive operations of each service failing with an outage error. Once a
+// service reaches the threshold its circuit opens, and its operations fail at once instead of each
+// waiting for its own timeouts and retries against a backend that is down. After circuitBreakerCooldown
+// the circuit is half open: one operation is let through, and closes the circuit if the service answers
+// it, or opens it again for another cooldown otherwise.
+type circuitBreaker struct {
+	threshold int64
+	cooldown  time.Duration
+	now       func() time.Time
+	mu        sync.Mutex
+	circuits  map[string]*circuitState
+}
+
+// newCircuitBreaker creates a breaker opening after threshold consecutive outage errors of a service. It
+// returns nil when threshold is 0.
+func newCircuitBreaker(threshold int64) (*circuitBreaker, error) {
+	if threshold < 0 {
+		return nil, fmt.Errorf("the threshold must be zero or greater, got %d", threshold)
+	}
+	if threshold == 0 {
+		return nil, nil
+	}
+	return &circuitBreaker{
+		threshold: threshold,
+		cooldown:  circuitBreakerCooldown,
+		now:       time.Now,
+		circuits:  map[string]*circuitState{},
+	}, nil
+}
+
+// check returns an error when the circuit of a service is open. Once the cooldown of an open circuit
+// passed, it lets one operation through and restarts the cooldown.
+func (b *circuitBreaker) check(serviceName string) error {
+	if b == nil {
+		return nil
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	circuit, ok := b.circuits[serviceName]
+	if !ok || circuit.openedAt.IsZero() {
+		return nil
+	}
+	now := b.now()
+	if retryIn := circuit.openedAt.Add(b.cooldown).Sub(now); retryIn > 0 {
+		return fmt.Errorf(
+			"service %s unavailable, skipping: its last %d operations failed with errors indicating it is down or unreachable, "+
+				"so its operations are not attempted for another %s. Run Terraform again once the service is back, or set "+
+				"circuit_breaker_threshold to 0 to attempt every operation",
+			serviceName, circuit.failures, retryIn.Round(time.Second))
+	}
+	circuit.openedAt = now
+	return nil
+}
+
+// record counts the outcome of an operation of a service: outage errors add to its consecutive failures,
+// opening its circuit at the threshold, while a success or any other error, which the service answered,
+// closes it.
+func (b *circuitBreaker) record(serviceName string, err error) {
+	if b == nil {
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if !isServiceOutageError(err) {
+		delete(b.circuits, serviceName)
+		return
+	}
+	circuit, ok := b.circuits[serviceName]
+	if !ok {
+		circuit = &circuitState{}
+		b.circuits[serviceName] = circuit
+	}
+	circuit.failures++
+	if circuit.failures >= b.threshold {
+		circuit.openedAt = b.now()
+	}
+}
@@ -0,0 +1,110 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestIsServiceOutageError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "nil_error", err: nil},
+		{name: "status_503", err: errors.New("failed to add safe - [503] - [Service Unavailable]"), expected: true},
+		{name: "status_500", err: errors.New("failed to add safe - [500] - [Internal Server Error]")},
+		{name: "connection_refused", err: errors.New("dial tcp 10.0.0.1:443: connect: connection refused"), expected: true},
+		{name: "client_timeout", err: errors.New("context deadline exceeded (Client.Timeout exceeded while awaiting headers)"), expected: true},
+		{name: "validation_error", err: errors.New("failed to add safe - [400] - [invalid name]")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := isServiceOutageError(tt.err); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	if breaker, err := newCircuitBreaker(0); err != nil || breaker != nil {
+		t.Fatalf("expected no breaker for a threshold of 0, got %v (%v)", breaker, err)
+	}
+	if _, err := newCircuitBreaker(-1); err == nil {
+		t.Fatal("expected an error for a negative threshold")
+	}
+	var disabled *circuitBreaker
+	disabled.record("sia-access", errors.New("[503]"))
+	if err := disabled.check("sia-access"); err != nil {
+		t.Errorf("expected a nil breaker never to open, got %v", err)
+	}
+
+	breaker, err := newCircuitBreaker(2)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	outage := errors.New("failed to list policies - [502] - [Bad Gateway]")
+	breaker.record("sia-access", outage)
+	breaker.record("sia-access", errors.New("failed to add policy - [409] - [already exists]"))
+	breaker.record("sia-access", outage)
+	if err := breaker.check("sia-access"); err != nil {
+		t.Errorf("expected an answered error to reset the count, got %v", err)
+	}
+	breaker.record("sia-access", outage)
+	err = breaker.check("sia-access")
+	if err == nil || !strings.Contains(err.Error(), "service sia-access unavailable, skipping") {
+		t.Errorf("expected the circuit to open after 2 consecutive outage errors, got %v", err)
+	}
+	if err := breaker.check("pcloud-accounts"); err != nil {
+		t.Errorf("expected other services not to be affected, got %v", err)
+	}
+}
+
+func TestCircuitBreakerHalfOpen(t *testing.T) {
+	t.Parallel()
+
+	breaker, err := newCircuitBreaker(1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	breaker.now = func() time.Time { return now }
+	outage := errors.New("failed to list policies - [503] - [Service Unavailable]")
+	breaker.record("sia-access", outage)
+	if err := breaker.check("sia-access"); err == nil {
+		t.Fatal("expected the circuit to be open")
+	}
+
+	now = now.Add(circuitBreakerCooldown)
+	if err := breaker.check("sia-access"); err != nil {
+		t.Fatalf("expected one operation to be let through after the cooldown, got %v", err)
+	}
+	if err := breaker.check("sia-access"); err == nil {
+		t.Error("expected the other operations to fail while the first one is attempted")
+	}
+	breaker.record("sia-access", outage)
+	now = now.Add(circuitBreakerCooldown / 2)
+	if err := breaker.check("sia-access"); err == nil {
+		t.Error("expected an outage error to open the circuit again")
+	}
+
+	now = now.Add(circuitBreakerCooldown)
+	if err := breaker.check("sia-access"); err != nil {
+		t.Fatalf("expected one operation to be let through after the cooldown, got %v", err)
+	}
+	breaker.record("sia-access", nil)
+	if err := breaker.check("sia-access"); err != nil {
+		t.Errorf("expected the service answering to close the circuit, got %v", err)
+	}
+}
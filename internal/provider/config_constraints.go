@@ -0,0 +1,104 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/cyberark/terraform-provider-idsec/internal/actions"
+	"github.com/cyberark/terraform-provider-idsec/internal/schemas"
+)
+
+// invalidConfigConstraints returns the errors of the config constraints of the action definition whose
+// expressions do not parse or reference attributes missing from the schema.
+func (s *IdsecResource) invalidConfigConstraints(resourceSchema schema.Schema) []error {
+	var errs []error
+	for _, constraint := range s.actionDefinition.ConfigConstraints {
+		attributes, err := schemas.ParseConstraint(constraint.Expression)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		for _, attribute := range attributes {
+			if _, ok := resourceSchema.Attributes[attribute]; !ok {
+				errs = append(errs, fmt.Errorf("constraint %q references the unknown attribute %s", constraint.Expression, attribute))
+			}
+		}
+	}
+	return errs
+}
+
+// validateConfigConstraints evaluates the config constraints of the action definition over the planned
+// input of the create or update operation, and fails the plan for those not satisfied. Constraints
+// referencing attribute paths not known yet are checked once they are, and constraints that cannot be
+// evaluated, e.g. over a null attribute, are skipped.
+func (s *IdsecResource) validateConfigConstraints(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if s.actionDefinition == nil || len(s.actionDefinition.ConfigConstraints) == 0 || req.Plan.Raw.IsNull() || resp.Diagnostics.HasError() {
+		return
+	}
+	operation := actions.CreateOperation
+	if !req.State.Raw.IsNull() {
+		operation = actions.UpdateOperation
+	}
+	operationSchema, err := s.schemaForOperation(operation)
+	if err != nil || operationSchema == nil {
+		return
+	}
+	var input interface{}
+	for _, constraint := range s.actionDefinition.ConfigConstraints {
+		paths, err := schemas.ConstraintAttributePaths(constraint.Expression)
+		if err != nil || !plannedPathsKnown(ctx, resp, paths) {
+			continue
+		}
+		if input == nil {
+			input, err = schemas.StructFromPlanObject(ctx, &resp.Plan, operationSchema)
+			if err != nil {
+				tflog.Debug(ctx, fmt.Sprintf("Skipping config constraints (plan decode failed): %s", err.Error()))
+				return
+			}
+		}
+		satisfied, err := schemas.EvaluateConstraint(constraint.Expression, input)
+		if err != nil {
+			tflog.Debug(ctx, fmt.Sprintf("Skipping config constraint: %s", err.Error()))
+			continue
+		}
+		if satisfied {
+			continue
+		}
+		detail := fmt.Sprintf("The planned values do not satisfy the constraint %s, which the API enforces on apply.", constraint.Expression)
+		if constraint.Message != "" {
+			detail = fmt.Sprintf("%s\n\nConstraint: %s", constraint.Message, constraint.Expression)
+		}
+		if len(paths) > 0 {
+			resp.Diagnostics.AddAttributeError(paths[0], "Constraint Not Satisfied", detail)
+		} else {
+			resp.Diagnostics.AddError("Constraint Not Satisfied", detail)
+		}
+	}
+}
+
+// plannedPathsKnown reports whether the planned values at the attribute paths are fully known, so a
+// constraint over a nested attribute does not wait for the computed attributes next to it. Paths
+// matching no attribute are left for the evaluation to report.
+func plannedPathsKnown(ctx context.Context, resp *resource.ModifyPlanResponse, paths []path.Path) bool {
+	for _, attributePath := range paths {
+		var planned attr.Value
+		if diags := resp.Plan.GetAttribute(ctx, attributePath, &planned); diags.HasError() || planned == nil {
+			continue
+		}
+		if planned.IsUnknown() {
+			return false
+		}
+		if value, err := planned.ToTerraformValue(ctx); err != nil || !value.IsFullyKnown() {
+			return false
+		}
+	}
+	return true
+}
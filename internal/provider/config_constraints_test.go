@@ -0,0 +1,117 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/cyberark/terraform-provider-idsec/internal/actions"
+)
+
+type configConstraintsTestModel struct {
+	GroupName  string   `json:"group_name" mapstructure:"group_name" validate:"required"`
+	MaxMembers int      `json:"max_members,omitempty" mapstructure:"max_members"`
+	Members    []string `json:"members,omitempty" mapstructure:"members"`
+}
+
+func configConstraintsTestResource(t *testing.T, constraints []actions.IdsecConfigConstraint) (*IdsecResource, resource.SchemaResponse) {
+	t.Helper()
+	operations := []actions.IdsecServiceActionOperation{actions.CreateOperation, actions.ReadOperation}
+	return CreateTestResourceSchema(&configConstraintsTestModel{}, operations, func(actionDefinition *actions.IdsecServiceTerraformResourceActionDefinition) {
+		actionDefinition.ConfigConstraints = constraints
+	})
+}
+
+func TestIdsecResource_ConfigConstraintsSchema(t *testing.T) {
+	_, schemaResp := configConstraintsTestResource(t, []actions.IdsecConfigConstraint{{Expression: "len(members) <= max_members"}})
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema errors: %v", schemaResp.Diagnostics)
+	}
+	for _, expression := range []string{"len(members) <=", "len(owners) <= max_members"} {
+		_, schemaResp = configConstraintsTestResource(t, []actions.IdsecConfigConstraint{{Expression: expression}})
+		if !schemaResp.Diagnostics.HasError() || schemaResp.Diagnostics.Errors()[0].Summary() != "Schema Error" {
+			t.Errorf("expected a schema error for %q, got %v", expression, schemaResp.Diagnostics)
+		}
+	}
+}
+
+func TestIdsecResource_ValidateConfigConstraints(t *testing.T) {
+	tests := []struct {
+		name      string
+		members   tftypes.Value
+		message   string
+		wantError string
+	}{
+		{name: "satisfied", members: tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "alice")})},
+		{name: "violated", members: tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "alice"), tftypes.NewValue(tftypes.String, "bob")}), wantError: "The planned values do not satisfy the constraint len(members) <= max_members, which the API enforces on apply."},
+		{name: "violated_with_message", members: tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "alice"), tftypes.NewValue(tftypes.String, "bob")}), message: "A group holds at most max_members members.", wantError: "A group holds at most max_members members.\n\nConstraint: len(members) <= max_members"},
+		{name: "unknown", members: tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, tftypes.UnknownValue)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			idsecRes, schemaResp := configConstraintsTestResource(t, []actions.IdsecConfigConstraint{{Expression: "len(members) <= max_members", Message: tt.message}})
+			raw := CreateTestResourceValue(schemaResp, map[string]tftypes.Value{
+				"group_name":  tftypes.NewValue(tftypes.String, "admins"),
+				"max_members": tftypes.NewValue(tftypes.Number, 1),
+				"members":     tt.members,
+			})
+			req := resource.ModifyPlanRequest{
+				Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: raw},
+				Plan:   tfsdk.Plan{Schema: schemaResp.Schema, Raw: raw},
+				State:  tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(raw.Type(), nil)},
+			}
+			resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+			idsecRes.validateConfigConstraints(ctx, req, resp)
+			if tt.wantError == "" {
+				if resp.Diagnostics.HasError() {
+					t.Errorf("unexpected diagnostics: %v", resp.Diagnostics)
+				}
+				return
+			}
+			if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Detail() != tt.wantError {
+				t.Errorf("expected the error %q, got %v", tt.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+type configConstraintsTestMetadata struct {
+	PolicyID   string   `json:"policy_id,omitempty" mapstructure:"policy_id"`
+	PolicyTags []string `json:"policy_tags,omitempty" mapstructure:"policy_tags"`
+}
+
+type configConstraintsTestPolicy struct {
+	Metadata configConstraintsTestMetadata `json:"metadata" mapstructure:"metadata"`
+}
+
+// TestIdsecResource_ValidateConfigConstraintsNested tests that a constraint over a nested attribute is
+// checked on create, while the computed attributes next to it are not known yet.
+func TestIdsecResource_ValidateConfigConstraintsNested(t *testing.T) {
+	ctx := context.Background()
+	operations := []actions.IdsecServiceActionOperation{actions.CreateOperation, actions.ReadOperation}
+	idsecRes, schemaResp := CreateTestResourceSchema(&configConstraintsTestPolicy{}, operations, func(actionDefinition *actions.IdsecServiceTerraformResourceActionDefinition) {
+		actionDefinition.ConfigConstraints = []actions.IdsecConfigConstraint{{Expression: "len(metadata.policy_tags) <= 1"}}
+	})
+	metadataType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object).AttributeTypes["metadata"].(tftypes.Object)
+	metadata := map[string]tftypes.Value{}
+	for name, attrType := range metadataType.AttributeTypes {
+		metadata[name] = tftypes.NewValue(attrType, tftypes.UnknownValue)
+	}
+	metadata["policy_tags"] = tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, []tftypes.Value{tftypes.NewValue(tftypes.String, "prod"), tftypes.NewValue(tftypes.String, "web")})
+	raw := CreateTestResourceValue(schemaResp, map[string]tftypes.Value{"metadata": tftypes.NewValue(metadataType, metadata)})
+	req := resource.ModifyPlanRequest{
+		Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: raw},
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(raw.Type(), nil)},
+	}
+	resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+	idsecRes.validateConfigConstraints(ctx, req, resp)
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Constraint Not Satisfied" {
+		t.Errorf("expected the constraint to fail the plan, got %v", resp.Diagnostics)
+	}
+}
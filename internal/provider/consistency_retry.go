@@ -0,0 +1,89 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/cyberark/terraform-provider-idsec/internal/actions"
+)
+
+// referenceNotFoundErrorPatterns are the error fragments of operations the Idsec APIs answer with a 404,
+// which creates and updates get when they reference an object that is not yet visible, typically because
+// it was created moments earlier. The SDK reports failed calls as "<action> - [<status>] - [<body>]", so
+// the status is matched in that form only, not wherever a "404" or "not found" appears in a message.
+var referenceNotFoundErrorPatterns = []string{
+	"- [404] - [",
+}
+
+// consistencyRetryBaseDelay is the delay before the first consistency retry, doubled on every retry.
+var consistencyRetryBaseDelay = 2 * time.Second
+
+// consistencyRetryMaxDelay caps the delay between consistency retries.
+var consistencyRetryMaxDelay = 30 * time.Second
+
+// isReferenceNotFoundError reports whether err belongs to the reference-not-found error class.
+func isReferenceNotFoundError(err error) bool {
+	return matchesErrorPatterns(err, referenceNotFoundErrorPatterns)
+}
+
+// callResultError returns the first non-nil error among the values returned by an action method.
+func callResultError(result []reflect.Value) error {
+	for _, res := range result {
+		if err, ok := res.Interface().(error); ok && err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// retryForConsistency calls call, and calls it again with exponential backoff while it fails with an error
+// classified as retriable by retryableReason with rules, up to retries times. It returns the values of the
+// last call. Reference-not-found errors of creates and updates are usually transient: objects referenced
+// by the operation were just created and are not yet visible everywhere. A delete retried after a gateway
+// error may find the object already deleted by the failed attempt, so a reference-not-found error of a
+// delete retry is reported as a success.
+func retryForConsistency(ctx context.Context, operation actions.IdsecServiceActionOperation, retries int64, rules []retryRule, call func() []reflect.Value) []reflect.Value {
+	result := call()
+	delay := consistencyRetryBaseDelay
+	for attempt := int64(1); attempt <= retries; attempt++ {
+		err := callResultError(result)
+		reason, retriable := retryableReason(operation, err, rules)
+		if !retriable {
+			return result
+		}
+		tflog.Warn(ctx, fmt.Sprintf("Operation %s failed with %s, retrying in %s (%d/%d): %s", operation, reason, delay, attempt, retries, err.Error()))
+		select {
+		case <-ctx.Done():
+			return result
+		case <-time.After(delay):
+		}
+		delay *= 2
+		if delay > consistencyRetryMaxDelay {
+			delay = consistencyRetryMaxDelay
+		}
+		result = call()
+		if operation == actions.DeleteOperation && isReferenceNotFoundError(callResultError(result)) {
+			tflog.Info(ctx, fmt.Sprintf("Operation %s found the object already deleted on retry, the failed attempt deleted it", operation))
+			return withoutCallErrors(result)
+		}
+	}
+	return result
+}
+
+// withoutCallErrors returns the values returned by an action method with its errors set to nil.
+func withoutCallErrors(result []reflect.Value) []reflect.Value {
+	cleared := make([]reflect.Value, len(result))
+	for i, res := range result {
+		cleared[i] = res
+		if err, ok := res.Interface().(error); ok && err != nil {
+			cleared[i] = reflect.Zero(res.Type())
+		}
+	}
+	return cleared
+}
@@ -0,0 +1,125 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/cyberark/terraform-provider-idsec/internal/actions"
+)
+
+// TestIsReferenceNotFoundError tests the classification of reference-not-found errors.
+func TestIsReferenceNotFoundError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "nil_error", err: nil, expected: false},
+		{name: "status_404", err: errors.New("failed to add policy - [404] - [{\"message\":\"target set ts-1 not found\"}]"), expected: true},
+		{name: "not_found_message", err: errors.New("failed to add safe - [400] - [member not found in directory]"), expected: false},
+		{name: "404_in_message", err: errors.New("failed to add safe - [400] - [retention must be below 404 days]"), expected: false},
+		{name: "other_error", err: errors.New("permission denied"), expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := isReferenceNotFoundError(tt.err); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestRetryForConsistency tests that only creates and updates are retried on reference-not-found errors.
+func TestRetryForConsistency(t *testing.T) {
+	previousDelay := consistencyRetryBaseDelay
+	t.Cleanup(func() { consistencyRetryBaseDelay = previousDelay })
+	consistencyRetryBaseDelay = time.Millisecond
+	notFound := errors.New("failed to update policy - [404] - [target set not found]")
+
+	tests := []struct {
+		name          string
+		operation     actions.IdsecServiceActionOperation
+		failures      int
+		failure       error
+		retries       int64
+		expectedCalls int
+		expectError   bool
+	}{
+		{name: "update_succeeds_after_retries", operation: actions.UpdateOperation, failures: 2, failure: notFound, retries: 3, expectedCalls: 3},
+		{name: "update_exhausts_retries", operation: actions.UpdateOperation, failures: 5, failure: notFound, retries: 2, expectedCalls: 3, expectError: true},
+		{name: "create_retried", operation: actions.CreateOperation, failures: 1, failure: notFound, retries: 3, expectedCalls: 2},
+		{name: "update_other_error_not_retried", operation: actions.UpdateOperation, failures: 1, failure: errors.New("bad request"), retries: 3, expectedCalls: 1, expectError: true},
+		{name: "delete_not_retried", operation: actions.DeleteOperation, failures: 1, failure: notFound, retries: 3, expectedCalls: 1, expectError: true},
+		{name: "retries_disabled", operation: actions.UpdateOperation, failures: 1, failure: notFound, retries: 0, expectedCalls: 1, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			method := func() (string, error) {
+				calls++
+				if calls <= tt.failures {
+					return "", tt.failure
+				}
+				return "ok", nil
+			}
+			result := retryForConsistency(context.Background(), tt.operation, tt.retries, nil, func() []reflect.Value { return reflect.ValueOf(method).Call(nil) })
+			if calls != tt.expectedCalls {
+				t.Errorf("Expected %d calls, got %d", tt.expectedCalls, calls)
+			}
+			if err := callResultError(result); (err != nil) != tt.expectError {
+				t.Errorf("Expected error=%v, got %v", tt.expectError, err)
+			}
+		})
+	}
+}
+
+// TestRetryForConsistency_DeleteGoneOnRetry tests that a delete retry finding the object already
+// deleted succeeds, as the failed attempt deleted it.
+func TestRetryForConsistency_DeleteGoneOnRetry(t *testing.T) {
+	previousDelay := consistencyRetryBaseDelay
+	t.Cleanup(func() { consistencyRetryBaseDelay = previousDelay })
+	consistencyRetryBaseDelay = time.Millisecond
+
+	calls := 0
+	method := func() error {
+		calls++
+		if calls == 1 {
+			return errors.New("failed to delete safe - [504] - [gateway timeout]")
+		}
+		return errors.New("failed to delete safe - [404] - [safe not found]")
+	}
+	result := retryForConsistency(context.Background(), actions.DeleteOperation, 3, nil, func() []reflect.Value { return reflect.ValueOf(method).Call(nil) })
+	if calls != 2 {
+		t.Errorf("Expected 2 calls, got %d", calls)
+	}
+	if err := callResultError(result); err != nil {
+		t.Errorf("Expected the delete to succeed, got %v", err)
+	}
+}
+
+// TestResolveTerraformInt64VarInvalidEnv tests that invalid values of retry environment variables are reported.
+func TestResolveTerraformInt64VarInvalidEnv(t *testing.T) {
+	t.Setenv(IdsecConsistencyRetriesEnvVar, "three")
+	p := &IdsecProvider{}
+	if _, err := p.resolveTerraformInt64Var(types.Int64Null(), IdsecConsistencyRetriesEnvVar, IdsecConsistencyRetriesDefault); err == nil {
+		t.Error("Expected an error for a non-integer environment variable")
+	}
+
+	t.Setenv(IdsecConsistencyRetriesEnvVar, "5")
+	value, err := p.resolveTerraformInt64Var(types.Int64Null(), IdsecConsistencyRetriesEnvVar, IdsecConsistencyRetriesDefault)
+	if err != nil || value.ValueInt64() != 5 {
+		t.Errorf("Expected 5, got %v (%v)", value, err)
+	}
+}
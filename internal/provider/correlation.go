@@ -0,0 +1,82 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/cyberark/idsec-sdk-golang/pkg/services"
+)
+
+// correlationIDHeader is the request header carrying the operation ID to the Idsec APIs.
+const correlationIDHeader = "X-Correlation-ID"
+
+// operationIDContextKey is the context key holding the ID of the current resource or data source operation.
+type operationIDContextKey struct{}
+
+// correlationIDContextKey is the context key holding the provider correlation ID the current operation ID
+// derives from.
+type correlationIDContextKey struct{}
+
+// newOperationID derives a per-operation sub-ID from the provider correlation ID,
+// e.g. "9b2c...-3fa1e07c", so every operation of an apply can be traced individually.
+func newOperationID(correlationID string) string {
+	suffix := make([]byte, 4)
+	if _, err := rand.Read(suffix); err != nil {
+		return correlationID
+	}
+	if correlationID == "" {
+		return hex.EncodeToString(suffix)
+	}
+	return fmt.Sprintf("%s-%s", correlationID, hex.EncodeToString(suffix))
+}
+
+// operationIDFromContext returns the operation ID stored on ctx, or an empty string.
+func operationIDFromContext(ctx context.Context) string {
+	if id, ok := ctx.Value(operationIDContextKey{}).(string); ok {
+		return id
+	}
+	return ""
+}
+
+// startOperation assigns a new operation ID, stores it and the provider correlation ID on the returned
+// context and the operation ID on its log fields, and adds it to the service telemetry context. The
+// correlation ID header is set once, when the service is configured, so every request of the provider
+// carries the same one.
+func (h *IdsecServiceHelper) startOperation(ctx context.Context, service services.IdsecService) context.Context {
+	correlationID := h.getSettings().correlationID
+	operationID := newOperationID(correlationID)
+	ctx = context.WithValue(ctx, correlationIDContextKey{}, correlationID)
+	ctx = context.WithValue(ctx, operationIDContextKey{}, operationID)
+	ctx = tflog.SetField(ctx, "correlation_id", operationID)
+	heapReporter.Report(ctx)
+	if service != nil {
+		h.addTelemetryContextField(service, "terraform_operation_id", "tfoid", operationID)
+	}
+	return ctx
+}
+
+// withCorrelationDetail appends the correlation ID sent with the requests and the operation ID to a
+// diagnostic detail so failed operations can be traced in CyberArk support logs.
+func withCorrelationDetail(ctx context.Context, detail string) string {
+	operationID := operationIDFromContext(ctx)
+	if operationID == "" {
+		return detail
+	}
+	correlationID, _ := ctx.Value(correlationIDContextKey{}).(string)
+	if correlationID == "" {
+		return fmt.Sprintf("%s\n\nOperation ID: %s", detail, operationID)
+	}
+	return fmt.Sprintf("%s\n\nCorrelation ID: %s\nOperation ID: %s", detail, correlationID, operationID)
+}
+
+// addErrorWithCorrelation adds an error diagnostic whose detail carries the operation ID.
+func addErrorWithCorrelation(ctx context.Context, diagnostics *diag.Diagnostics, summary string, detail string) {
+	diagnostics.AddError(summary, withCorrelationDetail(ctx, detail))
+}
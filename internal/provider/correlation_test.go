@@ -0,0 +1,53 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// TestNewOperationID tests that operation IDs are derived from the provider correlation ID.
+func TestNewOperationID(t *testing.T) {
+	first := newOperationID("pipeline-1234")
+	second := newOperationID("pipeline-1234")
+	if !strings.HasPrefix(first, "pipeline-1234-") {
+		t.Errorf("Expected operation ID to be prefixed with the correlation ID, got %q", first)
+	}
+	if first == second {
+		t.Errorf("Expected unique operation IDs, got %q twice", first)
+	}
+}
+
+// TestWithCorrelationDetail tests that the correlation and operation IDs are appended to diagnostic details.
+func TestWithCorrelationDetail(t *testing.T) {
+	helper := &IdsecServiceHelper{settings: &providerSettings{correlationID: "pipeline-1234"}}
+	ctx := context.Background()
+	if got := withCorrelationDetail(ctx, "failed"); got != "failed" {
+		t.Errorf("Expected detail to be unchanged without an operation ID, got %q", got)
+	}
+
+	ctx = helper.startOperation(ctx, nil)
+	operationID := operationIDFromContext(ctx)
+	if operationID == "" {
+		t.Fatal("Expected startOperation to store an operation ID on the context")
+	}
+	if got := withCorrelationDetail(ctx, "failed"); got != "failed\n\nCorrelation ID: pipeline-1234\nOperation ID: "+operationID {
+		t.Errorf("Unexpected detail %q", got)
+	}
+}
+
+// TestStartOperationLeavesHeaders tests that operations do not change the headers of the service clients,
+// which carry the correlation ID set when the service is configured.
+func TestStartOperationLeavesHeaders(t *testing.T) {
+	service := &mockServiceWithClient{client: &mockHeadersClient{headers: map[string]string{}}}
+	helper := &IdsecServiceHelper{settings: &providerSettings{correlationID: "pipeline-1234"}}
+	helper.applyRequestHeaders(service)
+	helper.startOperation(context.Background(), service)
+	if expected := map[string]string{correlationIDHeader: "pipeline-1234"}; !reflect.DeepEqual(service.client.headers, expected) {
+		t.Errorf("Expected %v, got %v", expected, service.client.headers)
+	}
+}
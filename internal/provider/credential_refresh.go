@@ -0,0 +1,102 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	authmodels "github.com/cyberark/idsec-sdk-golang/pkg/models/auth"
+)
+
+// authenticationErrorPatterns are the error fragments returned by the Idsec APIs when the token of a
+// call is rejected, typically because the credential it was obtained with was rotated or revoked.
+var authenticationErrorPatterns = []string{
+	"[401]",
+	"unauthorized",
+	"token expired",
+	"token is expired",
+	"invalid token",
+}
+
+// isAuthenticationError reports whether err belongs to the authentication error class.
+func isAuthenticationError(err error) bool {
+	return matchesErrorPatterns(err, authenticationErrorPatterns)
+}
+
+// callWithCredentialRefresh calls an action method, and calls it once more when it failed to
+// authenticate and the provider credentials were renewed meanwhile. Long-running provider processes,
+// such as those of Terraform Cloud agents, otherwise keep using a service token rotated in the
+// environment or the secret source after they were configured.
+func (h *IdsecServiceHelper) callWithCredentialRefresh(ctx context.Context, actionMethod reflect.Value, actionArgs []reflect.Value) []reflect.Value {
+	started := time.Now()
+	result := h.callActionMethod(ctx, actionMethod, actionArgs)
+	err := callResultError(result)
+	refresher := h.getSettings().credentialsRefresher
+	if !isAuthenticationError(err) || refresher == nil || !refresher(ctx, started) {
+		return result
+	}
+	tflog.Info(ctx, fmt.Sprintf("Retrying the call with the renewed provider credentials after: %s", err.Error()))
+	return h.callActionMethod(ctx, actionMethod, actionArgs)
+}
+
+// refreshCredentials resolves the credentials of the provider configuration again, from its secret
+// source, the environment and its attributes, and authenticates the auth of the provider with them when
+// they differ from the ones it holds. It reports whether the auth was authenticated after since, by this
+// call or a concurrent one, so that the calls of a batch failing together authenticate once.
+func (p *IdsecProvider) refreshCredentials(ctx context.Context, since time.Time) bool {
+	p.configureMu.Lock()
+	defer p.configureMu.Unlock()
+
+	if p.authRefreshedAt.After(since) {
+		return true
+	}
+	if p.credentialsConfig == nil {
+		return false
+	}
+	config := *p.credentialsConfig
+	creds, diags := p.resolveCredentials(ctx, &config)
+	if diags.HasError() {
+		tflog.Warn(ctx, fmt.Sprintf("Failed to resolve the provider credentials again: %v", diags.Errors()))
+		return false
+	}
+	defer creds.wipe()
+	key := credentialsKey(&config, creds)
+	if key == p.authKey {
+		tflog.Debug(ctx, "The provider credentials did not change, not authenticating again")
+		return false
+	}
+	var authenticator IdsecAuthenticator
+	switch {
+	case p.ispAuth != nil:
+		authenticator = p.ispAuth
+	case p.pvwaAuth != nil:
+		authenticator = p.pvwaAuth
+	default:
+		return false
+	}
+	tflog.Info(ctx, "The provider credentials changed, authenticating again")
+	// Forced, as the token cached for the same user was obtained with the previous credentials
+	_, err := authenticator.Authenticate(
+		nil,
+		&authmodels.IdsecAuthProfile{
+			Username:           creds.userName,
+			AuthMethod:         creds.authMethod,
+			AuthMethodSettings: creds.authMethodSettings,
+		},
+		creds.idsecSecret(),
+		true,
+		false,
+	)
+	if err != nil {
+		tflog.Warn(ctx, fmt.Sprintf("Failed to authenticate with the renewed provider credentials: %s", err.Error()))
+		return false
+	}
+	p.authKey = key
+	p.authRefreshedAt = time.Now()
+	return true
+}
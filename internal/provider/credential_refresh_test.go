@@ -0,0 +1,177 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TestIsAuthenticationError tests the classification of authentication errors.
+func TestIsAuthenticationError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "nil_error", err: nil, expected: false},
+		{name: "status_401", err: errors.New("failed to list safes - [401] - [{}]"), expected: true},
+		{name: "unauthorized", err: errors.New("request Unauthorized"), expected: true},
+		{name: "token_expired", err: errors.New("the token is expired"), expected: true},
+		{name: "other_error", err: errors.New("permission denied"), expected: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := isAuthenticationError(tt.err); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestCallWithCredentialRefresh tests that calls failing to authenticate are retried once when the credentials were renewed.
+func TestCallWithCredentialRefresh(t *testing.T) {
+	tests := []struct {
+		name          string
+		failure       error
+		renewed       bool
+		expectedCalls int
+		expectedRenew int
+		expectError   bool
+	}{
+		{name: "renewed_credentials_retried", failure: errors.New("[401] unauthorized"), renewed: true, expectedCalls: 2, expectedRenew: 1},
+		{name: "unchanged_credentials_not_retried", failure: errors.New("[401] unauthorized"), renewed: false, expectedCalls: 1, expectedRenew: 1, expectError: true},
+		{name: "other_error_not_refreshed", failure: errors.New("bad request"), renewed: true, expectedCalls: 1, expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			renewals := 0
+			helper := &IdsecServiceHelper{settings: newProviderSettings()}
+			helper.settings.credentialsRefresher = func(ctx context.Context, since time.Time) bool {
+				renewals++
+				return tt.renewed
+			}
+			calls := 0
+			method := func() (string, error) {
+				calls++
+				if calls == 1 {
+					return "", tt.failure
+				}
+				return "ok", nil
+			}
+			result := helper.callWithCredentialRefresh(context.Background(), reflect.ValueOf(method), nil)
+			if calls != tt.expectedCalls {
+				t.Errorf("Expected %d calls, got %d", tt.expectedCalls, calls)
+			}
+			if renewals != tt.expectedRenew {
+				t.Errorf("Expected %d credential refreshes, got %d", tt.expectedRenew, renewals)
+			}
+			if err := callResultError(result); (err != nil) != tt.expectError {
+				t.Errorf("Expected error=%v, got %v", tt.expectError, err)
+			}
+		})
+	}
+}
+
+// TestRefreshCredentials tests that the credentials are resolved again from the environment, and only
+// authenticated with when they changed.
+func TestRefreshCredentials(t *testing.T) {
+	t.Setenv(IdsecServiceTokenEnvVar, "token-1")
+	config := IdsecProviderSchema{
+		AuthMethod:  types.StringValue("identity_service_user"),
+		Subdomain:   types.StringValue("tenant"),
+		ServiceUser: types.StringValue("svc-terraform"),
+	}
+	p := &IdsecProvider{credentialsConfig: &config}
+	resolved := config
+	creds, diags := p.resolveCredentials(context.Background(), &resolved)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	p.authKey = credentialsKey(&resolved, creds)
+
+	if p.refreshCredentials(context.Background(), time.Now()) {
+		t.Error("expected unchanged credentials not to be renewed")
+	}
+	t.Setenv(IdsecServiceTokenEnvVar, "token-2")
+	if p.refreshCredentials(context.Background(), time.Now()) {
+		t.Error("expected rotated credentials not to be renewed without an auth to authenticate")
+	}
+	if config.ServiceToken.ValueString() != "" {
+		t.Error("expected the stored configuration not to hold the resolved token")
+	}
+
+	since := time.Now()
+	p.authRefreshedAt = since.Add(time.Second)
+	if !p.refreshCredentials(context.Background(), since) {
+		t.Error("expected credentials renewed by a concurrent call to be reported as renewed")
+	}
+}
+
+// TestRefreshableCredentialsConfig tests that the configuration kept to resolve the credentials again
+// holds no secret, and is not kept when the credential, or one of its secret source, is set in the
+// provider block.
+func TestRefreshableCredentialsConfig(t *testing.T) {
+	t.Parallel()
+
+	config := IdsecProviderSchema{
+		AuthMethod:    types.StringValue("identity_service_user"),
+		ServiceUser:   types.StringValue("svc-terraform"),
+		Secret:        types.StringValue("unused"),
+		ProxyPassword: types.StringValue("proxy"),
+	}
+	kept := refreshableCredentialsConfig(context.Background(), config)
+	if kept == nil {
+		t.Fatal("expected the configuration to be kept when the service token is resolved elsewhere")
+	}
+	if !kept.Secret.IsNull() || !kept.ProxyPassword.IsNull() || kept.ServiceUser.ValueString() != "svc-terraform" {
+		t.Errorf("expected the secrets to be dropped and the rest kept, got %+v", kept)
+	}
+
+	config.SecretSource = testSecretSource(t, map[string]string{"address": "https://vault.example.com", "path": "terraform/idsec"})
+	if refreshableCredentialsConfig(context.Background(), config) == nil {
+		t.Error("expected the configuration to be kept when the Vault token is read from the environment")
+	}
+	for name, source := range map[string]types.Object{
+		"vault_token":     testSecretSource(t, map[string]string{"address": "https://vault.example.com", "path": "terraform/idsec", "token": "root-token"}),
+		"vault_secret_id": testSecretSource(t, map[string]string{"address": "https://vault.example.com", "path": "terraform/idsec", "role_id": "role-1", "secret_id": "secret-1"}),
+		"conjur_api_key":  testConjurSecretSource(t, map[string]string{"appliance_url": "https://conjur.example.com", "api_key": "api-key"}),
+	} {
+		config.SecretSource = source
+		if refreshableCredentialsConfig(context.Background(), config) != nil {
+			t.Errorf("%s: expected no configuration to be kept with a credential set in secret_source", name)
+		}
+	}
+
+	config.SecretSource = types.ObjectNull(testSecretSourceAttrTypes)
+	config.ServiceToken = types.StringValue("token")
+	if refreshableCredentialsConfig(context.Background(), config) != nil {
+		t.Error("expected no configuration to be kept with a configured service token")
+	}
+}
+
+// TestAuthCredentialsWipe tests that wiping credentials zeroes the buffer of their secret.
+func TestAuthCredentialsWipe(t *testing.T) {
+	t.Parallel()
+
+	secret := []byte("secret")
+	creds := &authCredentials{userName: "admin", secret: secret}
+	if creds.idsecSecret().Secret != "secret" {
+		t.Errorf("expected the secret to be passed on, got %q", creds.idsecSecret().Secret)
+	}
+	creds.wipe()
+	if creds.secret != nil || string(secret) != "\x00\x00\x00\x00\x00\x00" {
+		t.Errorf("expected the secret to be zeroed, got %q", secret)
+	}
+}
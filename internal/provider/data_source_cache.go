@@ -0,0 +1,219 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	authmodels "github.com/cyberark/idsec-sdk-golang/pkg/models/auth"
+	"github.com/cyberark/terraform-provider-idsec/internal/schemas"
+)
+
+// Data sources have no private state, so the results kept for cache_ttl are persisted by the result
+// cache in files shared by the runs of the same machine, keyed by provider version, tenant, user,
+// service, action and arguments. The files are not encrypted, which is why data sources with sensitive
+// attributes have no cache_ttl.
+var dataSourceCacheFolder = func() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".idsec", "cache", "data_sources"), nil
+}
+
+// errorType is the type of the error returned by action methods, which is not persisted.
+var errorType = reflect.TypeOf((*error)(nil)).Elem()
+
+// persistedResult is an action call result stored for cache_ttl.
+type persistedResult struct {
+	FetchedAt time.Time `json:"fetched_at"`
+	// Values are the JSON encodings of the values returned by the action method, the items of a
+	// channel encoded as an array, and null for errors.
+	Values []json.RawMessage `json:"values"`
+}
+
+// diskResultStore is the resultStore persisting the results of an action method in dataSourceCacheFolder.
+type diskResultStore struct {
+	ctx context.Context
+	// resultTypes are the types of the values returned by the action method, which stored results are
+	// decoded into.
+	resultTypes []reflect.Type
+}
+
+// newDiskResultStore creates the store of the results of the action method of type methodType.
+func newDiskResultStore(ctx context.Context, methodType reflect.Type) *diskResultStore {
+	resultTypes := make([]reflect.Type, methodType.NumOut())
+	for i := range resultTypes {
+		resultTypes[i] = methodType.Out(i)
+	}
+	return &diskResultStore{ctx: ctx, resultTypes: resultTypes}
+}
+
+// file returns the file the result of key is stored in.
+func (d *diskResultStore) file(key string) (string, error) {
+	folder, err := dataSourceCacheFolder()
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256([]byte(providerVersion + "\n" + key))
+	return filepath.Join(folder, hex.EncodeToString(hash[:])+".json"), nil
+}
+
+func (d *diskResultStore) load(key string) ([]reflect.Value, map[int][]reflect.Value, time.Time, bool) {
+	file, err := d.file(key)
+	if err != nil {
+		return nil, nil, time.Time{}, false
+	}
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil, nil, time.Time{}, false
+	}
+	var stored persistedResult
+	if err := json.Unmarshal(content, &stored); err != nil || len(stored.Values) != len(d.resultTypes) {
+		return nil, nil, time.Time{}, false
+	}
+	result := make([]reflect.Value, len(d.resultTypes))
+	var items map[int][]reflect.Value
+	for i, resultType := range d.resultTypes {
+		switch {
+		case resultType.Implements(errorType):
+			result[i] = reflect.Zero(resultType)
+		case resultType.Kind() == reflect.Chan:
+			decoded := reflect.New(reflect.SliceOf(resultType.Elem()))
+			if err := json.Unmarshal(stored.Values[i], decoded.Interface()); err != nil {
+				tflog.Debug(d.ctx, fmt.Sprintf("Ignoring the cached result of the data source: %s", err.Error()))
+				return nil, nil, time.Time{}, false
+			}
+			if items == nil {
+				items = map[int][]reflect.Value{}
+			}
+			for j := 0; j < decoded.Elem().Len(); j++ {
+				items[i] = append(items[i], decoded.Elem().Index(j))
+			}
+			result[i] = reflect.Zero(resultType)
+		default:
+			decoded := reflect.New(resultType)
+			if err := json.Unmarshal(stored.Values[i], decoded.Interface()); err != nil {
+				tflog.Debug(d.ctx, fmt.Sprintf("Ignoring the cached result of the data source: %s", err.Error()))
+				return nil, nil, time.Time{}, false
+			}
+			result[i] = decoded.Elem()
+		}
+	}
+	tflog.Info(d.ctx, fmt.Sprintf("Reusing the result read at %s, within the cache_ttl", stored.FetchedAt.Format(time.RFC3339)))
+	return result, items, stored.FetchedAt, true
+}
+
+func (d *diskResultStore) save(key string, result []reflect.Value, items map[int][]reflect.Value, fetchedAt time.Time) {
+	err := func() error {
+		file, err := d.file(key)
+		if err != nil {
+			return err
+		}
+		stored := persistedResult{FetchedAt: fetchedAt, Values: make([]json.RawMessage, len(result))}
+		for i, value := range result {
+			var encoded []byte
+			switch {
+			case value.Type().Implements(errorType):
+				encoded = []byte("null")
+			case value.Kind() == reflect.Chan:
+				values := make([]interface{}, len(items[i]))
+				for j, item := range items[i] {
+					values[j] = item.Interface()
+				}
+				encoded, err = json.Marshal(values)
+			default:
+				encoded, err = json.Marshal(value.Interface())
+			}
+			if err != nil {
+				return err
+			}
+			stored.Values[i] = encoded
+		}
+		content, err := json.Marshal(stored)
+		if err != nil {
+			return err
+		}
+		if err := os.MkdirAll(filepath.Dir(file), 0o700); err != nil {
+			return err
+		}
+		// Written aside and renamed, so concurrent runs never read a partial file
+		temporary, err := os.CreateTemp(filepath.Dir(file), ".tmp-*")
+		if err != nil {
+			return err
+		}
+		defer os.Remove(temporary.Name())
+		if _, err := temporary.Write(content); err != nil {
+			_ = temporary.Close()
+			return err
+		}
+		if err := temporary.Close(); err != nil {
+			return err
+		}
+		return os.Rename(temporary.Name(), file)
+	}()
+	if err != nil {
+		tflog.Warn(d.ctx, fmt.Sprintf("Failed to cache the result of the data source: %s", err.Error()))
+	}
+}
+
+// tokenHolder is implemented by the authenticators given to data sources as provider data.
+type tokenHolder interface {
+	GetToken() *authmodels.IdsecToken
+}
+
+// dataSourceCacheIdentity returns the tenant and user the results of a data source are read as, or ""
+// when they are unknown, in which case results are not cached.
+func dataSourceCacheIdentity(providerData interface{}) string {
+	holder, ok := providerData.(tokenHolder)
+	if !ok {
+		return ""
+	}
+	token := holder.GetToken()
+	if token == nil || token.Endpoint == "" {
+		return ""
+	}
+	return token.Endpoint + "/" + token.Username
+}
+
+// cacheTTL returns the cache_ttl of the configuration, and false when it is not set.
+func (s *IdsecDataSource) cacheTTL(ctx context.Context, config tfsdk.Config) (time.Duration, bool) {
+	var cacheTTL types.String
+	if diags := config.GetAttribute(ctx, path.Root(schemas.CacheTTLAttributeName), &cacheTTL); diags.HasError() || cacheTTL.IsNull() || cacheTTL.IsUnknown() {
+		return 0, false
+	}
+	ttl, err := time.ParseDuration(cacheTTL.ValueString())
+	return ttl, err == nil && ttl > 0
+}
+
+// setCacheTTL sets cache_ttl in the state of a read to its configured value, as it has no field in the
+// models the state is converted from.
+func setCacheTTL(ctx context.Context, config tfsdk.Config, stateResult types.Object) (types.Object, error) {
+	if _, exists := stateResult.Attributes()[schemas.CacheTTLAttributeName]; !exists {
+		return stateResult, nil
+	}
+	var cacheTTL types.String
+	if diags := config.GetAttribute(ctx, path.Root(schemas.CacheTTLAttributeName), &cacheTTL); diags.HasError() {
+		return stateResult, fmt.Errorf("failed to get cache_ttl: %v", diags)
+	}
+	attributes := stateResult.Attributes()
+	attributes[schemas.CacheTTLAttributeName] = cacheTTL
+	updated, diags := types.ObjectValue(stateResult.AttributeTypes(ctx), attributes)
+	if diags.HasError() {
+		return stateResult, fmt.Errorf("failed to set cache_ttl: %v", diags)
+	}
+	return updated, nil
+}
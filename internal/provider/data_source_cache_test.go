@@ -0,0 +1,97 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"reflect"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	authmodels "github.com/cyberark/idsec-sdk-golang/pkg/models/auth"
+	"github.com/cyberark/terraform-provider-idsec/internal/schemas"
+)
+
+type testTokenHolder struct {
+	token *authmodels.IdsecToken
+}
+
+func (h testTokenHolder) GetToken() *authmodels.IdsecToken {
+	return h.token
+}
+
+func TestDataSourceCacheIdentity(t *testing.T) {
+	if identity := dataSourceCacheIdentity(testTokenHolder{token: &authmodels.IdsecToken{Endpoint: "https://tenant.example.com", Username: "admin"}}); identity != "https://tenant.example.com/admin" {
+		t.Errorf("expected the endpoint and user, got %q", identity)
+	}
+	if identity := dataSourceCacheIdentity(testTokenHolder{}); identity != "" {
+		t.Errorf("expected no identity without a token, got %q", identity)
+	}
+	if identity := dataSourceCacheIdentity("not an authenticator"); identity != "" {
+		t.Errorf("expected no identity for other provider data, got %q", identity)
+	}
+}
+
+func TestDiskResultStore(t *testing.T) {
+	folder := t.TempDir()
+	originalFolder := dataSourceCacheFolder
+	dataSourceCacheFolder = func() (string, error) { return folder, nil }
+	t.Cleanup(func() { dataSourceCacheFolder = originalFolder })
+
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+	var calls int32
+	listCall := func() []reflect.Value {
+		atomic.AddInt32(&calls, 1)
+		pages := make(chan *testCacheInput, 1)
+		pages <- &testCacheInput{SafeName: "finance"}
+		close(pages)
+		var readOnly <-chan *testCacheInput = pages
+		return []reflect.Value{reflect.ValueOf(readOnly), reflect.Zero(errorType)}
+	}
+	methodType := reflect.TypeOf(func(*testCacheInput) (<-chan *testCacheInput, error) { return nil, nil })
+	// run reads the key with a new cache, as a later Terraform run would
+	run := func(key string) []string {
+		cache := newResultCache()
+		cache.now = func() time.Time { return now }
+		result, _ := cache.call(key, 15*time.Minute, newDiskResultStore(context.Background(), methodType), listCall)
+		var names []string
+		for page := range result[0].Interface().(<-chan *testCacheInput) {
+			names = append(names, page.SafeName)
+		}
+		return names
+	}
+
+	if names := run("tenant/admin/pcloud-safes/list"); len(names) != 1 || names[0] != "finance" {
+		t.Fatalf("expected the listed safe, got %v", names)
+	}
+	now = now.Add(10 * time.Minute)
+	if names := run("tenant/admin/pcloud-safes/list"); len(names) != 1 || names[0] != "finance" || calls != 1 {
+		t.Fatalf("expected the stored result within the cache_ttl, got %v after %d calls", names, calls)
+	}
+	run("other/admin/pcloud-safes/list")
+	if calls != 2 {
+		t.Errorf("expected no stored result for another tenant, got %d calls", calls)
+	}
+	now = now.Add(10 * time.Minute)
+	run("tenant/admin/pcloud-safes/list")
+	if calls != 3 {
+		t.Errorf("expected the stored result to expire after the cache_ttl, got %d calls", calls)
+	}
+}
+
+func TestIdsecDataSource_CacheTTLAttribute(t *testing.T) {
+	sensitive := map[string]schema.Attribute{
+		"credentials": schema.SingleNestedAttribute{Computed: true, Attributes: map[string]schema.Attribute{
+			"password": schema.StringAttribute{Computed: true, Sensitive: true},
+		}},
+	}
+	if !schemas.HasSensitiveDataSourceAttributes(sensitive) {
+		t.Error("expected a nested sensitive attribute to be found")
+	}
+	if schemas.HasSensitiveDataSourceAttributes(map[string]schema.Attribute{"name": schema.StringAttribute{Computed: true}}) {
+		t.Error("expected no sensitive attribute")
+	}
+}
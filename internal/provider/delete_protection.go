@@ -0,0 +1,72 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/cyberark/terraform-provider-idsec/internal/actions"
+	"github.com/cyberark/terraform-provider-idsec/internal/schemas"
+)
+
+// addDeletionProtectionAttribute adds the prevent_destroy_api_side attribute to the schema of resources
+// that can be deleted, unless the models already declare an attribute of that name.
+func (s *IdsecResource) addDeletionProtectionAttribute(resourceSchema *schema.Schema) {
+	if !slices.Contains(s.actionDefinition.SupportedOperations, actions.DeleteOperation) {
+		return
+	}
+	if _, exists := resourceSchema.Attributes[schemas.PreventDestroyAPISideAttributeName]; exists {
+		return
+	}
+	resourceSchema.Attributes[schemas.PreventDestroyAPISideAttributeName] = schemas.PreventDestroyAPISideAttribute()
+}
+
+// deletionProtected reports whether prevent_destroy_api_side is true in state.
+func deletionProtected(ctx context.Context, state tfsdk.State) bool {
+	if state.Raw.IsNull() {
+		return false
+	}
+	var protected types.Bool
+	if diags := state.GetAttribute(ctx, path.Root(schemas.PreventDestroyAPISideAttributeName), &protected); diags.HasError() {
+		return false
+	}
+	return protected.ValueBool()
+}
+
+// refuseProtectedDelete fails the deletion of a resource whose prevent_destroy_api_side is true, and
+// reports whether it did.
+func (s *IdsecResource) refuseProtectedDelete(ctx context.Context, state tfsdk.State, diagnostics *diag.Diagnostics) bool {
+	if !deletionProtected(ctx, state) {
+		return false
+	}
+	diagnostics.AddAttributeError(
+		path.Root(schemas.PreventDestroyAPISideAttributeName),
+		"Resource Protected From Deletion",
+		fmt.Sprintf("This %s has prevent_destroy_api_side set to true, so it was not deleted. To delete it, set prevent_destroy_api_side to false and apply before destroying it.",
+			s.getTerraformTypeName(s.actionDefinition.ActionName)),
+	)
+	return true
+}
+
+// planDeletionProtection warns when a protected resource is planned for destruction, since the apply will
+// fail.
+func (s *IdsecResource) planDeletionProtection(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !req.Plan.Raw.IsNull() || !deletionProtected(ctx, req.State) {
+		return
+	}
+	resp.Diagnostics.AddAttributeWarning(
+		path.Root(schemas.PreventDestroyAPISideAttributeName),
+		"Resource Protected From Deletion",
+		fmt.Sprintf("This %s has prevent_destroy_api_side set to true, so destroying it will fail. Set prevent_destroy_api_side to false and apply first.",
+			s.getTerraformTypeName(s.actionDefinition.ActionName)),
+	)
+}
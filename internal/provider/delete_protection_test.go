@@ -0,0 +1,90 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/cyberark/terraform-provider-idsec/internal/actions"
+	"github.com/cyberark/terraform-provider-idsec/internal/schemas"
+)
+
+type deleteProtectionTestModel struct {
+	SafeName string `json:"safe_name" mapstructure:"safe_name" validate:"required"`
+}
+
+func deleteProtectionTestResource(t *testing.T, operations []actions.IdsecServiceActionOperation) (*IdsecResource, resource.SchemaResponse) {
+	t.Helper()
+	return CreateTestResourceWithSchema(t, &deleteProtectionTestModel{}, operations, nil)
+}
+
+func deleteProtectionTestValue(schemaResp resource.SchemaResponse, protected interface{}) tftypes.Value {
+	return CreateTestResourceValue(schemaResp, map[string]tftypes.Value{
+		"safe_name": tftypes.NewValue(tftypes.String, "crown-jewels"),
+		schemas.PreventDestroyAPISideAttributeName: tftypes.NewValue(tftypes.Bool, protected),
+	})
+}
+
+var deleteProtectionOperations = []actions.IdsecServiceActionOperation{actions.CreateOperation, actions.ReadOperation, actions.DeleteOperation}
+
+func TestIdsecResource_DeletionProtectionSchema(t *testing.T) {
+	_, schemaResp := deleteProtectionTestResource(t, deleteProtectionOperations)
+	attribute, ok := schemaResp.Schema.Attributes[schemas.PreventDestroyAPISideAttributeName]
+	if !ok || !attribute.IsOptional() || attribute.IsComputed() {
+		t.Fatalf("expected an optional prevent_destroy_api_side attribute, got %+v", attribute)
+	}
+
+	_, schemaResp = deleteProtectionTestResource(t, []actions.IdsecServiceActionOperation{actions.CreateOperation, actions.ReadOperation})
+	if _, ok := schemaResp.Schema.Attributes[schemas.PreventDestroyAPISideAttributeName]; ok {
+		t.Error("expected no prevent_destroy_api_side attribute on resources that cannot be deleted")
+	}
+}
+
+func TestIdsecResource_RefuseProtectedDelete(t *testing.T) {
+	tests := []struct {
+		name      string
+		protected interface{}
+		refused   bool
+	}{
+		{name: "protected", protected: true, refused: true},
+		{name: "unprotected", protected: false},
+		{name: "unset", protected: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idsecRes, schemaResp := deleteProtectionTestResource(t, deleteProtectionOperations)
+			state := tfsdk.State{Schema: schemaResp.Schema, Raw: deleteProtectionTestValue(schemaResp, tt.protected)}
+			var diags diag.Diagnostics
+			if refused := idsecRes.refuseProtectedDelete(context.Background(), state, &diags); refused != tt.refused {
+				t.Fatalf("refuseProtectedDelete() = %v, want %v", refused, tt.refused)
+			}
+			if tt.refused && (!diags.HasError() || diags.Errors()[0].Summary() != "Resource Protected From Deletion") {
+				t.Errorf("expected a protected resource error, got %v", diags)
+			}
+			if !tt.refused && diags.HasError() {
+				t.Errorf("unexpected diagnostics: %v", diags)
+			}
+		})
+	}
+}
+
+func TestIdsecResource_PlanDeletionProtectionDestroyWarning(t *testing.T) {
+	ctx := context.Background()
+	idsecRes, schemaResp := deleteProtectionTestResource(t, deleteProtectionOperations)
+	state := deleteProtectionTestValue(schemaResp, true)
+	req := resource.ModifyPlanRequest{
+		Plan:  tfsdk.Plan{Schema: schemaResp.Schema, Raw: tftypes.NewValue(state.Type(), nil)},
+		State: tfsdk.State{Schema: schemaResp.Schema, Raw: state},
+	}
+	resp := &resource.ModifyPlanResponse{Plan: req.Plan}
+	idsecRes.planDeletionProtection(ctx, req, resp)
+	if resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() != 1 || resp.Diagnostics.Warnings()[0].Summary() != "Resource Protected From Deletion" {
+		t.Errorf("expected a protected resource warning, got %v", resp.Diagnostics)
+	}
+}
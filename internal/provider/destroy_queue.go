@@ -0,0 +1,81 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/cyberark/terraform-provider-idsec/internal/actions"
+)
+
+// throttlingErrorPatterns are the error fragments returned by the Idsec APIs when they reject requests
+// under load. They are retried for deletes, which Terraform runs by the hundreds on large teardowns.
+var throttlingErrorPatterns = []string{
+	"[429]",
+	"[502]",
+	"[503]",
+	"[504]",
+	"too many requests",
+	"rate limit",
+	"throttl",
+}
+
+// isThrottlingError reports whether err belongs to the throttling error class.
+func isThrottlingError(err error) bool {
+	return matchesErrorPatterns(err, throttlingErrorPatterns)
+}
+
+// destroyQueue bounds the number of concurrent deletes across all services. Terraform walks the graph
+// with its own parallelism per provider operation, so a destroy of hundreds of resources otherwise sends
+// bursts of deletes the APIs answer with throttling errors. Deletes beyond the limit wait in line.
+type destroyQueue struct {
+	slots   chan struct{}
+	waiting atomic.Int64
+}
+
+// operationRetries returns how many times a failing operation is retried: deletes follow destroy_retries,
+// other operations consistency_retries.
+func (ps *providerSettings) operationRetries(operation actions.IdsecServiceActionOperation) int64 {
+	if operation == actions.DeleteOperation {
+		return ps.destroyRetries
+	}
+	return ps.consistencyRetries
+}
+
+// newDestroyQueue creates a queue allowing limit concurrent deletes. It returns nil for a limit of 0,
+// which leaves deletes unbounded.
+func newDestroyQueue(limit int64) (*destroyQueue, error) {
+	if limit < 0 {
+		return nil, fmt.Errorf("the limit must be zero or greater, got %d", limit)
+	}
+	if limit == 0 {
+		return nil, nil
+	}
+	return &destroyQueue{slots: make(chan struct{}, limit)}, nil
+}
+
+// acquire waits for a free delete slot, or for ctx to be done. The returned function releases the slot
+// and must be called once the delete completed.
+func (q *destroyQueue) acquire(ctx context.Context) (func(), error) {
+	if q == nil {
+		return func() {}, nil
+	}
+	select {
+	case q.slots <- struct{}{}:
+		return func() { <-q.slots }, nil
+	default:
+	}
+	waiting := q.waiting.Add(1)
+	defer q.waiting.Add(-1)
+	tflog.Debug(ctx, fmt.Sprintf("All %d delete slots are in use, waiting in line with %d other deletes", cap(q.slots), waiting-1))
+	select {
+	case q.slots <- struct{}{}:
+		return func() { <-q.slots }, nil
+	case <-ctx.Done():
+		return nil, fmt.Errorf("timed out waiting for a delete slot: %w", ctx.Err())
+	}
+}
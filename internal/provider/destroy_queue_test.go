@@ -0,0 +1,115 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cyberark/terraform-provider-idsec/internal/actions"
+)
+
+func TestNewDestroyQueue(t *testing.T) {
+	tests := []struct {
+		name      string
+		limit     int64
+		wantNil   bool
+		wantError bool
+	}{
+		{name: "success_unbounded", limit: 0, wantNil: true},
+		{name: "success_limit", limit: 3},
+		{name: "error_negative_limit", limit: -1, wantError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			queue, err := newDestroyQueue(tt.limit)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("expected error=%v, got %v", tt.wantError, err)
+			}
+			if !tt.wantError && (queue == nil) != tt.wantNil {
+				t.Errorf("expected nil queue=%v, got %v", tt.wantNil, queue)
+			}
+		})
+	}
+}
+
+func TestDestroyQueueAcquire(t *testing.T) {
+	queue, err := newDestroyQueue(3)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var running, peak int32
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			release, err := queue.acquire(context.Background())
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			current := atomic.AddInt32(&running, 1)
+			for {
+				previous := atomic.LoadInt32(&peak)
+				if current <= previous || atomic.CompareAndSwapInt32(&peak, previous, current) {
+					break
+				}
+			}
+			time.Sleep(2 * time.Millisecond)
+			atomic.AddInt32(&running, -1)
+			release()
+		}()
+	}
+	wg.Wait()
+	if peak > 3 {
+		t.Errorf("expected at most 3 concurrent deletes, got %d", peak)
+	}
+
+	var releases []func()
+	for i := 0; i < 3; i++ {
+		release, _ := queue.acquire(context.Background())
+		releases = append(releases, release)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := queue.acquire(ctx); err == nil {
+		t.Error("expected an error when the context is done before a slot frees up")
+	}
+	for _, release := range releases {
+		release()
+	}
+
+	var unbounded *destroyQueue
+	if release, err := unbounded.acquire(context.Background()); err != nil || release == nil {
+		t.Errorf("expected a nil queue to never block, got %v", err)
+	}
+}
+
+func TestRetryableReasonThrottling(t *testing.T) {
+	throttled := errors.New("failed to delete safe - [429] - [Too Many Requests]")
+	if _, retriable := retryableReason(actions.DeleteOperation, throttled, nil); !retriable {
+		t.Error("expected throttling errors of deletes to be retriable")
+	}
+	if _, retriable := retryableReason(actions.CreateOperation, throttled, nil); retriable {
+		t.Error("expected throttling errors of creates to stay non retriable")
+	}
+	if _, retriable := retryableReason(actions.DeleteOperation, errors.New("failed to delete safe - [400] - [safe has members]"), nil); retriable {
+		t.Error("expected other errors of deletes to stay non retriable")
+	}
+}
+
+func TestOperationRetries(t *testing.T) {
+	settings := &providerSettings{consistencyRetries: 3, destroyRetries: 5}
+	if got := settings.operationRetries(actions.DeleteOperation); got != 5 {
+		t.Errorf("expected deletes to be retried 5 times, got %d", got)
+	}
+	if got := settings.operationRetries(actions.CreateOperation); got != 3 {
+		t.Errorf("expected creates to be retried 3 times, got %d", got)
+	}
+}
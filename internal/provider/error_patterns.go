@@ -0,0 +1,24 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"strings"
+)
+
+// matchesErrorPatterns reports whether the message of err contains one of the patterns, which are given in
+// lowercase and matched regardless of case. Error classes such as throttling or authentication errors are
+// recognized by the fragments the Idsec APIs return, as the SDK does not type its errors.
+func matchesErrorPatterns(err error, patterns []string) bool {
+	if err == nil {
+		return false
+	}
+	message := strings.ToLower(err.Error())
+	for _, pattern := range patterns {
+		if strings.Contains(message, pattern) {
+			return true
+		}
+	}
+	return false
+}
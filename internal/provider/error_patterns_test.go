@@ -0,0 +1,33 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMatchesErrorPatterns(t *testing.T) {
+	t.Parallel()
+
+	patterns := []string{"[429]", "too many requests"}
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "status_code", err: errors.New("failed to add safe - [429] - [slow down]"), expected: true},
+		{name: "case_insensitive", err: errors.New("Too Many Requests"), expected: true},
+		{name: "other_error", err: errors.New("failed to add safe - [400] - [invalid name]"), expected: false},
+		{name: "nil", err: nil, expected: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := matchesErrorPatterns(tt.err, patterns); got != tt.expected {
+				t.Errorf("Expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/cyberark/terraform-provider-idsec/internal/actions"
+)
+
+// fakeActionInvoker is an in-memory actionInvoker standing in for an SDK service in unit tests of
+// IdsecResource. It resolves the actions mapped by an action definition to methods typed as the SDK ones,
+// taking the schema of the action and returning the state schema, and keeps the objects they manage by
+// ID: creates store their input under a generated ID, reads return the stored object, updates overwrite
+// the fields set in their input and deletes remove it.
+type fakeActionInvoker struct {
+	mu          sync.Mutex
+	definition  *actions.IdsecServiceTerraformResourceActionDefinition
+	idAttribute string
+	objects     map[string]map[string]interface{}
+	nextID      int
+	failures    map[actions.IdsecServiceActionOperation]error
+	calls       []actions.IdsecServiceActionOperation
+}
+
+// newFakeActionInvoker returns a fakeActionInvoker for the actions of definition, whose objects are
+// identified by the attribute of the given name.
+func newFakeActionInvoker(definition *actions.IdsecServiceTerraformResourceActionDefinition, idAttribute string) *fakeActionInvoker {
+	return &fakeActionInvoker{
+		definition:  definition,
+		idAttribute: idAttribute,
+		objects:     map[string]map[string]interface{}{},
+		failures:    map[actions.IdsecServiceActionOperation]error{},
+	}
+}
+
+// failOn makes the actions of the operation fail with err, or succeed again with a nil err.
+func (f *fakeActionInvoker) failOn(operation actions.IdsecServiceActionOperation, err error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err == nil {
+		delete(f.failures, operation)
+		return
+	}
+	f.failures[operation] = err
+}
+
+// object returns a copy of the stored fields of the object of the given ID, or nil.
+func (f *fakeActionInvoker) object(id string) map[string]interface{} {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	stored, ok := f.objects[id]
+	if !ok {
+		return nil
+	}
+	return copyFakeFields(stored)
+}
+
+// setField changes a field of a stored object, as a change made outside Terraform would.
+func (f *fakeActionInvoker) setField(id, name string, value interface{}) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if stored, ok := f.objects[id]; ok {
+		stored[name] = value
+	}
+}
+
+// operationCalls returns the operations called so far, in order.
+func (f *fakeActionInvoker) operationCalls() []actions.IdsecServiceActionOperation {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]actions.IdsecServiceActionOperation(nil), f.calls...)
+}
+
+// actionMethod returns a method typed as the SDK method of the action mapped to actionName.
+func (f *fakeActionInvoker) actionMethod(actionName string) (*reflect.Value, error) {
+	errorType := reflect.TypeOf((*error)(nil)).Elem()
+	for operation, mapped := range f.definition.ActionsMappings {
+		if !strings.EqualFold(strings.ReplaceAll(mapped, "-", ""), actionName) {
+			continue
+		}
+		inputType := reflect.TypeOf(f.definition.Schemas[mapped])
+		stateType := reflect.TypeOf(f.definition.StateSchema)
+		outputTypes := []reflect.Type{stateType, errorType}
+		if operation == actions.DeleteOperation {
+			outputTypes = []reflect.Type{errorType}
+		}
+		methodType := reflect.FuncOf([]reflect.Type{inputType}, outputTypes, false)
+		method := reflect.MakeFunc(methodType, func(args []reflect.Value) []reflect.Value {
+			fields, err := f.apply(operation, args[0].Interface())
+			errValue := reflect.Zero(errorType)
+			if err != nil {
+				errValue = reflect.ValueOf(&err).Elem()
+			}
+			if operation == actions.DeleteOperation {
+				return []reflect.Value{errValue}
+			}
+			result := reflect.Zero(stateType)
+			if err == nil {
+				result = reflect.New(stateType.Elem())
+				if encoded, marshalErr := json.Marshal(fields); marshalErr == nil {
+					_ = json.Unmarshal(encoded, result.Interface())
+				}
+			}
+			return []reflect.Value{result, errValue}
+		})
+		return &method, nil
+	}
+	return nil, fmt.Errorf("method %s not found", actionName)
+}
+
+// apply runs an operation over the stored objects and returns the fields of the object it leaves.
+func (f *fakeActionInvoker) apply(operation actions.IdsecServiceActionOperation, input interface{}) (map[string]interface{}, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.calls = append(f.calls, operation)
+	if err := f.failures[operation]; err != nil {
+		return nil, err
+	}
+	fields, err := fakeObjectFields(input)
+	if err != nil {
+		return nil, err
+	}
+	id, _ := fields[f.idAttribute].(string)
+	if operation == actions.CreateOperation {
+		f.nextID++
+		if id == "" {
+			id = fmt.Sprintf("fake-%d", f.nextID)
+		}
+		fields[f.idAttribute] = id
+		f.objects[id] = fields
+		return copyFakeFields(fields), nil
+	}
+	stored, ok := f.objects[id]
+	if !ok {
+		return nil, fmt.Errorf("[410] object %q is gone", id)
+	}
+	switch operation {
+	case actions.UpdateOperation:
+		for name, value := range fields {
+			if value != nil && !reflect.ValueOf(value).IsZero() {
+				stored[name] = value
+			}
+		}
+	case actions.DeleteOperation:
+		delete(f.objects, id)
+	}
+	return copyFakeFields(stored), nil
+}
+
+// fakeObjectFields returns the fields of an action input keyed by their JSON names. Fields left empty
+// are kept, with their zero value.
+func fakeObjectFields(input interface{}) (map[string]interface{}, error) {
+	encoded, err := json.Marshal(input)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]interface{}{}
+	if err := json.Unmarshal(encoded, &fields); err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+// copyFakeFields returns a shallow copy of the fields of a stored object.
+func copyFakeFields(fields map[string]interface{}) map[string]interface{} {
+	copied := make(map[string]interface{}, len(fields))
+	for key, value := range fields {
+		copied[key] = value
+	}
+	return copied
+}
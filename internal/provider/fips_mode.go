@@ -0,0 +1,29 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"crypto/fips140"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// fipsEnabled reports whether the Go Cryptographic Module runs in FIPS 140-3 mode, in which crypto/tls
+// only negotiates FIPS approved protocol versions, cipher suites and key exchanges, for the connections
+// of the SDK as well as those of the provider.
+var fipsEnabled = fips140.Enabled
+
+// requireFIPSMode fails the configuration when fips_mode is enabled and the provider does not run in
+// FIPS 140-3 mode.
+func requireFIPSMode(fipsMode bool, diags *diag.Diagnostics) {
+	if !fipsMode || fipsEnabled() {
+		return
+	}
+	diags.AddAttributeError(
+		path.Root("fips_mode"),
+		"FIPS Mode Unavailable",
+		"fips_mode requires the provider to run in FIPS 140-3 mode. Install the FIPS build of the provider, or set environment variable GODEBUG=fips140=on for Terraform.",
+	)
+}
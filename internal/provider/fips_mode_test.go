@@ -0,0 +1,37 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// TestRequireFIPSMode tests that fips_mode fails the configuration only outside of FIPS mode.
+func TestRequireFIPSMode(t *testing.T) {
+	original := fipsEnabled
+	t.Cleanup(func() { fipsEnabled = original })
+
+	tests := []struct {
+		name        string
+		fipsMode    bool
+		enabled     bool
+		expectError bool
+	}{
+		{name: "not_required", fipsMode: false, enabled: false},
+		{name: "required_and_enabled", fipsMode: true, enabled: true},
+		{name: "required_and_disabled", fipsMode: true, enabled: false, expectError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fipsEnabled = func() bool { return tt.enabled }
+			var diags diag.Diagnostics
+			requireFIPSMode(tt.fipsMode, &diags)
+			if diags.HasError() != tt.expectError {
+				t.Errorf("expected error=%v, got %v", tt.expectError, diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/action"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	api "github.com/cyberark/idsec-sdk-golang/pkg"
+	modelsactions "github.com/cyberark/idsec-sdk-golang/pkg/models/actions"
+	"github.com/cyberark/idsec-sdk-golang/pkg/services"
+	"github.com/cyberark/idsec-sdk-golang/pkg/validation"
+	"github.com/cyberark/terraform-provider-idsec/internal/actions"
+	"github.com/cyberark/terraform-provider-idsec/internal/featureadoption"
+	"github.com/cyberark/terraform-provider-idsec/internal/schemas"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+// IdsecAction is a struct that implements the action.Action interface.
+// It invokes an imperative SDK action, such as a credentials rotation, that does not fit the resource lifecycle.
+type IdsecAction struct {
+	IdsecServiceHelper
+	serviceConfig    *services.IdsecServiceConfig
+	actionDefinition *actions.IdsecServiceTerraformImperativeActionDefinition
+	idsecAPI         *api.IdsecAPI
+}
+
+var _ action.ActionWithConfigure = &IdsecAction{}
+
+// NewIdsecAction creates a new instance of IdsecAction.
+func NewIdsecAction(serviceConfig *services.IdsecServiceConfig,
+	actionDefinition *actions.IdsecServiceTerraformImperativeActionDefinition) action.Action {
+	return &IdsecAction{
+		IdsecServiceHelper: IdsecServiceHelper{
+			serviceConfig: serviceConfig,
+		},
+		serviceConfig:    serviceConfig,
+		actionDefinition: actionDefinition,
+	}
+}
+
+// setTerraformContext sets terraform context on the service for telemetry.
+func (s *IdsecAction) setTerraformContext(operation string) {
+	service := s.getService()
+	if service == nil {
+		return
+	}
+
+	s.addTelemetryContextField(service, "terraform_resource", "tfr", s.getTerraformTypeName(s.actionDefinition.ActionName))
+	s.addTelemetryContextField(service, "terraform_operation", "tfo", operation)
+	s.addTelemetryContextField(service, "provider_version", "tfv", providerVersion)
+}
+
+// clearTerraformContext clears terraform context from the SDK's telemetry.
+func (s *IdsecAction) clearTerraformContext() {
+	service := s.getService()
+	if service == nil {
+		return
+	}
+
+	s.clearTelemetryContext(service)
+}
+
+// Metadata defines the action type name.
+func (s *IdsecAction) Metadata(ctx context.Context, req action.MetadataRequest, resp *action.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_%s", req.ProviderTypeName, strings.ReplaceAll(s.actionDefinition.ActionName, "-", "_"))
+}
+
+// Schema defines the schema of the action, generated from the input of the invoked SDK action.
+func (s *IdsecAction) Schema(ctx context.Context, req action.SchemaRequest, resp *action.SchemaResponse) {
+	if s.actionDefinition.Schemas == nil {
+		resp.Diagnostics.AddError("Schema Error", "Schemas mappings are not provided.")
+		return
+	}
+	inputSchema, ok := s.actionDefinition.Schemas[s.actionDefinition.InvokeAction]
+	inputSchema, _ = modelsactions.UnwrapSchema(inputSchema)
+	if !ok {
+		resp.Diagnostics.AddError("Schema Error", fmt.Sprintf("No schema mapping found for action: %s", s.actionDefinition.InvokeAction))
+		return
+	}
+	s.reportAttributeNameCollisions(s.actionDefinition.ActionName, &resp.Diagnostics, inputSchema)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Schema = schemas.GenerateActionSchemaFromStruct(inputSchema)
+	resp.Schema.Description = s.actionDefinition.ActionDescription
+	localizeSchema(&resp.Schema, &resp.Diagnostics)
+}
+
+// Configure configures the action with the provider authentication.
+func (s *IdsecAction) Configure(ctx context.Context, req action.ConfigureRequest, resp *action.ConfigureResponse) {
+	if idsecAPI := s.configureFromProviderData(ctx, req.ProviderData, &resp.Diagnostics); idsecAPI != nil {
+		s.idsecAPI = idsecAPI
+	}
+}
+
+// Invoke handles invoking the imperative SDK action with the configured input.
+func (s *IdsecAction) Invoke(ctx context.Context, req action.InvokeRequest, resp *action.InvokeResponse) {
+	s.setTerraformContext("Invoke")
+	defer s.clearTerraformContext()
+	ctx = s.startOperation(ctx, s.getService())
+	defer featureadoption.ReportOperationDefer(ctx, s.idsecAPI, &resp.Diagnostics, s.buildFASTags(s.actionDefinition.ActionName, "Invoke"))()
+	defer s.recoverOperationPanic(ctx, s.getTerraformTypeName(s.actionDefinition.ActionName), "invoke", &resp.Diagnostics)
+
+	service := s.getServiceInstance()
+	if service == nil {
+		addErrorWithCorrelation(ctx, &resp.Diagnostics, "Service Error", "Service instance not configured")
+		return
+	}
+	titleCase := cases.Title(language.English)
+	actionNameTitled := strings.ReplaceAll(titleCase.String(s.actionDefinition.InvokeAction), "-", "")
+	actionMethod, err := schemas.FindMethodByName(reflect.ValueOf(service), actionNameTitled)
+	if err != nil {
+		addErrorWithCorrelation(ctx, &resp.Diagnostics, "Action Method Error", fmt.Sprintf("Unable to find action method: %s", err.Error()))
+		return
+	}
+
+	var actionArgs []reflect.Value
+	if inputSchema, _ := modelsactions.UnwrapSchema(s.actionDefinition.Schemas[s.actionDefinition.InvokeAction]); inputSchema != nil {
+		input, err := schemas.StructFromConfigObject(ctx, &req.Config, inputSchema)
+		if err != nil {
+			addErrorWithCorrelation(ctx, &resp.Diagnostics, "Parsing Error", fmt.Sprintf("Failed to parse action input: %s", err.Error()))
+			return
+		}
+		if err := validation.ValidateStruct(input); err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Invalid Configuration - %s", err.Error()))
+			appendValidationDiagnostics(&resp.Diagnostics, err)
+			return
+		}
+		actionArgs = append(actionArgs, reflect.ValueOf(input))
+	}
+
+	if resp.SendProgress != nil {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Invoking %s", s.getTerraformTypeName(s.actionDefinition.ActionName))})
+	}
+	tflog.Info(ctx, fmt.Sprintf("Calling action method %s", actionNameTitled))
+	result := s.callWithCredentialRefresh(ctx, *actionMethod, actionArgs)
+	for _, res := range result {
+		if err, ok := res.Interface().(error); ok && err != nil {
+			if isServiceUnavailableError(err) {
+				addErrorWithCorrelation(ctx, &resp.Diagnostics, "Service Not Enabled", serviceUnavailableDetail(s.serviceConfig.ServiceName, err))
+				return
+			}
+			addErrorWithCorrelation(ctx, &resp.Diagnostics, "Action Error", fmt.Sprintf("Unable to call action method: %s", err.Error()))
+			return
+		}
+	}
+	if resp.SendProgress != nil {
+		resp.SendProgress(action.InvokeProgressEvent{Message: fmt.Sprintf("Invoked %s successfully", s.getTerraformTypeName(s.actionDefinition.ActionName))})
+	}
+}
@@ -5,11 +5,16 @@ package provider
 
 import (
 	"context"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	terraformprovider "github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/cyberark/idsec-sdk-golang/pkg/auth"
+	authmodels "github.com/cyberark/idsec-sdk-golang/pkg/models/auth"
 	"github.com/cyberark/idsec-sdk-golang/pkg/services"
 	"github.com/cyberark/terraform-provider-idsec/internal/actions"
 )
@@ -504,3 +509,87 @@ func TestBothAuthTypesPassProviderLayer(t *testing.T) {
 		})
 	}
 }
+
+// TestIdsecResource_Configure_BeforeAuthentication tests that a resource configured while the provider
+// is still authenticating is left unconfigured without errors, for the next Configure call.
+func TestIdsecResource_Configure_BeforeAuthentication(t *testing.T) {
+	t.Parallel()
+
+	idsecResource := createTestResourceForAuth()
+	resp := &resource.ConfigureResponse{}
+	idsecResource.Configure(context.Background(), resource.ConfigureRequest{ProviderData: auth.NewIdsecISPAuth(false)}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("expected no errors, got %v", resp.Diagnostics)
+	}
+	if idsecResource.idsecAPI != nil || idsecResource.service != nil {
+		t.Error("expected the service to be left unconfigured")
+	}
+}
+
+// TestIdsecAction_Configure_InvalidProviderData tests that an action rejects provider data that is
+// neither an ISP nor a PVWA auth, same as resources and data sources.
+func TestIdsecAction_Configure_InvalidProviderData(t *testing.T) {
+	t.Parallel()
+
+	idsecAction := NewIdsecAction(CreateTestServiceConfig("test-service"), &actions.IdsecServiceTerraformImperativeActionDefinition{}).(*IdsecAction)
+	resp := &action.ConfigureResponse{}
+	idsecAction.Configure(context.Background(), action.ConfigureRequest{ProviderData: "invalid"}, resp)
+	if !resp.Diagnostics.HasError() || resp.Diagnostics[0].Summary() != "Authentication Error" {
+		t.Errorf("expected an authentication error, got %v", resp.Diagnostics)
+	}
+	if idsecAction.idsecAPI != nil {
+		t.Error("expected the API to be left unset")
+	}
+}
+
+func TestProviderAuthenticated(t *testing.T) {
+	t.Parallel()
+
+	authenticated := auth.NewIdsecISPAuth(false).(*auth.IdsecISPAuth)
+	authenticated.Token = &authmodels.IdsecToken{Token: "token"}
+	tests := []struct {
+		name         string
+		providerData interface{}
+		want         bool
+	}{
+		{name: "authenticated_isp", providerData: authenticated, want: true},
+		{name: "unauthenticated_isp", providerData: auth.NewIdsecISPAuth(false), want: false},
+		{name: "unauthenticated_pvwa", providerData: auth.NewIdsecPVWAAuth(false), want: false},
+		{name: "other_data_rejected_by_configure", providerData: "invalid_auth", want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := providerAuthenticated(tt.providerData); got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+// TestConfigureISPAuthReusesAuthentication tests that configuring the provider again with the same
+// credentials reuses the authenticated auth, and that other credentials do not match it.
+func TestConfigureISPAuthReusesAuthentication(t *testing.T) {
+	t.Parallel()
+
+	config := &IdsecProviderSchema{AuthMethod: types.StringValue("identity"), Subdomain: types.StringValue("acme")}
+	creds := &authCredentials{userName: "admin@acme", secret: []byte("secret"), authMethod: authmodels.Identity}
+	existing := auth.NewIdsecISPAuth(false).(*auth.IdsecISPAuth)
+	existing.Token = &authmodels.IdsecToken{Token: "token"}
+	p := &IdsecProvider{ispAuth: existing, authKey: credentialsKey(config, creds)}
+
+	resp := &terraformprovider.ConfigureResponse{}
+	p.configureISPAuth(context.Background(), config, creds, resp)
+	if resp.Diagnostics.HasError() || resp.ResourceData != existing || resp.DataSourceData != existing {
+		t.Errorf("expected the authenticated auth to be reused, got %v", resp.Diagnostics)
+	}
+
+	rotated := *creds
+	rotated.secret = []byte("rotated")
+	if credentialsKey(config, &rotated) == p.authKey {
+		t.Error("expected other credentials to be told apart")
+	}
+	if strings.Contains(p.authKey, "secret") {
+		t.Error("expected the key not to hold the secret")
+	}
+}
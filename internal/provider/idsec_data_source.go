@@ -7,14 +7,18 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	api "github.com/cyberark/idsec-sdk-golang/pkg"
-	"github.com/cyberark/idsec-sdk-golang/pkg/auth"
 	modelsactions "github.com/cyberark/idsec-sdk-golang/pkg/models/actions"
 	"github.com/cyberark/idsec-sdk-golang/pkg/services"
 	"github.com/cyberark/idsec-sdk-golang/pkg/validation"
@@ -32,6 +36,10 @@ type IdsecDataSource struct {
 	serviceConfig    *services.IdsecServiceConfig
 	actionDefinition *actions.IdsecServiceTerraformDataSourceActionDefinition
 	idsecAPI         *api.IdsecAPI
+	// resultCache is the result cache of the provider, shared by its data sources.
+	resultCache *resultCache
+	// cacheIdentity identifies the tenant and user the results persisted for cache_ttl are read as.
+	cacheIdentity string
 }
 
 // NewIdsecDataSource creates a new instance of IdsecDataSource.
@@ -73,8 +81,14 @@ func (s *IdsecDataSource) Metadata(ctx context.Context, req datasource.MetadataR
 	resp.TypeName = fmt.Sprintf("%s_%s", req.ProviderTypeName, strings.ReplaceAll(s.actionDefinition.ActionName, "-", "_"))
 }
 
-// ValidateConfig runs SDK struct-tag validation rules against the user's HCL config.
+// ValidateConfig checks the action of the data source exists in the SDK and runs SDK struct-tag validation rules against the user's HCL config.
 func (s *IdsecDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	if s.actionDefinition.DataSourceAction != "" {
+		addActionCompatibilityError(s.getTerraformTypeName(s.actionDefinition.ActionName), s.serviceConfig.ServiceName, []string{s.actionDefinition.DataSourceAction}, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 	if req.Config.Raw.IsNull() || !req.Config.Raw.IsFullyKnown() {
 		return
 	}
@@ -99,6 +113,16 @@ func (s *IdsecDataSource) ValidateConfig(ctx context.Context, req datasource.Val
 	}
 }
 
+// ConfigValidators requires at least one of the lookup keys of the action definition to be set.
+func (s *IdsecDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
+	if len(s.actionDefinition.LookupKeys) == 0 {
+		return nil
+	}
+	return []datasource.ConfigValidator{
+		schemas.AtLeastOneOfValidator{Attributes: s.actionDefinition.LookupKeys},
+	}
+}
+
 // Schema dynamically generates the resource schema using `generateSchemaFromStruct`.
 func (s *IdsecDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	if s.actionDefinition.StateSchema == nil || s.actionDefinition.DataSourceAction == "" {
@@ -116,49 +140,89 @@ func (s *IdsecDataSource) Schema(ctx context.Context, req datasource.SchemaReque
 	// surfaced to Terraform users because the resource type itself remains
 	// stable across SDK action renames.
 	inputScheme, _ = modelsactions.UnwrapSchema(inputScheme)
-	resp.Schema = schemas.GenerateDataSourceSchemaFromStruct(
+	s.reportAttributeNameCollisions(s.actionDefinition.ActionName, &resp.Diagnostics, inputScheme, s.actionDefinition.StateSchema)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Schema = s.generateSchema(inputScheme)
+	resp.Schema.Description = s.actionDefinition.ActionDescription
+	localizeSchema(&resp.Schema, &resp.Diagnostics)
+}
+
+// generateSchema generates the data source schema from the input and state models, adding the
+// attribute_projection argument when the action definition enables it and, without sensitive attributes,
+// the cache_ttl argument, unless the models declare attributes of the same names.
+func (s *IdsecDataSource) generateSchema(inputScheme interface{}) schema.Schema {
+	generated := schemas.GenerateDataSourceSchemaFromStruct(
 		inputScheme,
 		s.actionDefinition.StateSchema,
 		s.actionDefinition.SensitiveAttributes,
 		s.actionDefinition.ExtraRequiredAttributes,
 		s.actionDefinition.ComputedAsSetAttributes,
 	)
-	resp.Schema.Description = s.actionDefinition.ActionDescription
+	if _, exists := generated.Attributes[schemas.AttributeProjectionAttributeName]; !exists && s.actionDefinition.AttributeProjection {
+		generated.Attributes[schemas.AttributeProjectionAttributeName] = schemas.AttributeProjectionAttribute()
+	}
+	if _, exists := generated.Attributes[schemas.CacheTTLAttributeName]; !exists && !schemas.HasSensitiveDataSourceAttributes(generated.Attributes) {
+		generated.Attributes[schemas.CacheTTLAttributeName] = schemas.CacheTTLAttribute()
+	}
+	return generated
 }
 
-// Configure initializes the resource with the necessary dependencies.
-func (s *IdsecDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
+// projectState keeps only the attributes selected by attribute_projection in the state of a read, along
+// with the configured arguments. It returns stateResult as is when no projection is configured.
+func (s *IdsecDataSource) projectState(ctx context.Context, config tfsdk.Config, stateResult types.Object, diagnostics *diag.Diagnostics) (types.Object, error) {
+	if !s.actionDefinition.AttributeProjection {
+		return stateResult, nil
 	}
-	ispAuth, ok := req.ProviderData.(*auth.IdsecISPAuth)
-	if !ok {
-		// Try PVWA auth
-		pvwaAuth, ok := req.ProviderData.(*auth.IdsecPVWAAuth)
-		if !ok {
-			resp.Diagnostics.AddError("Authentication Error", "Unable to authenticate with the provided credentials.")
-			return
-		}
-		var err error
-		s.idsecAPI, err = api.NewIdsecAPI([]auth.IdsecAuth{pvwaAuth}, nil)
-		if err != nil {
-			resp.Diagnostics.AddError("Service Initialization Error", fmt.Sprintf("Unable to create API: %s", err.Error()))
-			return
+	var projection types.List
+	if diags := config.GetAttribute(ctx, path.Root(schemas.AttributeProjectionAttributeName), &projection); diags.HasError() || projection.IsNull() || projection.IsUnknown() {
+		return stateResult, nil
+	}
+	var keep []string
+	diagnostics.Append(projection.ElementsAs(ctx, &keep, false)...)
+	if diagnostics.HasError() {
+		return stateResult, nil
+	}
+	for _, name := range keep {
+		if _, ok := stateResult.Attributes()[name]; !ok {
+			diagnostics.AddAttributeError(
+				path.Root(schemas.AttributeProjectionAttributeName),
+				"Invalid Attribute Projection",
+				fmt.Sprintf("%s is not an attribute of %s.", name, s.getTerraformTypeName(s.actionDefinition.ActionName)),
+			)
 		}
-	} else {
-		var err error
-		s.idsecAPI, err = api.NewIdsecAPI([]auth.IdsecAuth{ispAuth}, nil)
-		if err != nil {
-			resp.Diagnostics.AddError("Service Initialization Error", fmt.Sprintf("Unable to create API: %s", err.Error()))
-			return
+	}
+	if diagnostics.HasError() {
+		return stateResult, nil
+	}
+	for name := range stateResult.Attributes() {
+		var configured attr.Value
+		if diags := config.GetAttribute(ctx, path.Root(name), &configured); !diags.HasError() && configured != nil && !configured.IsNull() {
+			keep = append(keep, name)
 		}
 	}
-
-	// Configure the service instance using the helper
-	err := s.configureService(s.idsecAPI)
+	slices.Sort(keep)
+	keep = slices.Compact(keep)
+	projected, err := schemas.ProjectObjectAttributes(ctx, stateResult, keep)
 	if err != nil {
-		resp.Diagnostics.AddError("Service Configuration Error", fmt.Sprintf("Unable to configure service: %s", err.Error()))
-		return
+		return stateResult, err
+	}
+	attributes := projected.Attributes()
+	attributes[schemas.AttributeProjectionAttributeName] = projection
+	projected, diags := types.ObjectValue(projected.AttributeTypes(ctx), attributes)
+	if diags.HasError() {
+		return stateResult, fmt.Errorf("failed to set attribute projection: %v", diags)
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Projected data source state to attributes: %s", strings.Join(keep, ", ")))
+	return projected, nil
+}
+
+// Configure initializes the resource with the necessary dependencies.
+func (s *IdsecDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if idsecAPI := s.configureFromProviderData(ctx, req.ProviderData, &resp.Diagnostics); idsecAPI != nil {
+		s.idsecAPI = idsecAPI
+		s.cacheIdentity = dataSourceCacheIdentity(req.ProviderData)
 	}
 }
 
@@ -182,7 +246,11 @@ func (s *IdsecDataSource) parseConfig(ctx context.Context, diagnostics *diag.Dia
 func (s *IdsecDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 	s.setTerraformContext("Read")
 	defer s.clearTerraformContext()
+	ctx = s.startOperation(ctx, s.getService())
+	ctx, moduleHeaders := s.moduleAttribution(ctx, s.getService(), req.ProviderMeta)
+	defer s.scopeRequestHeaders(s.getService(), moduleHeaders)()
 	defer featureadoption.ReportOperationDefer(ctx, s.idsecAPI, &resp.Diagnostics, s.buildFASTags(s.actionDefinition.ActionName, "Read"))()
+	defer s.recoverOperationPanic(ctx, s.getTerraformTypeName(s.actionDefinition.ActionName), "read", &resp.Diagnostics)
 
 	tflog.Info(ctx, "Triggering datasource read")
 	operationSchemaInput, err := s.parseConfig(ctx, &resp.Diagnostics, req.Config)
@@ -199,14 +267,14 @@ func (s *IdsecDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	// Get the service from the helper
 	service := s.getServiceInstance()
 	if service == nil {
-		resp.Diagnostics.AddError("Service Error", "Service instance not configured")
+		addErrorWithCorrelation(ctx, &resp.Diagnostics, "Service Error", "Service instance not configured")
 		return
 	}
 
 	// Get the method from the service
 	actionMethod, err := schemas.FindMethodByName(reflect.ValueOf(service), actionNameTitled)
 	if err != nil {
-		resp.Diagnostics.AddError("Action Method Error", fmt.Sprintf("Unable to find action method: %s", err.Error()))
+		addErrorWithCorrelation(ctx, &resp.Diagnostics, "Action Method Error", fmt.Sprintf("Unable to find action method: %s", err.Error()))
 		return
 	}
 	actionArgs := []reflect.Value{reflect.ValueOf(operationSchemaInput)}
@@ -215,12 +283,46 @@ func (s *IdsecDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		appendValidationDiagnostics(&resp.Diagnostics, err)
 		return
 	}
+	breaker := s.getSettings().circuitBreaker
+	if err := breaker.check(s.serviceConfig.ServiceName); err != nil {
+		addErrorWithCorrelation(ctx, &resp.Diagnostics, "Service Unavailable", err.Error())
+		return
+	}
 	tflog.Info(ctx, "Calling action method")
-	result := actionMethod.Call(actionArgs)
+	logPayloadSize(ctx, "Request", s.actionDefinition.DataSourceAction, operationSchemaInput)
+	call := func() []reflect.Value {
+		result := s.callWithCredentialRefresh(ctx, *actionMethod, actionArgs)
+		breaker.record(s.serviceConfig.ServiceName, callResultError(result))
+		return result
+	}
+	var result []reflect.Value
+	if cacheKey, ok := resultCacheKey(s.serviceConfig.ServiceName, s.actionDefinition.DataSourceAction, operationSchemaInput); ok {
+		var cached bool
+		cacheTTL, persisted := s.cacheTTL(ctx, req.Config)
+		var store resultStore
+		if persisted && s.cacheIdentity != "" {
+			store = newDiskResultStore(ctx, actionMethod.Type())
+			cacheKey = s.cacheIdentity + "/" + cacheKey
+		}
+		result, cached = s.resultCache.call(cacheKey, cacheTTL, store, call)
+		if cached {
+			tflog.Debug(ctx, "Reusing the cached result of an identical data source call")
+		}
+	} else {
+		result = call()
+	}
 	for _, res := range result {
 		if err, ok := res.Interface().(error); ok && err != nil {
+			if isServiceUnavailableError(err) {
+				if s.getSettings().ignoreUnavailableServices {
+					s.skipUnavailableService(ctx, err, req.Config, resp)
+					return
+				}
+				addErrorWithCorrelation(ctx, &resp.Diagnostics, "Service Not Enabled", serviceUnavailableDetail(s.serviceConfig.ServiceName, err))
+				return
+			}
 			tflog.Error(ctx, fmt.Sprintf("Failed to call action method: %s", err.Error()))
-			resp.Diagnostics.AddError("Action Error", fmt.Sprintf("Unable to call action method: %s", err.Error()))
+			addErrorWithCorrelation(ctx, &resp.Diagnostics, "Action Error", fmt.Sprintf("Unable to call action method: %s", err.Error()))
 			return
 		}
 	}
@@ -233,28 +335,36 @@ func (s *IdsecDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 	tflog.Info(ctx, "Managed to call action successfully with result")
+	logPayloadSize(ctx, "Response", s.actionDefinition.DataSourceAction, resultElem.Interface())
 	if resultElem.Kind() == reflect.Pointer {
 		resultElem = resultElem.Elem()
 	}
 	tflog.Info(ctx, "Converting result to state object")
 	inputScheme, ok := s.actionDefinition.Schemas[s.actionDefinition.DataSourceAction]
 	if !ok {
-		resp.Diagnostics.AddError("Schema Error", fmt.Sprintf("Data source schema for action %s is not provided.", s.actionDefinition.DataSourceAction))
+		addErrorWithCorrelation(ctx, &resp.Diagnostics, "Schema Error", fmt.Sprintf("Data source schema for action %s is not provided.", s.actionDefinition.DataSourceAction))
 		return
 	}
 	inputScheme, _ = modelsactions.UnwrapSchema(inputScheme)
-	outputSchemaDef := schemas.GenerateDataSourceSchemaFromStruct(
-		inputScheme,
-		s.actionDefinition.StateSchema,
-		s.actionDefinition.SensitiveAttributes,
-		s.actionDefinition.ExtraRequiredAttributes,
-		s.actionDefinition.ComputedAsSetAttributes,
-	)
+	outputSchemaDef := s.generateSchema(inputScheme)
 	schemaAttrs := schemas.DataSourceSchemaToSchemaAttrTypes(outputSchemaDef)
-	stateResult, err := schemas.StructToStateObject(ctx, resultElem.Interface(), nil, nil, schemaAttrs)
+	stateResult, err := schemas.StructToStateObject(ctx, schemas.SortListItems(resultElem.Interface()), nil, nil, schemaAttrs, s.actionDefinition.EmptyAsNullAttributes)
 	if err != nil {
 		tflog.Error(ctx, fmt.Sprintf("Failed to convert struct to state object: %s", err.Error()))
-		resp.Diagnostics.AddError("State Conversion Error", fmt.Sprintf("Failed to convert struct to state object: %s", err.Error()))
+		addErrorWithCorrelation(ctx, &resp.Diagnostics, "State Conversion Error", fmt.Sprintf("Failed to convert struct to state object: %s", err.Error()))
+		return
+	}
+	s.reportUnmappedAttributes(ctx, s.actionDefinition.ActionName, resultElem.Interface(), schemaAttrs, &resp.Diagnostics)
+	stateResult, err = setCacheTTL(ctx, req.Config, stateResult)
+	if err != nil {
+		addErrorWithCorrelation(ctx, &resp.Diagnostics, "State Conversion Error", err.Error())
+		return
+	}
+	stateResult, err = s.projectState(ctx, req.Config, stateResult, &resp.Diagnostics)
+	if err != nil || resp.Diagnostics.HasError() {
+		if err != nil {
+			addErrorWithCorrelation(ctx, &resp.Diagnostics, "State Conversion Error", err.Error())
+		}
 		return
 	}
 	diags := resp.State.Set(ctx, stateResult)
@@ -0,0 +1,120 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/cyberark/terraform-provider-idsec/internal/actions"
+	"github.com/cyberark/terraform-provider-idsec/internal/schemas"
+)
+
+func TestIdsecDataSource_projectState(t *testing.T) {
+	ctx := context.Background()
+	testSchema := schema.Schema{Attributes: map[string]schema.Attribute{
+		"policy_id":                              schema.StringAttribute{Required: true},
+		"name":                                   schema.StringAttribute{Computed: true},
+		"rules":                                  schema.ListAttribute{Computed: true, ElementType: types.StringType},
+		schemas.AttributeProjectionAttributeName: schemas.AttributeProjectionAttribute(),
+	}}
+	attrTypes := map[string]attr.Type{
+		"policy_id":                              types.StringType,
+		"name":                                   types.StringType,
+		"rules":                                  types.ListType{ElemType: types.StringType},
+		schemas.AttributeProjectionAttributeName: types.ListType{ElemType: types.StringType},
+	}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"policy_id":                              tftypes.String,
+		"name":                                   tftypes.String,
+		"rules":                                  tftypes.List{ElementType: tftypes.String},
+		schemas.AttributeProjectionAttributeName: tftypes.List{ElementType: tftypes.String},
+	}}
+	config := func(projection ...string) tfsdk.Config {
+		projectionValue := tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil)
+		if projection != nil {
+			values := make([]tftypes.Value, 0, len(projection))
+			for _, name := range projection {
+				values = append(values, tftypes.NewValue(tftypes.String, name))
+			}
+			projectionValue = tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, values)
+		}
+		return tfsdk.Config{Schema: testSchema, Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"policy_id":                              tftypes.NewValue(tftypes.String, "policy-1"),
+			"name":                                   tftypes.NewValue(tftypes.String, nil),
+			"rules":                                  tftypes.NewValue(tftypes.List{ElementType: tftypes.String}, nil),
+			schemas.AttributeProjectionAttributeName: projectionValue,
+		})}
+	}
+	rules, _ := types.ListValueFrom(ctx, types.StringType, []string{"allow", "deny"})
+	stateResult, _ := types.ObjectValue(attrTypes, map[string]attr.Value{
+		"policy_id":                              types.StringValue("policy-1"),
+		"name":                                   types.StringValue("Admins"),
+		"rules":                                  rules,
+		schemas.AttributeProjectionAttributeName: types.ListNull(types.StringType),
+	})
+	dataSource := &IdsecDataSource{actionDefinition: &actions.IdsecServiceTerraformDataSourceActionDefinition{
+		IdsecServiceBaseTerraformActionDefinition: actions.IdsecServiceBaseTerraformActionDefinition{
+			IdsecServiceBaseActionDefinition: actions.IdsecServiceBaseActionDefinition{ActionName: "policy"},
+		},
+		AttributeProjection: true,
+	}}
+
+	var diags diag.Diagnostics
+	unprojected, err := dataSource.projectState(ctx, config(), stateResult, &diags)
+	if err != nil || diags.HasError() || !unprojected.Equal(stateResult) {
+		t.Fatalf("expected the state to be kept without projection, got %v (%v, %v)", unprojected, err, diags)
+	}
+
+	projected, err := dataSource.projectState(ctx, config("name"), stateResult, &diags)
+	if err != nil || diags.HasError() {
+		t.Fatalf("unexpected error: %v %v", err, diags)
+	}
+	attributes := projected.Attributes()
+	if !attributes["rules"].IsNull() {
+		t.Errorf("expected rules to be projected out, got %v", attributes["rules"])
+	}
+	if !attributes["name"].Equal(types.StringValue("Admins")) || !attributes["policy_id"].Equal(types.StringValue("policy-1")) {
+		t.Errorf("expected projected and configured attributes to be kept, got %v", attributes)
+	}
+	if attributes[schemas.AttributeProjectionAttributeName].IsNull() {
+		t.Error("expected the configured projection to be stored in state")
+	}
+
+	_, _ = dataSource.projectState(ctx, config("owner"), stateResult, &diags)
+	if !diags.HasError() {
+		t.Error("expected an error for a projection of an unknown attribute")
+	}
+}
+
+func TestIdsecDataSource_generateSchemaAttributeProjection(t *testing.T) {
+	tests := []struct {
+		name                string
+		attributeProjection bool
+	}{
+		{name: "success_projection_enabled", attributeProjection: true},
+		{name: "success_projection_disabled", attributeProjection: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataSource := &IdsecDataSource{actionDefinition: &actions.IdsecServiceTerraformDataSourceActionDefinition{
+				IdsecServiceBaseTerraformActionDefinition: actions.IdsecServiceBaseTerraformActionDefinition{
+					IdsecServiceBaseActionDefinition: actions.IdsecServiceBaseActionDefinition{ActionName: "fake-safe"},
+					StateSchema:                      &fakeSafeModel{},
+				},
+				AttributeProjection: tt.attributeProjection,
+			}}
+			generated := dataSource.generateSchema(&fakeSafeIDModel{})
+			if _, exists := generated.Attributes[schemas.AttributeProjectionAttributeName]; exists != tt.attributeProjection {
+				t.Errorf("expected attribute_projection in the schema to be %v, got %v", tt.attributeProjection, exists)
+			}
+		})
+	}
+}
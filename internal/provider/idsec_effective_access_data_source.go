@@ -0,0 +1,287 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	api "github.com/cyberark/idsec-sdk-golang/pkg"
+	"github.com/cyberark/idsec-sdk-golang/pkg/auth"
+	"github.com/cyberark/idsec-sdk-golang/pkg/services/policy"
+	policycommonmodels "github.com/cyberark/idsec-sdk-golang/pkg/services/policy/common/models"
+)
+
+const effectiveAccessDataSourceName = "effective-access"
+
+// IdsecEffectiveAccessDataSource is a data source merging the access policies granted to principals, for
+// policy tests and compliance reports. Memberships are not resolved, the roles and groups of a user are
+// principals of their own.
+type IdsecEffectiveAccessDataSource struct {
+	idsecAPI *api.IdsecAPI
+}
+
+// IdsecEffectiveAccessDataSourceModel is the configuration and state of the effective access data source.
+type IdsecEffectiveAccessDataSourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Principals       types.List   `tfsdk:"principals"`
+	Statuses         types.List   `tfsdk:"statuses"`
+	Policies         types.List   `tfsdk:"policies"`
+	TargetCategories types.Set    `tfsdk:"target_categories"`
+}
+
+// effectiveAccessPolicy is an access policy granted to at least one of the principals of the data source.
+type effectiveAccessPolicy struct {
+	policyID       string
+	name           string
+	status         string
+	targetCategory string
+	locationType   string
+	policyType     string
+	grantedTo      []string
+}
+
+// effectiveAccessPolicyAttrTypes are the attribute types of the elements of the policies attribute.
+var effectiveAccessPolicyAttrTypes = map[string]attr.Type{
+	"policy_id":       types.StringType,
+	"name":            types.StringType,
+	"status":          types.StringType,
+	"target_category": types.StringType,
+	"location_type":   types.StringType,
+	"policy_type":     types.StringType,
+	"granted_to":      types.ListType{ElemType: types.StringType},
+}
+
+// policyLister lists the access policies of the tenant matching filters, as the policy service does.
+type policyLister interface {
+	ListPoliciesBy(filters *policycommonmodels.IdsecPolicyFilters) (<-chan *policy.IdsecPolicyPolicyPage, error)
+}
+
+// NewIdsecEffectiveAccessDataSource creates a new instance of IdsecEffectiveAccessDataSource.
+func NewIdsecEffectiveAccessDataSource() datasource.DataSource {
+	return &IdsecEffectiveAccessDataSource{}
+}
+
+// Metadata defines the data source type name.
+func (s *IdsecEffectiveAccessDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_%s", req.ProviderTypeName, strings.ReplaceAll(effectiveAccessDataSourceName, "-", "_"))
+}
+
+// Schema defines the schema of the effective access data source.
+func (s *IdsecEffectiveAccessDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Merges the access policies granted to the given principals, and the target categories they give access to. Role and group memberships are not resolved: a policy granted to a role is only included when the role is one of the principals, so list the roles and groups of a user along with it to include the policies granted through them. Use it to test policies or report on access in check blocks and outputs.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier of the lookup, made of the principals.",
+			},
+			"principals": schema.ListAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Description: "Principals whose access is merged, as names or as identifiers built with provider::idsec::principal, e.g. [provider::idsec::principal(\"user\", \"alice@example.com\"), provider::idsec::principal(\"role\", \"DBAdmins\")]. Identifiers also match the type of the principal.",
+			},
+			"statuses": schema.ListAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Statuses of the policies to include, e.g. [\"Active\"]. Policies of any status are included when not set.",
+			},
+			"policies": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Policies granted to at least one of the principals, sorted by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"policy_id":       schema.StringAttribute{Computed: true, Description: "ID of the policy."},
+						"name":            schema.StringAttribute{Computed: true, Description: "Name of the policy."},
+						"status":          schema.StringAttribute{Computed: true, Description: "Status of the policy."},
+						"target_category": schema.StringAttribute{Computed: true, Description: "Category of the targets of the policy, e.g. VM or DB."},
+						"location_type":   schema.StringAttribute{Computed: true, Description: "Location of the targets of the policy, e.g. AWS or FQDN/IP."},
+						"policy_type":     schema.StringAttribute{Computed: true, Description: "Type of the policy, Recurring or OnDemand."},
+						"granted_to": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    true,
+							Description: "Principals of the data source the policy is granted to.",
+						},
+					},
+				},
+			},
+			"target_categories": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Description: "Target categories the principals may access through the policies.",
+			},
+		},
+	}
+	localizeSchema(&resp.Schema, &resp.Diagnostics)
+}
+
+// Configure creates the API the policies are listed with from the provider authentication.
+func (s *IdsecEffectiveAccessDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if _, pvwa := req.ProviderData.(*auth.IdsecPVWAAuth); pvwa {
+		resp.Diagnostics.AddError("Authentication Error", "The effective access data source requires ISP authentication, access policies are not available with PVWA authentication.")
+		return
+	}
+	if idsecAPI := newProviderAPI(ctx, req.ProviderData, &resp.Diagnostics); idsecAPI != nil {
+		s.idsecAPI = idsecAPI
+	}
+}
+
+// Read lists the policies of the principals and merges them.
+func (s *IdsecEffectiveAccessDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config IdsecEffectiveAccessDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if s.idsecAPI == nil {
+		resp.Diagnostics.AddError("Service Error", "Service instance not configured")
+		return
+	}
+	var principals, statuses []string
+	resp.Diagnostics.Append(config.Principals.ElementsAs(ctx, &principals, false)...)
+	if !config.Statuses.IsNull() {
+		resp.Diagnostics.Append(config.Statuses.ElementsAs(ctx, &statuses, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	policyService, err := s.idsecAPI.Policy()
+	if err != nil {
+		resp.Diagnostics.AddError("Service Configuration Error", fmt.Sprintf("Unable to configure service: %s", err.Error()))
+		return
+	}
+	policies, err := listPrincipalPolicies(policyService, principals, statuses)
+	if err != nil {
+		if isServiceUnavailableError(err) {
+			addErrorWithCorrelation(ctx, &resp.Diagnostics, "Service Not Enabled", serviceUnavailableDetail("policy", err))
+			return
+		}
+		addErrorWithCorrelation(ctx, &resp.Diagnostics, "Action Error", fmt.Sprintf("Unable to list the policies of the principals: %s", err.Error()))
+		return
+	}
+	effective := mergeEffectiveAccess(policies, principals)
+	tflog.Info(ctx, fmt.Sprintf("Found %d policies granted to %d principals", len(effective), len(principals)))
+
+	policyValues := make([]attr.Value, 0, len(effective))
+	var categories []attr.Value
+	for _, granted := range effective {
+		grantedTo, diags := types.ListValueFrom(ctx, types.StringType, granted.grantedTo)
+		resp.Diagnostics.Append(diags...)
+		policyValue, diags := types.ObjectValue(effectiveAccessPolicyAttrTypes, map[string]attr.Value{
+			"policy_id":       types.StringValue(granted.policyID),
+			"name":            types.StringValue(granted.name),
+			"status":          types.StringValue(granted.status),
+			"target_category": types.StringValue(granted.targetCategory),
+			"location_type":   types.StringValue(granted.locationType),
+			"policy_type":     types.StringValue(granted.policyType),
+			"granted_to":      grantedTo,
+		})
+		resp.Diagnostics.Append(diags...)
+		policyValues = append(policyValues, policyValue)
+		if granted.targetCategory != "" && !slices.Contains(categories, attr.Value(types.StringValue(granted.targetCategory))) {
+			categories = append(categories, types.StringValue(granted.targetCategory))
+		}
+	}
+	policiesValue, diags := types.ListValue(types.ObjectType{AttrTypes: effectiveAccessPolicyAttrTypes}, policyValues)
+	resp.Diagnostics.Append(diags...)
+	categoriesValue, diags := types.SetValue(types.StringType, categories)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config.Policies = policiesValue
+	config.TargetCategories = categoriesValue
+	config.ID = types.StringValue(strings.Join(principals, ","))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// listPrincipalPolicies lists the policies whose identities contain one of the names of the principals,
+// with one of the given statuses when set.
+func listPrincipalPolicies(lister policyLister, principals []string, statuses []string) ([]*policycommonmodels.IdsecPolicyCommonAccessPolicy, error) {
+	filters := policycommonmodels.NewIdsecPolicyFilters()
+	for _, principal := range principals {
+		_, name := parsePrincipal(principal)
+		filters.Identities = append(filters.Identities, name)
+	}
+	filters.Status = append(filters.Status, statuses...)
+	pages, err := lister.ListPoliciesBy(filters)
+	if err != nil {
+		return nil, err
+	}
+	var policies []*policycommonmodels.IdsecPolicyCommonAccessPolicy
+	for page := range pages {
+		for _, item := range page.Items {
+			if item != nil {
+				policies = append(policies, item)
+			}
+		}
+	}
+	return policies, nil
+}
+
+// mergeEffectiveAccess returns the policies granted to at least one of the principals, once each and sorted
+// by name. The identities filter of the API matches names partially, so the principals of each policy are
+// matched again by name, case insensitively, and by type for principals given as identifiers.
+func mergeEffectiveAccess(policies []*policycommonmodels.IdsecPolicyCommonAccessPolicy, principals []string) []effectiveAccessPolicy {
+	merged := map[string]*effectiveAccessPolicy{}
+	for _, item := range policies {
+		var grantedTo []string
+		for _, principal := range principals {
+			if policyGrantedTo(item, principal) {
+				grantedTo = append(grantedTo, principal)
+			}
+		}
+		if len(grantedTo) == 0 {
+			continue
+		}
+		key := cmp.Or(item.Metadata.PolicyID, item.Metadata.Name)
+		if existing, ok := merged[key]; ok {
+			for _, principal := range grantedTo {
+				if !slices.Contains(existing.grantedTo, principal) {
+					existing.grantedTo = append(existing.grantedTo, principal)
+				}
+			}
+			continue
+		}
+		merged[key] = &effectiveAccessPolicy{
+			policyID:       item.Metadata.PolicyID,
+			name:           item.Metadata.Name,
+			status:         item.Metadata.GetStatusString(),
+			targetCategory: item.Metadata.PolicyEntitlement.TargetCategory,
+			locationType:   item.Metadata.PolicyEntitlement.LocationType,
+			policyType:     item.Metadata.PolicyEntitlement.PolicyType,
+			grantedTo:      grantedTo,
+		}
+	}
+	effective := make([]effectiveAccessPolicy, 0, len(merged))
+	for _, granted := range merged {
+		effective = append(effective, *granted)
+	}
+	slices.SortFunc(effective, func(a, b effectiveAccessPolicy) int {
+		return cmp.Or(cmp.Compare(a.name, b.name), cmp.Compare(a.policyID, b.policyID))
+	})
+	return effective
+}
+
+// policyGrantedTo reports whether one of the principals of a policy is the given principal.
+func policyGrantedTo(item *policycommonmodels.IdsecPolicyCommonAccessPolicy, principal string) bool {
+	principalType, name := parsePrincipal(principal)
+	for _, policyPrincipal := range item.Principals {
+		if !strings.EqualFold(policyPrincipal.Name, name) {
+			continue
+		}
+		if principalType == "" || strings.EqualFold(policyPrincipal.Type, principalType) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,96 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/cyberark/idsec-sdk-golang/pkg/services/policy"
+	policycommonmodels "github.com/cyberark/idsec-sdk-golang/pkg/services/policy/common/models"
+)
+
+// fakePolicyLister returns its policies as a single page and records the filters it was called with.
+type fakePolicyLister struct {
+	policies []*policycommonmodels.IdsecPolicyCommonAccessPolicy
+	filters  *policycommonmodels.IdsecPolicyFilters
+}
+
+func (l *fakePolicyLister) ListPoliciesBy(filters *policycommonmodels.IdsecPolicyFilters) (<-chan *policy.IdsecPolicyPolicyPage, error) {
+	l.filters = filters
+	pages := make(chan *policy.IdsecPolicyPolicyPage, 1)
+	pages <- &policy.IdsecPolicyPolicyPage{Items: l.policies}
+	close(pages)
+	return pages, nil
+}
+
+func testAccessPolicy(id string, name string, category string, principals ...policycommonmodels.IdsecPolicyPrincipal) *policycommonmodels.IdsecPolicyCommonAccessPolicy {
+	return &policycommonmodels.IdsecPolicyCommonAccessPolicy{
+		Metadata: policycommonmodels.IdsecPolicyMetadata{
+			PolicyID:          id,
+			Name:              name,
+			Status:            &policycommonmodels.IdsecPolicyStatus{Status: policycommonmodels.StatusTypeActive},
+			PolicyEntitlement: policycommonmodels.IdsecPolicyEntitlement{TargetCategory: category, LocationType: "FQDN/IP", PolicyType: policycommonmodels.PolicyTypeRecurring},
+		},
+		Principals: principals,
+	}
+}
+
+// TestEffectiveAccess tests that the policies of the principals are listed, matched exactly and merged.
+func TestEffectiveAccess(t *testing.T) {
+	t.Parallel()
+
+	alice := policycommonmodels.IdsecPolicyPrincipal{Name: "alice@example.com", Type: policycommonmodels.PrincipalTypeUser}
+	aliceRole := policycommonmodels.IdsecPolicyPrincipal{Name: "alice@example.com", Type: policycommonmodels.PrincipalTypeRole}
+	admins := policycommonmodels.IdsecPolicyPrincipal{Name: "DBAdmins", Type: policycommonmodels.PrincipalTypeRole}
+	malice := policycommonmodels.IdsecPolicyPrincipal{Name: "malice@example.com", Type: policycommonmodels.PrincipalTypeUser}
+	lister := &fakePolicyLister{policies: []*policycommonmodels.IdsecPolicyCommonAccessPolicy{
+		testAccessPolicy("p-2", "vm-access", "VM", alice, admins),
+		testAccessPolicy("p-1", "db-access", "DB", admins),
+		testAccessPolicy("p-3", "partial-match", "VM", malice),
+		testAccessPolicy("p-4", "role-of-same-name", "VM", aliceRole),
+		testAccessPolicy("p-2", "vm-access", "VM", alice, admins),
+	}}
+	principals := []string{"user:alice@example.com", "DBAdmins"}
+
+	policies, err := listPrincipalPolicies(lister, principals, []string{policycommonmodels.StatusTypeActive})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !slices.Equal(lister.filters.Identities, []string{"alice@example.com", "DBAdmins"}) || !slices.Equal(lister.filters.Status, []string{"Active"}) {
+		t.Errorf("unexpected filters: identities %v, statuses %v", lister.filters.Identities, lister.filters.Status)
+	}
+	effective := mergeEffectiveAccess(policies, principals)
+	if len(effective) != 2 {
+		t.Fatalf("expected 2 policies, got %+v", effective)
+	}
+	if effective[0].name != "db-access" || !slices.Equal(effective[0].grantedTo, []string{"DBAdmins"}) {
+		t.Errorf("unexpected first policy: %+v", effective[0])
+	}
+	if effective[1].name != "vm-access" || !slices.Equal(effective[1].grantedTo, principals) || effective[1].status != "Active" {
+		t.Errorf("unexpected second policy: %+v", effective[1])
+	}
+}
+
+// TestParsePrincipal tests the parsing of principal identifiers and plain names.
+func TestParsePrincipal(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		identifier   string
+		expectedType string
+		expectedName string
+	}{
+		{identifier: "user:alice@example.com", expectedType: "user", expectedName: "alice@example.com"},
+		{identifier: "ROLE:DBAdmins", expectedType: "role", expectedName: "DBAdmins"},
+		{identifier: "DBAdmins", expectedName: "DBAdmins"},
+		{identifier: "domain:alice", expectedName: "domain:alice"},
+	}
+	for _, tt := range tests {
+		principalType, name := parsePrincipal(tt.identifier)
+		if principalType != tt.expectedType || name != tt.expectedName {
+			t.Errorf("parsePrincipal(%q) = (%q, %q), expected (%q, %q)", tt.identifier, principalType, name, tt.expectedType, tt.expectedName)
+		}
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	modelsactions "github.com/cyberark/idsec-sdk-golang/pkg/models/actions"
+	"github.com/cyberark/idsec-sdk-golang/pkg/services"
+	"github.com/cyberark/terraform-provider-idsec/internal/actions"
+	"github.com/cyberark/terraform-provider-idsec/internal/featureadoption"
+	"github.com/cyberark/terraform-provider-idsec/internal/schemas"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+// listDisplayNameAttributes are the attributes, in order of preference, used as the display name of listed resources.
+var listDisplayNameAttributes = []string{"name", "display_name"}
+
+// IdsecListResource is a struct that implements the list.ListResource interface.
+// It lists the instances of a managed resource through the resource's SDK list action,
+// and shares the type name, configuration and service of the managed resource.
+type IdsecListResource struct {
+	*IdsecResource
+}
+
+var _ list.ListResourceWithConfigure = &IdsecListResource{}
+
+// NewIdsecListResource creates a new instance of IdsecListResource.
+func NewIdsecListResource(serviceConfig *services.IdsecServiceConfig,
+	actionDefinition *actions.IdsecServiceTerraformResourceActionDefinition) list.ListResource {
+	return &IdsecListResource{
+		IdsecResource: &IdsecResource{
+			IdsecServiceHelper: IdsecServiceHelper{
+				serviceConfig: serviceConfig,
+			},
+			serviceConfig:    serviceConfig,
+			actionDefinition: actionDefinition,
+		},
+	}
+}
+
+// ListResourceConfigSchema defines the schema of the list block, generated from the filters of the list action.
+func (s *IdsecListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	filtersSchema, ok := s.actionDefinition.Schemas[s.actionDefinition.ListAction]
+	if !ok {
+		resp.Diagnostics.AddError("Schema Error", fmt.Sprintf("No schema mapping found for list action: %s", s.actionDefinition.ListAction))
+		return
+	}
+	filtersSchema, _ = modelsactions.UnwrapSchema(filtersSchema)
+	resp.Schema = schemas.GenerateListResourceConfigSchemaFromStruct(filtersSchema)
+	resp.Schema.Description = fmt.Sprintf("Lists existing instances. %s", s.actionDefinition.ActionDescription)
+	localizeSchema(&resp.Schema, &resp.Diagnostics)
+}
+
+// List handles listing the instances of the managed resource.
+func (s *IdsecListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	s.setTerraformContext("List")
+	defer s.clearTerraformContext()
+	ctx = s.startOperation(ctx, s.getService())
+	var diagnostics diag.Diagnostics
+	defer featureadoption.ReportOperationDefer(ctx, s.idsecAPI, &diagnostics, s.buildFASTags(s.actionDefinition.ActionName, "List"))()
+
+	items, err := s.listItems(ctx, &req.Config, req.Limit)
+	if err != nil {
+		addErrorWithCorrelation(ctx, &diagnostics, "List Error", err.Error())
+		stream.Results = list.ListResultsStreamDiagnostics(diagnostics)
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Listed %d instances of %s", len(items), s.actionDefinition.ActionName))
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, item := range items {
+			if !push(s.newListResult(ctx, req, item)) {
+				return
+			}
+		}
+	}
+}
+
+// listItems calls the list action of the service with the configured filters and flattens its result into items,
+// sorted by their id and name as the pages of the result come in no stable order. A positive limit stops reading
+// pages once limit items were read, so the items are the first ones returned by the API.
+func (s *IdsecListResource) listItems(ctx context.Context, config *tfsdk.Config, limit int64) ([]interface{}, error) {
+	service := s.getServiceInstance()
+	if service == nil {
+		return nil, fmt.Errorf("service instance not configured")
+	}
+	titleCase := cases.Title(language.English)
+	actionNameTitled := strings.ReplaceAll(titleCase.String(s.actionDefinition.ListAction), "-", "")
+	actionMethod, err := schemas.FindMethodByName(reflect.ValueOf(service), actionNameTitled)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find list method: %s", err.Error())
+	}
+	var actionArgs []reflect.Value
+	if filtersSchema, _ := modelsactions.UnwrapSchema(s.actionDefinition.Schemas[s.actionDefinition.ListAction]); filtersSchema != nil {
+		filters, err := schemas.StructFromConfigObject(ctx, config, filtersSchema)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse list filters: %s", err.Error())
+		}
+		actionArgs = append(actionArgs, reflect.ValueOf(filters))
+	}
+	tflog.Info(ctx, fmt.Sprintf("Calling list action %s", actionNameTitled))
+	result := s.callActionMethod(ctx, *actionMethod, actionArgs)
+	for _, res := range result {
+		if err, ok := res.Interface().(error); ok && err != nil {
+			return nil, fmt.Errorf("unable to call list method: %s", err.Error())
+		}
+	}
+	if len(result) < 1 {
+		return nil, nil
+	}
+	items, _ := schemas.SortListItems(schemas.FlattenListResult(result[0].Interface(), int(limit))).([]interface{})
+	return items, nil
+}
+
+// newListResult converts a listed item into a list result, holding its identity and, when requested, its full state.
+func (s *IdsecListResource) newListResult(ctx context.Context, req list.ListRequest, item interface{}) list.ListResult {
+	result := req.NewListResult(ctx)
+	resourceType, ok := req.ResourceSchema.Type().(types.ObjectType)
+	if !ok {
+		result.Diagnostics.AddError("Schema Error", "Resource schema is not an object type")
+		return result
+	}
+	stateResult, err := schemas.StructToStateObject(ctx, item, nil, nil, resourceType.AttrTypes, s.actionDefinition.EmptyAsNullAttributes)
+	if err != nil {
+		result.Diagnostics.AddError("State Conversion Error", fmt.Sprintf("Failed to convert listed item to state object: %s", err.Error()))
+		return result
+	}
+	result.Diagnostics.Append(result.Resource.Set(ctx, stateResult)...)
+	if result.Diagnostics.HasError() {
+		return result
+	}
+	state := tfsdk.State{Schema: result.Resource.Schema, Raw: result.Resource.Raw}
+	identityValues := setIdentityFromState(ctx, s.getImportID(), &state, result.Identity, &result.Diagnostics)
+	result.DisplayName = s.listDisplayName(ctx, result.Resource, identityValues)
+	if !req.IncludeResource {
+		result.Resource = nil
+	}
+	return result
+}
+
+// listDisplayName returns the name of a listed resource, read from its ListDisplayNameAttribute or one of
+// listDisplayNameAttributes, falling back to its import ID.
+func (s *IdsecListResource) listDisplayName(ctx context.Context, res *tfsdk.Resource, identityValues []string) string {
+	attrNames := listDisplayNameAttributes
+	if s.actionDefinition.ListDisplayNameAttribute != "" {
+		attrNames = []string{s.actionDefinition.ListDisplayNameAttribute}
+	}
+	for _, attrName := range attrNames {
+		attrPath, err := schemas.ParseImportAttributePath(attrName)
+		if err != nil {
+			continue
+		}
+		var value types.String
+		if diags := res.GetAttribute(ctx, attrPath, &value); diags.HasError() {
+			continue
+		}
+		if value.ValueString() != "" {
+			return value.ValueString()
+		}
+	}
+	return schemas.FormatCompositeImportID(identityValues, s.getImportIDDelimiter())
+}
+
+// Metadata defines the list resource type name, which is the type name of the listed managed resource.
+func (s *IdsecListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	s.IdsecResource.Metadata(ctx, req, resp)
+}
+
+// Configure configures the list resource with the provider authentication, same as the managed resource.
+func (s *IdsecListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	s.IdsecResource.Configure(ctx, req, resp)
+}
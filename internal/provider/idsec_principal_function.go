@@ -0,0 +1,92 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+const principalFunctionName = "principal"
+
+// principalTypes are the principal types accepted by the principal function, as named in the identities
+// of access policies.
+var principalTypes = []string{"user", "role", "group"}
+
+// IdsecPrincipalFunction is the provider function formatting a principal identifier from its type and
+// name, e.g. provider::idsec::principal("user", "alice@example.com") returns "user:alice@example.com".
+// The identifier is a provider notation, only understood by the principals of idsec_effective_access.
+type IdsecPrincipalFunction struct{}
+
+// NewIdsecPrincipalFunction creates a new instance of IdsecPrincipalFunction.
+func NewIdsecPrincipalFunction() function.Function {
+	return &IdsecPrincipalFunction{}
+}
+
+// Metadata defines the function name.
+func (f *IdsecPrincipalFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = principalFunctionName
+}
+
+// Definition defines the parameters and the return type of the function.
+func (f *IdsecPrincipalFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:     "Formats a principal identifier",
+		Description: fmt.Sprintf("Returns the identifier of a principal as `<type>:<name>`, e.g. `user:alice@example.com`, for the `principals` of the `idsec_effective_access` data source, which then only matches policy identities of that type. The identifier is not accepted by the APIs, so it must not be used as a Safe member or an access policy identity. The type is one of %s, in any case.", strings.Join(principalTypes, ", ")),
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "type",
+				Description: fmt.Sprintf("Type of the principal, one of %s.", strings.Join(principalTypes, ", ")),
+			},
+			function.StringParameter{
+				Name:        "name",
+				Description: "Name of the principal, e.g. the user name or the name of the role.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run validates the type and the name of the principal and returns its identifier.
+func (f *IdsecPrincipalFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var principalType, name string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &principalType, &name))
+	if resp.Error != nil {
+		return
+	}
+	identifier, err := formatPrincipal(principalType, name)
+	if err != nil {
+		resp.Error = function.ConcatFuncErrors(resp.Error, err)
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, identifier))
+}
+
+// formatPrincipal returns the identifier of the principal of the given type and name, with the type
+// lower cased and the surrounding spaces of the name trimmed.
+func formatPrincipal(principalType string, name string) (string, *function.FuncError) {
+	principalType = strings.ToLower(strings.TrimSpace(principalType))
+	if !slices.Contains(principalTypes, principalType) {
+		return "", function.NewArgumentFuncError(0, fmt.Sprintf("Invalid principal type %q, expected one of %s.", principalType, strings.Join(principalTypes, ", ")))
+	}
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", function.NewArgumentFuncError(1, "The principal name must not be empty.")
+	}
+	return principalType + ":" + name, nil
+}
+
+// parsePrincipal splits a principal identifier formatted by formatPrincipal into its type and name. Plain
+// names, without a known type prefix, are returned with an empty type.
+func parsePrincipal(identifier string) (string, string) {
+	principalType, name, found := strings.Cut(identifier, ":")
+	if !found || !slices.Contains(principalTypes, strings.ToLower(principalType)) {
+		return "", strings.TrimSpace(identifier)
+	}
+	return strings.ToLower(principalType), strings.TrimSpace(name)
+}
@@ -0,0 +1,52 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TestIdsecPrincipalFunction tests the formatting and the validation of principal identifiers.
+func TestIdsecPrincipalFunction(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		principalType string
+		principalName string
+		expected      string
+		expectError   bool
+	}{
+		{name: "user", principalType: "user", principalName: "alice@example.com", expected: "user:alice@example.com"},
+		{name: "type_case_insensitive", principalType: "ROLE", principalName: "Vault Admins", expected: "role:Vault Admins"},
+		{name: "name_trimmed", principalType: "Group", principalName: " ops ", expected: "group:ops"},
+		{name: "invalid_type", principalType: "service", principalName: "svc", expectError: true},
+		{name: "empty_name", principalType: "user", principalName: " ", expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(tt.principalType), types.StringValue(tt.principalName)}),
+			}
+			resp := function.RunResponse{Result: function.NewResultData(types.StringUnknown())}
+			NewIdsecPrincipalFunction().Run(context.Background(), req, &resp)
+			if (resp.Error != nil) != tt.expectError {
+				t.Fatalf("Expected error=%v, got %v", tt.expectError, resp.Error)
+			}
+			if tt.expectError {
+				return
+			}
+			if got := resp.Result.Value().(types.String).ValueString(); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
@@ -5,13 +5,22 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
+	"sync"
+	"time"
 
 	"os"
 
+	"github.com/hashicorp/terraform-plugin-framework/action"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	terraformprovider "github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -24,6 +33,7 @@ import (
 	authmodels "github.com/cyberark/idsec-sdk-golang/pkg/models/auth"
 	"github.com/cyberark/idsec-sdk-golang/pkg/services"
 	provideractions "github.com/cyberark/terraform-provider-idsec/internal/actions"
+	"github.com/cyberark/terraform-provider-idsec/internal/featureadoption"
 	"github.com/cyberark/terraform-provider-idsec/internal/schemas"
 	_ "github.com/cyberark/terraform-provider-idsec/internal/tfactions"
 )
@@ -66,6 +76,88 @@ const (
 
 	// IdsecPVWALoginMethodDefault Default value for PVWA login method.
 	IdsecPVWALoginMethodDefault = "cyberark"
+
+	// IdsecChangeReasonEnvVar Environment variable for the change reason of the run.
+	IdsecChangeReasonEnvVar = "IDSEC_CHANGE_REASON"
+
+	// IdsecCorrelationIDEnvVar Environment variable for the correlation ID shared by all operations of a Terraform run.
+	IdsecCorrelationIDEnvVar = "IDSEC_CORRELATION_ID"
+
+	// IdsecConsistencyRetriesEnvVar Environment variable for the number of retries of creates and updates failing on references not yet visible.
+	IdsecConsistencyRetriesEnvVar = "IDSEC_CONSISTENCY_RETRIES"
+	// IdsecConsistencyRetriesDefault Default value for consistency retries.
+	IdsecConsistencyRetriesDefault = 3
+
+	// IdsecDestroyConcurrencyEnvVar Environment variable for the maximum number of concurrent deletes across all services.
+	IdsecDestroyConcurrencyEnvVar = "IDSEC_DESTROY_CONCURRENCY"
+	// IdsecDestroyConcurrencyDefault Default value for destroy concurrency.
+	IdsecDestroyConcurrencyDefault = 10
+
+	// IdsecDestroyRetriesEnvVar Environment variable for the number of retries of deletes failing with throttling errors.
+	IdsecDestroyRetriesEnvVar = "IDSEC_DESTROY_RETRIES"
+	// IdsecDestroyRetriesDefault Default value for destroy retries.
+	IdsecDestroyRetriesDefault = 5
+
+	// IdsecStrictSchemaSyncEnvVar Environment variable decides whether SDK response fields missing from the schema are reported as warnings.
+	IdsecStrictSchemaSyncEnvVar = "IDSEC_STRICT_SCHEMA_SYNC"
+	// IdsecStrictSchemaSyncDefault Default value for strict schema sync.
+	IdsecStrictSchemaSyncDefault = false
+
+	// IdsecValidateReferencesEnvVar Environment variable decides whether referenced IDs are looked up on the tenant during plan.
+	IdsecValidateReferencesEnvVar = "IDSEC_VALIDATE_REFERENCES"
+	// IdsecValidateReferencesDefault Default value for validate references.
+	IdsecValidateReferencesDefault = false
+
+	// IdsecValidateUniqueNamesEnvVar Environment variable decides whether names unique across the tenant are looked up during plan.
+	IdsecValidateUniqueNamesEnvVar = "IDSEC_VALIDATE_UNIQUE_NAMES"
+	// IdsecValidateUniqueNamesDefault Default value for validate unique names.
+	IdsecValidateUniqueNamesDefault = false
+
+	// IdsecDataSourceCacheTTLEnvVar Environment variable decides how long identical data source API calls share their result.
+	IdsecDataSourceCacheTTLEnvVar = "IDSEC_DATA_SOURCE_CACHE_TTL"
+	// IdsecDataSourceCacheTTLDefault Default value for the data source cache TTL, caching disabled.
+	IdsecDataSourceCacheTTLDefault = time.Duration(0)
+
+	// IdsecIgnoreUnavailableServicesEnvVar Environment variable decides whether data sources of services not enabled on the tenant are skipped.
+	IdsecIgnoreUnavailableServicesEnvVar = "IDSEC_IGNORE_UNAVAILABLE_SERVICES"
+	// IdsecIgnoreUnavailableServicesDefault Default value for ignore unavailable services.
+	IdsecIgnoreUnavailableServicesDefault = false
+
+	// IdsecRecoverPanicsEnvVar Environment variable decides whether panics of an operation fail only that operation instead of crashing the provider.
+	IdsecRecoverPanicsEnvVar = "IDSEC_RECOVER_PANICS"
+	// IdsecRecoverPanicsDefault Default value for recover panics.
+	IdsecRecoverPanicsDefault = true
+
+	// IdsecCircuitBreakerThresholdEnvVar Environment variable for the number of consecutive outage errors of a service after which its operations fail fast.
+	IdsecCircuitBreakerThresholdEnvVar = "IDSEC_CIRCUIT_BREAKER_THRESHOLD"
+	// IdsecCircuitBreakerThresholdDefault Default value for circuit breaker threshold.
+	IdsecCircuitBreakerThresholdDefault = 0
+
+	// IdsecFIPSModeEnvVar Environment variable decides whether the provider fails to configure unless it runs in FIPS 140-3 mode.
+	IdsecFIPSModeEnvVar = "IDSEC_FIPS_MODE"
+	// IdsecFIPSModeDefault Default value for FIPS mode.
+	IdsecFIPSModeDefault = false
+
+	// IdsecOfflineModeEnvVar Environment variable decides whether outbound calls other than those to the tenant APIs are disabled.
+	IdsecOfflineModeEnvVar = "IDSEC_OFFLINE_MODE"
+	// IdsecOfflineModeDefault Default value for offline mode.
+	IdsecOfflineModeDefault = false
+
+	// IdsecPlanSummaryFileEnvVar Environment variable for the file the JSON summaries of planned changes are appended to.
+	IdsecPlanSummaryFileEnvVar = "IDSEC_PLAN_SUMMARY_FILE"
+
+	// IdsecDescriptionTranslationsEnvVar Environment variable names a JSON file translating schema descriptions, keyed by the original description.
+	IdsecDescriptionTranslationsEnvVar = "IDSEC_DESCRIPTION_TRANSLATIONS"
+
+	// IdsecAuthCacheBackendEnvVar Environment variable for the backend cached authentication is stored in: auto, keyring or file.
+	IdsecAuthCacheBackendEnvVar = "IDSEC_AUTH_CACHE_BACKEND"
+	// IdsecAuthCacheBackendDefault Default value for auth cache backend.
+	IdsecAuthCacheBackendDefault = "auto"
+
+	// IdsecProfileEnvVar Environment variable for the profile of the profiles file applied to the provider configuration.
+	IdsecProfileEnvVar = "IDSEC_PROFILE"
+	// IdsecConfigFileEnvVar Environment variable overriding the path of the profiles file, ~/.idsec/config.toml by default.
+	IdsecConfigFileEnvVar = "IDSEC_CONFIG_FILE"
 )
 
 const (
@@ -80,6 +172,10 @@ var (
 
 // Ensure IdsecProvider satisfies various provider interfaces.
 var _ terraformprovider.Provider = &IdsecProvider{}
+var _ terraformprovider.ProviderWithListResources = &IdsecProvider{}
+var _ terraformprovider.ProviderWithActions = &IdsecProvider{}
+var _ terraformprovider.ProviderWithFunctions = &IdsecProvider{}
+var _ terraformprovider.ProviderWithConfigValidators = &IdsecProvider{}
 
 // providerVersion holds the version of the Terraform provider.
 // This is set during provider configuration and used by resources and data sources for telemetry.
@@ -87,19 +183,43 @@ var providerVersion string
 
 // IdsecProviderSchema defines the schema for the Idsec provider configuration.
 type IdsecProviderSchema struct {
-	AuthMethod           types.String `tfsdk:"auth_method"`
-	UserName             types.String `tfsdk:"username"`
-	Secret               types.String `tfsdk:"secret"`
-	ServiceUser          types.String `tfsdk:"service_user"`
-	ServiceToken         types.String `tfsdk:"service_token"`
-	ServiceAuthorizedApp types.String `tfsdk:"service_authorized_app"`
-	Subdomain            types.String `tfsdk:"subdomain"`
-	CacheAuthentication  types.Bool   `tfsdk:"cache_authentication"`
-	PVWAURL              types.String `tfsdk:"pvwa_url"`
-	PVWALoginMethod      types.String `tfsdk:"pvwa_login_method"`
-	ProxyAddress         types.String `tfsdk:"proxy_address"`
-	ProxyUsername        types.String `tfsdk:"proxy_username"`
-	ProxyPassword        types.String `tfsdk:"proxy_password"`
+	AuthMethod                types.String `tfsdk:"auth_method"`
+	UserName                  types.String `tfsdk:"username"`
+	Secret                    types.String `tfsdk:"secret"`
+	ServiceUser               types.String `tfsdk:"service_user"`
+	ServiceToken              types.String `tfsdk:"service_token"`
+	ServiceAuthorizedApp      types.String `tfsdk:"service_authorized_app"`
+	Subdomain                 types.String `tfsdk:"subdomain"`
+	CacheAuthentication       types.Bool   `tfsdk:"cache_authentication"`
+	PVWAURL                   types.String `tfsdk:"pvwa_url"`
+	PVWALoginMethod           types.String `tfsdk:"pvwa_login_method"`
+	ProxyAddress              types.String `tfsdk:"proxy_address"`
+	ProxyUsername             types.String `tfsdk:"proxy_username"`
+	ProxyPassword             types.String `tfsdk:"proxy_password"`
+	StrictSchemaSync          types.Bool   `tfsdk:"strict_schema_sync"`
+	ExtraHeaders              types.Map    `tfsdk:"extra_headers"`
+	ChangeReason              types.String `tfsdk:"change_reason"`
+	CorrelationID             types.String `tfsdk:"correlation_id"`
+	ConsistencyRetries        types.Int64  `tfsdk:"consistency_retries"`
+	ValidateReferences        types.Bool   `tfsdk:"validate_references"`
+	DataSourceCacheTTL        types.String `tfsdk:"data_source_cache_ttl"`
+	IgnoreUnavailableServices types.Bool   `tfsdk:"ignore_unavailable_services"`
+	ServiceConcurrency        types.Map    `tfsdk:"service_concurrency"`
+	RetryableErrors           types.List   `tfsdk:"retryable_errors"`
+	OperationHooks            types.Object `tfsdk:"operation_hooks"`
+	RecoverPanics             types.Bool   `tfsdk:"recover_panics"`
+	Profile                   types.String `tfsdk:"profile"`
+	AuthCacheBackend          types.String `tfsdk:"auth_cache_backend"`
+	SecretSource              types.Object `tfsdk:"secret_source"`
+	ValidateUniqueNames       types.Bool   `tfsdk:"validate_unique_names"`
+	DestroyConcurrency        types.Int64  `tfsdk:"destroy_concurrency"`
+	DestroyRetries            types.Int64  `tfsdk:"destroy_retries"`
+	FIPSMode                  types.Bool   `tfsdk:"fips_mode"`
+	OfflineMode               types.Bool   `tfsdk:"offline_mode"`
+	CircuitBreakerThreshold   types.Int64  `tfsdk:"circuit_breaker_threshold"`
+	ServiceTimeouts           types.Map    `tfsdk:"service_timeouts"`
+	PlanSummaryFile           types.String `tfsdk:"plan_summary_file"`
+	Defaults                  types.Map    `tfsdk:"defaults"`
 }
 
 // IdsecProviderConfig holds the configuration for the Idsec provider.
@@ -112,16 +232,36 @@ type IdsecProviderConfig struct {
 // IdsecProvider is the main struct for the Idsec provider.
 type IdsecProvider struct {
 	terraformprovider.Provider
-	ispAuth  *auth.IdsecISPAuth
-	pvwaAuth *auth.IdsecPVWAAuth
-	config   IdsecProviderConfig
+	// configureMu serializes Configure, so the auth and the settings of one configuration are not
+	// replaced halfway by another.
+	configureMu sync.Mutex
+	ispAuth     *auth.IdsecISPAuth
+	pvwaAuth    *auth.IdsecPVWAAuth
+	// authKey identifies the credentials ispAuth or pvwaAuth authenticated with. Configuring the
+	// provider again with the same credentials reuses the auth instead of authenticating again.
+	authKey string
+	// credentialsConfig is the configuration the credentials were resolved from, before reading its
+	// secret source and the environment and without its secrets, or nil when they cannot rotate or are set
+	// in the provider block, and authRefreshedAt the last time refreshCredentials authenticated again with
+	// rotated credentials.
+	credentialsConfig *IdsecProviderSchema
+	authRefreshedAt   time.Time
+	config            IdsecProviderConfig
+	// dataSourceResultCache is shared by the data sources of the provider, so data sources reading the
+	// same collection within a run share a single API call.
+	dataSourceResultCache *resultCache
+	// settings are shared by the resources, data sources, list resources and actions of the provider,
+	// and filled in by Configure.
+	settings *providerSettings
 }
 
 // NewIdsecProvider creates a new instance of the Idsec provider.
 func NewIdsecProvider(config IdsecProviderConfig) func() terraformprovider.Provider {
 	return func() terraformprovider.Provider {
 		return &IdsecProvider{
-			config: config,
+			config:                config,
+			dataSourceResultCache: newResultCache(),
+			settings:              newProviderSettings(),
 		}
 	}
 }
@@ -149,14 +289,58 @@ func (p *IdsecProvider) resolveTerraformBoolVar(variable types.Bool, envVar stri
 	return variable
 }
 
-// authCredentials holds the parsed authentication credentials.
+func (p *IdsecProvider) resolveTerraformInt64Var(variable types.Int64, envVar string, defaultVal int64) (types.Int64, error) {
+	if variable.IsNull() {
+		if val, ok := os.LookupEnv(envVar); ok {
+			intVal, err := strconv.ParseInt(strings.TrimSpace(val), 10, 64)
+			if err != nil {
+				return variable, fmt.Errorf("environment variable %s must be an integer, got %q", envVar, val)
+			}
+			return types.Int64Value(intVal), nil
+		}
+		return types.Int64Value(defaultVal), nil
+	}
+	return variable, nil
+}
+
+// buildUserAgent builds the User-Agent product tokens identifying the provider build and the
+// Terraform version executing it, e.g. "terraform-provider-idsec/1.2.0 (abc1234) Terraform/1.9.5".
+func (p *IdsecProvider) buildUserAgent(terraformVersion string) string {
+	version := p.config.Version
+	if version == "" {
+		version = "N/A"
+	}
+	userAgent := fmt.Sprintf("terraform-provider-idsec/%s", version)
+	if p.config.GitCommit != "" && p.config.GitCommit != "N/A" {
+		userAgent += fmt.Sprintf(" (%s)", p.config.GitCommit)
+	}
+	if terraformVersion != "" {
+		userAgent += fmt.Sprintf(" Terraform/%s", terraformVersion)
+	}
+	return userAgent
+}
+
+// authCredentials holds the parsed authentication credentials. The secret is held in a byte buffer
+// rather than a string, so that it can be wiped once authenticated with.
 type authCredentials struct {
 	userName           string
-	secret             string
+	secret             []byte
 	authMethod         authmodels.IdsecAuthMethod
 	authMethodSettings authmodels.IdsecAuthMethodSettings
 }
 
+// idsecSecret returns the secret of the credentials as passed to the SDK authenticators.
+func (c *authCredentials) idsecSecret() *authmodels.IdsecSecret {
+	return &authmodels.IdsecSecret{Secret: string(c.secret)}
+}
+
+// wipe zeroes the secret of the credentials. It is best effort: the SDK takes the secret as a string,
+// whose copies cannot be zeroed and are left to the garbage collector.
+func (c *authCredentials) wipe() {
+	clear(c.secret)
+	c.secret = nil
+}
+
 // IdsecAuthenticator is an interface for authentication providers.
 type IdsecAuthenticator interface {
 	Authenticate(profile *models.IdsecProfile, authProfile *authmodels.IdsecAuthProfile, secret *authmodels.IdsecSecret, forceRetry bool, forceReauth bool) (*authmodels.IdsecToken, error)
@@ -172,7 +356,7 @@ func (p *IdsecProvider) parseIdentityAuth(ctx context.Context, config *IdsecProv
 	}
 	creds := &authCredentials{
 		userName:   config.UserName.ValueString(),
-		secret:     config.Secret.ValueString(),
+		secret:     []byte(config.Secret.ValueString()),
 		authMethod: authmodels.IdsecAuthMethod("identity"),
 		authMethodSettings: &authmodels.IdentityIdsecAuthMethodSettings{
 			IdentityTenantSubdomain: config.Subdomain.ValueString(),
@@ -196,7 +380,7 @@ func (p *IdsecProvider) parseIdentityServiceUserAuth(ctx context.Context, config
 	}
 	creds := &authCredentials{
 		userName:   config.ServiceUser.ValueString(),
-		secret:     config.ServiceToken.ValueString(),
+		secret:     []byte(config.ServiceToken.ValueString()),
 		authMethod: authmodels.IdsecAuthMethod("identity_service_user"),
 		authMethodSettings: &authmodels.IdentityServiceUserIdsecAuthMethodSettings{
 			IdentityTenantSubdomain:          config.Subdomain.ValueString(),
@@ -225,7 +409,7 @@ func (p *IdsecProvider) parsePVWAAuth(ctx context.Context, config *IdsecProvider
 	}
 	creds := &authCredentials{
 		userName:   config.UserName.ValueString(),
-		secret:     config.Secret.ValueString(),
+		secret:     []byte(config.Secret.ValueString()),
 		authMethod: authmodels.PVWA,
 		authMethodSettings: &authmodels.PVWAIdsecAuthMethodSettings{
 			PVWAURL:         config.PVWAURL.ValueString(),
@@ -252,9 +436,7 @@ func (p *IdsecProvider) authenticateWithRetry(ctx context.Context, authenticator
 				AuthMethod:         creds.authMethod,
 				AuthMethodSettings: creds.authMethodSettings,
 			},
-			&authmodels.IdsecSecret{
-				Secret: creds.secret,
-			},
+			creds.idsecSecret(),
 			forceRetry,
 			false,
 		)
@@ -299,6 +481,14 @@ func (p *IdsecProvider) Schema(ctx context.Context, req terraformprovider.Schema
 					schemas.StringInChoicesValidator{Choices: []string{"identity", "identity_service_user", "pvwa"}},
 				},
 			},
+			"auth_cache_backend": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Backend cached authentication is stored in when cache_authentication is enabled. 'keyring' uses the OS credential store (macOS Keychain, Windows Credential Manager or the Secret Service through libsecret on Linux), falling back to the encrypted file with a warning where none is available, e.g. in containers. 'file' uses the encrypted file under ~/.idsec/cache/keyring, or the folder set in environment variable IDSEC_KEYRING_FOLDER; the identity and PVWA login sessions the SDK caches on its own follow environment variable IDSEC_BASIC_KEYRING instead. 'auto' uses the OS credential store when available. Defaults to 'auto'. Resolved from environment variable IDSEC_AUTH_CACHE_BACKEND.",
+				MarkdownDescription: "Backend cached authentication is stored in when `cache_authentication` is enabled. `keyring` uses the OS credential store (macOS Keychain, Windows Credential Manager or the Secret Service through libsecret on Linux), falling back to the encrypted file with a warning where none is available, e.g. in containers. `file` uses the encrypted file under `~/.idsec/cache/keyring`, or the folder set in environment variable `IDSEC_KEYRING_FOLDER`; the identity and PVWA login sessions the SDK caches on its own follow environment variable `IDSEC_BASIC_KEYRING` instead. `auto` uses the OS credential store when available. Defaults to `auto`. Resolved from environment variable `IDSEC_AUTH_CACHE_BACKEND`.",
+				Validators: []validator.String{
+					schemas.StringInChoicesValidator{Choices: authCacheBackends},
+				},
+			},
 			"subdomain": schema.StringAttribute{
 				Optional:            true,
 				Description:         "Tenant subdomain for authentication. Optional, typically used for external IDP authentication. Resolved from environment variable IDSEC_SUBDOMAIN.",
@@ -326,6 +516,110 @@ func (p *IdsecProvider) Schema(ctx context.Context, req terraformprovider.Schema
 				MarkdownDescription: "Service token for identity service user authentication. **Required** when `auth_method` is `identity_service_user`. Resolved from environment variable `IDSEC_SERVICE_TOKEN`.",
 				Sensitive:           true,
 			},
+			"secret_source": schema.SingleNestedAttribute{
+				Optional:            true,
+				Description:         "External secret store the credential of the authentication method is read from at configuration time, the secret for identity and pvwa authentication or the service token for identity_service_user authentication, so the credential is not passed as a Terraform variable. The credential cannot also be set in the provider block, and takes precedence over its environment variable.",
+				MarkdownDescription: "External secret store the credential of the authentication method is read from at configuration time, the `secret` for `identity` and `pvwa` authentication or the `service_token` for `identity_service_user` authentication, so the credential is not passed as a Terraform variable. The credential cannot also be set in the provider block, and takes precedence over its environment variable.",
+				Attributes: map[string]schema.Attribute{
+					"conjur": schema.SingleNestedAttribute{
+						Optional:            true,
+						Description:         "Read the credential from a CyberArk Conjur variable, authenticating with a host identity.",
+						MarkdownDescription: "Read the credential from a CyberArk Conjur variable, authenticating with a host identity.",
+						Attributes: map[string]schema.Attribute{
+							"appliance_url": schema.StringAttribute{
+								Optional:            true,
+								Description:         "URL of the Conjur server, including the /api path for Conjur Cloud. Defaults to environment variable CONJUR_APPLIANCE_URL.",
+								MarkdownDescription: "URL of the Conjur server, including the `/api` path for Conjur Cloud. Defaults to environment variable `CONJUR_APPLIANCE_URL`.",
+							},
+							"account": schema.StringAttribute{
+								Optional:            true,
+								Description:         "Conjur account. Defaults to environment variable CONJUR_ACCOUNT.",
+								MarkdownDescription: "Conjur account. Defaults to environment variable `CONJUR_ACCOUNT`.",
+							},
+							"login": schema.StringAttribute{
+								Optional:            true,
+								Description:         "Login of the Conjur identity the variable is read with, e.g. host/terraform/idsec. Defaults to environment variable CONJUR_AUTHN_LOGIN.",
+								MarkdownDescription: "Login of the Conjur identity the variable is read with, e.g. `host/terraform/idsec`. Defaults to environment variable `CONJUR_AUTHN_LOGIN`.",
+							},
+							"api_key": schema.StringAttribute{
+								Optional:            true,
+								Sensitive:           true,
+								Description:         "API key of the Conjur identity. Defaults to environment variable CONJUR_AUTHN_API_KEY.",
+								MarkdownDescription: "API key of the Conjur identity. Defaults to environment variable `CONJUR_AUTHN_API_KEY`.",
+							},
+							"access_token_file": schema.StringAttribute{
+								Optional:            true,
+								Description:         "File holding a Conjur access token, as written by the Conjur Kubernetes authenticator, used instead of login and api_key. Defaults to environment variable CONJUR_AUTHN_TOKEN_FILE.",
+								MarkdownDescription: "File holding a Conjur access token, as written by the Conjur Kubernetes authenticator, used instead of `login` and `api_key`. Defaults to environment variable `CONJUR_AUTHN_TOKEN_FILE`.",
+							},
+							"cert_file": schema.StringAttribute{
+								Optional:            true,
+								Description:         "PEM certificate of the authority of the Conjur server certificate, for self-hosted servers. Defaults to environment variable CONJUR_CERT_FILE.",
+								MarkdownDescription: "PEM certificate of the authority of the Conjur server certificate, for self-hosted servers. Defaults to environment variable `CONJUR_CERT_FILE`.",
+							},
+							"variable_id": schema.StringAttribute{
+								Optional:            true,
+								Description:         "ID of the Conjur variable holding the credential, e.g. terraform/idsec/service-token.",
+								MarkdownDescription: "ID of the Conjur variable holding the credential, e.g. `terraform/idsec/service-token`.",
+							},
+						},
+					},
+					"vault": schema.SingleNestedAttribute{
+						Optional:            true,
+						Description:         "Read the credential from a HashiCorp Vault KV version 2 secret, authenticating with a token or AppRole.",
+						MarkdownDescription: "Read the credential from a HashiCorp Vault KV version 2 secret, authenticating with a token or AppRole.",
+						Attributes: map[string]schema.Attribute{
+							"address": schema.StringAttribute{
+								Optional:            true,
+								Description:         "Address of the Vault server, e.g. https://vault.example.com:8200. Defaults to environment variable VAULT_ADDR.",
+								MarkdownDescription: "Address of the Vault server, e.g. `https://vault.example.com:8200`. Defaults to environment variable `VAULT_ADDR`.",
+							},
+							"namespace": schema.StringAttribute{
+								Optional:            true,
+								Description:         "Vault Enterprise namespace of the secret. Defaults to environment variable VAULT_NAMESPACE.",
+								MarkdownDescription: "Vault Enterprise namespace of the secret. Defaults to environment variable `VAULT_NAMESPACE`.",
+							},
+							"mount": schema.StringAttribute{
+								Optional:            true,
+								Description:         "Mount path of the KV version 2 secrets engine. Defaults to secret.",
+								MarkdownDescription: "Mount path of the KV version 2 secrets engine. Defaults to `secret`.",
+							},
+							"path": schema.StringAttribute{
+								Optional:            true,
+								Description:         "Path of the secret within the mount, e.g. terraform/idsec.",
+								MarkdownDescription: "Path of the secret within the mount, e.g. `terraform/idsec`.",
+							},
+							"field": schema.StringAttribute{
+								Optional:            true,
+								Description:         "Field of the secret holding the credential. Defaults to the name of the credential, secret or service_token.",
+								MarkdownDescription: "Field of the secret holding the credential. Defaults to the name of the credential, `secret` or `service_token`.",
+							},
+							"token": schema.StringAttribute{
+								Optional:            true,
+								Sensitive:           true,
+								Description:         "Vault token used to read the secret. Defaults to environment variable VAULT_TOKEN. Not used with AppRole authentication.",
+								MarkdownDescription: "Vault token used to read the secret. Defaults to environment variable `VAULT_TOKEN`. Not used with AppRole authentication.",
+							},
+							"approle_mount": schema.StringAttribute{
+								Optional:            true,
+								Description:         "Mount path of the AppRole auth method. Defaults to approle.",
+								MarkdownDescription: "Mount path of the AppRole auth method. Defaults to `approle`.",
+							},
+							"role_id": schema.StringAttribute{
+								Optional:            true,
+								Description:         "Role ID for AppRole authentication, used together with secret_id instead of a token.",
+								MarkdownDescription: "Role ID for AppRole authentication, used together with `secret_id` instead of a token.",
+							},
+							"secret_id": schema.StringAttribute{
+								Optional:            true,
+								Sensitive:           true,
+								Description:         "Secret ID for AppRole authentication.",
+								MarkdownDescription: "Secret ID for AppRole authentication.",
+							},
+						},
+					},
+				},
+			},
 			"service_authorized_app": schema.StringAttribute{
 				Optional:            true,
 				Description:         "Authorized application for identity service user authentication. Used when 'auth_method' is 'identity_service_user'. Defaults to '__idaptive_cybr_user_oidc'. Resolved from environment variable IDSEC_SERVICE_AUTHORIZED_APP.",
@@ -365,18 +659,157 @@ func (p *IdsecProvider) Schema(ctx context.Context, req terraformprovider.Schema
 				MarkdownDescription: "Proxy password for the provider to use for outgoing requests. Resolved from environment variable `IDSEC_PROXY_PASSWORD`.",
 				Sensitive:           true,
 			},
+			"change_reason": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Reason of the changes made by the run, e.g. a ticket number or pull request URL. No resource passes it to the API yet: it is currently only passed to the operation hooks and written to the plan summaries. Resolved from environment variable IDSEC_CHANGE_REASON.",
+				MarkdownDescription: "Reason of the changes made by the run, e.g. a ticket number or pull request URL. No resource passes it to the API yet: it is currently only passed to the operation hooks and written to the plan summaries. Resolved from environment variable `IDSEC_CHANGE_REASON`.",
+			},
+			"correlation_id": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Correlation ID shared by all operations of the Terraform run, e.g. a CI pipeline run ID. Sent with every request in the X-Correlation-ID header. Each operation gets a sub-ID derived from it, added to the telemetry of its requests and included with the correlation ID in error diagnostics. Generated when not set. Resolved from environment variable IDSEC_CORRELATION_ID.",
+				MarkdownDescription: "Correlation ID shared by all operations of the Terraform run, e.g. a CI pipeline run ID. Sent with every request in the X-Correlation-ID header. Each operation gets a sub-ID derived from it, added to the telemetry of its requests and included with the correlation ID in error diagnostics. Generated when not set. Resolved from environment variable `IDSEC_CORRELATION_ID`.",
+			},
+			"consistency_retries": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "Number of times a create or update failing because a referenced object is not found, answered by the API with a 404, is retried with exponential backoff. Absorbs the eventual consistency of objects created earlier in the same apply, e.g. a policy referencing a new target set. Set to 0 to disable. Defaults to 3. Resolved from environment variable IDSEC_CONSISTENCY_RETRIES.",
+				MarkdownDescription: "Number of times a create or update failing because a referenced object is not found, answered by the API with a 404, is retried with exponential backoff. Absorbs the eventual consistency of objects created earlier in the same apply, e.g. a policy referencing a new target set. Set to `0` to disable. Defaults to `3`. Resolved from environment variable `IDSEC_CONSISTENCY_RETRIES`.",
+			},
+			"destroy_concurrency": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "Maximum number of concurrent deletes across all services. Further deletes wait in line, so destroying hundreds of resources does not overwhelm the APIs. Set to 0 to leave deletes unbounded. Defaults to 10. Resolved from environment variable IDSEC_DESTROY_CONCURRENCY.",
+				MarkdownDescription: "Maximum number of concurrent deletes across all services. Further deletes wait in line, so destroying hundreds of resources does not overwhelm the APIs. Set to `0` to leave deletes unbounded. Defaults to `10`. Resolved from environment variable `IDSEC_DESTROY_CONCURRENCY`.",
+			},
+			"destroy_retries": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "Number of times a delete failing with a throttling or gateway error, such as HTTP 429, 503 or 504, is retried with exponential backoff. A retry finding the object already deleted, by the attempt that failed with a gateway error, succeeds. Set to 0 to disable. Defaults to 5. Resolved from environment variable IDSEC_DESTROY_RETRIES.",
+				MarkdownDescription: "Number of times a delete failing with a throttling or gateway error, such as HTTP 429, 503 or 504, is retried with exponential backoff. A retry finding the object already deleted, by the attempt that failed with a gateway error, succeeds. Set to `0` to disable. Defaults to `5`. Resolved from environment variable `IDSEC_DESTROY_RETRIES`.",
+			},
+			"extra_headers": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				Description:         "Extra HTTP headers sent with every API request made by resources and data sources, e.g. to tag requests with a pipeline run identifier.",
+				MarkdownDescription: "Extra HTTP headers sent with every API request made by resources and data sources, e.g. to tag requests with a pipeline run identifier.",
+			},
+			"offline_mode": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Disable all outbound calls of the provider other than those to the tenant APIs, for air-gapped environments: the usage telemetry reported after each operation, and the telemetry headers of API requests, whose collection probes the metadata endpoints of cloud instances. The proxy, secret source and operation hook endpoints are still contacted when configured. Defaults to false. Resolved from environment variable IDSEC_OFFLINE_MODE.",
+				MarkdownDescription: "Disable all outbound calls of the provider other than those to the tenant APIs, for air-gapped environments: the usage telemetry reported after each operation, and the telemetry headers of API requests, whose collection probes the metadata endpoints of cloud instances. The proxy, secret source and operation hook endpoints are still contacted when configured. Defaults to `false`. Resolved from environment variable `IDSEC_OFFLINE_MODE`.",
+			},
+			"operation_hooks": schema.SingleNestedAttribute{
+				Optional:            true,
+				Description:         "Hooks invoked before and after each resource create, update and delete, e.g. to gate changes on an approved change-management ticket. Hooks receive a JSON payload with the resource type, the operation, the phase (pre or post), the correlation ID and the names of the changed attributes. A failing pre hook aborts the operation, a failing post hook is reported as a warning.",
+				MarkdownDescription: "Hooks invoked before and after each resource create, update and delete, e.g. to gate changes on an approved change-management ticket. Hooks receive a JSON payload with the resource type, the operation, the phase (`pre` or `post`), the correlation ID and the names of the changed attributes. A failing pre hook aborts the operation, a failing post hook is reported as a warning.",
+				Attributes: map[string]schema.Attribute{
+					"command": schema.ListAttribute{
+						Optional:            true,
+						ElementType:         types.StringType,
+						Description:         "Local command run with the JSON payload on its standard input, as the executable followed by its arguments. A non-zero exit status fails the hook.",
+						MarkdownDescription: "Local command run with the JSON payload on its standard input, as the executable followed by its arguments. A non-zero exit status fails the hook.",
+					},
+					"webhook_url": schema.StringAttribute{
+						Optional:            true,
+						Description:         "URL the JSON payload is posted to. A non-2xx response fails the hook.",
+						MarkdownDescription: "URL the JSON payload is posted to. A non-2xx response fails the hook.",
+					},
+					"timeout": schema.StringAttribute{
+						Optional:            true,
+						Description:         "Maximum duration of a hook invocation, e.g. 30s. Defaults to 30s.",
+						MarkdownDescription: "Maximum duration of a hook invocation, e.g. `30s`. Defaults to `30s`.",
+					},
+				},
+			},
+			"retryable_errors": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				Description:         "Additional errors retried by resource operations, on top of the built-in retry classification. Each rule is an HTTP status code such as 503, a status class such as 5xx, or a regular expression matched against the error message. Matching operations are retried up to consistency_retries times with exponential backoff. Creates are not retried, since a create that reached the API before failing would create the object twice.",
+				MarkdownDescription: "Additional errors retried by resource operations, on top of the built-in retry classification. Each rule is an HTTP status code such as `503`, a status class such as `5xx`, or a regular expression matched against the error message. Matching operations are retried up to `consistency_retries` times with exponential backoff. Creates are not retried, since a create that reached the API before failing would create the object twice.",
+			},
+			"fips_mode": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Require the provider to run with the FIPS 140-3 validated Go Cryptographic Module, failing to configure otherwise. TLS connections are then restricted to FIPS approved protocol versions, cipher suites and key exchanges. The FIPS build of the provider runs in FIPS mode, as does any build when Terraform runs with environment variable GODEBUG=fips140=on. Defaults to false. Resolved from environment variable IDSEC_FIPS_MODE.",
+				MarkdownDescription: "Require the provider to run with the FIPS 140-3 validated Go Cryptographic Module, failing to configure otherwise. TLS connections are then restricted to FIPS approved protocol versions, cipher suites and key exchanges. The FIPS build of the provider runs in FIPS mode, as does any build when Terraform runs with environment variable `GODEBUG=fips140=on`. Defaults to `false`. Resolved from environment variable `IDSEC_FIPS_MODE`.",
+			},
+			"plan_summary_file": schema.StringAttribute{
+				Optional:            true,
+				Description:         "File a JSON summary of each planned change of a resource is appended to, one document per line, for external approval systems to review the changes without parsing the plan of Terraform. Each summary holds the resource type, the id of existing resources, the operation (create, update, replace or delete), the correlation ID, the change reason and the changed attribute paths with their prior and planned values, the values of sensitive attributes being masked. The file is emptied when the provider is configured, so it only holds the summaries of the last plan, including the plan Terraform makes again during apply. Resolved from environment variable IDSEC_PLAN_SUMMARY_FILE.",
+				MarkdownDescription: "File a JSON summary of each planned change of a resource is appended to, one document per line, for external approval systems to review the changes without parsing the plan of Terraform. Each summary holds the resource type, the `id` of existing resources, the operation (`create`, `update`, `replace` or `delete`), the correlation ID, the change reason and the changed attribute paths with their prior and planned values, the values of sensitive attributes being masked. The file is emptied when the provider is configured, so it only holds the summaries of the last plan, including the plan Terraform makes again during apply. Resolved from environment variable `IDSEC_PLAN_SUMMARY_FILE`.",
+			},
+			"recover_panics": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Turn unexpected internal errors (panics) of a resource, data source or action operation into an error of that operation, logging the stack trace at DEBUG, instead of crashing the provider and aborting all other operations of the run. Disable to get the raw crash output. Defaults to true. Resolved from environment variable IDSEC_RECOVER_PANICS.",
+				MarkdownDescription: "Turn unexpected internal errors (panics) of a resource, data source or action operation into an error of that operation, logging the stack trace at `DEBUG`, instead of crashing the provider and aborting all other operations of the run. Disable to get the raw crash output. Defaults to `true`. Resolved from environment variable `IDSEC_RECOVER_PANICS`.",
+			},
+			"service_concurrency": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.Int64Type,
+				Description:         "Maximum number of concurrent resource operations per service, for services failing under parallel requests, e.g. { \"sia\" = 2 }. Keys are service names or service families, a family such as sia limiting all its services together. Services not listed are not limited.",
+				MarkdownDescription: "Maximum number of concurrent resource operations per service, for services failing under parallel requests, e.g. `{ \"sia\" = 2 }`. Keys are service names or service families, a family such as `sia` limiting all its services together. Services not listed are not limited.",
+			},
+			"service_timeouts": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				Description:         "Default timeout of the create, read, update and delete operations of the resources of a service, as a duration, e.g. { \"sia\" = \"30m\" }. Keys are service names or service families, a family such as sia applying to all its services. Takes precedence over the defaults of the timeouts blocks of resources, while the timeouts set in those blocks take precedence over it. Like those, it bounds the wait for an operation to start and its retries, not an API call in flight.",
+				MarkdownDescription: "Default timeout of the create, read, update and delete operations of the resources of a service, as a duration, e.g. `{ \"sia\" = \"30m\" }`. Keys are service names or service families, a family such as `sia` applying to all its services. Takes precedence over the defaults of the `timeouts` blocks of resources, while the timeouts set in those blocks take precedence over it. Like those, it bounds the wait for an operation to start and its retries, not an API call in flight.",
+			},
+			"defaults": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				Description:         "Values of the attributes scoping resources inherited by the resources leaving them out of their configuration, keyed by attribute name, e.g. { \"safe_name\" = \"crown-jewels\" } for the resources scoped to the same Safe. Only the attributes documented as defaulting to the defaults of the provider inherit them, e.g. safe_name of idsec_pcloud_account.",
+				MarkdownDescription: "Values of the attributes scoping resources inherited by the resources leaving them out of their configuration, keyed by attribute name, e.g. `{ \"safe_name\" = \"crown-jewels\" }` for the resources scoped to the same Safe. Only the attributes documented as defaulting to the `defaults` of the provider inherit them, e.g. `safe_name` of `idsec_pcloud_account`.",
+			},
+			"circuit_breaker_threshold": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "Number of consecutive operations of a service failing with errors indicating it is down or unreachable, such as HTTP 502, 503 or 504, connection or timeout errors, after which the operations of that service fail at once with a service unavailable error instead of each waiting for its own timeouts and retries. After 30 seconds one operation is attempted again, and the service answering it closes the circuit. An operation the service answers otherwise resets the count. Defaults to 0, disabled. Resolved from environment variable IDSEC_CIRCUIT_BREAKER_THRESHOLD.",
+				MarkdownDescription: "Number of consecutive operations of a service failing with errors indicating it is down or unreachable, such as HTTP `502`, `503` or `504`, connection or timeout errors, after which the operations of that service fail at once with a service unavailable error instead of each waiting for its own timeouts and retries. After 30 seconds one operation is attempted again, and the service answering it closes the circuit. An operation the service answers otherwise resets the count. Defaults to `0`, disabled. Resolved from environment variable `IDSEC_CIRCUIT_BREAKER_THRESHOLD`.",
+			},
+			"strict_schema_sync": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Report fields of the SDK response models that are not part of the resource or data source schema as warnings. The SDK does not expose the HTTP body, so fields of the API the SDK models do not declare are not reported. Intended for provider maintainers. Defaults to false. Resolved from environment variable IDSEC_STRICT_SCHEMA_SYNC.",
+				MarkdownDescription: "Report fields of the SDK response models that are not part of the resource or data source schema as warnings. The SDK does not expose the HTTP body, so fields of the API the SDK models do not declare are not reported. Intended for provider maintainers. Defaults to `false`. Resolved from environment variable `IDSEC_STRICT_SCHEMA_SYNC`.",
+			},
+			"data_source_cache_ttl": schema.StringAttribute{
+				Optional:            true,
+				Description:         "How long data sources reading the same API endpoint with the same arguments share one API call within a Terraform run, as a duration such as 30s or 5m. Results of list endpoints are cached as well. Defaults to 0s, no caching. Resolved from environment variable IDSEC_DATA_SOURCE_CACHE_TTL.",
+				MarkdownDescription: "How long data sources reading the same API endpoint with the same arguments share one API call within a Terraform run, as a duration such as `30s` or `5m`. Results of list endpoints are cached as well. Defaults to `0s`, no caching. Resolved from environment variable `IDSEC_DATA_SOURCE_CACHE_TTL`.",
+			},
+			"ignore_unavailable_services": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Skip data sources of services that are not enabled on the tenant with a warning, leaving their computed attributes null, instead of failing. Resources of such services still fail. Defaults to false. Resolved from environment variable IDSEC_IGNORE_UNAVAILABLE_SERVICES.",
+				MarkdownDescription: "Skip data sources of services that are not enabled on the tenant with a warning, leaving their computed attributes null, instead of failing. Resources of such services still fail. Defaults to `false`. Resolved from environment variable `IDSEC_IGNORE_UNAVAILABLE_SERVICES`.",
+			},
+			"profile": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Name of the profile of the profiles file (~/.idsec/config.toml, or the file named by environment variable IDSEC_CONFIG_FILE) providing the settings that are neither configured nor set in their environment variable, such as auth_method, subdomain or cache_authentication. The profiles file is only read when a profile is selected. Resolved from environment variable IDSEC_PROFILE.",
+				MarkdownDescription: "Name of the profile of the profiles file (`~/.idsec/config.toml`, or the file named by environment variable `IDSEC_CONFIG_FILE`) providing the settings that are neither configured nor set in their environment variable, such as `auth_method`, `subdomain` or `cache_authentication`. The profiles file is only read when a profile is selected. Resolved from environment variable `IDSEC_PROFILE`.",
+			},
+			"validate_references": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Look up the objects referenced by resource attributes, such as safe names or policy IDs, on the tenant while planning, so a missing reference fails the plan instead of the apply. Adds API calls to every plan. Defaults to false. Resolved from environment variable IDSEC_VALIDATE_REFERENCES.",
+				MarkdownDescription: "Look up the objects referenced by resource attributes, such as safe names or policy IDs, on the tenant while planning, so a missing reference fails the plan instead of the apply. Adds API calls to every plan. Defaults to `false`. Resolved from environment variable `IDSEC_VALIDATE_REFERENCES`.",
+			},
+			"validate_unique_names": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Look up the names of resources being created or renamed on the tenant while planning, for services whose names are unique across the tenant, so a name already taken fails the plan instead of the apply. Adds an API call per new name to plans. Defaults to false. Resolved from environment variable IDSEC_VALIDATE_UNIQUE_NAMES.",
+				MarkdownDescription: "Look up the names of resources being created or renamed on the tenant while planning, for services whose names are unique across the tenant, so a name already taken fails the plan instead of the apply. Adds an API call per new name to plans. Defaults to `false`. Resolved from environment variable `IDSEC_VALIDATE_UNIQUE_NAMES`.",
+			},
 		},
 	}
+	localizeSchema(&resp.Schema, &resp.Diagnostics)
 }
 
 // Configure configures the provider with the given context and request.
 func (p *IdsecProvider) Configure(ctx context.Context, req terraformprovider.ConfigureRequest, resp *terraformprovider.ConfigureResponse) {
+	p.configureMu.Lock()
+	defer p.configureMu.Unlock()
+
 	// Set the tool type for telemetry reporting
 	// This ensures runtime report as Terraform Provider
 	sdkconfig.SetIdsecToolInUse(sdkconfig.IdsecToolTerraformProvider)
 
-	// Generate a unique correlation ID for this Terraform execution
-	sdkconfig.GenerateCorrelationID()
+	// The settings replace those of the resources, data sources and actions once the configuration is
+	// read. Generate a unique correlation ID for this Terraform execution, unless one is configured below
+	settings := newProviderSettings()
+	settings.correlationID = sdkconfig.GenerateCorrelationID()
 
 	var config IdsecProviderSchema
 	tflog.Info(ctx, "Configuring Idsec provider")
@@ -386,11 +819,195 @@ func (p *IdsecProvider) Configure(ctx context.Context, req terraformprovider.Con
 		tflog.Error(ctx, "Failed to read provider configuration")
 		return
 	}
+	if deferUnknownConfiguration(ctx, req, resp) {
+		return
+	}
+
+	// Apply the selected profile to the settings neither configured nor set in the environment
+	config.Profile = p.resolveTerraformStringVar(config.Profile, IdsecProfileEnvVar)
+	if profileName := config.Profile.ValueString(); profileName != "" {
+		profilesPath, err := profilesFilePath()
+		var profile *providerProfile
+		if err == nil {
+			profile, err = loadProviderProfile(profilesPath, profileName)
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Configuration", fmt.Sprintf("Failed to load provider profile: %s.", err.Error()))
+			return
+		}
+		tflog.Info(ctx, fmt.Sprintf("Applying provider profile %s from %s", profileName, profilesPath))
+		applyProfile(&config, profile)
+	}
 
 	// Resolve common configuration from environment variables
 	config.CacheAuthentication = p.resolveTerraformBoolVar(config.CacheAuthentication, IdsecCacheAuthenticationEnvVar, IdsecCacheAuthenticationDefault)
+	config.AuthCacheBackend = p.resolveTerraformStringVar(config.AuthCacheBackend, IdsecAuthCacheBackendEnvVar)
+	if config.AuthCacheBackend.ValueString() == "" {
+		config.AuthCacheBackend = types.StringValue(IdsecAuthCacheBackendDefault)
+	}
+	if config.CacheAuthentication.ValueBool() {
+		configureAuthCacheBackend(ctx, config.AuthCacheBackend.ValueString(), &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 	config.AuthMethod = p.resolveTerraformStringVar(config.AuthMethod, IdsecAuthMethodEnvVar)
 	config.Subdomain = p.resolveTerraformStringVar(config.Subdomain, IdsecSubdomainEnvVar)
+	config.StrictSchemaSync = p.resolveTerraformBoolVar(config.StrictSchemaSync, IdsecStrictSchemaSyncEnvVar, IdsecStrictSchemaSyncDefault)
+	settings.strictSchemaSync = config.StrictSchemaSync.ValueBool()
+	config.ValidateReferences = p.resolveTerraformBoolVar(config.ValidateReferences, IdsecValidateReferencesEnvVar, IdsecValidateReferencesDefault)
+	settings.validateReferences = config.ValidateReferences.ValueBool()
+	config.ValidateUniqueNames = p.resolveTerraformBoolVar(config.ValidateUniqueNames, IdsecValidateUniqueNamesEnvVar, IdsecValidateUniqueNamesDefault)
+	settings.validateUniqueNames = config.ValidateUniqueNames.ValueBool()
+	config.IgnoreUnavailableServices = p.resolveTerraformBoolVar(config.IgnoreUnavailableServices, IdsecIgnoreUnavailableServicesEnvVar, IdsecIgnoreUnavailableServicesDefault)
+	settings.ignoreUnavailableServices = config.IgnoreUnavailableServices.ValueBool()
+	config.RecoverPanics = p.resolveTerraformBoolVar(config.RecoverPanics, IdsecRecoverPanicsEnvVar, IdsecRecoverPanicsDefault)
+	settings.recoverPanics = config.RecoverPanics.ValueBool()
+	config.FIPSMode = p.resolveTerraformBoolVar(config.FIPSMode, IdsecFIPSModeEnvVar, IdsecFIPSModeDefault)
+	requireFIPSMode(config.FIPSMode.ValueBool(), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config.OfflineMode = p.resolveTerraformBoolVar(config.OfflineMode, IdsecOfflineModeEnvVar, IdsecOfflineModeDefault)
+	applyOfflineMode(config.OfflineMode.ValueBool())
+	config.DataSourceCacheTTL = p.resolveTerraformStringVar(config.DataSourceCacheTTL, IdsecDataSourceCacheTTLEnvVar)
+	dataSourceCacheTTL := IdsecDataSourceCacheTTLDefault
+	if config.DataSourceCacheTTL.ValueString() != "" {
+		var err error
+		dataSourceCacheTTL, err = time.ParseDuration(config.DataSourceCacheTTL.ValueString())
+		if err != nil || dataSourceCacheTTL < 0 {
+			resp.Diagnostics.AddError("Invalid Configuration", fmt.Sprintf("data_source_cache_ttl must be a non-negative duration, got %q.", config.DataSourceCacheTTL.ValueString()))
+			return
+		}
+	}
+	p.dataSourceResultCache.configure(dataSourceCacheTTL)
+	var err error
+	config.ConsistencyRetries, err = p.resolveTerraformInt64Var(config.ConsistencyRetries, IdsecConsistencyRetriesEnvVar, IdsecConsistencyRetriesDefault)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", fmt.Sprintf("%s.", err.Error()))
+		return
+	}
+	if config.ConsistencyRetries.ValueInt64() < 0 {
+		resp.Diagnostics.AddError("Invalid Configuration", "consistency_retries must be zero or greater.")
+		return
+	}
+	settings.consistencyRetries = config.ConsistencyRetries.ValueInt64()
+	config.DestroyRetries, err = p.resolveTerraformInt64Var(config.DestroyRetries, IdsecDestroyRetriesEnvVar, IdsecDestroyRetriesDefault)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", fmt.Sprintf("%s.", err.Error()))
+		return
+	}
+	if config.DestroyRetries.ValueInt64() < 0 {
+		resp.Diagnostics.AddError("Invalid Configuration", "destroy_retries must be zero or greater.")
+		return
+	}
+	settings.destroyRetries = config.DestroyRetries.ValueInt64()
+	config.DestroyConcurrency, err = p.resolveTerraformInt64Var(config.DestroyConcurrency, IdsecDestroyConcurrencyEnvVar, IdsecDestroyConcurrencyDefault)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", fmt.Sprintf("%s.", err.Error()))
+		return
+	}
+	queue, err := newDestroyQueue(config.DestroyConcurrency.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", fmt.Sprintf("Invalid destroy_concurrency: %s.", err.Error()))
+		return
+	}
+	settings.destroyQueue = queue
+
+	var retryableErrors []string
+	if !config.RetryableErrors.IsNull() && !config.RetryableErrors.IsUnknown() {
+		resp.Diagnostics.Append(config.RetryableErrors.ElementsAs(ctx, &retryableErrors, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	rules, err := parseRetryRules(retryableErrors)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", fmt.Sprintf("Invalid retryable_errors: %s.", err.Error()))
+		return
+	}
+	settings.retryableErrorRules = rules
+
+	if !config.ExtraHeaders.IsNull() && !config.ExtraHeaders.IsUnknown() {
+		resp.Diagnostics.Append(config.ExtraHeaders.ElementsAs(ctx, &settings.requestHeaders, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	settings.userAgent = p.buildUserAgent(req.TerraformVersion)
+
+	var serviceLimits map[string]int64
+	if !config.ServiceConcurrency.IsNull() && !config.ServiceConcurrency.IsUnknown() {
+		resp.Diagnostics.Append(config.ServiceConcurrency.ElementsAs(ctx, &serviceLimits, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	limiter, err := newServiceLimiter(serviceLimits)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", fmt.Sprintf("Invalid service_concurrency: %s.", err.Error()))
+		return
+	}
+	settings.serviceConcurrency = limiter
+
+	var timeouts map[string]string
+	if !config.ServiceTimeouts.IsNull() && !config.ServiceTimeouts.IsUnknown() {
+		resp.Diagnostics.Append(config.ServiceTimeouts.ElementsAs(ctx, &timeouts, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	settings.serviceTimeouts, err = parseServiceTimeouts(timeouts)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", fmt.Sprintf("Invalid service_timeouts: %s.", err.Error()))
+		return
+	}
+	var defaults map[string]string
+	if !config.Defaults.IsNull() && !config.Defaults.IsUnknown() {
+		resp.Diagnostics.Append(config.Defaults.ElementsAs(ctx, &defaults, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	settings.defaults, err = parseProviderDefaults(defaults)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", fmt.Sprintf("Invalid defaults: %s.", err.Error()))
+		return
+	}
+	config.CircuitBreakerThreshold, err = p.resolveTerraformInt64Var(config.CircuitBreakerThreshold, IdsecCircuitBreakerThresholdEnvVar, IdsecCircuitBreakerThresholdDefault)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", fmt.Sprintf("%s.", err.Error()))
+		return
+	}
+	breaker, err := newCircuitBreaker(config.CircuitBreakerThreshold.ValueInt64())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", fmt.Sprintf("Invalid circuit_breaker_threshold: %s.", err.Error()))
+		return
+	}
+	settings.circuitBreaker = breaker
+
+	config.PlanSummaryFile = p.resolveTerraformStringVar(config.PlanSummaryFile, IdsecPlanSummaryFileEnvVar)
+	settings.planSummaryFile = config.PlanSummaryFile.ValueString()
+	if err := truncatePlanSummaryFile(settings.planSummaryFile); err != nil {
+		resp.Diagnostics.AddWarning("Plan Summary Error", fmt.Sprintf("Failed to empty %s: %s", settings.planSummaryFile, err.Error()))
+	}
+
+	config.ChangeReason = p.resolveTerraformStringVar(config.ChangeReason, IdsecChangeReasonEnvVar)
+	settings.changeReason = config.ChangeReason.ValueString()
+
+	config.CorrelationID = p.resolveTerraformStringVar(config.CorrelationID, IdsecCorrelationIDEnvVar)
+	if config.CorrelationID.ValueString() != "" {
+		settings.correlationID = config.CorrelationID.ValueString()
+	}
+	featureadoption.SetCorrelationID(settings.correlationID)
+	tflog.Info(ctx, fmt.Sprintf("Using correlation ID: %s", settings.correlationID))
+
+	settings.operationHooks, diags = newOperationHookConfig(ctx, config.OperationHooks, settings.userAgent)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	*p.settings = *settings
 
 	// If no proxy is set in TF or in env vars, HTTPS_PROXY and HTTP_PROXY env vars will be used as the standard fallback by the SDK.
 	config.ProxyAddress = p.resolveTerraformStringVar(config.ProxyAddress, sdkconfig.IdsecProxyAddressEnvVar)
@@ -409,61 +1026,124 @@ func (p *IdsecProvider) Configure(ctx context.Context, req terraformprovider.Con
 		return
 	}
 
+	// The credentials are resolved again from this configuration when an operation fails to authenticate
+	credentialsConfig := refreshableCredentialsConfig(ctx, config)
+	creds, credsDiags := p.resolveCredentials(ctx, &config)
+	resp.Diagnostics.Append(credsDiags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer creds.wipe()
+
+	// Perform authentication based on the auth method
+	if config.AuthMethod.ValueString() == "pvwa" {
+		p.configurePVWAAuth(ctx, &config, creds, resp)
+	} else {
+		p.configureISPAuth(ctx, &config, creds, resp)
+	}
+	if !resp.Diagnostics.HasError() {
+		p.credentialsConfig = credentialsConfig
+		p.settings.credentialsRefresher = p.refreshCredentials
+	}
+}
+
+// refreshableCredentialsConfig returns the configuration kept by the provider to resolve its credentials
+// again, or nil when they cannot change or it would hold a credential. A credential set in the provider
+// block takes precedence over the environment and conflicts with a secret source, so the configuration is
+// only kept when none is set, and only when its secret_source block leaves the credentials of its secret
+// store to the environment, so that no credential is held for the lifetime of the provider process.
+func refreshableCredentialsConfig(ctx context.Context, config IdsecProviderSchema) *IdsecProviderSchema {
+	credential := config.Secret
+	if config.AuthMethod.ValueString() == "identity_service_user" {
+		credential = config.ServiceToken
+	}
+	if !credential.IsNull() || secretSourceHoldsCredential(ctx, config.SecretSource) {
+		return nil
+	}
+	config.Secret = types.StringNull()
+	config.ServiceToken = types.StringNull()
+	config.ProxyPassword = types.StringNull()
+	return &config
+}
+
+// resolveCredentials reads the credentials of config from its secret source, the environment and its
+// attributes, in that order of precedence, and parses them for its auth method.
+func (p *IdsecProvider) resolveCredentials(ctx context.Context, config *IdsecProviderSchema) (*authCredentials, diag.Diagnostics) {
+	diags := resolveSecretSource(ctx, config, secretSourceHTTPClient)
+	if diags.HasError() {
+		return nil, diags
+	}
+
 	// Parse authentication credentials based on auth method
 	var creds *authCredentials
 	var parseErr string
 	switch config.AuthMethod.ValueString() {
 	case "identity":
-		creds, parseErr = p.parseIdentityAuth(ctx, &config)
+		creds, parseErr = p.parseIdentityAuth(ctx, config)
 	case "identity_service_user":
-		creds, parseErr = p.parseIdentityServiceUserAuth(ctx, &config)
+		creds, parseErr = p.parseIdentityServiceUserAuth(ctx, config)
 	case "pvwa":
-		creds, parseErr = p.parsePVWAAuth(ctx, &config)
+		creds, parseErr = p.parsePVWAAuth(ctx, config)
 	default:
-		resp.Diagnostics.AddError("Invalid Configuration", "Unsupported auth method.")
-		return
+		diags.AddError("Invalid Configuration", "Unsupported auth method.")
+		return nil, diags
 	}
 
+	// Backstop to the config validators, which skip unknown values and may have read another environment
 	if parseErr != "" {
-		resp.Diagnostics.AddError("Invalid Configuration", parseErr)
-		return
-	}
-
-	// Perform authentication based on the auth method
-	if config.AuthMethod.ValueString() == "pvwa" {
-		p.configurePVWAAuth(ctx, &config, creds, resp)
-	} else {
-		p.configureISPAuth(ctx, &config, creds, resp)
+		diags.AddError("Invalid Configuration", parseErr)
+		return nil, diags
 	}
+	return creds, diags
 }
 
 // configurePVWAAuth configures PVWA authentication for the provider.
 func (p *IdsecProvider) configurePVWAAuth(ctx context.Context, config *IdsecProviderSchema, creds *authCredentials, resp *terraformprovider.ConfigureResponse) {
+	key := credentialsKey(config, creds)
+	if p.pvwaAuth != nil && p.authKey == key && p.pvwaAuth.GetToken() != nil {
+		tflog.Debug(ctx, "Reusing the PVWA authentication of the previous configuration")
+		p.setPVWAAuthData(resp)
+		return
+	}
 	pvwaAuth, ok := auth.NewIdsecPVWAAuth(config.CacheAuthentication.ValueBool()).(*auth.IdsecPVWAAuth)
 	if !ok {
 		resp.Diagnostics.AddError("Authentication Error", "Failed to create PVWA authentication.")
 		return
 	}
-	p.pvwaAuth = pvwaAuth
+	useAuthCacheBackend(pvwaAuth.IdsecAuthBase, config.AuthCacheBackend.ValueString())
 
 	if err := p.authenticateWithRetry(ctx, pvwaAuth, creds, "PVWA"); err != nil {
 		resp.Diagnostics.AddError("Authentication Error", err.Error())
 		return
 	}
 
+	p.ispAuth, p.pvwaAuth, p.authKey = nil, pvwaAuth, key
+	p.setPVWAAuthData(resp)
+}
+
+// setPVWAAuthData passes the PVWA auth to the resources, data sources and actions of the provider.
+func (p *IdsecProvider) setPVWAAuthData(resp *terraformprovider.ConfigureResponse) {
 	providerVersion = p.config.Version
 	resp.ResourceData = p.pvwaAuth
 	resp.DataSourceData = p.pvwaAuth
+	resp.ListResourceData = p.pvwaAuth
+	resp.ActionData = p.pvwaAuth
 }
 
 // configureISPAuth configures ISP (Identity) authentication for the provider.
 func (p *IdsecProvider) configureISPAuth(ctx context.Context, config *IdsecProviderSchema, creds *authCredentials, resp *terraformprovider.ConfigureResponse) {
+	key := credentialsKey(config, creds)
+	if p.ispAuth != nil && p.authKey == key && p.ispAuth.GetToken() != nil {
+		tflog.Debug(ctx, "Reusing the ISP authentication of the previous configuration")
+		p.setISPAuthData(resp)
+		return
+	}
 	ispAuth, ok := auth.NewIdsecISPAuth(config.CacheAuthentication.ValueBool()).(*auth.IdsecISPAuth)
 	if !ok {
 		resp.Diagnostics.AddError("Authentication Error", "Failed to create ISP authentication.")
 		return
 	}
-	p.ispAuth = ispAuth
+	useAuthCacheBackend(ispAuth.IdsecAuthBase, config.AuthCacheBackend.ValueString())
 
 	if err := p.authenticateWithRetry(ctx, ispAuth, creds, "ISP"); err != nil {
 		resp.Diagnostics.AddError("Authentication Error", err.Error())
@@ -474,7 +1154,7 @@ func (p *IdsecProvider) configureISPAuth(ctx context.Context, config *IdsecProvi
 	// on the auth object is not populated (e.g. keyring deserialization issues).
 	// FromISPAuth in the SDK dereferences Token without a nil check, so we must
 	// ensure it is set before any service tries to use it.
-	if ispAuth.Token == nil {
+	if ispAuth.GetToken() == nil {
 		tflog.Debug(ctx, "ISP auth token not populated after authentication, forcing fresh authentication")
 		_, err := ispAuth.Authenticate(
 			nil,
@@ -483,9 +1163,7 @@ func (p *IdsecProvider) configureISPAuth(ctx context.Context, config *IdsecProvi
 				AuthMethod:         creds.authMethod,
 				AuthMethodSettings: creds.authMethodSettings,
 			},
-			&authmodels.IdsecSecret{
-				Secret: creds.secret,
-			},
+			creds.idsecSecret(),
 			true,
 			true,
 		)
@@ -493,15 +1171,44 @@ func (p *IdsecProvider) configureISPAuth(ctx context.Context, config *IdsecProvi
 			resp.Diagnostics.AddError("Authentication Error", fmt.Sprintf("ISP token was nil after initial auth, forced re-auth also failed: %s", err.Error()))
 			return
 		}
-		if ispAuth.Token == nil {
+		if ispAuth.GetToken() == nil {
 			resp.Diagnostics.AddError("Authentication Error", "ISP auth token is nil even after forced re-authentication")
 			return
 		}
 	}
 
+	// The auth is only published once authenticated, so resources never see a half-authenticated one
+	p.ispAuth, p.pvwaAuth, p.authKey = ispAuth, nil, key
+	p.setISPAuthData(resp)
+}
+
+// setISPAuthData passes the ISP auth to the resources, data sources and actions of the provider.
+func (p *IdsecProvider) setISPAuthData(resp *terraformprovider.ConfigureResponse) {
 	providerVersion = p.config.Version
 	resp.ResourceData = p.ispAuth
 	resp.DataSourceData = p.ispAuth
+	resp.ListResourceData = p.ispAuth
+	resp.ActionData = p.ispAuth
+}
+
+// credentialsKey returns a digest identifying the credentials and the auth settings of a configuration,
+// without holding the secret itself.
+func credentialsKey(config *IdsecProviderSchema, creds *authCredentials) string {
+	settings, _ := json.Marshal(creds.authMethodSettings)
+	digest := sha256.New()
+	for _, part := range []string{
+		config.AuthMethod.ValueString(),
+		config.Subdomain.ValueString(),
+		strconv.FormatBool(config.CacheAuthentication.ValueBool()),
+		creds.userName,
+		string(creds.authMethod),
+		string(settings),
+	} {
+		digest.Write([]byte(part))
+		digest.Write([]byte{0})
+	}
+	digest.Write(creds.secret)
+	return hex.EncodeToString(digest.Sum(nil))
 }
 
 func (p *IdsecProvider) collectTfResources() []schemas.Tuple[*services.IdsecServiceConfig, *provideractions.IdsecServiceTerraformResourceActionDefinition] {
@@ -556,6 +1263,32 @@ func (p *IdsecProvider) collectTfDataSources() []schemas.Tuple[*services.IdsecSe
 	return collected
 }
 
+func (p *IdsecProvider) collectTfActions() []schemas.Tuple[*services.IdsecServiceConfig, *provideractions.IdsecServiceTerraformImperativeActionDefinition] {
+	collected := make([]schemas.Tuple[*services.IdsecServiceConfig, *provideractions.IdsecServiceTerraformImperativeActionDefinition], 0)
+	for _, config := range provideractions.AllTerraformConfigs() {
+		serviceConfig, err := services.GetServiceConfig(config.ServiceName)
+		if err != nil {
+			continue
+		}
+		for _, act := range config.Actions {
+			found := false
+			for _, existing := range collected {
+				if existing.Second.ActionName == act.ActionName {
+					found = true
+					break
+				}
+			}
+			if !found {
+				collected = append(collected, schemas.Tuple[*services.IdsecServiceConfig, *provideractions.IdsecServiceTerraformImperativeActionDefinition]{
+					First:  &serviceConfig,
+					Second: act,
+				})
+			}
+		}
+	}
+	return collected
+}
+
 // Resources returns the resources supported by the provider.
 func (p *IdsecProvider) Resources(ctx context.Context) []func() resource.Resource {
 	collectedResources := p.collectTfResources()
@@ -563,8 +1296,16 @@ func (p *IdsecProvider) Resources(ctx context.Context) []func() resource.Resourc
 	resourcesFunctions := make([]func() resource.Resource, 0, len(collectedResources))
 	for _, resourceDef := range collectedResources {
 		tflog.Info(ctx, fmt.Sprintf("Adding resource: %s", resourceDef.Second.ActionName))
+		logActionCompatibility(ctx, "resource", resourceDef.Second.ActionName, resourceDef.First.ServiceName, resourceActionNames(resourceDef.Second))
 		resourcesFunctions = append(resourcesFunctions, func() resource.Resource {
-			return NewIdsecResource(resourceDef.First, resourceDef.Second)
+			idsecResource := NewIdsecResource(resourceDef.First, resourceDef.Second)
+			switch r := idsecResource.(type) {
+			case *IdsecResource:
+				r.settings = p.settings
+			case *IdsecResourceWithIdentity:
+				r.settings = p.settings
+			}
+			return idsecResource
 		})
 	}
 	return resourcesFunctions
@@ -577,9 +1318,65 @@ func (p *IdsecProvider) DataSources(ctx context.Context) []func() datasource.Dat
 	dataSourceFunctions := make([]func() datasource.DataSource, 0, len(collectedDataSources))
 	for _, dataSourceDef := range collectedDataSources {
 		tflog.Info(ctx, fmt.Sprintf("Adding data source: %s", dataSourceDef.Second.ActionName))
+		logActionCompatibility(ctx, "data source", dataSourceDef.Second.ActionName, dataSourceDef.First.ServiceName, []string{dataSourceDef.Second.DataSourceAction})
 		dataSourceFunctions = append(dataSourceFunctions, func() datasource.DataSource {
-			return NewIdsecDataSource(dataSourceDef.First, dataSourceDef.Second)
+			dataSource := NewIdsecDataSource(dataSourceDef.First, dataSourceDef.Second).(*IdsecDataSource)
+			dataSource.resultCache = p.dataSourceResultCache
+			dataSource.settings = p.settings
+			return dataSource
 		})
 	}
+	dataSourceFunctions = append(dataSourceFunctions, func() datasource.DataSource {
+		waitFor := NewIdsecWaitForDataSource(collectedDataSources).(*IdsecWaitForDataSource)
+		waitFor.settings = p.settings
+		return waitFor
+	})
+	dataSourceFunctions = append(dataSourceFunctions, func() datasource.DataSource {
+		return NewIdsecProviderInfoDataSource(p.config)
+	})
+	dataSourceFunctions = append(dataSourceFunctions, NewIdsecEffectiveAccessDataSource)
+	dataSourceFunctions = append(dataSourceFunctions, NewIdsecSessionReportDataSource)
 	return dataSourceFunctions
 }
+
+// ListResources returns the list resources supported by the provider, one for each resource with a list action and an import ID.
+func (p *IdsecProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	collectedResources := p.collectTfResources()
+	listResourceFunctions := make([]func() list.ListResource, 0)
+	for _, resourceDef := range collectedResources {
+		if resourceDef.Second.ListAction == "" || resourceDef.Second.ImportID == "" {
+			continue
+		}
+		tflog.Info(ctx, fmt.Sprintf("Adding list resource: %s", resourceDef.Second.ActionName))
+		listResourceFunctions = append(listResourceFunctions, func() list.ListResource {
+			listResource := NewIdsecListResource(resourceDef.First, resourceDef.Second).(*IdsecListResource)
+			listResource.settings = p.settings
+			return listResource
+		})
+	}
+	return listResourceFunctions
+}
+
+// Actions returns the imperative actions supported by the provider.
+func (p *IdsecProvider) Actions(ctx context.Context) []func() action.Action {
+	collectedActions := p.collectTfActions()
+	tflog.Info(ctx, fmt.Sprintf("Collected %d actions from service configurations", len(collectedActions)))
+	actionFunctions := make([]func() action.Action, 0, len(collectedActions))
+	for _, actionDef := range collectedActions {
+		tflog.Info(ctx, fmt.Sprintf("Adding action: %s", actionDef.Second.ActionName))
+		logActionCompatibility(ctx, "action", actionDef.Second.ActionName, actionDef.First.ServiceName, []string{actionDef.Second.InvokeAction})
+		actionFunctions = append(actionFunctions, func() action.Action {
+			idsecAction := NewIdsecAction(actionDef.First, actionDef.Second).(*IdsecAction)
+			idsecAction.settings = p.settings
+			return idsecAction
+		})
+	}
+	return actionFunctions
+}
+
+// Functions returns the provider functions of the Idsec provider.
+func (p *IdsecProvider) Functions(ctx context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewIdsecPrincipalFunction,
+	}
+}
@@ -0,0 +1,140 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"runtime/debug"
+	"strings"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkconfig "github.com/cyberark/idsec-sdk-golang/pkg/config"
+)
+
+const (
+	providerInfoDataSourceName = "provider-info"
+	idsecSDKModulePath         = "github.com/cyberark/idsec-sdk-golang"
+)
+
+// IdsecProviderInfoDataSource is a data source exposing the build of the provider, so configurations can
+// assert the provider and SDK versions they rely on while planning.
+type IdsecProviderInfoDataSource struct {
+	config IdsecProviderConfig
+}
+
+// IdsecProviderInfoDataSourceModel is the configuration and state of the provider info data source.
+type IdsecProviderInfoDataSourceModel struct {
+	MinimumVersion types.String `tfsdk:"minimum_version"`
+	Version        types.String `tfsdk:"version"`
+	GitCommit      types.String `tfsdk:"git_commit"`
+	BuildDate      types.String `tfsdk:"build_date"`
+	SDKVersion     types.String `tfsdk:"sdk_version"`
+	FIPSEnabled    types.Bool   `tfsdk:"fips_enabled"`
+}
+
+// NewIdsecProviderInfoDataSource creates a new instance of IdsecProviderInfoDataSource for a provider build.
+func NewIdsecProviderInfoDataSource(config IdsecProviderConfig) datasource.DataSource {
+	return &IdsecProviderInfoDataSource{config: config}
+}
+
+// Metadata defines the data source type name.
+func (s *IdsecProviderInfoDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_%s", req.ProviderTypeName, strings.ReplaceAll(providerInfoDataSourceName, "-", "_"))
+}
+
+// Schema defines the schema of the provider info data source.
+func (s *IdsecProviderInfoDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Exposes the version and build of the provider and of the Idsec SDK it embeds. Use it to require a minimum provider version, or to assert on the build in check blocks and tests.",
+		Attributes: map[string]schema.Attribute{
+			"minimum_version": schema.StringAttribute{
+				Optional:    true,
+				Description: "Minimum provider version required by the configuration, e.g. 1.4.0. The plan fails when the provider is older. Development builds without a release version are not checked.",
+			},
+			"version": schema.StringAttribute{
+				Computed:    true,
+				Description: "Version of the provider.",
+			},
+			"git_commit": schema.StringAttribute{
+				Computed:    true,
+				Description: "Commit the provider was built from.",
+			},
+			"build_date": schema.StringAttribute{
+				Computed:    true,
+				Description: "Date the provider was built.",
+			},
+			"sdk_version": schema.StringAttribute{
+				Computed:    true,
+				Description: "Version of the Idsec SDK the provider embeds.",
+			},
+			"fips_enabled": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the provider runs with the Go Cryptographic Module in FIPS 140-3 mode, restricting TLS to FIPS approved cipher suites.",
+			},
+		},
+	}
+	localizeSchema(&resp.Schema, &resp.Diagnostics)
+}
+
+// Read sets the build information of the provider and checks the minimum version of the configuration.
+func (s *IdsecProviderInfoDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config IdsecProviderInfoDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if minimum := config.MinimumVersion.ValueString(); minimum != "" {
+		required, err := version.NewVersion(minimum)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("minimum_version"), "Invalid Minimum Version", fmt.Sprintf("minimum_version %q is not a valid version: %s", minimum, err.Error()))
+			return
+		}
+		if !providerVersionAtLeast(s.config.Version, required) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("minimum_version"),
+				"Provider Version Too Old",
+				fmt.Sprintf("This configuration requires provider version %s or later, but version %s is installed. Upgrade the provider with terraform init -upgrade.", minimum, s.config.Version),
+			)
+			return
+		}
+	}
+	config.Version = types.StringValue(s.config.Version)
+	config.GitCommit = types.StringValue(s.config.GitCommit)
+	config.BuildDate = types.StringValue(s.config.BuildDate)
+	config.SDKVersion = types.StringValue(idsecSDKVersion())
+	config.FIPSEnabled = types.BoolValue(fipsEnabled())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// providerVersionAtLeast reports whether the provider version is required or later. Versions that are not
+// release versions, such as "dev" builds, satisfy every requirement.
+func providerVersionAtLeast(providerVersion string, required *version.Version) bool {
+	current, err := version.NewVersion(providerVersion)
+	if err != nil {
+		return true
+	}
+	return !current.LessThan(required)
+}
+
+// idsecSDKVersion returns the version of the Idsec SDK module the provider was built with, falling back
+// to the version the SDK reports when the build information is not available.
+func idsecSDKVersion() string {
+	if info, ok := debug.ReadBuildInfo(); ok {
+		for _, dep := range info.Deps {
+			if dep.Path != idsecSDKModulePath {
+				continue
+			}
+			if dep.Replace != nil && dep.Replace.Version != "" {
+				return strings.TrimPrefix(dep.Replace.Version, "v")
+			}
+			return strings.TrimPrefix(dep.Version, "v")
+		}
+	}
+	return sdkconfig.IdsecVersion()
+}
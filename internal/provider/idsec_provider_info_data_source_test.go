@@ -0,0 +1,86 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestProviderVersionAtLeast(t *testing.T) {
+	required := version.Must(version.NewVersion("1.4.0"))
+	tests := []struct {
+		name            string
+		providerVersion string
+		expected        bool
+	}{
+		{name: "newer", providerVersion: "1.5.2", expected: true},
+		{name: "equal", providerVersion: "1.4.0", expected: true},
+		{name: "older", providerVersion: "1.3.9", expected: false},
+		{name: "prerelease_of_required", providerVersion: "1.4.0-rc1", expected: false},
+		{name: "dev_build", providerVersion: "dev", expected: true},
+		{name: "no_version", providerVersion: "N/A", expected: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := providerVersionAtLeast(tt.providerVersion, required); got != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestIdsecProviderInfoDataSource_Read(t *testing.T) {
+	tests := []struct {
+		name           string
+		minimumVersion interface{}
+		wantError      string
+	}{
+		{name: "no_minimum"},
+		{name: "minimum_met", minimumVersion: "1.2.0"},
+		{name: "minimum_not_met", minimumVersion: "2.0.0", wantError: "Provider Version Too Old"},
+		{name: "invalid_minimum", minimumVersion: "latest", wantError: "Invalid Minimum Version"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataSource := NewIdsecProviderInfoDataSource(IdsecProviderConfig{Version: "1.2.3", GitCommit: "abc1234", BuildDate: "2026-01-01"})
+			schemaResp := &datasource.SchemaResponse{}
+			dataSource.Schema(context.Background(), datasource.SchemaRequest{}, schemaResp)
+			objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+			values := map[string]tftypes.Value{}
+			for name, attrType := range objectType.AttributeTypes {
+				values[name] = tftypes.NewValue(attrType, nil)
+			}
+			values["minimum_version"] = tftypes.NewValue(tftypes.String, tt.minimumVersion)
+			req := datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}}
+			resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, nil)}}
+			dataSource.Read(context.Background(), req, resp)
+			if tt.wantError != "" {
+				if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != tt.wantError {
+					t.Fatalf("expected error %q, got %v", tt.wantError, resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+			}
+			var state IdsecProviderInfoDataSourceModel
+			resp.Diagnostics.Append(resp.State.Get(context.Background(), &state)...)
+			if state.Version.ValueString() != "1.2.3" || state.GitCommit.ValueString() != "abc1234" || state.BuildDate.ValueString() != "2026-01-01" {
+				t.Errorf("unexpected build information: %+v", state)
+			}
+			if state.SDKVersion.ValueString() == "" {
+				t.Error("expected an SDK version")
+			}
+			if state.FIPSEnabled.IsNull() || state.FIPSEnabled.ValueBool() != fipsEnabled() {
+				t.Errorf("expected fips_enabled to report the FIPS mode, got %v", state.FIPSEnabled)
+			}
+		})
+	}
+}
@@ -11,22 +11,21 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/mitchellh/mapstructure"
 	api "github.com/cyberark/idsec-sdk-golang/pkg"
-	"github.com/cyberark/idsec-sdk-golang/pkg/auth"
 	modelsactions "github.com/cyberark/idsec-sdk-golang/pkg/models/actions"
 	"github.com/cyberark/idsec-sdk-golang/pkg/services"
 	"github.com/cyberark/idsec-sdk-golang/pkg/validation"
 	"github.com/cyberark/terraform-provider-idsec/internal/actions"
 	"github.com/cyberark/terraform-provider-idsec/internal/featureadoption"
 	"github.com/cyberark/terraform-provider-idsec/internal/schemas"
-	"golang.org/x/text/cases"
-	"golang.org/x/text/language"
 )
 
 // IdsecResource is a struct that implements the resource.Resource interface.
@@ -41,13 +40,17 @@ type IdsecResource struct {
 // NewIdsecResource creates a new instance of IdsecResource.
 func NewIdsecResource(serviceConfig *services.IdsecServiceConfig,
 	actionDefinition *actions.IdsecServiceTerraformResourceActionDefinition) resource.Resource {
-	return &IdsecResource{
+	idsecResource := &IdsecResource{
 		IdsecServiceHelper: IdsecServiceHelper{
 			serviceConfig: serviceConfig,
 		},
 		serviceConfig:    serviceConfig,
 		actionDefinition: actionDefinition,
 	}
+	if actionDefinition.ListAction != "" && actionDefinition.ImportID != "" {
+		return &IdsecResourceWithIdentity{IdsecResource: idsecResource}
+	}
+	return idsecResource
 }
 
 // setTerraformContext sets terraform context on the service for telemetry.
@@ -112,8 +115,23 @@ func (s *IdsecResource) getForceNewAttributes() []string {
 	return s.getStringSliceFromActionDefinition("ForceNewAttributes")
 }
 
+// getComputedAttributes returns the computed-only attribute paths of the resource. When the
+// action definition enables ComputedOnlyOutputs, attributes that only exist on the state schema
+// are included so they are generated as read-only.
 func (s *IdsecResource) getComputedAttributes() []string {
-	return s.getStringSliceFromActionDefinition("ComputedAttributes")
+	computedAttrs := s.getStringSliceFromActionDefinition("ComputedAttributes")
+	if !s.actionDefinition.ComputedOnlyOutputs {
+		return computedAttrs
+	}
+	createSchema, _ := s.schemaForOperation(actions.CreateOperation)
+	updateSchema, _ := s.schemaForOperation(actions.UpdateOperation)
+	computedAttrs = append([]string{}, computedAttrs...)
+	for _, name := range schemas.StateOnlyAttributeNames(createSchema, updateSchema, s.actionDefinition.StateSchema) {
+		if !slices.Contains(computedAttrs, name) {
+			computedAttrs = append(computedAttrs, name)
+		}
+	}
+	return computedAttrs
 }
 
 func (s *IdsecResource) getHistoryComputedAttributes() []string {
@@ -124,6 +142,76 @@ func (s *IdsecResource) getCaseInsensitiveAttributes() []string {
 	return s.getStringSliceFromActionDefinition("CaseInsensitiveAttributes")
 }
 
+// generateSchema generates the resource schema from the create, update and state models. When the action
+// definition has an IDTemplate and the models have no top-level id, a computed id built from the template
+// is injected; the second return value reports whether it was.
+func (s *IdsecResource) generateSchema(createSchema interface{}, updateSchema interface{}) (schema.Schema, bool) {
+	generated := schemas.GenerateResourceSchemaFromStruct(
+		createSchema,
+		updateSchema,
+		s.actionDefinition.StateSchema,
+		schemas.ResourceSchemaOptions{
+			SensitiveAttrs:       s.actionDefinition.SensitiveAttributes,
+			ExtraRequiredAttrs:   s.actionDefinition.ExtraRequiredAttributes,
+			ComputedAsSetAttrs:   s.actionDefinition.ComputedAsSetAttributes,
+			ImmutableAttrs:       s.getImmutableAttributes(),
+			ForceNewAttrs:        s.getForceNewAttributes(),
+			ComputedAttrs:        s.getComputedAttributes(),
+			CaseInsensitiveAttrs: s.getCaseInsensitiveAttributes(),
+			BlockAttrs:           s.actionDefinition.BlockAttributes,
+			PlanModifiers:        s.actionDefinition.PlanModifiers,
+		},
+	)
+	if s.actionDefinition.KnownAfterApplyAllowlist != nil {
+		schemas.RestrictKnownAfterApply(&generated, s.actionDefinition.KnownAfterApplyAllowlist)
+	}
+	s.addLifecycleMetaAttributes(&generated)
+	if s.actionDefinition.NamePrefixAttribute != "" {
+		schemas.AddNamePrefixAttribute(&generated, s.actionDefinition.NamePrefixAttribute)
+	}
+	schemas.AddContentDigestAttributes(&generated, s.actionDefinition.ContentDigestAttributes)
+	s.addProviderDefaults(&generated)
+	s.addDeletionProtectionAttribute(&generated)
+	s.addAdoptExistingAttribute(&generated)
+	addSDKResponseAttributes(&generated)
+	s.addTimeoutsBlock(&generated)
+	if s.actionDefinition.IDTemplate == "" {
+		return generated, false
+	}
+	if _, exists := generated.Attributes[schemas.SyntheticIDAttributeName]; exists {
+		return generated, false
+	}
+	if _, exists := generated.Blocks[schemas.SyntheticIDAttributeName]; exists {
+		return generated, false
+	}
+	generated.Attributes[schemas.SyntheticIDAttributeName] = schemas.SyntheticIDAttribute(s.actionDefinition.IDTemplate)
+	return generated, true
+}
+
+// addLifecycleMetaAttributes adds the computed created_at and last_modified_at attributes for the
+// timestamps the action definition maps, unless the models already declare attributes of these names.
+func (s *IdsecResource) addLifecycleMetaAttributes(resourceSchema *schema.Schema) {
+	for name, sourcePath := range s.lifecycleMetaSources() {
+		if sourcePath == "" {
+			continue
+		}
+		if name == schemas.CreatedAtAttributeName {
+			resourceSchema.Attributes[name] = schemas.CreatedAtAttribute()
+		} else {
+			resourceSchema.Attributes[name] = schemas.LastModifiedAtAttribute()
+		}
+	}
+}
+
+// lifecycleMetaSources maps the created_at and last_modified_at attributes to the state attributes they
+// are populated from, with an empty source for attributes the models already declare.
+func (s *IdsecResource) lifecycleMetaSources() map[string]string {
+	createSchema, _ := s.schemaForOperation(actions.CreateOperation)
+	updateSchema, _ := s.schemaForOperation(actions.UpdateOperation)
+	return schemas.LifecycleMetaSources(s.actionDefinition.CreatedAtAttribute, s.actionDefinition.LastModifiedAtAttribute,
+		createSchema, updateSchema, s.actionDefinition.StateSchema)
+}
+
 func (s *IdsecResource) getImportID() string {
 	// Use reflection to safely check if ImportID field exists
 	// This provides backward compatibility with SDK versions that don't have this field yet
@@ -137,6 +225,14 @@ func (s *IdsecResource) getImportID() string {
 	return "" // Return empty string if not configured (import not supported)
 }
 
+// getImportIDDelimiter returns the separator of the values of a composite import ID.
+func (s *IdsecResource) getImportIDDelimiter() string {
+	if s.actionDefinition.ImportIDDelimiter != "" {
+		return s.actionDefinition.ImportIDDelimiter
+	}
+	return schemas.DefaultImportIDDelimiter
+}
+
 // readKeyTopLevelAttributes returns the top-level attribute names that make up the resource's read
 // key (its ImportID). Only un-nested names are returned: a dotted key such as "metadata.policy_id"
 // addresses a nested attribute (a stable, server-owned id) that is handled elsewhere and should keep
@@ -264,135 +360,65 @@ func (s *IdsecResource) finalizeState(ctx context.Context, operation actions.Ids
 
 func (s *IdsecResource) finalizeFailure(ctx context.Context, summary string, detail string, operation actions.IdsecServiceActionOperation, originalState basetypes.ObjectValue, respState *tfsdk.State, diagnostics *diag.Diagnostics) {
 	tflog.Error(ctx, fmt.Sprintf("%s - %s", summary, detail))
-	diagnostics.AddError(summary, detail)
+	addErrorWithCorrelation(ctx, diagnostics, summary, detail)
 	s.finalizeState(ctx, operation, originalState, respState, diagnostics)
 }
 
-func (s *IdsecResource) triggerOperation(ctx context.Context, operation actions.IdsecServiceActionOperation, diagnostics *diag.Diagnostics, plan *tfsdk.Plan, state *tfsdk.State, config *tfsdk.Config, respState *tfsdk.State, userSetPaths map[string]bool) {
-	tflog.Info(ctx, fmt.Sprintf("Triggering operation: %s", operation))
-	var originalState basetypes.ObjectValue
-	if state != nil {
-		diags := state.Get(ctx, &originalState)
-		if diags.HasError() {
-			s.finalizeFailure(ctx, "State Retrieval Error", fmt.Sprintf("Failed to get original state: %v", diags), operation, originalState, respState, diagnostics)
-			return
-		}
+// applyChangeReason sends the provider level change reason through the operation's reason field
+// when the action definition declares one and the user left it unset. Returns whether it was applied.
+func (s *IdsecResource) applyChangeReason(ctx context.Context, operation actions.IdsecServiceActionOperation, operationSchemaInput interface{}) bool {
+	changeReason := s.getSettings().changeReason
+	if changeReason == "" || s.actionDefinition.ChangeReasonAttribute == "" || operation == actions.ReadOperation {
+		return false
 	}
-	if !slices.Contains(s.actionDefinition.SupportedOperations, operation) {
-		tflog.Info(ctx, fmt.Sprintf("Operation %s is not supported, no action will be made", operation))
-		s.finalizeState(ctx, operation, originalState, respState, diagnostics)
-		return
+	if !schemas.SetEmptyStringAttribute(operationSchemaInput, s.actionDefinition.ChangeReasonAttribute, changeReason) {
+		return false
 	}
-	operationSchemaInput, err := s.parsePlanAndState(ctx, operation, diagnostics, plan, state, config, userSetPaths)
-	if diagnostics.HasError() || err != nil {
-		if err != nil {
-			s.finalizeFailure(ctx, "Parsing Error", fmt.Sprintf("Failed to parse plan and state: %s", err.Error()), operation, originalState, respState, diagnostics)
-		} else {
-			tflog.Error(ctx, "Error parsing plan and state, diagnostics already have errors")
-			s.finalizeState(ctx, operation, originalState, respState, diagnostics)
-		}
-		return
-	}
-	actionName, ok := s.actionDefinition.ActionsMappings[operation]
-	if !ok {
-		s.finalizeFailure(ctx, "Action Mapping Error", fmt.Sprintf("No action mapping found for operation: %s", operation), operation, originalState, respState, diagnostics)
-		return
-	}
-
-	titleCase := cases.Title(language.English)
-	actionNameTitled := strings.ReplaceAll(titleCase.String(actionName), "-", "")
-	serviceNameTitled := s.getServiceNameTitled()
-	tflog.Info(ctx, fmt.Sprintf("Searching for Service Name: %s, Action Name: %s", serviceNameTitled, actionNameTitled))
+	tflog.Debug(ctx, fmt.Sprintf("Applied provider change reason to attribute %s", s.actionDefinition.ChangeReasonAttribute))
+	return true
+}
 
-	// Get the service from the helper
-	service := s.getServiceInstance()
-	if service == nil {
-		s.finalizeFailure(ctx, "Service Error", "Service instance not configured", operation, originalState, respState, diagnostics)
+// restoreChangeReason keeps the reason attribute in state as planned, so a reason injected from the
+// provider configuration does not surface as a difference against the resource configuration.
+func (s *IdsecResource) restoreChangeReason(ctx context.Context, plan *tfsdk.Plan, respState *tfsdk.State, diagnostics *diag.Diagnostics) {
+	if plan == nil {
 		return
 	}
-
-	// Get the method from the service
-	actionMethod, err := schemas.FindMethodByName(reflect.ValueOf(service), actionNameTitled)
-	if err != nil {
-		s.finalizeFailure(ctx, "Action Method Error", fmt.Sprintf("Unable to find action method: %s", err.Error()), operation, originalState, respState, diagnostics)
+	reasonPath := path.Root(s.actionDefinition.ChangeReasonAttribute)
+	var planned types.String
+	if diags := plan.GetAttribute(ctx, reasonPath, &planned); diags.HasError() {
 		return
 	}
+	diagnostics.Append(respState.SetAttribute(ctx, reasonPath, planned)...)
+}
 
-	var actionArgs []reflect.Value
-	if operationSchemaInput != nil {
-		actionArgs = append(actionArgs, reflect.ValueOf(operationSchemaInput))
-		if err := validation.ValidateStruct(operationSchemaInput); err != nil {
-			tflog.Error(ctx, fmt.Sprintf("Invalid Configuration - %s", err.Error()))
-			appendValidationDiagnostics(diagnostics, err)
-			s.finalizeState(ctx, operation, originalState, respState, diagnostics)
-			return
-		}
+// triggerOperation runs an operation of the resource through the operation pipeline: the action mapped to
+// the operation is called with the input decoded from plan and state, and its response written to
+// respState. Failures are reported in diagnostics, and restore the prior state of updates.
+func (s *IdsecResource) triggerOperation(ctx context.Context, operation actions.IdsecServiceActionOperation, diagnostics *diag.Diagnostics, plan *tfsdk.Plan, state *tfsdk.State, config *tfsdk.Config, respState *tfsdk.State, userSetPaths map[string]bool) {
+	tflog.Info(ctx, fmt.Sprintf("Triggering operation: %s", operation))
+	run := &operationRun{
+		operation:    operation,
+		diagnostics:  diagnostics,
+		plan:         plan,
+		state:        state,
+		config:       config,
+		respState:    respState,
+		userSetPaths: userSetPaths,
 	}
-	tflog.Info(ctx, "Calling action method")
-	result := actionMethod.Call(actionArgs)
-	for _, res := range result {
-		if err, ok := res.Interface().(error); ok && err != nil {
-			s.finalizeFailure(ctx, "Action Error", fmt.Sprintf("Unable to call action method: %s", err.Error()), operation, originalState, respState, diagnostics)
+	if state != nil {
+		diags := state.Get(ctx, &run.originalState)
+		if diags.HasError() {
+			s.finalizeFailure(ctx, "State Retrieval Error", fmt.Sprintf("Failed to get original state: %v", diags), operation, run.originalState, respState, diagnostics)
 			return
 		}
 	}
-	if len(result) < 1 {
-		tflog.Info(ctx, "No result returned from action method")
-		return
-	}
-	resultElem := result[0]
-	if _, ok := resultElem.Interface().(error); ok {
+	if !slices.Contains(s.actionDefinition.SupportedOperations, operation) {
+		tflog.Info(ctx, fmt.Sprintf("Operation %s is not supported, no action will be made", operation))
+		s.finalizeState(ctx, operation, run.originalState, respState, diagnostics)
 		return
 	}
-	tflog.Info(ctx, "Managed to call action successfully with result")
-	if resultElem.Kind() == reflect.Pointer {
-		resultElem = resultElem.Elem()
-	}
-	if respState != nil {
-		tflog.Info(ctx, "Converting result to state object")
-		createSchema, err := s.schemaForOperation(actions.CreateOperation)
-		if err != nil {
-			s.finalizeFailure(ctx, "Schema Error", fmt.Sprintf("No schema mapping found for operation: %s", actions.CreateOperation), operation, originalState, respState, diagnostics)
-			return
-		}
-		updateSchema, err := s.schemaForOperation(actions.UpdateOperation)
-		if err != nil {
-			s.finalizeFailure(ctx, "Schema Error", fmt.Sprintf("No schema mapping found for operation: %s", actions.UpdateOperation), operation, originalState, respState, diagnostics)
-			return
-		}
-		outputSchemaDef := schemas.GenerateResourceSchemaFromStruct(
-			createSchema,
-			updateSchema,
-			s.actionDefinition.StateSchema,
-			s.actionDefinition.SensitiveAttributes,
-			s.actionDefinition.ExtraRequiredAttributes,
-			s.actionDefinition.ComputedAsSetAttributes,
-			s.getImmutableAttributes(),
-			s.getForceNewAttributes(),
-			s.getComputedAttributes(),
-			s.getCaseInsensitiveAttributes(),
-		)
-
-		schemaAttrs := schemas.ResourceSchemaToSchemaAttrTypes(outputSchemaDef)
-		stateResult, err := schemas.StructToStateObject(ctx, resultElem.Interface(), state, plan, schemaAttrs)
-		if err != nil {
-			s.finalizeFailure(ctx, "State Conversion Error", fmt.Sprintf("Failed to convert struct to state object: %s", err.Error()), operation, originalState, respState, diagnostics)
-			return
-		}
-		if plan != nil {
-			stateResult, err = schemas.MergePlanToStateObject(ctx, plan, stateResult, schemaAttrs)
-			if err != nil {
-				s.finalizeFailure(ctx, "State Merge Error", fmt.Sprintf("Failed to merge plan to state object: %s", err.Error()), operation, originalState, respState, diagnostics)
-				return
-			}
-		}
-		tflog.Info(ctx, "Setting state result")
-		diags := respState.Set(ctx, stateResult)
-		if diags.HasError() {
-			tflog.Error(ctx, fmt.Sprintf("Failed to set state: %s", diags))
-		}
-		diagnostics.Append(diags...)
-	}
+	s.runOperationPipeline(ctx, run)
 }
 
 // Metadata defines the resource type name.
@@ -400,8 +426,12 @@ func (s *IdsecResource) Metadata(ctx context.Context, req resource.MetadataReque
 	resp.TypeName = fmt.Sprintf("%s_%s", req.ProviderTypeName, strings.ReplaceAll(s.actionDefinition.ActionName, "-", "_"))
 }
 
-// ValidateConfig runs SDK struct-tag validation rules against the user's HCL config.
+// ValidateConfig checks the actions of the resource exist in the SDK and runs SDK struct-tag validation rules against the user's HCL config.
 func (s *IdsecResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	addActionCompatibilityError(s.getTerraformTypeName(s.actionDefinition.ActionName), s.serviceConfig.ServiceName, resourceActionNames(s.actionDefinition), &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	if req.Config.Raw.IsNull() || !req.Config.Raw.IsFullyKnown() {
 		return
 	}
@@ -414,6 +444,11 @@ func (s *IdsecResource) ValidateConfig(ctx context.Context, req resource.Validat
 		tflog.Debug(ctx, fmt.Sprintf("ValidateConfig: skipping (config decode failed): %s", err.Error()))
 		return
 	}
+	s.applyNamePrefixPlaceholder(ctx, &req.Config, input, &resp.Diagnostics)
+	s.applyProviderDefaultPlaceholders(ctx, &req.Config, input)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	if err := validation.ValidateStruct(input); err != nil {
 		appendValidationDiagnostics(&resp.Diagnostics, err)
 	}
@@ -435,58 +470,32 @@ func (s *IdsecResource) Schema(ctx context.Context, req resource.SchemaRequest,
 		resp.Diagnostics.AddError("Schema Error", fmt.Sprintf("No schema mapping found for operation: %s - %v", actions.UpdateOperation, err))
 		return
 	}
-	resp.Schema = schemas.GenerateResourceSchemaFromStruct(
-		createSchema,
-		updateSchema,
-		s.actionDefinition.StateSchema,
-		s.actionDefinition.SensitiveAttributes,
-		s.actionDefinition.ExtraRequiredAttributes,
-		s.actionDefinition.ComputedAsSetAttributes,
-		s.getImmutableAttributes(),
-		s.getForceNewAttributes(),
-		s.getComputedAttributes(),
-		s.getCaseInsensitiveAttributes(),
-	)
+	s.reportAttributeNameCollisions(s.actionDefinition.ActionName, &resp.Diagnostics, createSchema, updateSchema, s.actionDefinition.StateSchema)
+	if unknown := schemas.UnknownPlanModifiers(s.actionDefinition.PlanModifiers); len(unknown) > 0 {
+		resp.Diagnostics.AddError("Schema Error", fmt.Sprintf("Unknown plan modifiers for %s: %s", s.actionDefinition.ActionName, strings.Join(unknown, ", ")))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Schema, _ = s.generateSchema(createSchema, updateSchema)
+	for _, err := range s.invalidConfigConstraints(resp.Schema) {
+		resp.Diagnostics.AddError("Schema Error", fmt.Sprintf("Invalid config constraint for %s: %s", s.actionDefinition.ActionName, err.Error()))
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	schemas.ApplyRemovedToNullModifiers(resp.Schema.Attributes, s.readKeyTopLevelAttributes()...)
 	resp.Schema.Description = s.actionDefinition.ActionDescription
 	if s.actionDefinition.ActionVersion != 0 {
 		resp.Schema.Version = s.actionDefinition.ActionVersion
 	}
+	localizeSchema(&resp.Schema, &resp.Diagnostics)
 }
 
 // Configure initializes the resource with the necessary dependencies.
 func (s *IdsecResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if req.ProviderData == nil {
-		return
-	}
-	ispAuth, ok := req.ProviderData.(*auth.IdsecISPAuth)
-	if !ok {
-		// Try PVWA auth
-		pvwaAuth, ok := req.ProviderData.(*auth.IdsecPVWAAuth)
-		if !ok {
-			resp.Diagnostics.AddError("Authentication Error", "Unable to authenticate with the provided credentials.")
-			return
-		}
-		var err error
-		s.idsecAPI, err = api.NewIdsecAPI([]auth.IdsecAuth{pvwaAuth}, nil)
-		if err != nil {
-			resp.Diagnostics.AddError("Service Initialization Error", fmt.Sprintf("Unable to create API: %s", err.Error()))
-			return
-		}
-	} else {
-		var err error
-		s.idsecAPI, err = api.NewIdsecAPI([]auth.IdsecAuth{ispAuth}, nil)
-		if err != nil {
-			resp.Diagnostics.AddError("Service Initialization Error", fmt.Sprintf("Unable to create API: %s", err.Error()))
-			return
-		}
-	}
-
-	// Configure the service instance using the helper
-	err := s.configureService(s.idsecAPI)
-	if err != nil {
-		resp.Diagnostics.AddError("Service Configuration Error", fmt.Sprintf("Unable to configure service: %s", err.Error()))
-		return
+	if idsecAPI := s.configureFromProviderData(ctx, req.ProviderData, &resp.Diagnostics); idsecAPI != nil {
+		s.idsecAPI = idsecAPI
 	}
 }
 
@@ -548,18 +557,7 @@ func (s *IdsecResource) seedUserSetHistoryFromState(ctx context.Context, state *
 		tflog.Warn(ctx, "Skipping synthetic user-set history seed: failed to resolve update schema")
 		return
 	}
-	outputSchemaDef := schemas.GenerateResourceSchemaFromStruct(
-		createSchema,
-		updateSchema,
-		s.actionDefinition.StateSchema,
-		s.actionDefinition.SensitiveAttributes,
-		s.actionDefinition.ExtraRequiredAttributes,
-		s.actionDefinition.ComputedAsSetAttributes,
-		s.getImmutableAttributes(),
-		s.getForceNewAttributes(),
-		s.getComputedAttributes(),
-		s.getCaseInsensitiveAttributes(),
-	)
+	outputSchemaDef, _ := s.generateSchema(createSchema, updateSchema)
 	computedPaths := append([]string{}, s.getComputedAttributes()...)
 	computedPaths = append(computedPaths, s.getHistoryComputedAttributes()...)
 	computedPaths = append(computedPaths, schemas.ComputedOnlyAttributePaths(outputSchemaDef.Attributes)...)
@@ -576,8 +574,18 @@ func (s *IdsecResource) seedUserSetHistoryFromState(ctx context.Context, state *
 func (s *IdsecResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	s.setTerraformContext("Create")
 	defer s.clearTerraformContext()
+	ctx = s.startOperation(ctx, s.getService())
+	ctx, moduleHeaders := s.moduleAttribution(ctx, s.getService(), req.ProviderMeta)
+	defer s.scopeRequestHeaders(s.getService(), moduleHeaders)()
 	defer featureadoption.ReportOperationDefer(ctx, s.idsecAPI, &resp.Diagnostics, s.buildFASTags(s.actionDefinition.ActionName, "Create"))()
-	s.triggerOperation(ctx, actions.CreateOperation, &resp.Diagnostics, &req.Plan, nil, nil, &resp.State, nil)
+	defer s.recoverOperationPanic(ctx, s.getTerraformTypeName(s.actionDefinition.ActionName), "create", &resp.Diagnostics)
+	if s.generatePrefixedName(ctx, &req.Plan, &resp.Diagnostics); resp.Diagnostics.HasError() {
+		return
+	}
+	run := &operationRun{operation: actions.CreateOperation, diagnostics: &resp.Diagnostics, plan: &req.Plan, respState: &resp.State}
+	s.handleOperation(ctx, run, func(ctx context.Context, _ *operationRun) {
+		s.createOrAdopt(ctx, req, resp)
+	})
 	if !resp.Diagnostics.HasError() {
 		s.recordUserSetHistory(ctx, &req.Config, resp.Private, &resp.Diagnostics)
 	}
@@ -587,9 +595,15 @@ func (s *IdsecResource) Create(ctx context.Context, req resource.CreateRequest,
 func (s *IdsecResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	s.setTerraformContext("Read")
 	defer s.clearTerraformContext()
+	ctx = s.startOperation(ctx, s.getService())
+	ctx, moduleHeaders := s.moduleAttribution(ctx, s.getService(), req.ProviderMeta)
+	defer s.scopeRequestHeaders(s.getService(), moduleHeaders)()
 	defer featureadoption.ReportOperationDefer(ctx, s.idsecAPI, &resp.Diagnostics, s.buildFASTags(s.actionDefinition.ActionName, "Read"))()
-	s.triggerOperation(ctx, actions.ReadOperation, &resp.Diagnostics, nil, &req.State, nil, &resp.State, nil)
+	defer s.recoverOperationPanic(ctx, s.getTerraformTypeName(s.actionDefinition.ActionName), "read", &resp.Diagnostics)
+	run := &operationRun{operation: actions.ReadOperation, diagnostics: &resp.Diagnostics, state: &req.State, respState: &resp.State}
+	s.handleOperation(ctx, run, s.triggerRun)
 	if !resp.Diagnostics.HasError() {
+		s.warnImmutableDrift(ctx, req.State.Raw, resp.State.Raw, &resp.Diagnostics)
 		s.seedUserSetHistoryFromState(ctx, &resp.State, req.Private, resp.Private, &resp.Diagnostics)
 	}
 }
@@ -598,12 +612,18 @@ func (s *IdsecResource) Read(ctx context.Context, req resource.ReadRequest, resp
 func (s *IdsecResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	s.setTerraformContext("Update")
 	defer s.clearTerraformContext()
+	ctx = s.startOperation(ctx, s.getService())
+	ctx, moduleHeaders := s.moduleAttribution(ctx, s.getService(), req.ProviderMeta)
+	defer s.scopeRequestHeaders(s.getService(), moduleHeaders)()
 	defer featureadoption.ReportOperationDefer(ctx, s.idsecAPI, &resp.Diagnostics, s.buildFASTags(s.actionDefinition.ActionName, "Update"))()
+	defer s.recoverOperationPanic(ctx, s.getTerraformTypeName(s.actionDefinition.ActionName), "update", &resp.Diagnostics)
 	// Prior user-set history gates which removed attributes are actually cleared on apply: only
 	// attributes the user had previously set are removed, leaving server-defaulted values intact.
 	priorUserSetPaths := schemas.ReadUserSetPaths(ctx, req.Private)
-	s.triggerOperation(ctx, actions.UpdateOperation, &resp.Diagnostics, &req.Plan, &req.State, &req.Config, &resp.State, priorUserSetPaths)
+	run := &operationRun{operation: actions.UpdateOperation, diagnostics: &resp.Diagnostics, plan: &req.Plan, state: &req.State, config: &req.Config, respState: &resp.State, userSetPaths: priorUserSetPaths}
+	s.handleOperation(ctx, run, s.triggerRun)
 	if !resp.Diagnostics.HasError() {
+		s.warnUnmanagedChanges(ctx, req.State.Raw, resp.State.Raw, req.Config.Raw, &resp.Diagnostics)
 		s.recordUserSetHistory(ctx, &req.Config, resp.Private, &resp.Diagnostics)
 	}
 }
@@ -612,8 +632,27 @@ func (s *IdsecResource) Update(ctx context.Context, req resource.UpdateRequest,
 func (s *IdsecResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	s.setTerraformContext("Delete")
 	defer s.clearTerraformContext()
+	ctx = s.startOperation(ctx, s.getService())
+	ctx, moduleHeaders := s.moduleAttribution(ctx, s.getService(), req.ProviderMeta)
+	defer s.scopeRequestHeaders(s.getService(), moduleHeaders)()
 	defer featureadoption.ReportOperationDefer(ctx, s.idsecAPI, &resp.Diagnostics, s.buildFASTags(s.actionDefinition.ActionName, "Delete"))()
-	s.triggerOperation(ctx, actions.DeleteOperation, &resp.Diagnostics, nil, &req.State, nil, nil, nil)
+	defer s.recoverOperationPanic(ctx, s.getTerraformTypeName(s.actionDefinition.ActionName), "delete", &resp.Diagnostics)
+	if s.refuseProtectedDelete(ctx, req.State, &resp.Diagnostics) {
+		return
+	}
+	run := &operationRun{operation: actions.DeleteOperation, diagnostics: &resp.Diagnostics, state: &req.State}
+	s.handleOperation(ctx, run, s.triggerRun)
+}
+
+// ModifyPlan adjusts the plan of the resource before it is shown to the user.
+func (s *IdsecResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	s.requireReplaceWithoutUpdate(ctx, req, resp)
+	s.warnReplaceImpact(ctx, req, resp)
+	s.validatePlannedReferences(ctx, req, resp)
+	s.validatePlannedUniqueNames(ctx, req, resp)
+	s.planDeletionProtection(ctx, req, resp)
+	s.validateConfigConstraints(ctx, req, resp)
+	s.writePlanSummary(ctx, req, resp)
 }
 
 // ImportState handles importing existing resources into Terraform state.
@@ -646,8 +685,9 @@ func (s *IdsecResource) ImportState(ctx context.Context, req resource.ImportStat
 	// Get the import ID attribute from action definition
 	// Import is only supported if ImportID is explicitly configured
 	// If ImportID contains ":", it defines multiple attributes (e.g. "safe_id:member_name" or
-	// "metadata.policy_id:other_id"). In that case req.ID must contain ":"-separated values in
-	// the same order (e.g. "safe-123:member-456"). Dot notation addresses nested state attributes.
+	// "metadata.policy_id:other_id"). In that case req.ID must contain values separated by the
+	// ImportIDDelimiter of the definition in the same order (e.g. "safe-123:member-456").
+	// Dot notation addresses nested state attributes.
 	importIDAttr := s.getImportID()
 	if importIDAttr == "" {
 		resp.Diagnostics.AddError(
@@ -663,40 +703,20 @@ func (s *IdsecResource) ImportState(ctx context.Context, req resource.ImportStat
 		return
 	}
 
-	if len(attributes) > 1 {
-		if !strings.Contains(req.ID, ":") {
-			resp.Diagnostics.AddError(
-				"Invalid Import ID",
-				fmt.Sprintf("This resource requires multiple attributes to import. Use colon-separated values in the same order as the import attributes (%s). Example: value1:value2", importIDAttr),
-			)
+	values, err := schemas.ParseCompositeImportID(req.ID, attributes, s.getImportIDDelimiter())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+	for i, attr := range attributes {
+		attrPath, err := schemas.ParseImportAttributePath(attr)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Import ID Attribute", err.Error())
 			return
 		}
-		values := strings.Split(req.ID, ":")
-		if len(attributes) != len(values) {
-			resp.Diagnostics.AddError(
-				"Invalid Import ID",
-				fmt.Sprintf("Import ID has %d part(s) but %d attribute(s) are required (%s). Provide colon-separated values for each attribute.", len(values), len(attributes), importIDAttr),
-			)
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, types.StringValue(values[i]))...)
+		if resp.Diagnostics.HasError() {
 			return
 		}
-		for i, attr := range attributes {
-			attrPath, err := schemas.ParseImportAttributePath(attr)
-			if err != nil {
-				resp.Diagnostics.AddError("Invalid Import ID Attribute", err.Error())
-				return
-			}
-			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, types.StringValue(values[i]))...)
-			if resp.Diagnostics.HasError() {
-				return
-			}
-		}
-		return
-	}
-
-	attrPath, err := schemas.ParseImportAttributePath(attributes[0])
-	if err != nil {
-		resp.Diagnostics.AddError("Invalid Import ID Attribute", err.Error())
-		return
 	}
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, types.StringValue(req.ID))...)
 }
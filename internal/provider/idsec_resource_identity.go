@@ -0,0 +1,110 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/cyberark/terraform-provider-idsec/internal/schemas"
+)
+
+// IdsecResourceWithIdentity is an IdsecResource that also exposes a resource identity, built from its ImportID
+// attributes. Resource identity is required for resources to be listed by a list resource.
+type IdsecResourceWithIdentity struct {
+	*IdsecResource
+}
+
+var _ resource.ResourceWithIdentity = &IdsecResourceWithIdentity{}
+
+// identityAttributeName returns the identity attribute name of an ImportID attribute path,
+// e.g. "metadata.policy_id" becomes "metadata_policy_id".
+func identityAttributeName(importAttr string) string {
+	return strings.ReplaceAll(importAttr, ".", "_")
+}
+
+// setIdentityFromState sets the identity attributes from the ImportID attributes of the state, and
+// returns the identity values in ImportID order.
+func setIdentityFromState(ctx context.Context, importID string, state *tfsdk.State, identity *tfsdk.ResourceIdentity, diagnostics *diag.Diagnostics) []string {
+	if identity == nil {
+		return nil
+	}
+	var values []string
+	for _, attr := range schemas.SplitImportIDAttributes(importID) {
+		attrPath, err := schemas.ParseImportAttributePath(attr)
+		if err != nil {
+			diagnostics.AddError("Invalid Import ID Attribute", err.Error())
+			return nil
+		}
+		var value types.String
+		diagnostics.Append(state.GetAttribute(ctx, attrPath, &value)...)
+		if diagnostics.HasError() {
+			return nil
+		}
+		diagnostics.Append(identity.SetAttribute(ctx, path.Root(identityAttributeName(attr)), value)...)
+		values = append(values, value.ValueString())
+	}
+	return values
+}
+
+// IdentitySchema defines the identity schema of the resource, one string attribute per ImportID attribute.
+func (s *IdsecResourceWithIdentity) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	attributes := map[string]identityschema.Attribute{}
+	for _, attr := range schemas.SplitImportIDAttributes(s.getImportID()) {
+		attributes[identityAttributeName(attr)] = identityschema.StringAttribute{
+			RequiredForImport: true,
+			Description:       fmt.Sprintf("The %s of the resource.", attr),
+		}
+	}
+	resp.IdentitySchema = identityschema.Schema{Attributes: attributes}
+}
+
+// Create handles the creation of the resource and sets its identity.
+func (s *IdsecResourceWithIdentity) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	s.IdsecResource.Create(ctx, req, resp)
+	if !resp.Diagnostics.HasError() {
+		setIdentityFromState(ctx, s.getImportID(), &resp.State, resp.Identity, &resp.Diagnostics)
+	}
+}
+
+// Read handles reading the resource state and sets its identity.
+func (s *IdsecResourceWithIdentity) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	s.IdsecResource.Read(ctx, req, resp)
+	if !resp.Diagnostics.HasError() && !resp.State.Raw.IsNull() {
+		setIdentityFromState(ctx, s.getImportID(), &resp.State, resp.Identity, &resp.Diagnostics)
+	}
+}
+
+// Update handles updating the resource and sets its identity.
+func (s *IdsecResourceWithIdentity) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	s.IdsecResource.Update(ctx, req, resp)
+	if !resp.Diagnostics.HasError() {
+		setIdentityFromState(ctx, s.getImportID(), &resp.State, resp.Identity, &resp.Diagnostics)
+	}
+}
+
+// ImportState handles importing by ID or by identity. An identity import is translated to the
+// import ID of the ImportID attributes, joined by the import ID delimiter.
+func (s *IdsecResourceWithIdentity) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" && req.Identity != nil && !req.Identity.Raw.IsNull() {
+		var values []string
+		for _, attr := range schemas.SplitImportIDAttributes(s.getImportID()) {
+			var value types.String
+			resp.Diagnostics.Append(req.Identity.GetAttribute(ctx, path.Root(identityAttributeName(attr)), &value)...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			values = append(values, value.ValueString())
+		}
+		req.ID = schemas.FormatCompositeImportID(values, s.getImportIDDelimiter())
+	}
+	s.IdsecResource.ImportState(ctx, req, resp)
+}
@@ -0,0 +1,194 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/cyberark/terraform-provider-idsec/internal/actions"
+)
+
+type fakeSafeCreateModel struct {
+	SafeName    string `json:"safe_name" mapstructure:"safe_name" validate:"required"`
+	Description string `json:"description,omitempty" mapstructure:"description"`
+}
+
+type fakeSafeIDModel struct {
+	SafeID string `json:"safe_id" mapstructure:"safe_id"`
+}
+
+type fakeSafeModel struct {
+	SafeID      string `json:"safe_id" mapstructure:"safe_id"`
+	SafeName    string `json:"safe_name" mapstructure:"safe_name"`
+	Description string `json:"description,omitempty" mapstructure:"description"`
+}
+
+// fakeOperationsTestResource returns a resource whose actions are served by a fakeActionInvoker.
+func fakeOperationsTestResource(t *testing.T) (*IdsecResource, *fakeActionInvoker, resource.SchemaResponse) {
+	t.Helper()
+	operations := []actions.IdsecServiceActionOperation{actions.CreateOperation, actions.ReadOperation, actions.UpdateOperation, actions.DeleteOperation}
+	idsecRes, schemaResp := CreateTestResourceWithSchema(t, &fakeSafeModel{}, operations, func(actionDefinition *actions.IdsecServiceTerraformResourceActionDefinition) {
+		actionDefinition.ActionName = "fake-safe"
+		actionDefinition.Schemas = map[string]interface{}{
+			"add-safe":    &fakeSafeCreateModel{},
+			"safe":        &fakeSafeIDModel{},
+			"update-safe": &fakeSafeModel{},
+			"delete-safe": &fakeSafeIDModel{},
+		}
+		actionDefinition.ActionsMappings = map[actions.IdsecServiceActionOperation]string{
+			actions.CreateOperation: "add-safe",
+			actions.ReadOperation:   "safe",
+			actions.UpdateOperation: "update-safe",
+			actions.DeleteOperation: "delete-safe",
+		}
+	})
+	invoker := newFakeActionInvoker(idsecRes.actionDefinition, "safe_id")
+	idsecRes.invoker = invoker
+	return idsecRes, invoker, schemaResp
+}
+
+// fakeOperationsTestSafe returns the values of a safe object.
+func fakeOperationsTestSafe(safeID interface{}, description string) map[string]tftypes.Value {
+	return map[string]tftypes.Value{
+		"safe_id":     tftypes.NewValue(tftypes.String, safeID),
+		"safe_name":   tftypes.NewValue(tftypes.String, "finance"),
+		"description": tftypes.NewValue(tftypes.String, description),
+	}
+}
+
+// fakeOperationsTestAttribute returns a top-level string attribute of a state.
+func fakeOperationsTestAttribute(t *testing.T, state tfsdk.State, name string) types.String {
+	t.Helper()
+	var value types.String
+	if diags := state.GetAttribute(context.Background(), path.Root(name), &value); diags.HasError() {
+		t.Fatalf("failed to get %s: %v", name, diags)
+	}
+	return value
+}
+
+func TestIdsecResource_TriggerOperationCreate(t *testing.T) {
+	idsecRes, invoker, schemaResp := fakeOperationsTestResource(t)
+	ctx := context.Background()
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: CreateTestResourceValue(schemaResp, fakeOperationsTestSafe(tftypes.UnknownValue, "Finance team"))}
+	respState := tfsdk.State{Schema: schemaResp.Schema, Raw: plan.Raw}
+	var diagnostics diag.Diagnostics
+
+	idsecRes.triggerOperation(ctx, actions.CreateOperation, &diagnostics, &plan, nil, nil, &respState, nil)
+	if diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diagnostics)
+	}
+	if safeID := fakeOperationsTestAttribute(t, respState, "safe_id"); safeID.ValueString() != "fake-1" {
+		t.Errorf("expected the ID assigned by the API in state, got %v", safeID)
+	}
+	if description := fakeOperationsTestAttribute(t, respState, "description"); description.ValueString() != "Finance team" {
+		t.Errorf("expected the planned description in state, got %v", description)
+	}
+	if stored := invoker.object("fake-1"); stored == nil || stored["safe_name"] != "finance" {
+		t.Errorf("expected the safe to be created, got %v", stored)
+	}
+}
+
+func TestIdsecResource_TriggerOperationRead(t *testing.T) {
+	idsecRes, invoker, schemaResp := fakeOperationsTestResource(t)
+	ctx := context.Background()
+	invoker.objects["safe-1"] = map[string]interface{}{"safe_id": "safe-1", "safe_name": "finance", "description": "Finance team"}
+	invoker.setField("safe-1", "description", "Changed outside Terraform")
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: CreateTestResourceValue(schemaResp, fakeOperationsTestSafe("safe-1", "Finance team"))}
+	respState := tfsdk.State{Schema: schemaResp.Schema, Raw: state.Raw}
+	var diagnostics diag.Diagnostics
+
+	idsecRes.triggerOperation(ctx, actions.ReadOperation, &diagnostics, nil, &state, nil, &respState, nil)
+	if diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diagnostics)
+	}
+	if description := fakeOperationsTestAttribute(t, respState, "description"); description.ValueString() != "Changed outside Terraform" {
+		t.Errorf("expected the description read from the API in state, got %v", description)
+	}
+}
+
+func TestIdsecResource_TriggerOperationUpdateFailureKeepsState(t *testing.T) {
+	idsecRes, invoker, schemaResp := fakeOperationsTestResource(t)
+	ctx := context.Background()
+	invoker.objects["safe-1"] = map[string]interface{}{"safe_id": "safe-1", "safe_name": "finance", "description": "Finance team"}
+	invoker.failOn(actions.UpdateOperation, errors.New("[409] the safe is locked"))
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: CreateTestResourceValue(schemaResp, fakeOperationsTestSafe("safe-1", "Finance team"))}
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: CreateTestResourceValue(schemaResp, fakeOperationsTestSafe("safe-1", "Finance and audit"))}
+	config := tfsdk.Config{Schema: schemaResp.Schema, Raw: plan.Raw}
+	respState := tfsdk.State{Schema: schemaResp.Schema, Raw: plan.Raw}
+	var diagnostics diag.Diagnostics
+
+	idsecRes.triggerOperation(ctx, actions.UpdateOperation, &diagnostics, &plan, &state, &config, &respState, nil)
+	if !diagnostics.HasError() || diagnostics.Errors()[0].Summary() != "Action Error" {
+		t.Fatalf("expected an action error, got %v", diagnostics)
+	}
+	if description := fakeOperationsTestAttribute(t, respState, "description"); description.ValueString() != "Finance team" {
+		t.Errorf("expected the prior description to be kept in state, got %v", description)
+	}
+	if stored := invoker.object("safe-1"); stored["description"] != "Finance team" {
+		t.Errorf("expected the safe to be left as is, got %v", stored)
+	}
+}
+
+func TestIdsecResource_TriggerOperationDelete(t *testing.T) {
+	idsecRes, invoker, schemaResp := fakeOperationsTestResource(t)
+	ctx := context.Background()
+	invoker.objects["safe-1"] = map[string]interface{}{"safe_id": "safe-1", "safe_name": "finance"}
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: CreateTestResourceValue(schemaResp, fakeOperationsTestSafe("safe-1", ""))}
+	var diagnostics diag.Diagnostics
+
+	idsecRes.triggerOperation(ctx, actions.DeleteOperation, &diagnostics, nil, &state, nil, nil, nil)
+	if diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diagnostics)
+	}
+	if stored := invoker.object("safe-1"); stored != nil {
+		t.Errorf("expected the safe to be deleted, got %v", stored)
+	}
+	if calls := invoker.operationCalls(); !slices.Equal(calls, []actions.IdsecServiceActionOperation{actions.DeleteOperation}) {
+		t.Errorf("expected a single delete call, got %v", calls)
+	}
+}
+
+func TestIdsecResource_OperationPipelineMiddleware(t *testing.T) {
+	idsecRes, invoker, schemaResp := fakeOperationsTestResource(t)
+	ctx := context.Background()
+	invoker.objects["safe-1"] = map[string]interface{}{"safe_id": "safe-1", "safe_name": "finance"}
+	var stages []string
+	previous := operationMiddlewares
+	operationMiddlewares = append(slices.Clone(previous), func(name string, next operationStage) operationStage {
+		return func(ctx context.Context, run *operationRun) error {
+			stages = append(stages, name)
+			return next(ctx, run)
+		}
+	})
+	defer func() { operationMiddlewares = previous }()
+
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: CreateTestResourceValue(schemaResp, fakeOperationsTestSafe("safe-1", ""))}
+	respState := tfsdk.State{Schema: schemaResp.Schema, Raw: state.Raw}
+	var diagnostics diag.Diagnostics
+	idsecRes.triggerOperation(ctx, actions.ReadOperation, &diagnostics, nil, &state, nil, &respState, nil)
+	if diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diagnostics)
+	}
+	if expected := []string{"parse", "invoke", "convert", "merge", "persist"}; !slices.Equal(stages, expected) {
+		t.Errorf("expected the stages %v, got %v", expected, stages)
+	}
+
+	stages = nil
+	idsecRes.triggerOperation(ctx, actions.DeleteOperation, &diagnostics, nil, &state, nil, nil, nil)
+	if diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diagnostics)
+	}
+	if expected := []string{"parse", "invoke", "convert"}; !slices.Equal(stages, expected) {
+		t.Errorf("expected a delete to end without a state to convert to, got the stages %v", stages)
+	}
+}
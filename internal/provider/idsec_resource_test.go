@@ -116,6 +116,69 @@ func CreateTestActionDefinitionWithImportIDAndOperations(actionName, actionDescr
 	return actionDef
 }
 
+// CreateTestResourceWithSchema creates a test resource whose create action takes model, which its state
+// also follows, and generates its schema, failing the test on schema errors.
+//
+// Parameters:
+//   - t: The test to fail on schema errors
+//   - model: Model of the create action input and of the state
+//   - supportedOperations: List of supported operations
+//   - configure: Optional function setting the fields of the action definition under test
+//
+// Returns the resource along with the response of its Schema call.
+func CreateTestResourceWithSchema(
+	t *testing.T,
+	model interface{},
+	supportedOperations []actions.IdsecServiceActionOperation,
+	configure func(*actions.IdsecServiceTerraformResourceActionDefinition),
+) (*IdsecResource, resource.SchemaResponse) {
+	t.Helper()
+	idsecRes, schemaResp := CreateTestResourceSchema(model, supportedOperations, configure)
+	if schemaResp.Diagnostics.HasError() {
+		t.Fatalf("unexpected schema errors: %v", schemaResp.Diagnostics)
+	}
+	return idsecRes, schemaResp
+}
+
+// CreateTestResourceSchema is CreateTestResourceWithSchema leaving schema errors in the returned response,
+// for tests expecting them.
+func CreateTestResourceSchema(
+	model interface{},
+	supportedOperations []actions.IdsecServiceActionOperation,
+	configure func(*actions.IdsecServiceTerraformResourceActionDefinition),
+) (*IdsecResource, resource.SchemaResponse) {
+	actionDefinition := CreateTestActionDefinitionWithOperations("test-action", "Test action description", supportedOperations)
+	actionDefinition.Schemas = map[string]interface{}{"create": model}
+	actionDefinition.ActionsMappings = map[actions.IdsecServiceActionOperation]string{actions.CreateOperation: "create"}
+	actionDefinition.StateSchema = model
+	if configure != nil {
+		configure(actionDefinition)
+	}
+	idsecRes := CreateTestIdsecResource(CreateTestServiceConfig("test-service"), actionDefinition).(*IdsecResource)
+	schemaResp := resource.SchemaResponse{}
+	idsecRes.Schema(context.Background(), resource.SchemaRequest{}, &schemaResp)
+	return idsecRes, schemaResp
+}
+
+// CreateTestResourceValue creates a resource object of a test schema.
+//
+// Parameters:
+//   - schemaResp: Response of the Schema call of the resource
+//   - values: Values of the attributes to set, the others are null
+//
+// Returns the object value, usable as the raw value of a plan, state or config.
+func CreateTestResourceValue(schemaResp resource.SchemaResponse, values map[string]tftypes.Value) tftypes.Value {
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	object := map[string]tftypes.Value{}
+	for name, attrType := range objectType.AttributeTypes {
+		object[name] = tftypes.NewValue(attrType, nil)
+	}
+	for name, value := range values {
+		object[name] = value
+	}
+	return tftypes.NewValue(objectType, object)
+}
+
 // TestIdsecResource_Metadata tests the Metadata function of IdsecResource.
 //
 // This test validates that the Metadata function correctly generates the TypeName
@@ -529,6 +592,35 @@ func TestIdsecResource_ImportState(t *testing.T) {
 	}
 }
 
+func TestIdsecResource_ImportStateCustomDelimiter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	actionDefinition := CreateTestActionDefinitionWithImportIDAndOperations(
+		"test-action",
+		"Test action description",
+		"safe_id:member_name",
+		[]actions.IdsecServiceActionOperation{actions.ReadOperation},
+	)
+	actionDefinition.ImportIDDelimiter = "/"
+	idsecRes := CreateTestIdsecResource(CreateTestServiceConfig("test-service"), actionDefinition).(*IdsecResource)
+
+	testSchema, rawValue := buildImportTestState("safe_id", "member_name")
+	resp := &resource.ImportStateResponse{State: tfsdk.State{Raw: rawValue, Schema: testSchema}}
+	idsecRes.ImportState(ctx, resource.ImportStateRequest{ID: "safe-123/domain:user"}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Expected no errors, but got: %v", resp.Diagnostics.Errors())
+	}
+	assertImportStateString(t, ctx, resp.State, "safe_id", "safe-123")
+	assertImportStateString(t, ctx, resp.State, "member_name", "domain:user")
+
+	resp = &resource.ImportStateResponse{State: tfsdk.State{Raw: rawValue, Schema: testSchema}}
+	idsecRes.ImportState(ctx, resource.ImportStateRequest{ID: "safe-123:user"}, resp)
+	if !resp.Diagnostics.HasError() || resp.Diagnostics.Errors()[0].Summary() != "Invalid Import ID" {
+		t.Fatalf("Expected an Invalid Import ID error, got: %v", resp.Diagnostics)
+	}
+}
+
 func assertImportStateString(t *testing.T, ctx context.Context, state tfsdk.State, attributePath, expectedValue string) {
 	t.Helper()
 
@@ -648,3 +740,55 @@ func TestIdsecResource_seedUserSetHistoryFromState(t *testing.T) {
 		t.Fatalf("existing history should be preserved, got %v", got)
 	}
 }
+
+func TestIdsecResource_generateSchemaSyntheticID(t *testing.T) {
+	t.Parallel()
+
+	type memberSchema struct {
+		SafeID     string `json:"safe_id" mapstructure:"safe_id"`
+		MemberName string `json:"member_name" mapstructure:"member_name"`
+	}
+	type identifiedSchema struct {
+		ID   string `json:"id" mapstructure:"id"`
+		Name string `json:"name" mapstructure:"name"`
+	}
+
+	tests := []struct {
+		name       string
+		model      interface{}
+		idTemplate string
+		expectedID bool
+	}{
+		{name: "success_injected_when_model_lacks_id", model: memberSchema{}, idTemplate: "{safe_id}:{member_name}", expectedID: true},
+		{name: "success_not_injected_without_template", model: memberSchema{}},
+		{name: "success_model_id_kept", model: identifiedSchema{}, idTemplate: "{name}"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			idsecRes := &IdsecResource{
+				actionDefinition: &actions.IdsecServiceTerraformResourceActionDefinition{
+					IdsecServiceBaseTerraformActionDefinition: actions.IdsecServiceBaseTerraformActionDefinition{
+						StateSchema: tt.model,
+					},
+					IDTemplate: tt.idTemplate,
+				},
+			}
+			generated, injected := idsecRes.generateSchema(tt.model, tt.model)
+			if injected != tt.expectedID {
+				t.Fatalf("injected = %v, want %v", injected, tt.expectedID)
+			}
+			idAttr, exists := generated.Attributes["id"]
+			if tt.expectedID {
+				stringAttr, ok := idAttr.(schema.StringAttribute)
+				if !ok || !stringAttr.Computed || stringAttr.Optional || stringAttr.Required {
+					t.Errorf("expected a computed only id attribute, got %#v", idAttr)
+				}
+				return
+			}
+			if _, hasModelID := reflect.TypeOf(tt.model).FieldByName("ID"); exists != hasModelID {
+				t.Errorf("id attribute exists = %v, want %v", exists, hasModelID)
+			}
+		})
+	}
+}
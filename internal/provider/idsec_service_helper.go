@@ -7,10 +7,15 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
+	"sync"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	api "github.com/cyberark/idsec-sdk-golang/pkg"
+	"github.com/cyberark/idsec-sdk-golang/pkg/auth"
 	"github.com/cyberark/idsec-sdk-golang/pkg/services"
 	"github.com/cyberark/terraform-provider-idsec/internal/featureadoption"
 	"github.com/cyberark/terraform-provider-idsec/internal/schemas"
@@ -21,13 +26,22 @@ import (
 // IdsecServiceHelper provides common helper methods for working with service instances.
 // This is embedded by both IdsecResource and IdsecDataSource.
 type IdsecServiceHelper struct {
-	serviceConfig *services.IdsecServiceConfig
-	service       services.IdsecService
+	serviceConfig    *services.IdsecServiceConfig
+	service          services.IdsecService
+	invoker          actionInvoker
+	requestHeadersMu sync.Mutex
+	// settings are the settings of the provider, read through getSettings.
+	settings *providerSettings
 }
 
 // getServiceNameTitled converts the service name to TitleCase format for reflection.
 func (h *IdsecServiceHelper) getServiceNameTitled() string {
-	serviceParts := strings.Split(h.serviceConfig.ServiceName, "-")
+	return h.serviceNameTitled(h.serviceConfig.ServiceName)
+}
+
+// serviceNameTitled converts a service name such as "pcloud-safes" to the name of its IdsecAPI accessor.
+func (h *IdsecServiceHelper) serviceNameTitled(serviceName string) string {
+	serviceParts := strings.Split(serviceName, "-")
 	titleCase := cases.Title(language.English)
 	serviceNameTitled := ""
 	for _, part := range serviceParts {
@@ -36,6 +50,61 @@ func (h *IdsecServiceHelper) getServiceNameTitled() string {
 	return strings.ReplaceAll(serviceNameTitled, "-", "")
 }
 
+// providerAuthenticated reports whether the provider data passed to Configure holds an authenticated ISP
+// or PVWA auth. Terraform configures resources, data sources and actions again for each operation, so
+// those configured while the provider is still authenticating leave their service unset until the next
+// call. Other provider data is reported as authenticated for Configure to reject it.
+func providerAuthenticated(providerData any) bool {
+	switch typed := providerData.(type) {
+	case *auth.IdsecISPAuth:
+		return typed.IdsecAuthBase != nil && typed.GetToken() != nil
+	case *auth.IdsecPVWAAuth:
+		return typed.IdsecAuthBase != nil && typed.GetToken() != nil
+	}
+	return true
+}
+
+// newProviderAPI creates the API from the ISP or PVWA auth passed as provider data to Configure. It returns nil
+// without error when there is no provider data yet, or when the provider has not finished authenticating.
+func newProviderAPI(ctx context.Context, providerData any, diagnostics *diag.Diagnostics) *api.IdsecAPI {
+	if providerData == nil {
+		return nil
+	}
+	if !providerAuthenticated(providerData) {
+		tflog.Debug(ctx, "Provider has not finished authenticating, the service is configured on the next call")
+		return nil
+	}
+	var idsecAuth auth.IdsecAuth
+	switch typed := providerData.(type) {
+	case *auth.IdsecISPAuth:
+		idsecAuth = typed
+	case *auth.IdsecPVWAAuth:
+		idsecAuth = typed
+	default:
+		diagnostics.AddError("Authentication Error", "Unable to authenticate with the provided credentials.")
+		return nil
+	}
+	idsecAPI, err := api.NewIdsecAPI([]auth.IdsecAuth{idsecAuth}, nil)
+	if err != nil {
+		diagnostics.AddError("Service Initialization Error", fmt.Sprintf("Unable to create API: %s", err.Error()))
+		return nil
+	}
+	return idsecAPI
+}
+
+// configureFromProviderData creates the API from the provider data passed to Configure and configures the
+// service instance with it. It returns the API, or nil when it could not be created yet.
+func (h *IdsecServiceHelper) configureFromProviderData(ctx context.Context, providerData any, diagnostics *diag.Diagnostics) *api.IdsecAPI {
+	idsecAPI := newProviderAPI(ctx, providerData, diagnostics)
+	if idsecAPI == nil {
+		return nil
+	}
+	if err := h.configureService(idsecAPI); err != nil {
+		diagnostics.AddError("Service Configuration Error", fmt.Sprintf("Unable to configure service: %s", err.Error()))
+	}
+	return idsecAPI
+}
+
 // configureService retrieves and stores the service instance from the API.
 // This should be called once during Configure() to set up the service.
 // Returns an error if the service cannot be retrieved.
@@ -89,9 +158,143 @@ func (h *IdsecServiceHelper) configureService(idsecAPI *api.IdsecAPI) error {
 	}
 
 	h.service = service
+	h.applyRequestHeaders(service)
 	return nil
 }
 
+// serviceClientAccessors lists the base service methods exposing the HTTP clients of a service.
+var serviceClientAccessors = []string{"ISPClient", "PVWAClient"}
+
+// idsecHeadersClient is implemented by the SDK HTTP clients embedded in the service clients.
+type idsecHeadersClient interface {
+	GetHeaders() map[string]string
+	UpdateHeaders(headers map[string]string)
+}
+
+// applyRequestHeaders applies the provider's extra headers, User-Agent and correlation ID to the HTTP
+// clients of the service. Services without an accessible client are left untouched.
+func (h *IdsecServiceHelper) applyRequestHeaders(service services.IdsecService) {
+	settings := h.getSettings()
+	if len(settings.requestHeaders) == 0 && settings.userAgent == "" && settings.correlationID == "" {
+		return
+	}
+	h.updateClientHeaders(service, func(current map[string]string) map[string]string {
+		updates := mergeRequestHeaders(current, settings.requestHeaders, settings.userAgent)
+		if settings.correlationID != "" {
+			updates[correlationIDHeader] = settings.correlationID
+		}
+		return updates
+	})
+}
+
+// idsecHeaderRemover is implemented by the SDK HTTP clients able to remove a header.
+type idsecHeaderRemover interface {
+	RemoveHeader(key string)
+}
+
+// updateClientHeaders updates the headers of every HTTP client exposed by the service with the
+// headers returned by updates, which receives the client's current headers.
+func (h *IdsecServiceHelper) updateClientHeaders(service services.IdsecService, updates func(current map[string]string) map[string]string) {
+	h.forEachHeadersClient(service, func(client idsecHeadersClient) {
+		client.UpdateHeaders(updates(client.GetHeaders()))
+	})
+}
+
+// requestHeadersUpdate returns the headers to set on an HTTP client, given its current headers.
+type requestHeadersUpdate func(current map[string]string) map[string]string
+
+// scopeRequestHeaders sets the headers returned by updates on the HTTP clients of the service for the
+// requests of the current operation, and returns a function restoring the headers the clients had, which
+// the caller defers. Like the telemetry context, the headers are set on the service Configure created
+// for the resource or data source instance, which the framework creates for every operation, so they
+// are never sent with the requests of another operation; scopes of the same instance are serialized.
+func (h *IdsecServiceHelper) scopeRequestHeaders(service services.IdsecService, updates ...requestHeadersUpdate) func() {
+	updates = slices.DeleteFunc(updates, func(update requestHeadersUpdate) bool { return update == nil })
+	if service == nil || len(updates) == 0 {
+		return func() {}
+	}
+	h.requestHeadersMu.Lock()
+	var restores []func()
+	h.forEachHeadersClient(service, func(client idsecHeadersClient) {
+		current := client.GetHeaders()
+		scoped := map[string]string{}
+		for _, update := range updates {
+			for key, value := range update(current) {
+				scoped[key] = value
+			}
+		}
+		previous := map[string]string{}
+		var added []string
+		for key := range scoped {
+			if value, ok := current[key]; ok {
+				previous[key] = value
+			} else {
+				added = append(added, key)
+			}
+		}
+		client.UpdateHeaders(scoped)
+		restores = append(restores, func() {
+			client.UpdateHeaders(previous)
+			if remover, ok := client.(idsecHeaderRemover); ok {
+				for _, key := range added {
+					remover.RemoveHeader(key)
+				}
+			}
+		})
+	})
+	return func() {
+		defer h.requestHeadersMu.Unlock()
+		for _, restore := range restores {
+			restore()
+		}
+	}
+}
+
+// forEachHeadersClient calls fn with every HTTP client exposed by the service.
+func (h *IdsecServiceHelper) forEachHeadersClient(service services.IdsecService, fn func(client idsecHeadersClient)) {
+	for _, accessor := range serviceClientAccessors {
+		clientMethod, err := schemas.FindMethodByName(reflect.ValueOf(service), accessor)
+		if err != nil || clientMethod.Type().NumIn() != 0 || clientMethod.Type().NumOut() != 1 {
+			continue
+		}
+		clientValue := clientMethod.Call(nil)[0]
+		if clientValue.Kind() == reflect.Pointer && clientValue.IsNil() {
+			continue
+		}
+		client, ok := clientValue.Interface().(idsecHeadersClient)
+		if !ok {
+			continue
+		}
+		fn(client)
+	}
+}
+
+// mergeRequestHeaders returns the headers to update on a client: the extra headers, plus the
+// User-Agent with the provider product tokens appended once. User-Agent entries in the extra
+// headers are treated as additional product tokens rather than a replacement.
+func mergeRequestHeaders(current map[string]string, extra map[string]string, userAgent string) map[string]string {
+	updates := make(map[string]string, len(extra)+1)
+	var extraUserAgent string
+	for key, value := range extra {
+		if strings.EqualFold(key, "User-Agent") {
+			extraUserAgent = value
+			continue
+		}
+		updates[key] = value
+	}
+	mergedUserAgent := current["User-Agent"]
+	for _, token := range []string{userAgent, extraUserAgent} {
+		if token == "" || strings.Contains(mergedUserAgent, token) {
+			continue
+		}
+		mergedUserAgent = strings.TrimSpace(mergedUserAgent + " " + token)
+	}
+	if mergedUserAgent != "" {
+		updates["User-Agent"] = mergedUserAgent
+	}
+	return updates
+}
+
 // getServiceInstance retrieves the service instance.
 // All services now implement the IdsecService interface which includes telemetry methods.
 // Returns the service instance or nil if not configured.
@@ -144,3 +347,36 @@ func (h *IdsecServiceHelper) buildFASTags(actionName, operation string) map[stri
 		featureadoption.TagKeyTFVersion:   providerVersion,
 	}
 }
+
+// reportUnmappedAttributes surfaces fields of the SDK response model that have no attribute in the
+// Terraform schema, so maintainers can keep schemas in sync with SDK updates. The SDK does not expose
+// the HTTP body, so API fields its models do not declare go unnoticed. The fields are always logged
+// at debug level; in strict schema sync mode they are also raised as a warning.
+func (h *IdsecServiceHelper) reportUnmappedAttributes(ctx context.Context, actionName string, result interface{}, schemaAttrs map[string]attr.Type, diagnostics *diag.Diagnostics) {
+	unmapped := schemas.UnmappedAttributePaths(result, schemaAttrs)
+	if len(unmapped) == 0 {
+		return
+	}
+	typeName := h.getTerraformTypeName(actionName)
+	tflog.Debug(ctx, "SDK response model contains fields not present in the schema", map[string]interface{}{
+		"type":       typeName,
+		"attributes": unmapped,
+	})
+	if h.getSettings().strictSchemaSync && diagnostics != nil {
+		diagnostics.AddWarning(
+			"Unmapped SDK Response Fields",
+			fmt.Sprintf("The SDK response model for %s contains fields that are not part of the Terraform schema and were dropped: %s", typeName, strings.Join(unmapped, ", ")),
+		)
+	}
+}
+
+// reportAttributeNameCollisions fails schema generation when fields of the given models map to the same
+// snake_case attribute name, since the generated schema would silently keep only one of them.
+func (h *IdsecServiceHelper) reportAttributeNameCollisions(actionName string, diagnostics *diag.Diagnostics, models ...interface{}) {
+	for _, collision := range schemas.FindAttributeNameCollisions(models...) {
+		diagnostics.AddError(
+			"Attribute Name Collision",
+			fmt.Sprintf("The schema of %s cannot be generated: %s. Rename one of the fields through its mapstructure tag.", h.getTerraformTypeName(actionName), collision.String()),
+		)
+	}
+}
@@ -445,6 +445,99 @@ func TestFindMethodByName(t *testing.T) {
 	}
 }
 
+// TestMergeRequestHeaders tests the mergeRequestHeaders function.
+func TestMergeRequestHeaders(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		current   map[string]string
+		extra     map[string]string
+		userAgent string
+		expected  map[string]string
+	}{
+		{
+			name:      "success_appends_user_agent",
+			current:   map[string]string{"User-Agent": "Mozilla/5.0 Idsec-Terraform-Provider/0.5.3"},
+			userAgent: "terraform-provider-idsec/1.0.0 Terraform/1.9.5",
+			expected:  map[string]string{"User-Agent": "Mozilla/5.0 Idsec-Terraform-Provider/0.5.3 terraform-provider-idsec/1.0.0 Terraform/1.9.5"},
+		},
+		{
+			name:      "success_user_agent_not_duplicated",
+			current:   map[string]string{"User-Agent": "Mozilla/5.0 terraform-provider-idsec/1.0.0"},
+			userAgent: "terraform-provider-idsec/1.0.0",
+			expected:  map[string]string{"User-Agent": "Mozilla/5.0 terraform-provider-idsec/1.0.0"},
+		},
+		{
+			name:      "success_extra_headers_and_user_agent_token",
+			current:   map[string]string{},
+			extra:     map[string]string{"X-Pipeline-Run": "42", "user-agent": "ci/7"},
+			userAgent: "terraform-provider-idsec/1.0.0",
+			expected:  map[string]string{"X-Pipeline-Run": "42", "User-Agent": "terraform-provider-idsec/1.0.0 ci/7"},
+		},
+		{
+			name:     "success_nothing_to_apply",
+			current:  map[string]string{},
+			expected: map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result := mergeRequestHeaders(tt.current, tt.extra, tt.userAgent)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+// TestApplyRequestHeaders tests that the provider headers reach the service clients.
+func TestApplyRequestHeaders(t *testing.T) {
+	service := &mockServiceWithClient{client: &mockHeadersClient{headers: map[string]string{"User-Agent": "sdk"}}}
+	helper := &IdsecServiceHelper{settings: &providerSettings{
+		requestHeaders: map[string]string{"X-Change-Ticket": "CHG-1"},
+		userAgent:      "terraform-provider-idsec/1.0.0",
+	}}
+	helper.applyRequestHeaders(service)
+
+	expected := map[string]string{"User-Agent": "sdk terraform-provider-idsec/1.0.0", "X-Change-Ticket": "CHG-1"}
+	if !reflect.DeepEqual(service.client.headers, expected) {
+		t.Errorf("Expected %v, got %v", expected, service.client.headers)
+	}
+
+	// Services without a client accessor must be left alone.
+	helper.applyRequestHeaders(&mockService{})
+}
+
+// TestScopeRequestHeaders tests that scoped headers are set for an operation and the previous ones restored.
+func TestScopeRequestHeaders(t *testing.T) {
+	t.Parallel()
+
+	service := &mockServiceWithClient{client: &mockHeadersClient{headers: map[string]string{"User-Agent": "sdk"}}}
+	helper := &IdsecServiceHelper{}
+	restore := helper.scopeRequestHeaders(service, func(current map[string]string) map[string]string {
+		return map[string]string{"User-Agent": current["User-Agent"] + " (scoped)", "X-Scoped": "1"}
+	}, nil)
+
+	expected := map[string]string{"User-Agent": "sdk (scoped)", "X-Scoped": "1"}
+	if !reflect.DeepEqual(service.client.headers, expected) {
+		t.Errorf("Expected %v, got %v", expected, service.client.headers)
+	}
+	restore()
+	if expected := map[string]string{"User-Agent": "sdk"}; !reflect.DeepEqual(service.client.headers, expected) {
+		t.Errorf("Expected the headers to be restored to %v, got %v", expected, service.client.headers)
+	}
+
+	// The scope is released, so the next operation can set its own headers.
+	helper.scopeRequestHeaders(service, func(map[string]string) map[string]string {
+		return map[string]string{"X-Scoped": "2"}
+	})()
+	helper.scopeRequestHeaders(nil, nil)()
+}
+
 // Helper functions and mock types
 
 // contains checks if a string contains a substring.
@@ -476,6 +569,35 @@ func (m *mockService) ClearExtraContext() error {
 	return nil
 }
 
+// mockHeadersClient is a mock HTTP client exposing header accessors.
+type mockHeadersClient struct {
+	headers map[string]string
+}
+
+func (c *mockHeadersClient) GetHeaders() map[string]string {
+	return c.headers
+}
+
+func (c *mockHeadersClient) UpdateHeaders(headers map[string]string) {
+	for key, value := range headers {
+		c.headers[key] = value
+	}
+}
+
+func (c *mockHeadersClient) RemoveHeader(key string) {
+	delete(c.headers, key)
+}
+
+// mockServiceWithClient is a mock service exposing an ISP client.
+type mockServiceWithClient struct {
+	mockService
+	client *mockHeadersClient
+}
+
+func (m *mockServiceWithClient) ISPClient() *mockHeadersClient {
+	return m.client
+}
+
 // mockServiceWithError is a mock that returns errors.
 type mockServiceWithError struct{}
 
@@ -0,0 +1,280 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	api "github.com/cyberark/idsec-sdk-golang/pkg"
+	"github.com/cyberark/idsec-sdk-golang/pkg/auth"
+	"github.com/cyberark/idsec-sdk-golang/pkg/services/sm/sessions"
+	sessionsmodels "github.com/cyberark/idsec-sdk-golang/pkg/services/sm/sessions/models"
+)
+
+const sessionReportDataSourceName = "session-report"
+
+// IdsecSessionReportDataSource is a data source reporting the sessions recorded by session monitoring over a
+// time range, as typed objects, for recurring compliance reports.
+type IdsecSessionReportDataSource struct {
+	idsecAPI *api.IdsecAPI
+}
+
+// IdsecSessionReportDataSourceModel is the configuration and state of the session report data source.
+type IdsecSessionReportDataSourceModel struct {
+	StartTime        types.String `tfsdk:"start_time"`
+	EndTime          types.String `tfsdk:"end_time"`
+	Search           types.String `tfsdk:"search"`
+	TotalCount       types.Int64  `tfsdk:"total_count"`
+	CountPerStatus   types.Map    `tfsdk:"count_per_status"`
+	CountPerProtocol types.Map    `tfsdk:"count_per_protocol"`
+	FailedCount      types.Int64  `tfsdk:"failed_count"`
+	Sessions         types.List   `tfsdk:"sessions"`
+}
+
+// sessionLister lists the sessions matching a search filter, as the session monitoring service does.
+type sessionLister interface {
+	ListBy(filter *sessionsmodels.IdsecSMSessionsFilter) (<-chan *sessions.IdsecSMSessionsPage, error)
+}
+
+// sessionReportSessionAttrTypes are the attribute types of the elements of the sessions attribute.
+var sessionReportSessionAttrTypes = map[string]attr.Type{
+	"session_id":       types.StringType,
+	"status":           types.StringType,
+	"user":             types.StringType,
+	"source":           types.StringType,
+	"target":           types.StringType,
+	"target_username":  types.StringType,
+	"protocol":         types.StringType,
+	"platform":         types.StringType,
+	"access_method":    types.StringType,
+	"application_code": types.StringType,
+	"start_time":       types.StringType,
+	"end_time":         types.StringType,
+	"duration":         types.StringType,
+	"end_reason":       types.StringType,
+	"error_code":       types.StringType,
+	"is_recording":     types.BoolType,
+}
+
+// NewIdsecSessionReportDataSource creates a new instance of IdsecSessionReportDataSource.
+func NewIdsecSessionReportDataSource() datasource.DataSource {
+	return &IdsecSessionReportDataSource{}
+}
+
+// Metadata defines the data source type name.
+func (s *IdsecSessionReportDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_%s", req.ProviderTypeName, strings.ReplaceAll(sessionReportDataSourceName, "-", "_"))
+}
+
+// Schema defines the schema of the session report data source.
+func (s *IdsecSessionReportDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	sessionAttributes := map[string]schema.Attribute{
+		"session_id":       schema.StringAttribute{Computed: true, Description: "ID of the session."},
+		"status":           schema.StringAttribute{Computed: true, Description: "Status of the session: Active, Ended or Failed."},
+		"user":             schema.StringAttribute{Computed: true, Description: "User who opened the session."},
+		"source":           schema.StringAttribute{Computed: true, Description: "Source of the session, usually an IP address."},
+		"target":           schema.StringAttribute{Computed: true, Description: "Target of the session, usually an IP address or DNS name."},
+		"target_username":  schema.StringAttribute{Computed: true, Description: "User the session connected to the target as."},
+		"protocol":         schema.StringAttribute{Computed: true, Description: "Protocol of the session, e.g. SSH or RDP."},
+		"platform":         schema.StringAttribute{Computed: true, Description: "Platform of the session."},
+		"access_method":    schema.StringAttribute{Computed: true, Description: "Access method of the session: Vaulted, JIT or Unknown."},
+		"application_code": schema.StringAttribute{Computed: true, Description: "Code of the application of the session, e.g. SIA."},
+		"start_time":       schema.StringAttribute{Computed: true, Description: "Time the session started."},
+		"end_time":         schema.StringAttribute{Computed: true, Description: "Time the session ended."},
+		"duration":         schema.StringAttribute{Computed: true, Description: "Duration of the session."},
+		"end_reason":       schema.StringAttribute{Computed: true, Description: "Reason the session ended."},
+		"error_code":       schema.StringAttribute{Computed: true, Description: "Error code of a failed session."},
+		"is_recording":     schema.BoolAttribute{Computed: true, Description: "Whether the session is recorded."},
+	}
+	resp.Schema = schema.Schema{
+		Description: "Reports the sessions recorded by session monitoring over a time range, with their counts per status and protocol, for recurring compliance reports.",
+		Attributes: map[string]schema.Attribute{
+			"start_time": schema.StringAttribute{
+				Optional:    true,
+				Description: "Start of the time range of the report, in RFC 3339 format, e.g. 2026-01-01T00:00:00Z. Sessions started at or after it are reported.",
+			},
+			"end_time": schema.StringAttribute{
+				Optional:    true,
+				Description: "End of the time range of the report, in RFC 3339 format. Sessions started at or before it are reported.",
+			},
+			"search": schema.StringAttribute{
+				Optional:    true,
+				Description: "Additional search expression of session monitoring the sessions must match, e.g. \"protocol IN SSH,RDP\".",
+			},
+			"total_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of sessions matching the time range and the search expression.",
+			},
+			"failed_count": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Number of matching sessions that failed.",
+			},
+			"count_per_status": schema.MapAttribute{
+				ElementType: types.Int64Type,
+				Computed:    true,
+				Description: "Number of matching sessions per status.",
+			},
+			"count_per_protocol": schema.MapAttribute{
+				ElementType: types.Int64Type,
+				Computed:    true,
+				Description: "Number of matching sessions per protocol.",
+			},
+			"sessions": schema.ListNestedAttribute{
+				Computed:     true,
+				Description:  "Matching sessions, in the order of session monitoring.",
+				NestedObject: schema.NestedAttributeObject{Attributes: sessionAttributes},
+			},
+		},
+	}
+	localizeSchema(&resp.Schema, &resp.Diagnostics)
+}
+
+// Configure creates the API the sessions are listed with from the provider authentication.
+func (s *IdsecSessionReportDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if _, pvwa := req.ProviderData.(*auth.IdsecPVWAAuth); pvwa {
+		resp.Diagnostics.AddError("Authentication Error", "The session report data source requires ISP authentication, session monitoring is not available with PVWA authentication.")
+		return
+	}
+	if idsecAPI := newProviderAPI(ctx, req.ProviderData, &resp.Diagnostics); idsecAPI != nil {
+		s.idsecAPI = idsecAPI
+	}
+}
+
+// Read lists the sessions of the time range and reports them.
+func (s *IdsecSessionReportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config IdsecSessionReportDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	search, err := sessionReportSearch(config.StartTime.ValueString(), config.EndTime.ValueString(), config.Search.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
+		return
+	}
+	if s.idsecAPI == nil {
+		resp.Diagnostics.AddError("Service Error", "Service instance not configured")
+		return
+	}
+	sessionsService, err := s.idsecAPI.SmSessions()
+	if err != nil {
+		resp.Diagnostics.AddError("Service Configuration Error", fmt.Sprintf("Unable to configure service: %s", err.Error()))
+		return
+	}
+	matching, err := listReportSessions(sessionsService, search)
+	if err != nil {
+		if isServiceUnavailableError(err) {
+			addErrorWithCorrelation(ctx, &resp.Diagnostics, "Service Not Enabled", serviceUnavailableDetail("sm-sessions", err))
+			return
+		}
+		addErrorWithCorrelation(ctx, &resp.Diagnostics, "Action Error", fmt.Sprintf("Unable to list the sessions: %s", err.Error()))
+		return
+	}
+	tflog.Info(ctx, fmt.Sprintf("Found %d sessions matching %q", len(matching), search))
+
+	countPerStatus := map[string]int64{}
+	countPerProtocol := map[string]int64{}
+	var failed int64
+	for _, session := range matching {
+		countPerStatus[string(session.SessionStatus)]++
+		if session.Protocol != "" {
+			countPerProtocol[session.Protocol]++
+		}
+		if session.SessionStatus == sessionsmodels.Failed {
+			failed++
+		}
+	}
+	sessionValues := make([]attr.Value, 0, len(matching))
+	for _, session := range matching {
+		sessionValue, diags := types.ObjectValue(sessionReportSessionAttrTypes, map[string]attr.Value{
+			"session_id":       types.StringValue(session.SessionID),
+			"status":           types.StringValue(string(session.SessionStatus)),
+			"user":             types.StringValue(session.User),
+			"source":           types.StringValue(session.Source),
+			"target":           types.StringValue(session.Target),
+			"target_username":  types.StringValue(session.TargetUsername),
+			"protocol":         types.StringValue(session.Protocol),
+			"platform":         types.StringValue(session.Platform),
+			"access_method":    types.StringValue(session.AccessMethod),
+			"application_code": types.StringValue(session.ApplicationCode),
+			"start_time":       types.StringValue(session.StartTime),
+			"end_time":         types.StringValue(session.EndTime),
+			"duration":         types.StringValue(session.SessionDuration),
+			"end_reason":       types.StringValue(session.EndReason),
+			"error_code":       types.StringValue(session.ErrorCode),
+			"is_recording":     types.BoolValue(session.IsRecording),
+		})
+		resp.Diagnostics.Append(diags...)
+		sessionValues = append(sessionValues, sessionValue)
+	}
+	sessionsValue, diags := types.ListValue(types.ObjectType{AttrTypes: sessionReportSessionAttrTypes}, sessionValues)
+	resp.Diagnostics.Append(diags...)
+	countPerStatusValue, diags := types.MapValueFrom(ctx, types.Int64Type, countPerStatus)
+	resp.Diagnostics.Append(diags...)
+	countPerProtocolValue, diags := types.MapValueFrom(ctx, types.Int64Type, countPerProtocol)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	config.TotalCount = types.Int64Value(int64(len(matching)))
+	config.FailedCount = types.Int64Value(failed)
+	config.CountPerStatus = countPerStatusValue
+	config.CountPerProtocol = countPerProtocolValue
+	config.Sessions = sessionsValue
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// sessionReportSearch builds the search expression of session monitoring selecting the sessions started in
+// the time range, and matching the additional search expression when set.
+func sessionReportSearch(startTime string, endTime string, search string) (string, error) {
+	var clauses []string
+	for _, bound := range []struct {
+		attribute string
+		value     string
+		operator  string
+	}{
+		{attribute: "start_time", value: startTime, operator: "GE"},
+		{attribute: "end_time", value: endTime, operator: "LE"},
+	} {
+		if bound.value == "" {
+			continue
+		}
+		parsed, err := time.Parse(time.RFC3339, bound.value)
+		if err != nil {
+			return "", fmt.Errorf("%s %q is not an RFC 3339 time: %w", bound.attribute, bound.value, err)
+		}
+		clauses = append(clauses, fmt.Sprintf("startTime %s %s", bound.operator, parsed.UTC().Format(time.RFC3339)))
+	}
+	if search = strings.TrimSpace(search); search != "" {
+		clauses = append(clauses, search)
+	}
+	return strings.Join(clauses, " AND "), nil
+}
+
+// listReportSessions lists all the sessions matching search. The pages are read to the end, as the service
+// keeps listing pages in the background until they are, and the filter of the service takes no offset or
+// limit to read a window of them.
+func listReportSessions(lister sessionLister, search string) ([]*sessionsmodels.IdsecSMSession, error) {
+	pages, err := lister.ListBy(&sessionsmodels.IdsecSMSessionsFilter{Search: search})
+	if err != nil {
+		return nil, err
+	}
+	var matching []*sessionsmodels.IdsecSMSession
+	for page := range pages {
+		for _, session := range page.Items {
+			if session != nil {
+				matching = append(matching, session)
+			}
+		}
+	}
+	return matching, nil
+}
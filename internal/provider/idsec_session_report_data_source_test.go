@@ -0,0 +1,79 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/cyberark/idsec-sdk-golang/pkg/services/sm/sessions"
+	sessionsmodels "github.com/cyberark/idsec-sdk-golang/pkg/services/sm/sessions/models"
+)
+
+// fakeSessionLister returns its sessions as pages of one session and records the search it was called with.
+type fakeSessionLister struct {
+	sessions []*sessionsmodels.IdsecSMSession
+	search   string
+}
+
+func (l *fakeSessionLister) ListBy(filter *sessionsmodels.IdsecSMSessionsFilter) (<-chan *sessions.IdsecSMSessionsPage, error) {
+	l.search = filter.Search
+	pages := make(chan *sessions.IdsecSMSessionsPage, len(l.sessions))
+	for _, session := range l.sessions {
+		pages <- &sessions.IdsecSMSessionsPage{Items: []*sessionsmodels.IdsecSMSession{session}}
+	}
+	close(pages)
+	return pages, nil
+}
+
+// TestSessionReportSearch tests the search expressions built from the time range of the report.
+func TestSessionReportSearch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		startTime   string
+		endTime     string
+		search      string
+		expected    string
+		expectError bool
+	}{
+		{name: "no_range", expected: ""},
+		{name: "range_in_utc", startTime: "2026-01-01T02:00:00+02:00", endTime: "2026-02-01T00:00:00Z", expected: "startTime GE 2026-01-01T00:00:00Z AND startTime LE 2026-02-01T00:00:00Z"},
+		{name: "start_and_search", startTime: "2026-01-01T00:00:00Z", search: " protocol IN SSH,RDP ", expected: "startTime GE 2026-01-01T00:00:00Z AND protocol IN SSH,RDP"},
+		{name: "invalid_time", endTime: "yesterday", expectError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := sessionReportSearch(tt.startTime, tt.endTime, tt.search)
+			if (err != nil) != tt.expectError {
+				t.Fatalf("Expected error=%v, got %v", tt.expectError, err)
+			}
+			if got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+// TestListReportSessions tests that all the pages of the matching sessions are read.
+func TestListReportSessions(t *testing.T) {
+	t.Parallel()
+
+	lister := &fakeSessionLister{sessions: []*sessionsmodels.IdsecSMSession{
+		{SessionID: "s-1", SessionStatus: sessionsmodels.Ended},
+		nil,
+		{SessionID: "s-2", SessionStatus: sessionsmodels.Failed},
+	}}
+	matching, err := listReportSessions(lister, "protocol IN SSH")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if lister.search != "protocol IN SSH" {
+		t.Errorf("unexpected search %q", lister.search)
+	}
+	if len(matching) != 2 || matching[0].SessionID != "s-1" || matching[1].SessionID != "s-2" {
+		t.Errorf("expected the sessions of every page in order, got %+v", matching)
+	}
+}
@@ -0,0 +1,309 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	api "github.com/cyberark/idsec-sdk-golang/pkg"
+	modelsactions "github.com/cyberark/idsec-sdk-golang/pkg/models/actions"
+	"github.com/cyberark/idsec-sdk-golang/pkg/services"
+	"github.com/cyberark/terraform-provider-idsec/internal/actions"
+	"github.com/cyberark/terraform-provider-idsec/internal/featureadoption"
+	"github.com/cyberark/terraform-provider-idsec/internal/schemas"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+const (
+	waitForDataSourceName      = "wait-for"
+	waitForDefaultTimeout      = 5 * time.Minute
+	waitForDefaultPollInterval = 10 * time.Second
+)
+
+// IdsecWaitForDataSource is a data source that polls another data source of the provider until one
+// of its attributes reaches an expected value, e.g. waiting for a connector to become ONLINE.
+type IdsecWaitForDataSource struct {
+	dataSources []schemas.Tuple[*services.IdsecServiceConfig, *actions.IdsecServiceTerraformDataSourceActionDefinition]
+	idsecAPI    *api.IdsecAPI
+	// settings are the settings of the provider, passed to the resources the data sources are polled through.
+	settings *providerSettings
+}
+
+// IdsecWaitForDataSourceModel is the configuration and state of the wait for data source.
+type IdsecWaitForDataSourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	DataSource    types.String `tfsdk:"data_source"`
+	Input         types.Map    `tfsdk:"input"`
+	Attribute     types.String `tfsdk:"attribute"`
+	ExpectedValue types.String `tfsdk:"expected_value"`
+	Timeout       types.String `tfsdk:"timeout"`
+	PollInterval  types.String `tfsdk:"poll_interval"`
+	Value         types.String `tfsdk:"value"`
+}
+
+// NewIdsecWaitForDataSource creates a new instance of IdsecWaitForDataSource, able to poll any of the given data sources.
+func NewIdsecWaitForDataSource(dataSources []schemas.Tuple[*services.IdsecServiceConfig, *actions.IdsecServiceTerraformDataSourceActionDefinition]) datasource.DataSource {
+	return &IdsecWaitForDataSource{
+		dataSources: dataSources,
+	}
+}
+
+// Metadata defines the data source type name.
+func (s *IdsecWaitForDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = fmt.Sprintf("%s_%s", req.ProviderTypeName, strings.ReplaceAll(waitForDataSourceName, "-", "_"))
+}
+
+// Schema defines the schema of the wait for data source.
+func (s *IdsecWaitForDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Polls a data source of the provider until one of its attributes matches an expected value, or the timeout expires. Use it to order operations on eventually consistent objects, e.g. wait for a connector to become ONLINE before creating policies that use it.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "Identifier of the wait, made of the polled data source, attribute and expected value.",
+			},
+			"data_source": schema.StringAttribute{
+				Required:    true,
+				Description: "Type name of the data source to poll, e.g. idsec_cmgr_pool.",
+			},
+			"input": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+				Description: "Input attributes of the polled data source, e.g. { pool_id = \"...\" }.",
+			},
+			"attribute": schema.StringAttribute{
+				Required:    true,
+				Description: "Dot-separated path of the attribute to check in the polled data source, e.g. status or metadata.state.",
+			},
+			"expected_value": schema.StringAttribute{
+				Required:    true,
+				Description: "Value the attribute must reach. The comparison is case insensitive.",
+			},
+			"timeout": schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Maximum duration to wait, as a Go duration string. Defaults to %s.", waitForDefaultTimeout),
+			},
+			"poll_interval": schema.StringAttribute{
+				Optional:    true,
+				Description: fmt.Sprintf("Duration between polls, as a Go duration string. Defaults to %s.", waitForDefaultPollInterval),
+			},
+			"value": schema.StringAttribute{
+				Computed:    true,
+				Description: "Last observed value of the attribute.",
+			},
+		},
+	}
+	localizeSchema(&resp.Schema, &resp.Diagnostics)
+}
+
+// Configure stores the provider authentication, used to configure the service of the polled data source on read.
+func (s *IdsecWaitForDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if idsecAPI := newProviderAPI(ctx, req.ProviderData, &resp.Diagnostics); idsecAPI != nil {
+		s.idsecAPI = idsecAPI
+	}
+}
+
+// newWaitForPoller returns the resource the data source action of a service is polled through, for the
+// polls to go through the actionCallMiddlewares of the reads of the service, such as its circuit breaker.
+func newWaitForPoller(serviceConfig *services.IdsecServiceConfig, settings *providerSettings) *IdsecResource {
+	return &IdsecResource{
+		IdsecServiceHelper: IdsecServiceHelper{
+			serviceConfig: serviceConfig,
+			settings:      settings,
+		},
+		serviceConfig:    serviceConfig,
+		actionDefinition: &actions.IdsecServiceTerraformResourceActionDefinition{},
+	}
+}
+
+// skipUnavailableService ends the wait for a data source of a service not enabled on the tenant with a
+// warning, leaving the value null, as ignore_unavailable_services is set.
+func (s *IdsecWaitForDataSource) skipUnavailableService(ctx context.Context, poller *IdsecResource, err error, config IdsecWaitForDataSourceModel, resp *datasource.ReadResponse) {
+	serviceName := poller.serviceConfig.ServiceName
+	tflog.Warn(ctx, fmt.Sprintf("Skipping the wait for %s, service %s is not enabled on the tenant: %s", config.DataSource.ValueString(), serviceName, err.Error()))
+	resp.Diagnostics.AddWarning(
+		"Service Not Enabled",
+		fmt.Sprintf("The wait for %s was skipped because ignore_unavailable_services is set. %s", config.DataSource.ValueString(), serviceUnavailableDetail(serviceName, err)),
+	)
+	config.ID = types.StringValue(fmt.Sprintf("%s:%s:%s", config.DataSource.ValueString(), config.Attribute.ValueString(), config.ExpectedValue.ValueString()))
+	config.Value = types.StringNull()
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// findDataSource returns the data source definition of the given type name, with or without the provider prefix.
+func (s *IdsecWaitForDataSource) findDataSource(typeName string) (schemas.Tuple[*services.IdsecServiceConfig, *actions.IdsecServiceTerraformDataSourceActionDefinition], bool) {
+	actionName := strings.ReplaceAll(strings.TrimPrefix(typeName, "idsec_"), "_", "-")
+	for _, dataSourceDef := range s.dataSources {
+		if dataSourceDef.Second.ActionName == actionName {
+			return dataSourceDef, true
+		}
+	}
+	return schemas.Tuple[*services.IdsecServiceConfig, *actions.IdsecServiceTerraformDataSourceActionDefinition]{}, false
+}
+
+// parseDuration parses an optional duration attribute, falling back to the given default.
+func parseDuration(value types.String, defaultVal time.Duration) (time.Duration, error) {
+	if value.IsNull() || value.IsUnknown() || value.ValueString() == "" {
+		return defaultVal, nil
+	}
+	duration, err := time.ParseDuration(value.ValueString())
+	if err != nil {
+		return 0, err
+	}
+	if duration <= 0 {
+		return 0, fmt.Errorf("duration must be positive, got %s", value.ValueString())
+	}
+	return duration, nil
+}
+
+// pollValue calls the data source action once, through the actionCallMiddlewares of the reads of the
+// poller, and returns the current value of the watched attribute. The call is bounded by the timeout the
+// service_timeouts provider attribute sets for the service, if any.
+func pollValue(ctx context.Context, poller *IdsecResource, actionName string, input interface{}, attributePath string) (string, error) {
+	titleCase := cases.Title(language.English)
+	actionNameTitled := strings.ReplaceAll(titleCase.String(actionName), "-", "")
+	invoker := poller.getActionInvoker()
+	if invoker == nil {
+		return "", operationFailed("Service Error", "Service instance not configured")
+	}
+	actionMethod, err := invoker.actionMethod(actionNameTitled)
+	if err != nil {
+		return "", operationFailed("Action Method Error", fmt.Sprintf("Unable to find action method: %s", err.Error()))
+	}
+	var actionArgs []reflect.Value
+	if input != nil {
+		actionArgs = append(actionArgs, reflect.ValueOf(input))
+	}
+	if timeout, ok := poller.getSettings().serviceTimeout(poller.serviceConfig.ServiceName); ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	run := &operationRun{operation: actions.ReadOperation, actionName: actionName, input: input, diagnostics: &diag.Diagnostics{}}
+	result, err := poller.actionCall()(ctx, run, *actionMethod, actionArgs)
+	if err != nil {
+		return "", err
+	}
+	if err := callResultError(result); err != nil {
+		return "", err
+	}
+	if len(result) < 1 {
+		return "", fmt.Errorf("no result returned from action %s", actionName)
+	}
+	value, found := schemas.LookupAttributeValue(result[0].Interface(), attributePath)
+	if !found {
+		return "", fmt.Errorf("attribute %s not found in the result of action %s", attributePath, actionName)
+	}
+	return value, nil
+}
+
+// Read polls the data source until the attribute reaches the expected value or the timeout expires.
+func (s *IdsecWaitForDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config IdsecWaitForDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dataSourceDef, ok := s.findDataSource(config.DataSource.ValueString())
+	if !ok {
+		resp.Diagnostics.AddAttributeError(path.Root("data_source"), "Unknown Data Source", fmt.Sprintf("Data source %s is not supported by the provider.", config.DataSource.ValueString()))
+		return
+	}
+	timeout, err := parseDuration(config.Timeout, waitForDefaultTimeout)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("timeout"), "Invalid Timeout", err.Error())
+		return
+	}
+	pollInterval, err := parseDuration(config.PollInterval, waitForDefaultPollInterval)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("poll_interval"), "Invalid Poll Interval", err.Error())
+		return
+	}
+
+	poller := newWaitForPoller(dataSourceDef.First, s.settings)
+	if err := poller.configureService(s.idsecAPI); err != nil {
+		resp.Diagnostics.AddError("Service Configuration Error", fmt.Sprintf("Unable to configure service: %s", err.Error()))
+		return
+	}
+	ctx = poller.startOperation(ctx, poller.getService())
+	defer featureadoption.ReportOperationDefer(ctx, s.idsecAPI, &resp.Diagnostics, poller.buildFASTags(waitForDataSourceName, "Read"))()
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	actionDef := dataSourceDef.Second
+	var input interface{}
+	if inputSchema, _ := modelsactions.UnwrapSchema(actionDef.Schemas[actionDef.DataSourceAction]); inputSchema != nil {
+		inputValues := map[string]string{}
+		resp.Diagnostics.Append(config.Input.ElementsAs(ctx, &inputValues, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		input, err = schemas.StructFromStringMap(inputValues, inputSchema)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("input"), "Invalid Input", err.Error())
+			return
+		}
+	}
+
+	expected := config.ExpectedValue.ValueString()
+	attributePath := config.Attribute.ValueString()
+	deadline := time.Now().Add(timeout)
+	var lastValue string
+	var lastErr error
+	for {
+		lastValue, lastErr = pollValue(ctx, poller, actionDef.DataSourceAction, input, attributePath)
+		if lastErr == nil && strings.EqualFold(lastValue, expected) {
+			break
+		}
+		var failure *operationFailure
+		if errors.As(lastErr, &failure) {
+			addErrorWithCorrelation(ctx, &resp.Diagnostics, failure.summary, failure.detail)
+			return
+		}
+		if lastErr != nil && isServiceUnavailableError(lastErr) {
+			if poller.getSettings().ignoreUnavailableServices {
+				s.skipUnavailableService(ctx, poller, lastErr, config, resp)
+				return
+			}
+			addErrorWithCorrelation(ctx, &resp.Diagnostics, "Service Not Enabled", serviceUnavailableDetail(dataSourceDef.First.ServiceName, lastErr))
+			return
+		}
+		if lastErr != nil {
+			tflog.Debug(ctx, fmt.Sprintf("Polling %s failed: %s", actionDef.ActionName, lastErr.Error()))
+		} else {
+			tflog.Debug(ctx, fmt.Sprintf("Polling %s: %s is %q, waiting for %q", actionDef.ActionName, attributePath, lastValue, expected))
+		}
+		if time.Now().Add(pollInterval).After(deadline) {
+			detail := fmt.Sprintf("Attribute %s of %s did not reach %q within %s, last value was %q.", attributePath, config.DataSource.ValueString(), expected, timeout, lastValue)
+			if lastErr != nil {
+				detail = fmt.Sprintf("Attribute %s of %s did not reach %q within %s, last error: %s", attributePath, config.DataSource.ValueString(), expected, timeout, lastErr.Error())
+			}
+			addErrorWithCorrelation(ctx, &resp.Diagnostics, "Wait Timeout", detail)
+			return
+		}
+		select {
+		case <-ctx.Done():
+			addErrorWithCorrelation(ctx, &resp.Diagnostics, "Wait Cancelled", ctx.Err().Error())
+			return
+		case <-time.After(pollInterval):
+		}
+	}
+
+	config.ID = types.StringValue(fmt.Sprintf("%s:%s:%s", config.DataSource.ValueString(), attributePath, expected))
+	config.Value = types.StringValue(lastValue)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
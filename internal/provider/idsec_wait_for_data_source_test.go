@@ -0,0 +1,60 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type waitForTestConnector struct {
+	Status string `json:"status" mapstructure:"status"`
+}
+
+// waitForTestInvoker serves every action with the same method.
+type waitForTestInvoker struct {
+	method reflect.Value
+}
+
+func (i waitForTestInvoker) actionMethod(string) (*reflect.Value, error) {
+	return &i.method, nil
+}
+
+func TestPollValue(t *testing.T) {
+	calls := 0
+	breaker, err := newCircuitBreaker(1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	settings := newProviderSettings()
+	settings.circuitBreaker = breaker
+	poller := newWaitForPoller(CreateTestServiceConfig("test-service"), settings)
+	poller.invoker = waitForTestInvoker{method: reflect.ValueOf(func() (*waitForTestConnector, error) {
+		calls++
+		return nil, errors.New("failed to get connector - [503] - [Service Unavailable]")
+	})}
+
+	if _, err := pollValue(context.Background(), poller, "connector", nil, "status"); err == nil {
+		t.Fatal("expected the error of the action")
+	}
+	_, err = pollValue(context.Background(), poller, "connector", nil, "status")
+	var failure *operationFailure
+	if !errors.As(err, &failure) || failure.summary != "Service Unavailable" {
+		t.Errorf("expected the open circuit to fail the poll, got %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("expected the action to be called once, got %d calls", calls)
+	}
+
+	poller.invoker = waitForTestInvoker{method: reflect.ValueOf(func() (*waitForTestConnector, error) {
+		return &waitForTestConnector{Status: "ONLINE"}, nil
+	})}
+	settings.circuitBreaker = nil
+	value, err := pollValue(context.Background(), poller, "connector", nil, "status")
+	if err != nil || value != "ONLINE" {
+		t.Errorf("expected ONLINE, got %q, %v", value, err)
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// attributeValueAtPath returns the value of an attribute of an object value by its dotted path, e.g.
+// "source.id". It returns false when the path does not address an attribute of nested objects.
+func attributeValueAtPath(value tftypes.Value, attributePath string) (tftypes.Value, bool) {
+	path := tftypes.NewAttributePath()
+	for _, name := range strings.Split(attributePath, ".") {
+		path = path.WithAttributeName(name)
+	}
+	found, _, err := tftypes.WalkAttributePath(value, path)
+	if err != nil {
+		return tftypes.Value{}, false
+	}
+	attributeValue, ok := found.(tftypes.Value)
+	return attributeValue, ok
+}
+
+// immutableDriftedAttributes returns the sorted immutable attributes whose value read from the API
+// differs from the value in prior state. Attributes null or unknown in prior state, e.g. right after
+// an import, have nothing to drift from and are skipped.
+func immutableDriftedAttributes(prior, current tftypes.Value, immutable []string) []string {
+	if prior.IsNull() || !prior.IsKnown() || current.IsNull() || !current.IsKnown() {
+		return nil
+	}
+	var drifted []string
+	for _, attributePath := range immutable {
+		priorValue, ok := attributeValueAtPath(prior, attributePath)
+		if !ok || priorValue.IsNull() || !priorValue.IsFullyKnown() {
+			continue
+		}
+		currentValue, ok := attributeValueAtPath(current, attributePath)
+		if !ok || currentValue.Equal(priorValue) {
+			continue
+		}
+		drifted = append(drifted, attributePath)
+	}
+	sort.Strings(drifted)
+	return drifted
+}
+
+// warnImmutableDrift warns when a read finds immutable attributes changed outside of Terraform. The
+// immutable plan modifiers reject the next plan while the configuration holds the previous values, so
+// the warning explains the failure ahead of it and how to reconcile the resource.
+func (s *IdsecResource) warnImmutableDrift(ctx context.Context, prior, current tftypes.Value, diagnostics *diag.Diagnostics) {
+	drifted := immutableDriftedAttributes(prior, current, s.getImmutableAttributes())
+	if len(drifted) == 0 {
+		return
+	}
+	typeName := s.getTerraformTypeName(s.actionDefinition.ActionName)
+	tflog.Warn(ctx, fmt.Sprintf("Immutable attributes of %s changed outside of Terraform: %s", typeName, strings.Join(drifted, ", ")))
+	diagnostics.AddWarning(
+		"Immutable Attributes Changed Outside of Terraform",
+		fmt.Sprintf("The immutable attributes %s of %s were modified outside of Terraform, e.g. in the portal or through the API. "+
+			"Immutable attributes cannot be updated in place, so planning the previous values fails. "+
+			"Update the configuration to the values read from the API to keep the resource, "+
+			"replace it with terraform apply -replace to recreate it with the configured values, "+
+			"or remove it from state and import it again.",
+			strings.Join(drifted, ", "), typeName),
+	)
+}
@@ -0,0 +1,69 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestImmutableDriftedAttributes(t *testing.T) {
+	sourceType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.String}}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"name":        tftypes.String,
+		"member_type": tftypes.String,
+		"description": tftypes.String,
+		"source":      sourceType,
+	}}
+	object := func(name, memberType, description, sourceID interface{}) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"name":        tftypes.NewValue(tftypes.String, name),
+			"member_type": tftypes.NewValue(tftypes.String, memberType),
+			"description": tftypes.NewValue(tftypes.String, description),
+			"source":      tftypes.NewValue(sourceType, map[string]tftypes.Value{"id": tftypes.NewValue(tftypes.String, sourceID)}),
+		})
+	}
+	immutable := []string{"name", "member_type", "source.id", "missing.path"}
+	tests := []struct {
+		name    string
+		prior   tftypes.Value
+		current tftypes.Value
+		want    []string
+	}{
+		{
+			name:    "immutable_changed_out_of_band",
+			prior:   object("alice", "User", "old", "src-1"),
+			current: object("alice", "Group", "new", "src-2"),
+			want:    []string{"member_type", "source.id"},
+		},
+		{
+			name:    "mutable_changes_ignored",
+			prior:   object("alice", "User", "old", "src-1"),
+			current: object("alice", "User", "new", "src-1"),
+			want:    nil,
+		},
+		{
+			name:    "imported_state_has_nothing_to_drift_from",
+			prior:   object("alice", nil, nil, nil),
+			current: object("alice", "User", "new", "src-1"),
+			want:    nil,
+		},
+		{
+			name:    "resource_gone",
+			prior:   object("alice", "User", "old", "src-1"),
+			current: tftypes.NewValue(objectType, nil),
+			want:    nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := immutableDriftedAttributes(tt.prior, tt.current, immutable)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
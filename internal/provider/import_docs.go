@@ -0,0 +1,129 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/cyberark/terraform-provider-idsec/internal/actions"
+	"github.com/cyberark/terraform-provider-idsec/internal/schemas"
+)
+
+// ResourceImportDoc describes how a resource is imported, as documented in the Import section of its
+// page. It is built from the ImportID key spec of the action definition of the resource.
+type ResourceImportDoc struct {
+	// TypeName is the Terraform type name of the resource, e.g. "idsec_pcloud_safe_member".
+	TypeName string
+	// Attributes are the ImportID attributes the import ID holds the values of, in order.
+	Attributes []string
+	// Delimiter separates the values of a composite import ID.
+	Delimiter string
+	// Singleton is true for resources with a single instance per tenant, imported with a fixed ID.
+	Singleton bool
+	// HasIdentity is true when the resource exposes a resource identity, so it can be imported by identity.
+	HasIdentity bool
+}
+
+// ResourceImportDocs returns the import documentation of the resources supporting import, sorted by type name.
+func ResourceImportDocs(providerTypeName string) []ResourceImportDoc {
+	p := &IdsecProvider{}
+	var docs []ResourceImportDoc
+	for _, resourceDef := range p.collectTfResources() {
+		definition := resourceDef.Second
+		if definition.ImportID == "" || !slices.Contains(definition.SupportedOperations, actions.ReadOperation) {
+			continue
+		}
+		delimiter := definition.ImportIDDelimiter
+		if delimiter == "" {
+			delimiter = schemas.DefaultImportIDDelimiter
+		}
+		docs = append(docs, ResourceImportDoc{
+			TypeName:    fmt.Sprintf("%s_%s", providerTypeName, strings.ReplaceAll(definition.ActionName, "-", "_")),
+			Attributes:  schemas.SplitImportIDAttributes(definition.ImportID),
+			Delimiter:   delimiter,
+			Singleton:   definition.ImportID == actions.SingletonResourceImportDummyID,
+			HasIdentity: definition.ListAction != "",
+		})
+	}
+	sort.Slice(docs, func(i, j int) bool {
+		return docs[i].TypeName < docs[j].TypeName
+	})
+	return docs
+}
+
+// ExampleID returns an import ID of placeholder values, e.g. "safe-id-123:member-name-123".
+func (d ResourceImportDoc) ExampleID() string {
+	if d.Singleton {
+		return actions.SingletonResourceImportDummyID
+	}
+	values := make([]string, len(d.Attributes))
+	for i, attr := range d.Attributes {
+		attr = attr[strings.LastIndex(attr, ".")+1:]
+		values[i] = strings.ReplaceAll(attr, "_", "-") + "-123"
+	}
+	return schemas.FormatCompositeImportID(values, d.Delimiter)
+}
+
+// ParseID splits an import ID into one value per import attribute, failing when it does not match the key spec.
+func (d ResourceImportDoc) ParseID(importID string) ([]string, error) {
+	if d.Singleton {
+		if importID != actions.SingletonResourceImportDummyID {
+			return nil, fmt.Errorf("singleton resources are imported with the ID %q", actions.SingletonResourceImportDummyID)
+		}
+		return []string{importID}, nil
+	}
+	return schemas.ParseCompositeImportID(importID, d.Attributes, d.Delimiter)
+}
+
+// idFormat describes the import ID in a sentence of the Import section.
+func (d ResourceImportDoc) idFormat() string {
+	if d.Singleton {
+		return fmt.Sprintf("the ID `%s`, as a tenant has a single instance of it,", actions.SingletonResourceImportDummyID)
+	}
+	quoted := make([]string, len(d.Attributes))
+	for i, attr := range d.Attributes {
+		quoted[i] = "`" + attr + "`"
+	}
+	if len(quoted) == 1 {
+		return "its " + quoted[0]
+	}
+	return fmt.Sprintf("its %s and %s, separated by `%s`,", strings.Join(quoted[:len(quoted)-1], ", "), quoted[len(quoted)-1], d.Delimiter)
+}
+
+// Markdown renders the Import section of the resource page, importing the resource resourceName with importID.
+func (d ResourceImportDoc) Markdown(resourceName string, importID string) (string, error) {
+	values, err := d.ParseID(importID)
+	if err != nil {
+		return "", err
+	}
+	address := d.TypeName + "." + resourceName
+	var section strings.Builder
+	section.WriteString("## Import\n\n")
+	fmt.Fprintf(&section, "The `%s` resource can be imported using %s with the following command:\n\n", d.TypeName, d.idFormat())
+	fmt.Fprintf(&section, "```shell\n%s\n```\n\n", ImportCommand(address, importID))
+	section.WriteString("In Terraform v1.5.0 and later, an `import` block can be used instead:\n\n")
+	fmt.Fprintf(&section, "```terraform\nimport {\n  to = %s\n  id = %q\n}\n```\n", address, importID)
+	if !d.HasIdentity || d.Singleton {
+		return section.String(), nil
+	}
+	section.WriteString("\nIn Terraform v1.12.0 and later, the `import` block can identify the resource by its identity instead:\n\n")
+	fmt.Fprintf(&section, "```terraform\nimport {\n  to = %s\n  identity = {\n", address)
+	width := 0
+	for _, attr := range d.Attributes {
+		width = max(width, len(identityAttributeName(attr)))
+	}
+	for i, attr := range d.Attributes {
+		fmt.Fprintf(&section, "    %-*s = %q\n", width, identityAttributeName(attr), values[i])
+	}
+	section.WriteString("  }\n}\n```\n")
+	return section.String(), nil
+}
+
+// ImportCommand returns the terraform import command importing the resource at address with importID.
+func ImportCommand(address string, importID string) string {
+	return fmt.Sprintf("terraform import %s %s", address, importID)
+}
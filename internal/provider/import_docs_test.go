@@ -0,0 +1,106 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestResourceImportDocMarkdown(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		doc      ResourceImportDoc
+		importID string
+		want     []string
+		notWant  []string
+		wantErr  bool
+	}{
+		{
+			name:     "composite_id",
+			doc:      ResourceImportDoc{TypeName: "idsec_pcloud_safe_member", Attributes: []string{"safe_id", "member_name"}, Delimiter: ":"},
+			importID: "safe-1:alice",
+			want: []string{
+				"using its `safe_id` and `member_name`, separated by `:`, with the following command",
+				"terraform import idsec_pcloud_safe_member.example safe-1:alice",
+				"  id = \"safe-1:alice\"",
+			},
+			notWant: []string{"identity = {"},
+		},
+		{
+			name:     "identity",
+			doc:      ResourceImportDoc{TypeName: "idsec_policy_db", Attributes: []string{"metadata.policy_id", "name"}, Delimiter: "/", HasIdentity: true},
+			importID: "policy-1/db",
+			want: []string{
+				"    metadata_policy_id = \"policy-1\"\n    name               = \"db\"\n",
+			},
+		},
+		{
+			name:     "singleton",
+			doc:      ResourceImportDoc{TypeName: "idsec_sia_settings_settings", Attributes: []string{"singleton"}, Delimiter: ":", Singleton: true, HasIdentity: true},
+			importID: "singleton",
+			want:     []string{"using the ID `singleton`"},
+			notWant:  []string{"identity = {"},
+		},
+		{
+			name:     "id_not_matching_key_spec",
+			doc:      ResourceImportDoc{TypeName: "idsec_pcloud_safe_member", Attributes: []string{"safe_id", "member_name"}, Delimiter: ":"},
+			importID: "safe-1",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			section, err := tt.doc.Markdown("example", tt.importID)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error for an import ID not matching the key spec")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(section, want) {
+					t.Errorf("expected section to contain %q, got:\n%s", want, section)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if strings.Contains(section, notWant) {
+					t.Errorf("expected section not to contain %q, got:\n%s", notWant, section)
+				}
+			}
+		})
+	}
+}
+
+func TestResourceImportDocExampleID(t *testing.T) {
+	t.Parallel()
+
+	doc := ResourceImportDoc{Attributes: []string{"metadata.policy_id", "member_name"}, Delimiter: ":"}
+	if id := doc.ExampleID(); id != "policy-id-123:member-name-123" {
+		t.Errorf("expected example ID %q, got %q", "policy-id-123:member-name-123", id)
+	}
+	if _, err := doc.ParseID(doc.ExampleID()); err != nil {
+		t.Errorf("expected the example ID to match the key spec: %v", err)
+	}
+}
+
+func TestResourceImportDocsSupportImport(t *testing.T) {
+	t.Parallel()
+
+	docs := ResourceImportDocs("idsec")
+	if len(docs) == 0 {
+		t.Fatal("expected resources supporting import")
+	}
+	for _, doc := range docs {
+		if !strings.HasPrefix(doc.TypeName, "idsec_") || len(doc.Attributes) == 0 {
+			t.Errorf("unexpected import documentation %+v", doc)
+		}
+	}
+}
@@ -0,0 +1,42 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/cyberark/terraform-provider-idsec/internal/schemas"
+)
+
+var (
+	loadDescriptionTranslationsOnce sync.Once
+	descriptionTranslationsErr      error
+)
+
+// localizeSchema translates the descriptions of a schema with the translation table named by
+// IDSEC_DESCRIPTION_TRANSLATIONS. The table is loaded once per provider process, since schemas are
+// served before the provider is configured. A table that cannot be loaded is reported as a warning
+// and the original descriptions are kept.
+func localizeSchema(schema interface{}, diagnostics *diag.Diagnostics) {
+	loadDescriptionTranslationsOnce.Do(func() {
+		path := os.Getenv(IdsecDescriptionTranslationsEnvVar)
+		if path == "" {
+			return
+		}
+		table, err := schemas.LoadDescriptionTable(path)
+		if err != nil {
+			descriptionTranslationsErr = err
+			return
+		}
+		schemas.SetDescriptionLocalizer(table)
+	})
+	if descriptionTranslationsErr != nil {
+		diagnostics.AddWarning("Description Translations Error", fmt.Sprintf("Schema descriptions are not translated: %s", descriptionTranslationsErr.Error()))
+		return
+	}
+	schemas.LocalizeSchemaDescriptions(schema)
+}
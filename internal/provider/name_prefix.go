@@ -0,0 +1,78 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/cyberark/terraform-provider-idsec/internal/schemas"
+)
+
+// attributeGetter reads an attribute of a configuration or plan.
+type attributeGetter interface {
+	GetAttribute(ctx context.Context, path path.Path, target interface{}) diag.Diagnostics
+}
+
+// nameAndPrefix returns the name attribute of the action definition and its prefix attribute as set in
+// a configuration or plan. The last return value is false when the resource has no name prefix.
+func (s *IdsecResource) nameAndPrefix(ctx context.Context, source attributeGetter) (types.String, types.String, bool) {
+	nameAttribute := s.actionDefinition.NamePrefixAttribute
+	if nameAttribute == "" {
+		return types.String{}, types.String{}, false
+	}
+	var name, prefix types.String
+	if diags := source.GetAttribute(ctx, path.Root(nameAttribute), &name); diags.HasError() {
+		return name, prefix, false
+	}
+	if diags := source.GetAttribute(ctx, path.Root(schemas.NamePrefixAttributeName(nameAttribute)), &prefix); diags.HasError() {
+		return name, prefix, false
+	}
+	return name, prefix, true
+}
+
+// applyNamePrefixPlaceholder rejects configurations setting both the name and its prefix. When only the
+// prefix is set, a name of the length of the generated ones is set on the decoded create input, so the
+// SDK validation rules of the name, such as a required name or a maximum length, apply to the prefix.
+func (s *IdsecResource) applyNamePrefixPlaceholder(ctx context.Context, config *tfsdk.Config, input interface{}, diagnostics *diag.Diagnostics) {
+	name, prefix, ok := s.nameAndPrefix(ctx, config)
+	if !ok || prefix.IsNull() {
+		return
+	}
+	nameAttribute := s.actionDefinition.NamePrefixAttribute
+	prefixAttribute := schemas.NamePrefixAttributeName(nameAttribute)
+	if !name.IsNull() {
+		diagnostics.AddAttributeError(
+			path.Root(prefixAttribute),
+			"Conflicting Name Attributes",
+			fmt.Sprintf("Only one of %s and %s can be set.", nameAttribute, prefixAttribute),
+		)
+		return
+	}
+	if prefix.IsUnknown() {
+		return
+	}
+	schemas.SetEmptyStringAttribute(input, nameAttribute, prefix.ValueString()+strings.Repeat("0", schemas.NamePrefixSuffixLength))
+}
+
+// generatePrefixedName sets the planned name of a resource being created to a unique name beginning with
+// its prefix, when the name is not configured. The name is generated at apply time rather than while
+// planning, since the plan is computed again on apply and must not change; it is then kept in state and
+// carried over to later plans.
+func (s *IdsecResource) generatePrefixedName(ctx context.Context, plan *tfsdk.Plan, diagnostics *diag.Diagnostics) {
+	name, prefix, ok := s.nameAndPrefix(ctx, plan)
+	if !ok || !name.IsUnknown() || prefix.IsNull() || prefix.IsUnknown() {
+		return
+	}
+	nameAttribute := s.actionDefinition.NamePrefixAttribute
+	generated := schemas.PrefixedUniqueName(prefix.ValueString())
+	tflog.Info(ctx, fmt.Sprintf("Generated %s %s from prefix %s", nameAttribute, generated, prefix.ValueString()))
+	diagnostics.Append(plan.SetAttribute(ctx, path.Root(nameAttribute), generated)...)
+}
@@ -0,0 +1,126 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/cyberark/idsec-sdk-golang/pkg/validation"
+	"github.com/cyberark/terraform-provider-idsec/internal/actions"
+	"github.com/cyberark/terraform-provider-idsec/internal/schemas"
+)
+
+type namePrefixTestModel struct {
+	SafeName    string `json:"safe_name" mapstructure:"safe_name" validate:"required,max=40"`
+	Description string `json:"description,omitempty" mapstructure:"description"`
+}
+
+func namePrefixTestResource(t *testing.T) (*IdsecResource, resource.SchemaResponse) {
+	t.Helper()
+	operations := []actions.IdsecServiceActionOperation{actions.CreateOperation, actions.ReadOperation, actions.DeleteOperation}
+	return CreateTestResourceWithSchema(t, &namePrefixTestModel{}, operations, func(actionDefinition *actions.IdsecServiceTerraformResourceActionDefinition) {
+		actionDefinition.NamePrefixAttribute = "safe_name"
+	})
+}
+
+func namePrefixTestValue(schemaResp resource.SchemaResponse, name, prefix interface{}) tftypes.Value {
+	return CreateTestResourceValue(schemaResp, map[string]tftypes.Value{
+		"safe_name":        tftypes.NewValue(tftypes.String, name),
+		"safe_name_prefix": tftypes.NewValue(tftypes.String, prefix),
+	})
+}
+
+func TestIdsecResource_NamePrefixSchema(t *testing.T) {
+	_, schemaResp := namePrefixTestResource(t)
+	name := schemaResp.Schema.Attributes["safe_name"]
+	if name.IsRequired() || !name.IsOptional() || !name.IsComputed() {
+		t.Errorf("expected safe_name to be optional and computed, got %+v", name)
+	}
+	if _, ok := schemaResp.Schema.Attributes["safe_name_prefix"]; !ok {
+		t.Error("expected safe_name_prefix attribute")
+	}
+}
+
+func TestIdsecResource_ApplyNamePrefixPlaceholder(t *testing.T) {
+	tests := []struct {
+		name      string
+		safeName  interface{}
+		prefix    interface{}
+		wantError string
+	}{
+		{name: "prefix_only", prefix: "team-"},
+		{name: "name_only", safeName: "team-safe"},
+		{name: "both", safeName: "team-safe", prefix: "team-", wantError: "Conflicting Name Attributes"},
+		{name: "prefix_too_long", prefix: strings.Repeat("a", 30), wantError: "safe_name"},
+		{name: "neither", wantError: "safe_name"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			idsecRes, schemaResp := namePrefixTestResource(t)
+			config := tfsdk.Config{Schema: schemaResp.Schema, Raw: namePrefixTestValue(schemaResp, tt.safeName, tt.prefix)}
+			resp := &resource.ValidateConfigResponse{}
+			input, err := schemas.StructFromConfigObject(context.Background(), &config, &namePrefixTestModel{})
+			if err != nil {
+				t.Fatalf("unexpected decode error: %v", err)
+			}
+			idsecRes.applyNamePrefixPlaceholder(context.Background(), &config, input, &resp.Diagnostics)
+			if !resp.Diagnostics.HasError() {
+				if err := validation.ValidateStruct(input); err != nil {
+					appendValidationDiagnostics(&resp.Diagnostics, err)
+				}
+			}
+			if tt.wantError == "" {
+				if resp.Diagnostics.HasError() {
+					t.Errorf("expected no errors, got %v", resp.Diagnostics)
+				}
+				return
+			}
+			if !resp.Diagnostics.HasError() {
+				t.Fatalf("expected an error containing %q", tt.wantError)
+			}
+			errors := resp.Diagnostics.Errors()
+			if got := errors[0].Summary() + " " + errors[0].Detail(); !strings.Contains(got, tt.wantError) {
+				t.Errorf("expected an error containing %q, got %q", tt.wantError, got)
+			}
+		})
+	}
+}
+
+func TestIdsecResource_GeneratePrefixedName(t *testing.T) {
+	idsecRes, schemaResp := namePrefixTestResource(t)
+	tests := []struct {
+		name       string
+		safeName   interface{}
+		prefix     interface{}
+		wantPrefix string
+		want       string
+	}{
+		{name: "generated", safeName: tftypes.UnknownValue, prefix: "team-", wantPrefix: "team-"},
+		{name: "configured_name", safeName: "team-safe", want: "team-safe"},
+		{name: "kept_from_state", safeName: "team-20260101000000abcdef01", prefix: "team-", want: "team-20260101000000abcdef01"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: namePrefixTestValue(schemaResp, tt.safeName, tt.prefix)}
+			diags := &diag.Diagnostics{}
+			idsecRes.generatePrefixedName(context.Background(), &plan, diags)
+			if diags.HasError() {
+				t.Fatalf("unexpected errors: %v", diags)
+			}
+			name, _, _ := idsecRes.nameAndPrefix(context.Background(), &plan)
+			if tt.want != "" && name.ValueString() != tt.want {
+				t.Errorf("expected %q, got %q", tt.want, name.ValueString())
+			}
+			if tt.wantPrefix != "" && (!strings.HasPrefix(name.ValueString(), tt.wantPrefix) || len(name.ValueString()) != len(tt.wantPrefix)+schemas.NamePrefixSuffixLength) {
+				t.Errorf("expected a generated name beginning with %q, got %q", tt.wantPrefix, name.ValueString())
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	sdkconfig "github.com/cyberark/idsec-sdk-golang/pkg/config"
+	"github.com/cyberark/terraform-provider-idsec/internal/featureadoption"
+)
+
+// applyOfflineMode turns off, or back on, the outbound calls of the provider other than those to the
+// tenant APIs: the feature adoption reports sent after each operation, and the telemetry of the SDK
+// clients, whose environment detection probes the metadata endpoints of cloud instances. The proxy,
+// secret source and operation hook endpoints are only contacted when configured.
+func applyOfflineMode(offline bool) {
+	if offline {
+		sdkconfig.DisableTelemetryCollection()
+	} else {
+		sdkconfig.EnableTelemetryCollection()
+	}
+	featureadoption.SetDisabled(offline)
+}
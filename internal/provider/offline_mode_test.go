@@ -0,0 +1,74 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/cyberark/idsec-sdk-golang/pkg/common"
+	sdkconfig "github.com/cyberark/idsec-sdk-golang/pkg/config"
+)
+
+// egressRecorder is an HTTP proxy recording the hosts requests are sent to, without forwarding them.
+type egressRecorder struct {
+	mu    sync.Mutex
+	hosts []string
+}
+
+func (r *egressRecorder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	r.hosts = append(r.hosts, req.Host)
+	r.mu.Unlock()
+	w.WriteHeader(http.StatusBadGateway)
+}
+
+func (r *egressRecorder) recorded() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.hosts)
+}
+
+// TestOfflineModeEgress tests that with offline_mode, a request of an SDK client only contacts the
+// tenant, as recorded by a proxy all SDK transports are routed through, while it also probes the cloud
+// metadata endpoints for telemetry otherwise.
+func TestOfflineModeEgress(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	for _, envVar := range []string{"HTTP_PROXY", "HTTPS_PROXY", "NO_PROXY", "http_proxy", "https_proxy", "no_proxy", sdkconfig.IdsecDisableTelemetryCollectionEnvVar} {
+		t.Setenv(envVar, "")
+	}
+	recorder := &egressRecorder{}
+	proxy := httptest.NewServer(recorder)
+	defer proxy.Close()
+	sdkconfig.SetProxyAddress(proxy.URL)
+	t.Cleanup(func() {
+		sdkconfig.SetProxyAddress("")
+		applyOfflineMode(false)
+	})
+
+	contacted := func(offline bool) []string {
+		applyOfflineMode(offline)
+		recorder.mu.Lock()
+		recorder.hosts = nil
+		recorder.mu.Unlock()
+		client := common.NewIdsecClient("tenant.example.com", "token", "Bearer", "Authorization", nil, nil, "test", true)
+		if resp, err := client.Get(context.Background(), "/api/items", nil); err == nil {
+			_ = resp.Body.Close()
+		}
+		hosts := recorder.recorded()
+		slices.Sort(hosts)
+		return slices.Compact(hosts)
+	}
+
+	if hosts := contacted(true); !slices.Equal(hosts, []string{"tenant.example.com:443"}) {
+		t.Errorf("expected only the tenant to be contacted in offline mode, got %v", hosts)
+	}
+	if hosts := contacted(false); len(hosts) < 2 {
+		t.Errorf("expected the telemetry to contact other endpoints than the tenant, got %v", hosts)
+	}
+}
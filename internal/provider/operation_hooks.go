@@ -0,0 +1,252 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os/exec"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/cyberark/terraform-provider-idsec/internal/actions"
+)
+
+// Phases of an operation hook invocation.
+const (
+	operationHookPhasePre  = "pre"
+	operationHookPhasePost = "post"
+)
+
+// defaultOperationHookTimeout bounds a hook invocation when the operation_hooks block sets no timeout.
+const defaultOperationHookTimeout = 30 * time.Second
+
+// IdsecOperationHooksModel describes the operation_hooks block of the provider configuration.
+type IdsecOperationHooksModel struct {
+	Command    types.List   `tfsdk:"command"`
+	WebhookURL types.String `tfsdk:"webhook_url"`
+	Timeout    types.String `tfsdk:"timeout"`
+}
+
+// operationHookConfig is the parsed operation_hooks block. Hooks run the command, post to the webhook,
+// or both, each receiving the JSON payload of the operation.
+type operationHookConfig struct {
+	command    []string
+	webhookURL string
+	timeout    time.Duration
+	// userAgent is the User-Agent of the webhook requests.
+	userAgent string
+}
+
+// operationHookPayload is the JSON document passed to hooks. It names the changed attributes without
+// their values, so secrets never leave the provider.
+type operationHookPayload struct {
+	Phase             string   `json:"phase"`
+	ResourceType      string   `json:"resource_type"`
+	Operation         string   `json:"operation"`
+	CorrelationID     string   `json:"correlation_id,omitempty"`
+	ChangeReason      string   `json:"change_reason,omitempty"`
+	ChangedAttributes []string `json:"changed_attributes"`
+	Succeeded         *bool    `json:"succeeded,omitempty"`
+	Error             string   `json:"error,omitempty"`
+}
+
+// newOperationHookConfig validates the operation_hooks block, whose webhook requests carry userAgent. It
+// returns nil when the block is not set.
+func newOperationHookConfig(ctx context.Context, hooks types.Object, userAgent string) (*operationHookConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if hooks.IsNull() || hooks.IsUnknown() {
+		return nil, diags
+	}
+	var model IdsecOperationHooksModel
+	diags.Append(hooks.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	config := &operationHookConfig{
+		webhookURL: strings.TrimSpace(model.WebhookURL.ValueString()),
+		timeout:    defaultOperationHookTimeout,
+		userAgent:  userAgent,
+	}
+	if !model.Command.IsNull() && !model.Command.IsUnknown() {
+		diags.Append(model.Command.ElementsAs(ctx, &config.command, false)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+	if len(config.command) == 0 && config.webhookURL == "" {
+		diags.AddError("Invalid Configuration", "operation_hooks requires at least one of command or webhook_url.")
+		return nil, diags
+	}
+	if len(config.command) > 0 && strings.TrimSpace(config.command[0]) == "" {
+		diags.AddError("Invalid Configuration", "The first element of operation_hooks.command must name an executable.")
+		return nil, diags
+	}
+	if config.webhookURL != "" && !strings.HasPrefix(config.webhookURL, "https://") && !strings.HasPrefix(config.webhookURL, "http://") {
+		diags.AddError("Invalid Configuration", fmt.Sprintf("operation_hooks.webhook_url must be an http or https URL, got %q.", config.webhookURL))
+		return nil, diags
+	}
+	if timeout := model.Timeout.ValueString(); timeout != "" {
+		parsed, err := time.ParseDuration(timeout)
+		if err != nil || parsed <= 0 {
+			diags.AddError("Invalid Configuration", fmt.Sprintf("operation_hooks.timeout must be a positive duration such as 30s, got %q.", timeout))
+			return nil, diags
+		}
+		config.timeout = parsed
+	}
+	return config, diags
+}
+
+// changedAttributes summarizes the diff of an operation as the sorted names of the top-level attributes
+// whose planned value differs from the prior state. A null plan or state, as for creates and deletes,
+// counts every non-null attribute of the other side as changed.
+func changedAttributes(plan, state tftypes.Value) []string {
+	planAttributes := topLevelAttributes(plan)
+	stateAttributes := topLevelAttributes(state)
+	names := map[string]bool{}
+	for name, planned := range planAttributes {
+		prior, ok := stateAttributes[name]
+		if !ok {
+			if !planned.IsNull() {
+				names[name] = true
+			}
+			continue
+		}
+		if !planned.Equal(prior) {
+			names[name] = true
+		}
+	}
+	for name, prior := range stateAttributes {
+		if _, ok := planAttributes[name]; !ok && !prior.IsNull() {
+			names[name] = true
+		}
+	}
+	changed := make([]string, 0, len(names))
+	for name := range names {
+		changed = append(changed, name)
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+// invoke runs the hooks with payload, returning the first failure.
+func (c *operationHookConfig) invoke(ctx context.Context, payload operationHookPayload) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode hook payload: %w", err)
+	}
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	if len(c.command) > 0 {
+		if err := c.runCommand(ctx, body); err != nil {
+			return err
+		}
+	}
+	if c.webhookURL != "" {
+		if err := c.postWebhook(ctx, body); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// runCommand runs the hook command with the payload on its standard input. A non-zero exit status fails the hook.
+func (c *operationHookConfig) runCommand(ctx context.Context, body []byte) error {
+	cmd := exec.CommandContext(ctx, c.command[0], c.command[1:]...)
+	cmd.Stdin = bytes.NewReader(body)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		message := strings.TrimSpace(string(output))
+		if message == "" {
+			return fmt.Errorf("hook command %s failed: %w", c.command[0], err)
+		}
+		return fmt.Errorf("hook command %s failed: %w: %s", c.command[0], err, message)
+	}
+	return nil
+}
+
+// postWebhook posts the payload to the hook webhook. A non-2xx response fails the hook.
+func (c *operationHookConfig) postWebhook(ctx context.Context, body []byte) error {
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, c.webhookURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build hook webhook request: %w", err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if c.userAgent != "" {
+		request.Header.Set("User-Agent", c.userAgent)
+	}
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return fmt.Errorf("hook webhook request failed: %w", err)
+	}
+	defer response.Body.Close()
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		message, _ := io.ReadAll(io.LimitReader(response.Body, 1024))
+		return fmt.Errorf("hook webhook returned [%d] - [%s]", response.StatusCode, strings.TrimSpace(string(message)))
+	}
+	return nil
+}
+
+// newOperationHookPayload builds the payload of a resource operation from its plan and prior state.
+func (s *IdsecResource) newOperationHookPayload(operation actions.IdsecServiceActionOperation, plan, state tftypes.Value) operationHookPayload {
+	return operationHookPayload{
+		ResourceType:      s.getTerraformTypeName(s.actionDefinition.ActionName),
+		Operation:         string(operation),
+		CorrelationID:     s.getSettings().correlationID,
+		ChangeReason:      s.getSettings().changeReason,
+		ChangedAttributes: changedAttributes(plan, state),
+	}
+}
+
+// runPreOperationHooks invokes the configured hooks before a change. A failing hook aborts the operation,
+// letting change-management systems gate changes, e.g. on an approved ticket.
+func (s *IdsecResource) runPreOperationHooks(ctx context.Context, payload operationHookPayload, diagnostics *diag.Diagnostics) {
+	operationHooks := s.getSettings().operationHooks
+	if operationHooks == nil {
+		return
+	}
+	payload.Phase = operationHookPhasePre
+	tflog.Debug(ctx, fmt.Sprintf("Running pre-%s hooks of %s", payload.Operation, payload.ResourceType))
+	if err := operationHooks.invoke(ctx, payload); err != nil {
+		diagnostics.AddError(
+			"Operation Hook Failed",
+			fmt.Sprintf("The pre-%s hook rejected the change to %s, the operation was not performed: %s", payload.Operation, payload.ResourceType, err.Error()),
+		)
+	}
+}
+
+// runPostOperationHooks invokes the configured hooks after a change with its outcome. The change already
+// happened, so a failing hook is only reported as a warning.
+func (s *IdsecResource) runPostOperationHooks(ctx context.Context, payload operationHookPayload, diagnostics *diag.Diagnostics) {
+	operationHooks := s.getSettings().operationHooks
+	if operationHooks == nil {
+		return
+	}
+	payload.Phase = operationHookPhasePost
+	succeeded := !diagnostics.HasError()
+	payload.Succeeded = &succeeded
+	if !succeeded {
+		for _, d := range diagnostics.Errors() {
+			payload.Error = d.Summary() + ": " + d.Detail()
+			break
+		}
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Running post-%s hooks of %s", payload.Operation, payload.ResourceType))
+	if err := operationHooks.invoke(ctx, payload); err != nil {
+		diagnostics.AddWarning(
+			"Operation Hook Failed",
+			fmt.Sprintf("The post-%s hook of %s failed: %s", payload.Operation, payload.ResourceType, err.Error()),
+		)
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/cyberark/terraform-provider-idsec/internal/actions"
+)
+
+func TestNewOperationHookConfig(t *testing.T) {
+	attrTypes := map[string]attr.Type{
+		"command":     types.ListType{ElemType: types.StringType},
+		"webhook_url": types.StringType,
+		"timeout":     types.StringType,
+	}
+	hooks := func(command []string, webhookURL, timeout string) types.Object {
+		commandValue := types.ListNull(types.StringType)
+		if command != nil {
+			commandValue, _ = types.ListValueFrom(context.Background(), types.StringType, command)
+		}
+		webhookValue := types.StringNull()
+		if webhookURL != "" {
+			webhookValue = types.StringValue(webhookURL)
+		}
+		timeoutValue := types.StringNull()
+		if timeout != "" {
+			timeoutValue = types.StringValue(timeout)
+		}
+		obj, _ := types.ObjectValue(attrTypes, map[string]attr.Value{
+			"command":     commandValue,
+			"webhook_url": webhookValue,
+			"timeout":     timeoutValue,
+		})
+		return obj
+	}
+	tests := []struct {
+		name        string
+		hooks       types.Object
+		wantNil     bool
+		wantError   bool
+		wantTimeout time.Duration
+	}{
+		{name: "success_not_set", hooks: types.ObjectNull(attrTypes), wantNil: true},
+		{name: "success_command", hooks: hooks([]string{"check-ticket", "--strict"}, "", ""), wantTimeout: defaultOperationHookTimeout},
+		{name: "success_webhook_with_timeout", hooks: hooks(nil, "https://hooks.example.com/change", "5s"), wantTimeout: 5 * time.Second},
+		{name: "error_no_hook", hooks: hooks(nil, "", "5s"), wantError: true},
+		{name: "error_empty_executable", hooks: hooks([]string{""}, "", ""), wantError: true},
+		{name: "error_invalid_url", hooks: hooks(nil, "hooks.example.com", ""), wantError: true},
+		{name: "error_invalid_timeout", hooks: hooks([]string{"check-ticket"}, "", "soon"), wantError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, diags := newOperationHookConfig(context.Background(), tt.hooks, "")
+			if diags.HasError() != tt.wantError {
+				t.Fatalf("expected error=%v, got %v", tt.wantError, diags)
+			}
+			if tt.wantError {
+				return
+			}
+			if (config == nil) != tt.wantNil {
+				t.Fatalf("expected nil config=%v, got %v", tt.wantNil, config)
+			}
+			if config != nil && config.timeout != tt.wantTimeout {
+				t.Errorf("expected timeout %s, got %s", tt.wantTimeout, config.timeout)
+			}
+		})
+	}
+}
+
+func TestChangedAttributes(t *testing.T) {
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"name":        tftypes.String,
+		"description": tftypes.String,
+		"secret":      tftypes.String,
+	}}
+	object := func(name, description, secret interface{}) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"name":        tftypes.NewValue(tftypes.String, name),
+			"description": tftypes.NewValue(tftypes.String, description),
+			"secret":      tftypes.NewValue(tftypes.String, secret),
+		})
+	}
+	tests := []struct {
+		name  string
+		plan  tftypes.Value
+		state tftypes.Value
+		want  []string
+	}{
+		{name: "create", plan: object("safe", nil, "s3cr3t"), state: tftypes.Value{}, want: []string{"name", "secret"}},
+		{name: "update", plan: object("safe", "new", "rotated"), state: object("safe", "old", "s3cr3t"), want: []string{"description", "secret"}},
+		{name: "update_no_change", plan: object("safe", "old", nil), state: object("safe", "old", nil), want: []string{}},
+		{name: "delete", plan: tftypes.Value{}, state: object("safe", "old", nil), want: []string{"description", "name"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := changedAttributes(tt.plan, tt.state); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestOperationHookConfigInvokeCommand(t *testing.T) {
+	payloadFile := filepath.Join(t.TempDir(), "payload.json")
+	config := &operationHookConfig{
+		command: []string{"sh", "-c", `cat > "$0"`, payloadFile},
+		timeout: 5 * time.Second,
+	}
+	payload := operationHookPayload{Phase: operationHookPhasePre, ResourceType: "idsec_pcloud_safe", Operation: "update", ChangedAttributes: []string{"description"}}
+	if err := config.invoke(context.Background(), payload); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	content, err := os.ReadFile(payloadFile)
+	if err != nil {
+		t.Fatalf("failed to read payload: %v", err)
+	}
+	var received operationHookPayload
+	if err := json.Unmarshal(content, &received); err != nil {
+		t.Fatalf("failed to decode payload: %v", err)
+	}
+	if !reflect.DeepEqual(received, payload) {
+		t.Errorf("expected payload %+v, got %+v", payload, received)
+	}
+
+	config.command = []string{"sh", "-c", "echo ticket CHG0001 not approved; exit 1"}
+	if err := config.invoke(context.Background(), payload); err == nil {
+		t.Error("expected a failing command to fail the hook")
+	}
+}
+
+func TestOperationHookConfigInvokeWebhook(t *testing.T) {
+	var received operationHookPayload
+	status := http.StatusOK
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("unexpected request %s with content type %q", r.Method, r.Header.Get("Content-Type"))
+		}
+		if err := json.NewDecoder(r.Body).Decode(&received); err != nil {
+			t.Errorf("failed to decode payload: %v", err)
+		}
+		w.WriteHeader(status)
+	}))
+	defer server.Close()
+
+	succeeded := true
+	config := &operationHookConfig{webhookURL: server.URL, timeout: 5 * time.Second}
+	payload := operationHookPayload{Phase: operationHookPhasePost, ResourceType: "idsec_pcloud_safe", Operation: "create", ChangedAttributes: []string{"safe_name"}, Succeeded: &succeeded}
+	if err := config.invoke(context.Background(), payload); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(received, payload) {
+		t.Errorf("expected payload %+v, got %+v", payload, received)
+	}
+
+	status = http.StatusForbidden
+	if err := config.invoke(context.Background(), payload); err == nil {
+		t.Error("expected a non-2xx response to fail the hook")
+	}
+}
+
+func TestIdsecResource_HandleOperationHooks(t *testing.T) {
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"name": tftypes.String}}
+	object := func(name string) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{"name": tftypes.NewValue(tftypes.String, name)})
+	}
+	resourceSchema := schema.Schema{Attributes: map[string]schema.Attribute{"name": schema.StringAttribute{Optional: true}}}
+	idsecRes := CreateTestIdsecResource(CreateTestServiceConfig("test-service"), CreateTestActionDefinition("test-action", "Test action description")).(*IdsecResource)
+	idsecRes.settings = &providerSettings{operationHooks: &operationHookConfig{
+		command: []string{"sh", "-c", "echo ticket CHG0001 not approved; exit 1"},
+		timeout: 5 * time.Second,
+	}}
+
+	handled := false
+	handler := func(context.Context, *operationRun) { handled = true }
+	var diagnostics diag.Diagnostics
+	respState := tfsdk.State{Schema: resourceSchema, Raw: object("new")}
+	plan := &tfsdk.Plan{Schema: resourceSchema, Raw: object("new")}
+	state := &tfsdk.State{Schema: resourceSchema, Raw: object("old")}
+	run := &operationRun{operation: actions.UpdateOperation, diagnostics: &diagnostics, plan: plan, state: state, respState: &respState}
+	idsecRes.handleOperation(context.Background(), run, handler)
+	if handled || !diagnostics.HasError() {
+		t.Fatalf("expected the failing pre-update hook to end the update, got handled=%t and %v", handled, diagnostics)
+	}
+	if !respState.Raw.Equal(object("old")) {
+		t.Errorf("expected the update to keep the prior state, got %s", respState.Raw)
+	}
+
+	diagnostics = diag.Diagnostics{}
+	run = &operationRun{operation: actions.ReadOperation, diagnostics: &diagnostics, state: state, respState: &respState}
+	idsecRes.handleOperation(context.Background(), run, handler)
+	if !handled || diagnostics.HasError() {
+		t.Errorf("expected reads not to run the hooks, got handled=%t and %v", handled, diagnostics)
+	}
+}
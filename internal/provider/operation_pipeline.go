@@ -0,0 +1,491 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/cyberark/idsec-sdk-golang/pkg/validation"
+	"github.com/cyberark/terraform-provider-idsec/internal/actions"
+	"github.com/cyberark/terraform-provider-idsec/internal/schemas"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+// operationRun holds an operation of a resource as it goes through the stages of the operation pipeline.
+// The request fields are set by triggerOperation, or by the CRUD method handling the operation, the others
+// by the stages producing them.
+type operationRun struct {
+	operation     actions.IdsecServiceActionOperation
+	diagnostics   *diag.Diagnostics
+	plan          *tfsdk.Plan
+	state         *tfsdk.State
+	config        *tfsdk.Config
+	respState     *tfsdk.State
+	userSetPaths  map[string]bool
+	originalState basetypes.ObjectValue
+
+	// Set by the parse stage.
+	input               interface{}
+	changeReasonApplied bool
+
+	// Set by the invoke stage.
+	actionName string
+	response   reflect.Value
+
+	// Set by the convert and merge stages.
+	outputSchema schema.Schema
+	syntheticID  bool
+	schemaAttrs  map[string]attr.Type
+	stateResult  types.Object
+}
+
+// operationStage is a stage of the operation pipeline. It returns nil for the next stage to run, or ends the
+// operation with errOperationDone, errOperationDiagnostics or an *operationFailure.
+type operationStage func(ctx context.Context, run *operationRun) error
+
+// namedOperationStage is a stage of the operation pipeline with the name middleware see it by.
+type namedOperationStage struct {
+	name  string
+	stage operationStage
+}
+
+// operationMiddleware wraps every stage of the operation pipeline, given the name of the stage, e.g. to
+// trace it. Cross-cutting features that apply to whole stages are added as middleware rather than to the
+// stages themselves.
+type operationMiddleware func(name string, next operationStage) operationStage
+
+// operationMiddlewares wrap the stages of the operation pipeline, outermost first.
+var operationMiddlewares = []operationMiddleware{traceOperationStage}
+
+// operationHandler handles a create, read, update or delete of a resource, running the operation pipeline
+// once, or several times when a create adopts an existing object.
+type operationHandler func(ctx context.Context, run *operationRun)
+
+// operationHandlerMiddleware wraps the handling of a whole operation, e.g. to bound it by its timeout.
+// Cross-cutting features that apply once per operation, however many times it runs the operation pipeline,
+// are added as such middleware rather than to the CRUD methods of the resource.
+type operationHandlerMiddleware func(s *IdsecResource, next operationHandler) operationHandler
+
+// operationHandlerMiddlewares wrap the handling of every operation, outermost first.
+var operationHandlerMiddlewares = []operationHandlerMiddleware{
+	withOperationTimeouts,
+	withOperationHooks,
+}
+
+// errOperationDone ends an operation successfully before the last stage, e.g. when the action returns no
+// response to store in state.
+var errOperationDone = errors.New("operation done")
+
+// errOperationDiagnostics ends an operation whose errors were already added to its diagnostics.
+var errOperationDiagnostics = errors.New("operation failed with diagnostics")
+
+// operationFailure ends an operation with an error diagnostic, and restores the prior state of updates.
+type operationFailure struct {
+	summary string
+	detail  string
+}
+
+func (f *operationFailure) Error() string {
+	return fmt.Sprintf("%s: %s", f.summary, f.detail)
+}
+
+// operationFailed returns an *operationFailure with the given diagnostic summary and detail.
+func operationFailed(summary, detail string) error {
+	return &operationFailure{summary: summary, detail: detail}
+}
+
+// operationStages returns the stages of the operation pipeline, in order: the plan and state are parsed
+// into the input of the action, the action is invoked, its response converted to a state object, merged
+// with the plan and the attributes derived by the provider, and persisted to the response state.
+func (s *IdsecResource) operationStages() []namedOperationStage {
+	return []namedOperationStage{
+		{name: "parse", stage: s.parseOperationInput},
+		{name: "invoke", stage: s.invokeOperationAction},
+		{name: "convert", stage: s.convertOperationResponse},
+		{name: "merge", stage: s.mergeOperationState},
+		{name: "persist", stage: s.persistOperationState},
+	}
+}
+
+// handleOperation handles the operation of run with handler, wrapped by operationHandlerMiddlewares.
+func (s *IdsecResource) handleOperation(ctx context.Context, run *operationRun, handler operationHandler) {
+	for i := len(operationHandlerMiddlewares) - 1; i >= 0; i-- {
+		handler = operationHandlerMiddlewares[i](s, handler)
+	}
+	handler(ctx, run)
+}
+
+// triggerRun is the operationHandler of the operations running the operation pipeline once.
+func (s *IdsecResource) triggerRun(ctx context.Context, run *operationRun) {
+	s.triggerOperation(ctx, run.operation, run.diagnostics, run.plan, run.state, run.config, run.respState, run.userSetPaths)
+}
+
+// keepPriorState sets the response state to the prior state, for operations ended before they changed
+// anything, as Terraform sets the response state of updates to the plan.
+func (run *operationRun) keepPriorState() {
+	if run.respState != nil && run.state != nil {
+		run.respState.Raw = run.state.Raw
+	}
+}
+
+// withOperationTimeouts bounds the context of the operation by its timeout, set in the timeouts block of
+// the plan, or of the state for reads and deletes.
+func withOperationTimeouts(s *IdsecResource, next operationHandler) operationHandler {
+	return func(ctx context.Context, run *operationRun) {
+		var source attributeGetter = run.state
+		if run.operation == actions.CreateOperation || run.operation == actions.UpdateOperation {
+			source = run.plan
+		}
+		ctx, cancel := s.withOperationTimeout(ctx, run.operation, source, run.diagnostics)
+		defer cancel()
+		if run.diagnostics.HasError() {
+			run.keepPriorState()
+			return
+		}
+		next(ctx, run)
+	}
+}
+
+// withOperationHooks invokes the operation hooks before and after creates, updates and deletes. A failing
+// pre-operation hook ends the operation before it changes anything.
+func withOperationHooks(s *IdsecResource, next operationHandler) operationHandler {
+	return func(ctx context.Context, run *operationRun) {
+		if run.operation == actions.ReadOperation {
+			next(ctx, run)
+			return
+		}
+		var plan, state tftypes.Value
+		if run.plan != nil {
+			plan = run.plan.Raw
+		}
+		if run.state != nil {
+			state = run.state.Raw
+		}
+		payload := s.newOperationHookPayload(run.operation, plan, state)
+		if s.runPreOperationHooks(ctx, payload, run.diagnostics); run.diagnostics.HasError() {
+			run.keepPriorState()
+			return
+		}
+		next(ctx, run)
+		s.runPostOperationHooks(ctx, payload, run.diagnostics)
+	}
+}
+
+// runOperationPipeline runs the stages of the operation pipeline, wrapped by operationMiddlewares, until one
+// of them ends the operation.
+func (s *IdsecResource) runOperationPipeline(ctx context.Context, run *operationRun) {
+	for _, step := range s.operationStages() {
+		stage := step.stage
+		for i := len(operationMiddlewares) - 1; i >= 0; i-- {
+			stage = operationMiddlewares[i](step.name, stage)
+		}
+		if err := stage(ctx, run); err != nil {
+			s.endOperation(ctx, run, err)
+			return
+		}
+	}
+}
+
+// endOperation finalizes an operation ended by a stage with err.
+func (s *IdsecResource) endOperation(ctx context.Context, run *operationRun, err error) {
+	var failure *operationFailure
+	switch {
+	case errors.Is(err, errOperationDone):
+	case errors.Is(err, errOperationDiagnostics):
+		s.finalizeState(ctx, run.operation, run.originalState, run.respState, run.diagnostics)
+	case errors.As(err, &failure):
+		s.finalizeFailure(ctx, failure.summary, failure.detail, run.operation, run.originalState, run.respState, run.diagnostics)
+	default:
+		s.finalizeFailure(ctx, "Operation Error", err.Error(), run.operation, run.originalState, run.respState, run.diagnostics)
+	}
+}
+
+// traceOperationStage logs the start, end and duration of every stage of the operation pipeline.
+func traceOperationStage(name string, next operationStage) operationStage {
+	return func(ctx context.Context, run *operationRun) error {
+		started := time.Now()
+		tflog.Trace(ctx, fmt.Sprintf("Starting the %s stage of operation %s", name, run.operation))
+		err := next(ctx, run)
+		tflog.Trace(ctx, fmt.Sprintf("Ended the %s stage of operation %s in %s", name, run.operation, time.Since(started)))
+		return err
+	}
+}
+
+// parseOperationInput decodes the plan and state into the input of the action of the operation.
+func (s *IdsecResource) parseOperationInput(ctx context.Context, run *operationRun) error {
+	input, err := s.parsePlanAndState(ctx, run.operation, run.diagnostics, run.plan, run.state, run.config, run.userSetPaths)
+	if err != nil {
+		return operationFailed("Parsing Error", fmt.Sprintf("Failed to parse plan and state: %s", err.Error()))
+	}
+	if run.diagnostics.HasError() {
+		tflog.Error(ctx, "Error parsing plan and state, diagnostics already have errors")
+		return errOperationDiagnostics
+	}
+	run.input = input
+	run.changeReasonApplied = s.applyChangeReason(ctx, run.operation, input)
+	return nil
+}
+
+// invokeOperationAction calls the action of the operation with the parsed input, through
+// actionCallMiddlewares, and keeps the response of the action.
+func (s *IdsecResource) invokeOperationAction(ctx context.Context, run *operationRun) error {
+	actionName, ok := s.actionDefinition.ActionsMappings[run.operation]
+	if !ok {
+		return operationFailed("Action Mapping Error", fmt.Sprintf("No action mapping found for operation: %s", run.operation))
+	}
+	run.actionName = actionName
+
+	titleCase := cases.Title(language.English)
+	actionNameTitled := strings.ReplaceAll(titleCase.String(actionName), "-", "")
+	serviceNameTitled := s.getServiceNameTitled()
+	tflog.Info(ctx, fmt.Sprintf("Searching for Service Name: %s, Action Name: %s", serviceNameTitled, actionNameTitled))
+
+	// Get the action invoker of the service from the helper
+	invoker := s.getActionInvoker()
+	if invoker == nil {
+		return operationFailed("Service Error", "Service instance not configured")
+	}
+
+	// Get the method of the action
+	actionMethod, err := invoker.actionMethod(actionNameTitled)
+	if err != nil {
+		return operationFailed("Action Method Error", fmt.Sprintf("Unable to find action method: %s", err.Error()))
+	}
+
+	var actionArgs []reflect.Value
+	if run.input != nil {
+		actionArgs = append(actionArgs, reflect.ValueOf(run.input))
+		if err := validation.ValidateStruct(run.input); err != nil {
+			tflog.Error(ctx, fmt.Sprintf("Invalid Configuration - %s", err.Error()))
+			appendValidationDiagnostics(run.diagnostics, err)
+			return errOperationDiagnostics
+		}
+	}
+	result, err := s.actionCall()(ctx, run, *actionMethod, actionArgs)
+	if err != nil {
+		return err
+	}
+	for _, res := range result {
+		if err, ok := res.Interface().(error); ok && err != nil {
+			if isServiceUnavailableError(err) {
+				return operationFailed("Service Not Enabled", serviceUnavailableDetail(s.serviceConfig.ServiceName, err))
+			}
+			return operationFailed("Action Error", fmt.Sprintf("Unable to call action method: %s", err.Error()))
+		}
+	}
+	if len(result) < 1 {
+		tflog.Info(ctx, "No result returned from action method")
+		return errOperationDone
+	}
+	resultElem := result[0]
+	if _, ok := resultElem.Interface().(error); ok {
+		return errOperationDone
+	}
+	tflog.Info(ctx, "Managed to call action successfully with result")
+	if resultElem.Kind() == reflect.Pointer {
+		resultElem = resultElem.Elem()
+	}
+	run.response = resultElem
+	return nil
+}
+
+// convertOperationResponse converts the response of the action to a state object of the schema of the
+// resource. Operations without a response state, such as deletes, end here.
+func (s *IdsecResource) convertOperationResponse(ctx context.Context, run *operationRun) error {
+	if run.respState == nil {
+		return errOperationDone
+	}
+	tflog.Info(ctx, "Converting result to state object")
+	createSchema, err := s.schemaForOperation(actions.CreateOperation)
+	if err != nil {
+		return operationFailed("Schema Error", fmt.Sprintf("No schema mapping found for operation: %s", actions.CreateOperation))
+	}
+	updateSchema, err := s.schemaForOperation(actions.UpdateOperation)
+	if err != nil {
+		return operationFailed("Schema Error", fmt.Sprintf("No schema mapping found for operation: %s", actions.UpdateOperation))
+	}
+	run.outputSchema, run.syntheticID = s.generateSchema(createSchema, updateSchema)
+
+	run.schemaAttrs = schemas.ResourceSchemaToSchemaAttrTypes(run.outputSchema)
+	run.stateResult, err = schemas.StructToStateObject(ctx, run.response.Interface(), run.state, run.plan, run.schemaAttrs, s.actionDefinition.EmptyAsNullAttributes)
+	if err != nil {
+		return operationFailed("State Conversion Error", fmt.Sprintf("Failed to convert struct to state object: %s", err.Error()))
+	}
+	s.reportUnmappedAttributes(ctx, s.actionDefinition.ActionName, run.response.Interface(), run.schemaAttrs, run.diagnostics)
+	return nil
+}
+
+// mergeOperationState merges the plan into the converted state object, and sets the attributes the
+// provider derives from the response, such as the lifecycle timestamps, digests and synthetic ID.
+func (s *IdsecResource) mergeOperationState(ctx context.Context, run *operationRun) error {
+	plan := run.plan
+	stateResult := run.stateResult
+	var err error
+	if plan != nil {
+		stateResult, err = schemas.MergePlanToStateObject(ctx, plan, stateResult, run.schemaAttrs, s.actionDefinition.EmptyAsNullAttributes)
+		if err != nil {
+			return operationFailed("State Merge Error", fmt.Sprintf("Failed to merge plan to state object: %s", err.Error()))
+		}
+	}
+	if sources := s.lifecycleMetaSources(); sources[schemas.CreatedAtAttributeName] != "" || sources[schemas.LastModifiedAtAttributeName] != "" {
+		stateResult, err = schemas.SetLifecycleMeta(stateResult, sources[schemas.CreatedAtAttributeName], sources[schemas.LastModifiedAtAttributeName])
+		if err != nil {
+			return operationFailed("State Conversion Error", err.Error())
+		}
+	}
+	stateResult, err = schemas.SetContentDigests(stateResult, s.actionDefinition.ContentDigestAttributes)
+	if err != nil {
+		return operationFailed("State Conversion Error", err.Error())
+	}
+	stateResult, err = schemas.SetSDKResponse(stateResult, run.response.Interface())
+	if err != nil {
+		return operationFailed("State Conversion Error", err.Error())
+	}
+	if plan != nil && s.actionDefinition.KnownAfterApplyAllowlist != nil {
+		stateResult, err = schemas.PinPlannedAttributes(ctx, plan, stateResult, schemas.ConfiguredOnlyAttributeNames(run.outputSchema))
+		if err != nil {
+			return operationFailed("State Merge Error", err.Error())
+		}
+	}
+	if run.syntheticID {
+		stateResult, err = schemas.SetSyntheticID(stateResult, s.actionDefinition.IDTemplate)
+		if err != nil {
+			return operationFailed("State Conversion Error", err.Error())
+		}
+	}
+	stateResult, diags := schemas.EmptyNullBlocks(ctx, stateResult, run.outputSchema.Blocks)
+	if diags.HasError() {
+		run.diagnostics.Append(diags...)
+		return errOperationDiagnostics
+	}
+	run.stateResult = stateResult
+	return nil
+}
+
+// persistOperationState sets the response state to the merged state object.
+func (s *IdsecResource) persistOperationState(ctx context.Context, run *operationRun) error {
+	tflog.Info(ctx, "Setting state result")
+	diags := run.respState.Set(ctx, run.stateResult)
+	if diags.HasError() {
+		tflog.Error(ctx, fmt.Sprintf("Failed to set state: %s", diags))
+	}
+	run.diagnostics.Append(diags...)
+	if run.changeReasonApplied && !diags.HasError() {
+		s.restoreChangeReason(ctx, run.plan, run.respState, run.diagnostics)
+	}
+	return nil
+}
+
+// actionCall calls the method of the action of an operation with its arguments, and returns the values
+// the method returns. It returns an error, ending the operation, when the call could not be made.
+type actionCall func(ctx context.Context, run *operationRun, method reflect.Value, args []reflect.Value) ([]reflect.Value, error)
+
+// actionCallMiddleware wraps the call of the action of an operation, e.g. to limit or retry it.
+type actionCallMiddleware func(s *IdsecResource, next actionCall) actionCall
+
+// actionCallMiddlewares wrap the call of the action of every operation, outermost first. The innermost
+// call renews the provider credentials once when the call fails to authenticate.
+var actionCallMiddlewares = []actionCallMiddleware{
+	withServiceCircuitBreaker,
+	withDestroyQueue,
+	withServiceConcurrency,
+	withPayloadLogging,
+	withConsistencyRetries,
+}
+
+// actionCall returns the call of the action of an operation wrapped by actionCallMiddlewares.
+func (s *IdsecResource) actionCall() actionCall {
+	call := actionCall(func(ctx context.Context, _ *operationRun, method reflect.Value, args []reflect.Value) ([]reflect.Value, error) {
+		return s.callWithCredentialRefresh(ctx, method, args), nil
+	})
+	for i := len(actionCallMiddlewares) - 1; i >= 0; i-- {
+		call = actionCallMiddlewares[i](s, call)
+	}
+	return call
+}
+
+// withServiceCircuitBreaker fails calls fast while the circuit of the service is open, and records the
+// outcome of the others.
+func withServiceCircuitBreaker(s *IdsecResource, next actionCall) actionCall {
+	return func(ctx context.Context, run *operationRun, method reflect.Value, args []reflect.Value) ([]reflect.Value, error) {
+		breaker := s.getSettings().circuitBreaker
+		if err := breaker.check(s.serviceConfig.ServiceName); err != nil {
+			return nil, operationFailed("Service Unavailable", err.Error())
+		}
+		result, err := next(ctx, run, method, args)
+		if err == nil {
+			breaker.record(s.serviceConfig.ServiceName, callResultError(result))
+		}
+		return result, err
+	}
+}
+
+// withDestroyQueue holds a slot of the destroy queue for the call of deletes.
+func withDestroyQueue(s *IdsecResource, next actionCall) actionCall {
+	return func(ctx context.Context, run *operationRun, method reflect.Value, args []reflect.Value) ([]reflect.Value, error) {
+		if run.operation != actions.DeleteOperation {
+			return next(ctx, run, method, args)
+		}
+		release, err := s.getSettings().destroyQueue.acquire(ctx)
+		if err != nil {
+			return nil, operationFailed("Action Error", err.Error())
+		}
+		defer release()
+		return next(ctx, run, method, args)
+	}
+}
+
+// withServiceConcurrency holds a slot of the concurrency limit of the service for the call.
+func withServiceConcurrency(s *IdsecResource, next actionCall) actionCall {
+	return func(ctx context.Context, run *operationRun, method reflect.Value, args []reflect.Value) ([]reflect.Value, error) {
+		release, err := s.getSettings().serviceConcurrency.acquire(ctx, s.serviceConfig.ServiceName)
+		if err != nil {
+			return nil, operationFailed("Action Error", err.Error())
+		}
+		defer release()
+		return next(ctx, run, method, args)
+	}
+}
+
+// withPayloadLogging logs the size of the input and response of the call.
+func withPayloadLogging(_ *IdsecResource, next actionCall) actionCall {
+	return func(ctx context.Context, run *operationRun, method reflect.Value, args []reflect.Value) ([]reflect.Value, error) {
+		tflog.Info(ctx, "Calling action method")
+		logPayloadSize(ctx, "Request", run.actionName, run.input)
+		result, err := next(ctx, run, method, args)
+		if len(result) > 0 && result[0].CanInterface() {
+			logPayloadSize(ctx, "Response", run.actionName, result[0].Interface())
+		}
+		return result, err
+	}
+}
+
+// withConsistencyRetries retries the call while it fails with an error classified as retriable, up to the
+// retries configured for the operation.
+func withConsistencyRetries(s *IdsecResource, next actionCall) actionCall {
+	return func(ctx context.Context, run *operationRun, method reflect.Value, args []reflect.Value) ([]reflect.Value, error) {
+		var callErr error
+		settings := s.getSettings()
+		result := retryForConsistency(ctx, run.operation, settings.operationRetries(run.operation), settings.retryableErrorRules, func() []reflect.Value {
+			var result []reflect.Value
+			result, callErr = next(ctx, run, method, args)
+			return result
+		})
+		return result, callErr
+	}
+}
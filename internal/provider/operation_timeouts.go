@@ -0,0 +1,87 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/cyberark/terraform-provider-idsec/internal/actions"
+	"github.com/cyberark/terraform-provider-idsec/internal/schemas"
+)
+
+// operationTimeoutDefaults returns the default timeout of each CRUD operation the resource supports: the
+// one declared by the action definition, or schemas.DefaultOperationTimeout.
+func (s *IdsecResource) operationTimeoutDefaults() map[string]time.Duration {
+	defaults := map[string]time.Duration{}
+	for _, operation := range []actions.IdsecServiceActionOperation{actions.CreateOperation, actions.ReadOperation, actions.UpdateOperation, actions.DeleteOperation} {
+		if !slices.Contains(s.actionDefinition.SupportedOperations, operation) {
+			continue
+		}
+		defaults[string(operation)] = schemas.DefaultOperationTimeout
+		if timeout, ok := s.actionDefinition.OperationTimeouts[operation]; ok && timeout > 0 {
+			defaults[string(operation)] = timeout
+		}
+	}
+	return defaults
+}
+
+// addTimeoutsBlock adds the timeouts block to the schema of the resource, unless the models already
+// declare an attribute or block of that name.
+func (s *IdsecResource) addTimeoutsBlock(resourceSchema *schema.Schema) {
+	if _, exists := resourceSchema.Attributes[schemas.TimeoutsBlockName]; exists {
+		return
+	}
+	if _, exists := resourceSchema.Blocks[schemas.TimeoutsBlockName]; exists {
+		return
+	}
+	defaults := s.operationTimeoutDefaults()
+	if len(defaults) == 0 {
+		return
+	}
+	if resourceSchema.Blocks == nil {
+		resourceSchema.Blocks = map[string]schema.Block{}
+	}
+	resourceSchema.Blocks[schemas.TimeoutsBlockName] = schemas.TimeoutsBlock(defaults)
+}
+
+// operationTimeout returns the timeout of an operation, as set in the timeouts block of a plan or state,
+// or the default of its service set in service_timeouts, or its own default.
+func (s *IdsecResource) operationTimeout(ctx context.Context, operation actions.IdsecServiceActionOperation, source attributeGetter) (time.Duration, error) {
+	defaultTimeout, ok := s.operationTimeoutDefaults()[string(operation)]
+	if !ok {
+		defaultTimeout = schemas.DefaultOperationTimeout
+	}
+	if timeout, ok := s.getSettings().serviceTimeout(s.serviceConfig.ServiceName); ok {
+		defaultTimeout = timeout
+	}
+	var configured types.String
+	if diags := source.GetAttribute(ctx, path.Root(schemas.TimeoutsBlockName).AtName(string(operation)), &configured); diags.HasError() {
+		return defaultTimeout, nil
+	}
+	return parseDuration(configured, defaultTimeout)
+}
+
+// withOperationTimeout bounds the context of an operation by its timeout. The SDK calls themselves are
+// not cancelable, so the timeout bounds the waits for concurrency slots and the retries around them.
+func (s *IdsecResource) withOperationTimeout(ctx context.Context, operation actions.IdsecServiceActionOperation, source attributeGetter, diagnostics *diag.Diagnostics) (context.Context, context.CancelFunc) {
+	timeout, err := s.operationTimeout(ctx, operation, source)
+	if err != nil {
+		diagnostics.AddAttributeError(
+			path.Root(schemas.TimeoutsBlockName).AtName(string(operation)),
+			"Invalid Timeout",
+			fmt.Sprintf("The %s timeout is not a valid duration: %s", operation, err.Error()),
+		)
+		return ctx, func() {}
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Operation %s times out after %s", operation, timeout))
+	return context.WithTimeout(ctx, timeout)
+}
@@ -0,0 +1,149 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/cyberark/terraform-provider-idsec/internal/actions"
+	"github.com/cyberark/terraform-provider-idsec/internal/schemas"
+)
+
+type operationTimeoutsTestModel struct {
+	ConnectorName string `json:"connector_name" mapstructure:"connector_name" validate:"required"`
+}
+
+func operationTimeoutsTestResource(t *testing.T, timeouts map[actions.IdsecServiceActionOperation]time.Duration) (*IdsecResource, resource.SchemaResponse) {
+	t.Helper()
+	operations := []actions.IdsecServiceActionOperation{actions.CreateOperation, actions.ReadOperation, actions.DeleteOperation}
+	return CreateTestResourceWithSchema(t, &operationTimeoutsTestModel{}, operations, func(actionDefinition *actions.IdsecServiceTerraformResourceActionDefinition) {
+		actionDefinition.OperationTimeouts = timeouts
+	})
+}
+
+func operationTimeoutsTestPlan(schemaResp resource.SchemaResponse, timeouts map[string]interface{}) tfsdk.Plan {
+	objectType := schemaResp.Schema.Type().TerraformType(context.Background()).(tftypes.Object)
+	timeoutsType := objectType.AttributeTypes[schemas.TimeoutsBlockName].(tftypes.Object)
+	timeoutsValue := tftypes.NewValue(timeoutsType, nil)
+	if timeouts != nil {
+		timeoutValues := map[string]tftypes.Value{}
+		for name, attrType := range timeoutsType.AttributeTypes {
+			timeoutValues[name] = tftypes.NewValue(attrType, timeouts[name])
+		}
+		timeoutsValue = tftypes.NewValue(timeoutsType, timeoutValues)
+	}
+	return tfsdk.Plan{Schema: schemaResp.Schema, Raw: CreateTestResourceValue(schemaResp, map[string]tftypes.Value{
+		"connector_name":          tftypes.NewValue(tftypes.String, "connector-1"),
+		schemas.TimeoutsBlockName: timeoutsValue,
+	})}
+}
+
+func TestIdsecResource_TimeoutsBlockSchema(t *testing.T) {
+	_, schemaResp := operationTimeoutsTestResource(t, map[actions.IdsecServiceActionOperation]time.Duration{actions.CreateOperation: time.Hour})
+	block, ok := schemaResp.Schema.Blocks[schemas.TimeoutsBlockName].(schema.SingleNestedBlock)
+	if !ok {
+		t.Fatalf("expected a timeouts block, got %T", schemaResp.Schema.Blocks[schemas.TimeoutsBlockName])
+	}
+	for _, operation := range []string{"create", "read", "delete"} {
+		if _, ok := block.Attributes[operation]; !ok {
+			t.Errorf("expected a %s timeout", operation)
+		}
+	}
+	if _, ok := block.Attributes["update"]; ok {
+		t.Error("expected no update timeout on a resource without update")
+	}
+	if got := block.Attributes["create"].GetDescription(); got != "Timeout of the create operation, as a duration such as 30m or 1h30m. Defaults to 1h." {
+		t.Errorf("unexpected create timeout description %q", got)
+	}
+	if got := block.Attributes["read"].GetDescription(); got != "Timeout of the read operation, as a duration such as 30m or 1h30m. Defaults to 20m." {
+		t.Errorf("unexpected read timeout description %q", got)
+	}
+}
+
+func TestIdsecResource_OperationTimeout(t *testing.T) {
+	idsecRes, schemaResp := operationTimeoutsTestResource(t, map[actions.IdsecServiceActionOperation]time.Duration{actions.CreateOperation: time.Hour})
+	tests := []struct {
+		name      string
+		operation actions.IdsecServiceActionOperation
+		timeouts  map[string]interface{}
+		expected  time.Duration
+		expectErr bool
+	}{
+		{name: "action_definition_default", operation: actions.CreateOperation, expected: time.Hour},
+		{name: "global_default", operation: actions.DeleteOperation, expected: schemas.DefaultOperationTimeout},
+		{name: "configured", operation: actions.CreateOperation, timeouts: map[string]interface{}{"create": "90m"}, expected: 90 * time.Minute},
+		{name: "other_operation_configured", operation: actions.DeleteOperation, timeouts: map[string]interface{}{"create": "90m"}, expected: schemas.DefaultOperationTimeout},
+		{name: "invalid", operation: actions.CreateOperation, timeouts: map[string]interface{}{"create": "soon"}, expectErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan := operationTimeoutsTestPlan(schemaResp, tt.timeouts)
+			timeout, err := idsecRes.operationTimeout(context.Background(), tt.operation, &plan)
+			if tt.expectErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if timeout != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, timeout)
+			}
+		})
+	}
+}
+
+func TestIdsecResource_WithOperationTimeout(t *testing.T) {
+	idsecRes, schemaResp := operationTimeoutsTestResource(t, nil)
+	plan := operationTimeoutsTestPlan(schemaResp, map[string]interface{}{"create": "5m"})
+	var diags diag.Diagnostics
+	ctx, cancel := idsecRes.withOperationTimeout(context.Background(), actions.CreateOperation, &plan, &diags)
+	defer cancel()
+	if diags.HasError() {
+		t.Fatalf("unexpected errors: %v", diags)
+	}
+	deadline, ok := ctx.Deadline()
+	if !ok || time.Until(deadline) > 5*time.Minute {
+		t.Errorf("expected a deadline within 5m, got %v", deadline)
+	}
+
+	plan = operationTimeoutsTestPlan(schemaResp, map[string]interface{}{"create": "-1m"})
+	_, cancel = idsecRes.withOperationTimeout(context.Background(), actions.CreateOperation, &plan, &diags)
+	cancel()
+	if !diags.HasError() {
+		t.Error("expected an error for a negative timeout")
+	}
+}
+
+func TestIdsecResource_OperationTimeoutServiceDefault(t *testing.T) {
+	idsecRes, schemaResp := operationTimeoutsTestResource(t, map[actions.IdsecServiceActionOperation]time.Duration{actions.CreateOperation: time.Hour})
+	timeouts, err := parseServiceTimeouts(map[string]string{"test": "45m"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	idsecRes.settings = &providerSettings{serviceTimeouts: timeouts}
+
+	plan := operationTimeoutsTestPlan(schemaResp, nil)
+	if timeout, err := idsecRes.operationTimeout(context.Background(), actions.CreateOperation, &plan); err != nil || timeout != 45*time.Minute {
+		t.Errorf("expected the service family default of 45m, got %s (%v)", timeout, err)
+	}
+	plan = operationTimeoutsTestPlan(schemaResp, map[string]interface{}{"create": "5m"})
+	if timeout, err := idsecRes.operationTimeout(context.Background(), actions.CreateOperation, &plan); err != nil || timeout != 5*time.Minute {
+		t.Errorf("expected the configured timeout to take precedence, got %s (%v)", timeout, err)
+	}
+	for _, invalid := range []map[string]string{{"sia": "soon"}, {"sia": "0s"}, {" ": "5m"}} {
+		if _, err := parseServiceTimeouts(invalid); err == nil {
+			t.Errorf("expected an error for %v", invalid)
+		}
+	}
+}
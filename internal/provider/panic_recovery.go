@@ -0,0 +1,58 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"runtime/debug"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// actionPanicError is returned in place of the results of an action method that panicked.
+type actionPanicError struct {
+	value interface{}
+}
+
+func (e *actionPanicError) Error() string {
+	return fmt.Sprintf("action method panicked: %v", e.value)
+}
+
+// callActionMethod calls an action method through reflection. A panic of the call, e.g. a nil pointer
+// dereference in the SDK or a mismatched argument, is logged with its stack trace at DEBUG and returned
+// as the error result of the call, so callers handle it like any failed action, unless the provider does
+// not recover panics.
+func (h *IdsecServiceHelper) callActionMethod(ctx context.Context, actionMethod reflect.Value, actionArgs []reflect.Value) (result []reflect.Value) {
+	if !h.getSettings().recoverPanics {
+		return actionMethod.Call(actionArgs)
+	}
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			tflog.Debug(ctx, fmt.Sprintf("Recovered from panic of action method: %v\n%s", recovered, debug.Stack()))
+			var err error = &actionPanicError{value: recovered}
+			result = []reflect.Value{reflect.ValueOf(err)}
+		}
+	}()
+	return actionMethod.Call(actionArgs)
+}
+
+// recoverOperationPanic turns a panic of a resource, data source or action operation into an error
+// diagnostic naming the object it belongs to, unless the provider does not recover panics. It must be
+// deferred directly by the operation.
+func (h *IdsecServiceHelper) recoverOperationPanic(ctx context.Context, typeName string, operation string, diagnostics *diag.Diagnostics) {
+	if !h.getSettings().recoverPanics {
+		return
+	}
+	recovered := recover()
+	if recovered == nil {
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Recovered from panic of %s %s: %v\n%s", typeName, operation, recovered, debug.Stack()))
+	addErrorWithCorrelation(ctx, diagnostics, "Internal Provider Error",
+		fmt.Sprintf("The %s operation of %s failed unexpectedly: %v. Other resources are not affected. "+
+			"Please report this issue with the provider logs, collected with TF_LOG=DEBUG.", operation, typeName, recovered))
+}
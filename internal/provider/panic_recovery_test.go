@@ -0,0 +1,65 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+type panickingService struct{}
+
+func (s *panickingService) Get(input *struct{ Name string }) (*struct{ Name string }, error) {
+	return &struct{ Name string }{Name: input.Name}, nil
+}
+
+func TestCallActionMethod(t *testing.T) {
+	ctx := context.Background()
+	method := reflect.ValueOf(&panickingService{}).MethodByName("Get")
+	helper := &IdsecServiceHelper{}
+
+	t.Run("success_returns_results", func(t *testing.T) {
+		result := helper.callActionMethod(ctx, method, []reflect.Value{reflect.ValueOf(&struct{ Name string }{Name: "Safe1"})})
+		if err := callResultError(result); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(result) != 2 {
+			t.Fatalf("expected the results of the method, got %v", result)
+		}
+	})
+
+	t.Run("nil_input_panic_becomes_error", func(t *testing.T) {
+		result := helper.callActionMethod(ctx, method, []reflect.Value{reflect.ValueOf((*struct{ Name string })(nil))})
+		err := callResultError(result)
+		if err == nil || !strings.Contains(err.Error(), "action method panicked") {
+			t.Fatalf("expected the panic to be returned as an error, got %v", err)
+		}
+	})
+
+	t.Run("wrong_argument_count_panic_becomes_error", func(t *testing.T) {
+		if err := callResultError(helper.callActionMethod(ctx, method, nil)); err == nil {
+			t.Fatal("expected an error for a call with missing arguments")
+		}
+	})
+}
+
+func TestRecoverOperationPanic(t *testing.T) {
+	var diagnostics diag.Diagnostics
+	helper := &IdsecServiceHelper{}
+	func() {
+		defer helper.recoverOperationPanic(context.Background(), "idsec_pcloud_safe", "create", &diagnostics)
+		var safe *struct{ Name string }
+		_ = safe.Name
+	}()
+	if diagnostics.ErrorsCount() != 1 {
+		t.Fatalf("expected one error diagnostic, got %v", diagnostics)
+	}
+	if detail := diagnostics.Errors()[0].Detail(); !strings.Contains(detail, "idsec_pcloud_safe") || !strings.Contains(detail, "create") {
+		t.Errorf("expected the diagnostic to name the resource and operation, got %q", detail)
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// payloadSize returns the size in bytes of the JSON encoding of payload, an SDK request or response model.
+// It is not the size of the HTTP body, which the SDK does not expose: fields the SDK does not decode are
+// missing and the body may travel compressed. The second return value is false when payload is nil or
+// cannot be encoded.
+func payloadSize(payload interface{}) (int, bool) {
+	if payload == nil {
+		return 0, false
+	}
+	if _, isError := payload.(error); isError {
+		return 0, false
+	}
+	if value := reflect.ValueOf(payload); value.Kind() == reflect.Pointer && value.IsNil() {
+		return 0, false
+	}
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return 0, false
+	}
+	return len(encoded), true
+}
+
+// debugLogging reports whether Terraform runs the provider with DEBUG or TRACE logs, sparing the encoding
+// of payloads only measured for logging otherwise.
+func debugLogging() bool {
+	for _, envVar := range []string{"TF_LOG_PROVIDER", "TF_LOG"} {
+		switch strings.ToUpper(os.Getenv(envVar)) {
+		case "DEBUG", "TRACE", "JSON":
+			return true
+		}
+	}
+	return false
+}
+
+// logPayloadSize logs the JSON encoded size of an SDK request or response model at DEBUG, to spot the
+// large payloads slowing down applies over slow links.
+func logPayloadSize(ctx context.Context, direction string, action string, payload interface{}) {
+	if !debugLogging() {
+		return
+	}
+	size, ok := payloadSize(payload)
+	if !ok {
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("%s model of %s is %d bytes once encoded as JSON", direction, action, size), map[string]interface{}{
+		"payload_direction":  strings.ToLower(direction),
+		"payload_json_bytes": size,
+		"action":             action,
+	})
+}
@@ -0,0 +1,35 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPayloadSize(t *testing.T) {
+	type payload struct {
+		SafeName string `json:"safe_name"`
+	}
+	var nilPayload *payload
+	tests := []struct {
+		name     string
+		payload  interface{}
+		expected int
+		ok       bool
+	}{
+		{name: "success_struct", payload: &payload{SafeName: "Safe1"}, expected: len(`{"safe_name":"Safe1"}`), ok: true},
+		{name: "skip_nil", payload: nil},
+		{name: "skip_nil_pointer", payload: nilPayload},
+		{name: "skip_error", payload: errors.New("failed")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			size, ok := payloadSize(tt.payload)
+			if ok != tt.ok || size != tt.expected {
+				t.Errorf("expected (%d, %v), got (%d, %v)", tt.expected, tt.ok, size, ok)
+			}
+		})
+	}
+}
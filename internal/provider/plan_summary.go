@@ -0,0 +1,272 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/cyberark/terraform-provider-idsec/internal/actions"
+	"github.com/cyberark/terraform-provider-idsec/internal/schemas"
+)
+
+// planSummaryReplaceOperation is the operation of planned changes replacing a resource.
+const planSummaryReplaceOperation = "replace"
+
+// Values standing for the values the plan summary does not reveal.
+const (
+	planSummarySensitiveValue = "(sensitive)"
+	planSummaryUnknownValue   = "(known after apply)"
+)
+
+// planSummaryMu serializes the writes of summaries, as resources are planned concurrently.
+var planSummaryMu sync.Mutex
+
+// truncatedPlanSummaryFiles holds the plan summary files already emptied by this provider process, so
+// provider configurations sharing a file, such as aliases, do not empty the summaries of one another.
+var truncatedPlanSummaryFiles = map[string]bool{}
+
+// planSummary is the JSON line written per planned change, for approval systems to reason about the
+// changes without parsing the plan of Terraform.
+type planSummary struct {
+	ResourceType      string              `json:"resource_type"`
+	ID                string              `json:"id,omitempty"`
+	Operation         string              `json:"operation"`
+	CorrelationID     string              `json:"correlation_id,omitempty"`
+	ChangeReason      string              `json:"change_reason,omitempty"`
+	ChangedAttributes []string            `json:"changed_attributes"`
+	Changes           []plannedAttrChange `json:"changes"`
+}
+
+// plannedAttrChange is the change of one attribute path. The values of sensitive attributes are masked.
+type plannedAttrChange struct {
+	Path   string      `json:"path"`
+	Before interface{} `json:"before"`
+	After  interface{} `json:"after"`
+}
+
+// plannedAttrChanges returns the changes between the prior state and the plan, descending into nested
+// objects down to the attributes whose value changed. Lists, sets and maps are reported as a whole.
+func plannedAttrChanges(prior, planned tftypes.Value, sensitive func(*tftypes.AttributePath) bool) []plannedAttrChange {
+	var changes []plannedAttrChange
+	var walk func(path *tftypes.AttributePath, before, after tftypes.Value)
+	walk = func(path *tftypes.AttributePath, before, after tftypes.Value) {
+		if before.Equal(after) {
+			return
+		}
+		beforeAttributes, beforeIsObject := objectAttributes(before)
+		afterAttributes, afterIsObject := objectAttributes(after)
+		if beforeIsObject && afterIsObject && !sensitive(path) {
+			names := make([]string, 0, len(afterAttributes))
+			for name := range afterAttributes {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				walk(path.WithAttributeName(name), beforeAttributes[name], afterAttributes[name])
+			}
+			return
+		}
+		changes = append(changes, plannedAttrChange{
+			Path:   formatAttributePath(path),
+			Before: planSummaryValue(path, before, sensitive),
+			After:  planSummaryValue(path, after, sensitive),
+		})
+	}
+	walk(tftypes.NewAttributePath(), prior, planned)
+	return changes
+}
+
+// objectAttributes returns the attributes of an object value, null objects having null attributes, and
+// false for other values.
+func objectAttributes(value tftypes.Value) (map[string]tftypes.Value, bool) {
+	objectType, ok := value.Type().(tftypes.Object)
+	if !ok || !value.IsKnown() {
+		return nil, false
+	}
+	attributes := map[string]tftypes.Value{}
+	if value.IsNull() {
+		for name, attributeType := range objectType.AttributeTypes {
+			attributes[name] = tftypes.NewValue(attributeType, nil)
+		}
+		return attributes, true
+	}
+	if err := value.As(&attributes); err != nil {
+		return nil, false
+	}
+	return attributes, true
+}
+
+// planSummaryValue converts a value to its JSON form, masking the values of sensitive attributes and
+// those still unknown.
+func planSummaryValue(path *tftypes.AttributePath, value tftypes.Value, sensitive func(*tftypes.AttributePath) bool) interface{} {
+	switch {
+	case sensitive(path):
+		if value.IsNull() {
+			return nil
+		}
+		return planSummarySensitiveValue
+	case !value.IsKnown():
+		return planSummaryUnknownValue
+	case value.IsNull():
+		return nil
+	}
+	switch {
+	case value.Type().Is(tftypes.String):
+		var s string
+		_ = value.As(&s)
+		return s
+	case value.Type().Is(tftypes.Bool):
+		var b bool
+		_ = value.As(&b)
+		return b
+	case value.Type().Is(tftypes.Number):
+		n := new(big.Float)
+		_ = value.As(&n)
+		return json.Number(n.Text('g', -1))
+	case value.Type().Is(tftypes.Object{}), value.Type().Is(tftypes.Map{}):
+		var attributes map[string]tftypes.Value
+		_ = value.As(&attributes)
+		converted := make(map[string]interface{}, len(attributes))
+		for name, attribute := range attributes {
+			next := path.WithElementKeyString(name)
+			if value.Type().Is(tftypes.Object{}) {
+				next = path.WithAttributeName(name)
+			}
+			converted[name] = planSummaryValue(next, attribute, sensitive)
+		}
+		return converted
+	case value.Type().Is(tftypes.Set{}):
+		var elements []tftypes.Value
+		_ = value.As(&elements)
+		converted := make([]interface{}, 0, len(elements))
+		for _, element := range elements {
+			converted = append(converted, planSummaryValue(path.WithElementKeyValue(element), element, sensitive))
+		}
+		return converted
+	default:
+		var elements []tftypes.Value
+		_ = value.As(&elements)
+		converted := make([]interface{}, 0, len(elements))
+		for i, element := range elements {
+			converted = append(converted, planSummaryValue(path.WithElementKeyInt(i), element, sensitive))
+		}
+		return converted
+	}
+}
+
+// formatAttributePath formats a path the way Terraform shows it, e.g. metadata.tags["env"] or rules[0].
+func formatAttributePath(path *tftypes.AttributePath) string {
+	var formatted strings.Builder
+	for _, step := range path.Steps() {
+		switch typed := step.(type) {
+		case tftypes.AttributeName:
+			if formatted.Len() > 0 {
+				formatted.WriteString(".")
+			}
+			formatted.WriteString(string(typed))
+		case tftypes.ElementKeyString:
+			fmt.Fprintf(&formatted, "[%q]", string(typed))
+		case tftypes.ElementKeyInt:
+			fmt.Fprintf(&formatted, "[%d]", int64(typed))
+		default:
+			formatted.WriteString("[*]")
+		}
+	}
+	return formatted.String()
+}
+
+// planSummaryOperation returns the operation of a planned change.
+func planSummaryOperation(req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) string {
+	switch {
+	case req.State.Raw.IsNull():
+		return string(actions.CreateOperation)
+	case req.Plan.Raw.IsNull():
+		return string(actions.DeleteOperation)
+	case len(resp.RequiresReplace) > 0:
+		return planSummaryReplaceOperation
+	}
+	return string(actions.UpdateOperation)
+}
+
+// writePlanSummary appends the summary of the planned change of the resource to the plan_summary_file,
+// one JSON document per line. Plans without changes are not written. Failing to write it does not fail
+// the plan, and is reported as a warning.
+func (s *IdsecResource) writePlanSummary(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	settings := s.getSettings()
+	if settings.planSummaryFile == "" || resp.Diagnostics.HasError() || (req.Plan.Raw.IsNull() && req.State.Raw.IsNull()) {
+		return
+	}
+	sensitive := func(path *tftypes.AttributePath) bool {
+		attribute, err := req.Plan.Schema.AttributeAtTerraformPath(ctx, path)
+		return err == nil && attribute.IsSensitive()
+	}
+	changes := plannedAttrChanges(req.State.Raw, resp.Plan.Raw, sensitive)
+	if len(changes) == 0 {
+		return
+	}
+	summary := planSummary{
+		ResourceType:      s.getTerraformTypeName(s.actionDefinition.ActionName),
+		Operation:         planSummaryOperation(req, resp),
+		CorrelationID:     settings.correlationID,
+		ChangeReason:      settings.changeReason,
+		ChangedAttributes: make([]string, 0, len(changes)),
+		Changes:           changes,
+	}
+	for _, change := range changes {
+		summary.ChangedAttributes = append(summary.ChangedAttributes, change.Path)
+	}
+	if id, ok := topLevelAttributes(req.State.Raw)[schemas.SyntheticIDAttributeName]; ok && id.IsKnown() && id.Type().Is(tftypes.String) {
+		_ = id.As(&summary.ID)
+	}
+	if err := appendPlanSummary(settings.planSummaryFile, summary); err != nil {
+		resp.Diagnostics.AddWarning("Plan Summary Error", fmt.Sprintf("Failed to write the summary of the planned change to %s: %s", settings.planSummaryFile, err.Error()))
+		return
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Wrote the summary of the planned %s of %s", summary.Operation, summary.ResourceType))
+}
+
+// truncatePlanSummaryFile empties the plan_summary_file when the provider is configured, once per
+// provider process. Terraform starts the provider again to apply, and plans the changes again then, so
+// the file only holds the summaries of the last plan rather than repeating them.
+func truncatePlanSummaryFile(planSummaryFile string) error {
+	planSummaryMu.Lock()
+	defer planSummaryMu.Unlock()
+	if planSummaryFile == "" || truncatedPlanSummaryFiles[planSummaryFile] {
+		return nil
+	}
+	if err := os.Truncate(planSummaryFile, 0); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	truncatedPlanSummaryFiles[planSummaryFile] = true
+	return nil
+}
+
+// appendPlanSummary appends summary to the plan_summary_file as a line of JSON.
+func appendPlanSummary(planSummaryFile string, summary planSummary) error {
+	line, err := json.Marshal(summary)
+	if err != nil {
+		return err
+	}
+	planSummaryMu.Lock()
+	defer planSummaryMu.Unlock()
+	file, err := os.OpenFile(planSummaryFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(append(line, '\n')); err != nil {
+		_ = file.Close()
+		return err
+	}
+	return file.Close()
+}
@@ -0,0 +1,130 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestPlannedAttrChanges(t *testing.T) {
+	t.Parallel()
+
+	metadataType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"owner": tftypes.String, "retention": tftypes.Number}}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"id":       tftypes.String,
+		"name":     tftypes.String,
+		"password": tftypes.String,
+		"metadata": metadataType,
+		"tags":     tftypes.Map{ElementType: tftypes.String},
+	}}
+	value := func(name string, password string, owner string, retention int64, tags map[string]tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"id":       tftypes.NewValue(tftypes.String, "safe-1"),
+			"name":     tftypes.NewValue(tftypes.String, name),
+			"password": tftypes.NewValue(tftypes.String, password),
+			"metadata": tftypes.NewValue(metadataType, map[string]tftypes.Value{
+				"owner":     tftypes.NewValue(tftypes.String, owner),
+				"retention": tftypes.NewValue(tftypes.Number, retention),
+			}),
+			"tags": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, tags),
+		})
+	}
+	sensitive := func(path *tftypes.AttributePath) bool {
+		return path.Equal(tftypes.NewAttributePath().WithAttributeName("password"))
+	}
+
+	prior := value("web", "old", "alice", 7, map[string]tftypes.Value{"env": tftypes.NewValue(tftypes.String, "dev")})
+	planned := value("web", "new", "alice", 30, map[string]tftypes.Value{"env": tftypes.NewValue(tftypes.String, "prod")})
+	changes := plannedAttrChanges(prior, planned, sensitive)
+	expected := []plannedAttrChange{
+		{Path: "metadata.retention", Before: json.Number("7"), After: json.Number("30")},
+		{Path: "password", Before: planSummarySensitiveValue, After: planSummarySensitiveValue},
+		{Path: "tags", Before: map[string]interface{}{"env": "dev"}, After: map[string]interface{}{"env": "prod"}},
+	}
+	if !reflect.DeepEqual(changes, expected) {
+		t.Errorf("expected %+v, got %+v", expected, changes)
+	}
+
+	created := plannedAttrChanges(tftypes.NewValue(objectType, nil), tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"id":       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		"name":     tftypes.NewValue(tftypes.String, "web"),
+		"password": tftypes.NewValue(tftypes.String, nil),
+		"metadata": tftypes.NewValue(metadataType, nil),
+		"tags":     tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+	}), sensitive)
+	expected = []plannedAttrChange{
+		{Path: "id", Before: nil, After: planSummaryUnknownValue},
+		{Path: "name", Before: nil, After: "web"},
+	}
+	if !reflect.DeepEqual(created, expected) {
+		t.Errorf("expected %+v, got %+v", expected, created)
+	}
+	if changes := plannedAttrChanges(prior, prior, sensitive); len(changes) != 0 {
+		t.Errorf("expected no changes, got %+v", changes)
+	}
+}
+
+func TestFormatAttributePath(t *testing.T) {
+	t.Parallel()
+
+	path := tftypes.NewAttributePath().WithAttributeName("rules").WithElementKeyInt(0).WithAttributeName("tags").WithElementKeyString("env")
+	if formatted := formatAttributePath(path); formatted != `rules[0].tags["env"]` {
+		t.Errorf("unexpected path %s", formatted)
+	}
+}
+
+func TestAppendPlanSummary(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "plan-summary.jsonl")
+
+	for _, operation := range []string{"create", "delete"} {
+		summary := planSummary{ResourceType: "idsec_pcloud_safe", Operation: operation, ChangedAttributes: []string{"name"}}
+		if err := appendPlanSummary(file, summary); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	content, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `{"resource_type":"idsec_pcloud_safe","operation":"create","changed_attributes":["name"],"changes":null}` + "\n" +
+		`{"resource_type":"idsec_pcloud_safe","operation":"delete","changed_attributes":["name"],"changes":null}` + "\n"
+	if string(content) != expected {
+		t.Errorf("expected %s, got %s", expected, content)
+	}
+}
+
+func TestTruncatePlanSummaryFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "plan-summary.jsonl")
+	t.Cleanup(func() { delete(truncatedPlanSummaryFiles, file) })
+
+	if err := truncatePlanSummaryFile(file); err != nil {
+		t.Fatalf("expected a missing file not to fail, got %v", err)
+	}
+	if err := os.WriteFile(file, []byte("{}\n"), 0o600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	delete(truncatedPlanSummaryFiles, file)
+	if err := truncatePlanSummaryFile(file); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if content, _ := os.ReadFile(file); len(content) != 0 {
+		t.Errorf("expected the summaries of the previous plan to be removed, got %s", content)
+	}
+
+	if err := appendPlanSummary(file, planSummary{ResourceType: "idsec_pcloud_safe", Operation: "create"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := truncatePlanSummaryFile(file); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if content, _ := os.ReadFile(file); len(content) == 0 {
+		t.Error("expected another configuration of the same process to keep the summaries")
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/BurntSushi/toml"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkconfig "github.com/cyberark/idsec-sdk-golang/pkg/config"
+)
+
+// providerProfile holds the provider settings of a named profile of the profiles file. Secrets are
+// deliberately not part of profiles, they are still resolved from the provider configuration or the
+// environment.
+type providerProfile struct {
+	AuthMethod           *string `toml:"auth_method"`
+	Subdomain            *string `toml:"subdomain"`
+	Username             *string `toml:"username"`
+	ServiceUser          *string `toml:"service_user"`
+	ServiceAuthorizedApp *string `toml:"service_authorized_app"`
+	PVWAURL              *string `toml:"pvwa_url"`
+	PVWALoginMethod      *string `toml:"pvwa_login_method"`
+	CacheAuthentication  *bool   `toml:"cache_authentication"`
+	AuthCacheBackend     *string `toml:"auth_cache_backend"`
+	DataSourceCacheTTL   *string `toml:"data_source_cache_ttl"`
+	ProxyAddress         *string `toml:"proxy_address"`
+	ProxyUsername        *string `toml:"proxy_username"`
+}
+
+// providerProfilesFile is the layout of the profiles file, one table per profile:
+//
+//	[profiles.dev]
+//	auth_method = "identity_service_user"
+//	subdomain   = "dev-tenant"
+type providerProfilesFile struct {
+	Profiles map[string]providerProfile `toml:"profiles"`
+}
+
+// profilesFilePath returns the path of the profiles file, IDSEC_CONFIG_FILE or ~/.idsec/config.toml.
+func profilesFilePath() (string, error) {
+	if path, ok := os.LookupEnv(IdsecConfigFileEnvVar); ok && path != "" {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve the home directory: %w", err)
+	}
+	return filepath.Join(home, ".idsec", "config.toml"), nil
+}
+
+// loadProviderProfile returns the profile named name from the profiles file at path. It is only called
+// when a profile is selected, configurations that do not select one never read the file.
+func loadProviderProfile(path string, name string) (*providerProfile, error) {
+	var file providerProfilesFile
+	if _, err := toml.DecodeFile(path, &file); err != nil {
+		return nil, fmt.Errorf("failed to read profiles file %s: %w", path, err)
+	}
+	profile, ok := file.Profiles[name]
+	if !ok {
+		names := make([]string, 0, len(file.Profiles))
+		for profileName := range file.Profiles {
+			names = append(names, profileName)
+		}
+		sort.Strings(names)
+		return nil, fmt.Errorf("profile %q is not defined in %s, defined profiles are: %s", name, path, strings.Join(names, ", "))
+	}
+	return &profile, nil
+}
+
+// profileStringVar returns the profile value of a string setting that is neither configured nor set
+// in its environment variable, so both keep precedence over profiles.
+func profileStringVar(variable types.String, envVar string, value *string) types.String {
+	if !variable.IsNull() || value == nil {
+		return variable
+	}
+	if _, ok := os.LookupEnv(envVar); ok {
+		return variable
+	}
+	return types.StringValue(*value)
+}
+
+// profileBoolVar is the boolean counterpart of profileStringVar.
+func profileBoolVar(variable types.Bool, envVar string, value *bool) types.Bool {
+	if !variable.IsNull() || value == nil {
+		return variable
+	}
+	if _, ok := os.LookupEnv(envVar); ok {
+		return variable
+	}
+	return types.BoolValue(*value)
+}
+
+// applyProfile fills the settings of config that are not configured from profile.
+func applyProfile(config *IdsecProviderSchema, profile *providerProfile) {
+	config.AuthMethod = profileStringVar(config.AuthMethod, IdsecAuthMethodEnvVar, profile.AuthMethod)
+	config.Subdomain = profileStringVar(config.Subdomain, IdsecSubdomainEnvVar, profile.Subdomain)
+	config.UserName = profileStringVar(config.UserName, IdsecUsernameEnvVar, profile.Username)
+	config.ServiceUser = profileStringVar(config.ServiceUser, IdsecServiceUserEnvVar, profile.ServiceUser)
+	config.ServiceAuthorizedApp = profileStringVar(config.ServiceAuthorizedApp, IdsecServiceAuthorizedAppEnvVar, profile.ServiceAuthorizedApp)
+	config.PVWAURL = profileStringVar(config.PVWAURL, IdsecPVWAURLEnvVar, profile.PVWAURL)
+	config.PVWALoginMethod = profileStringVar(config.PVWALoginMethod, IdsecPVWALoginMethodEnvVar, profile.PVWALoginMethod)
+	config.CacheAuthentication = profileBoolVar(config.CacheAuthentication, IdsecCacheAuthenticationEnvVar, profile.CacheAuthentication)
+	config.AuthCacheBackend = profileStringVar(config.AuthCacheBackend, IdsecAuthCacheBackendEnvVar, profile.AuthCacheBackend)
+	config.DataSourceCacheTTL = profileStringVar(config.DataSourceCacheTTL, IdsecDataSourceCacheTTLEnvVar, profile.DataSourceCacheTTL)
+	config.ProxyAddress = profileStringVar(config.ProxyAddress, sdkconfig.IdsecProxyAddressEnvVar, profile.ProxyAddress)
+	config.ProxyUsername = profileStringVar(config.ProxyUsername, sdkconfig.IdsecProxyUsernameEnvVar, profile.ProxyUsername)
+}
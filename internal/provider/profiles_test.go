@@ -0,0 +1,120 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const testProfilesFile = `
+[profiles.default]
+auth_method = "identity"
+subdomain = "prod-tenant"
+
+[profiles.dev]
+auth_method = "identity_service_user"
+subdomain = "dev-tenant"
+service_user = "terraform@dev"
+cache_authentication = false
+data_source_cache_ttl = "30s"
+`
+
+func writeTestProfilesFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "config.toml")
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed to write profiles file: %v", err)
+	}
+	return path
+}
+
+func TestLoadProviderProfile(t *testing.T) {
+	t.Parallel()
+
+	path := writeTestProfilesFile(t, testProfilesFile)
+	tests := []struct {
+		name          string
+		path          string
+		profile       string
+		wantSubdomain string
+		wantErr       string
+	}{
+		{name: "named_profile", path: path, profile: "dev", wantSubdomain: "dev-tenant"},
+		{name: "other_profile", path: path, profile: "default", wantSubdomain: "prod-tenant"},
+		{name: "undefined_profile", path: path, profile: "staging", wantErr: `profile "staging" is not defined`},
+		{name: "missing_file_with_profile", path: filepath.Join(t.TempDir(), "missing.toml"), profile: "dev", wantErr: "failed to read profiles file"},
+		{name: "malformed_file", path: writeTestProfilesFile(t, "[profiles.dev\n"), profile: "dev", wantErr: "failed to read profiles file"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			profile, err := loadProviderProfile(tt.path, tt.profile)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if profile == nil || profile.Subdomain == nil || *profile.Subdomain != tt.wantSubdomain {
+				t.Fatalf("expected subdomain %q, got %+v", tt.wantSubdomain, profile)
+			}
+		})
+	}
+}
+
+func TestApplyProfilePrecedence(t *testing.T) {
+	path := writeTestProfilesFile(t, testProfilesFile)
+	t.Setenv(IdsecSubdomainEnvVar, "env-tenant")
+	t.Setenv(IdsecCacheAuthenticationEnvVar, "")
+	os.Unsetenv(IdsecCacheAuthenticationEnvVar)
+	t.Setenv(IdsecAuthMethodEnvVar, "")
+	os.Unsetenv(IdsecAuthMethodEnvVar)
+
+	profile, err := loadProviderProfile(path, "dev")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	config := IdsecProviderSchema{
+		AuthMethod:          types.StringNull(),
+		Subdomain:           types.StringNull(),
+		ServiceUser:         types.StringValue("configured@dev"),
+		CacheAuthentication: types.BoolNull(),
+	}
+	applyProfile(&config, profile)
+
+	if config.AuthMethod.ValueString() != "identity_service_user" {
+		t.Errorf("expected auth_method from the profile, got %v", config.AuthMethod)
+	}
+	if !config.Subdomain.IsNull() {
+		t.Errorf("expected subdomain to be left to its environment variable, got %v", config.Subdomain)
+	}
+	if config.ServiceUser.ValueString() != "configured@dev" {
+		t.Errorf("expected the configured service_user to take precedence, got %v", config.ServiceUser)
+	}
+	if config.CacheAuthentication.IsNull() || config.CacheAuthentication.ValueBool() {
+		t.Errorf("expected cache_authentication false from the profile, got %v", config.CacheAuthentication)
+	}
+	if !config.PVWAURL.IsNull() {
+		t.Errorf("expected settings missing from the profile to stay null, got %v", config.PVWAURL)
+	}
+}
+
+func TestProfilesFilePath(t *testing.T) {
+	t.Setenv(IdsecConfigFileEnvVar, "/etc/idsec/config.toml")
+	path, err := profilesFilePath()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if path != "/etc/idsec/config.toml" {
+		t.Errorf("expected the path from %s, got %s", IdsecConfigFileEnvVar, path)
+	}
+}
@@ -0,0 +1,19 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"time"
+
+	"github.com/cyberark/terraform-provider-idsec/internal/profiling"
+)
+
+// heapReporter logs heap summaries at the start of operations when enabled in debug mode.
+var heapReporter *profiling.HeapReporter
+
+// EnableHeapSummaries logs a summary of the heap of the provider process at the start of resource and
+// data source operations, at most once per interval.
+func EnableHeapSummaries(interval time.Duration) {
+	heapReporter = profiling.NewHeapReporter(interval)
+}
@@ -0,0 +1,130 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	terraformprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// credentialAttribute is a provider attribute an auth method requires, along with the environment
+// variable it is resolved from.
+type credentialAttribute struct {
+	name   string
+	envVar string
+}
+
+// authMethodCredentials are the attributes each auth method requires, in the order they are reported.
+var authMethodCredentials = map[string][]credentialAttribute{
+	"identity": {
+		{name: "username", envVar: IdsecUsernameEnvVar},
+		{name: "secret", envVar: IdsecSecretEnvVar},
+	},
+	"identity_service_user": {
+		{name: "service_user", envVar: IdsecServiceUserEnvVar},
+		{name: "service_token", envVar: IdsecServiceTokenEnvVar},
+	},
+	"pvwa": {
+		{name: "username", envVar: IdsecUsernameEnvVar},
+		{name: "secret", envVar: IdsecSecretEnvVar},
+		{name: "pvwa_url", envVar: IdsecPVWAURLEnvVar},
+	},
+}
+
+// ConfigValidators checks the combinations of authentication attributes, so terraform validate reports
+// a missing credential before any authentication attempt.
+func (p *IdsecProvider) ConfigValidators(ctx context.Context) []terraformprovider.ConfigValidator {
+	return []terraformprovider.ConfigValidator{
+		authCredentialsValidator{},
+	}
+}
+
+// authCredentialsValidator requires the credentials of the auth method of the provider configuration,
+// and rejects the credential read from secret_source being set as well. A credential counts as set
+// when configured, in its environment variable or in the selected profile, and the secret or service
+// token when read from secret_source.
+type authCredentialsValidator struct{}
+
+// Description returns a description of the validator.
+func (v authCredentialsValidator) Description(ctx context.Context) string {
+	return "The credentials of the auth method must be set"
+}
+
+// MarkdownDescription returns a markdown description of the validator.
+func (v authCredentialsValidator) MarkdownDescription(ctx context.Context) string {
+	return "The credentials of the `auth_method` must be set"
+}
+
+// ValidateProvider checks the credentials of the auth method are set. Configurations with values not
+// known yet are left to Configure, which defers them.
+func (v authCredentialsValidator) ValidateProvider(ctx context.Context, req terraformprovider.ValidateConfigRequest, resp *terraformprovider.ValidateConfigResponse) {
+	if req.Config.Raw.IsNull() || len(unknownProviderAttributes(req.Config.Raw)) > 0 {
+		return
+	}
+	var config IdsecProviderSchema
+	if diags := req.Config.Get(ctx, &config); diags.HasError() {
+		return
+	}
+	if profile := selectedProfile(config); profile != nil {
+		applyProfile(&config, profile)
+	}
+	authMethod := config.AuthMethod
+	if authMethod.IsNull() {
+		authMethod = types.StringValue(os.Getenv(IdsecAuthMethodEnvVar))
+	}
+	required, ok := authMethodCredentials[authMethod.ValueString()]
+	if !ok {
+		return
+	}
+
+	fromSecretSource := ""
+	if !config.SecretSource.IsNull() {
+		fromSecretSource = secretSourceCredential(authMethod.ValueString())
+	}
+	values := map[string]types.String{
+		"username":      config.UserName,
+		"secret":        config.Secret,
+		"service_user":  config.ServiceUser,
+		"service_token": config.ServiceToken,
+		"pvwa_url":      config.PVWAURL,
+	}
+	for _, credential := range required {
+		value := values[credential.name]
+		if credential.name == fromSecretSource {
+			if !value.IsNull() {
+				resp.Diagnostics.AddAttributeError(path.Root(credential.name), "Invalid Attribute Combination",
+					fmt.Sprintf("%s cannot be set together with secret_source.", credential.name))
+			}
+			continue
+		}
+		if _, inEnv := os.LookupEnv(credential.envVar); value.IsNull() && !inEnv {
+			resp.Diagnostics.AddAttributeError(path.Root(credential.name), "Missing Attribute Configuration",
+				fmt.Sprintf("%s is required when auth_method is %q, set it in the provider block or in environment variable %s.",
+					credential.name, authMethod.ValueString(), credential.envVar))
+		}
+	}
+}
+
+// selectedProfile returns the profile the configuration selects, or nil when there is none or the
+// profiles file cannot be read, which Configure reports.
+func selectedProfile(config IdsecProviderSchema) *providerProfile {
+	name := config.Profile
+	if name.IsNull() {
+		name = types.StringValue(os.Getenv(IdsecProfileEnvVar))
+	}
+	profilesPath, err := profilesFilePath()
+	if err != nil {
+		return nil
+	}
+	profile, err := loadProviderProfile(profilesPath, name.ValueString())
+	if err != nil {
+		return nil
+	}
+	return profile
+}
@@ -0,0 +1,179 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	terraformprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testProviderConfig builds a provider configuration setting the given string attributes, and an empty
+// secret_source block when withSecretSource is set.
+func testProviderConfig(t *testing.T, p *IdsecProvider, attributes map[string]string, withSecretSource bool) tfsdk.Config {
+	t.Helper()
+	config := testUnknownProviderConfig(t, p)
+	objectType := config.Raw.Type().(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	if err := config.Raw.As(&values); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for name, value := range attributes {
+		values[name] = tftypes.NewValue(tftypes.String, value)
+	}
+	if withSecretSource {
+		sourceType := objectType.AttributeTypes["secret_source"].(tftypes.Object)
+		sourceValues := map[string]tftypes.Value{}
+		for name, attrType := range sourceType.AttributeTypes {
+			sourceValues[name] = tftypes.NewValue(attrType, nil)
+		}
+		values["secret_source"] = tftypes.NewValue(sourceType, sourceValues)
+	}
+	config.Raw = tftypes.NewValue(objectType, values)
+	return config
+}
+
+// unsetCredentialEnvVars clears the environment variables credentials are resolved from for the test,
+// and points the profiles file to a missing file.
+func unsetCredentialEnvVars(t *testing.T) {
+	t.Helper()
+	for _, envVar := range []string{IdsecAuthMethodEnvVar, IdsecUsernameEnvVar, IdsecSecretEnvVar, IdsecServiceUserEnvVar, IdsecServiceTokenEnvVar, IdsecPVWAURLEnvVar, IdsecProfileEnvVar} {
+		t.Setenv(envVar, "")
+		if err := os.Unsetenv(envVar); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	t.Setenv(IdsecConfigFileEnvVar, filepath.Join(t.TempDir(), "config.toml"))
+}
+
+func TestAuthCredentialsValidator(t *testing.T) {
+	p := &IdsecProvider{}
+	tests := []struct {
+		name             string
+		attributes       map[string]string
+		env              map[string]string
+		withSecretSource bool
+		wantErrors       []string
+	}{
+		{
+			name:       "identity_complete",
+			attributes: map[string]string{"auth_method": "identity", "username": "admin", "secret": "pass"},
+		},
+		{
+			name:       "identity_missing_secret",
+			attributes: map[string]string{"auth_method": "identity", "username": "admin"},
+			wantErrors: []string{"secret is required when auth_method is \"identity\""},
+		},
+		{
+			name:       "identity_secret_from_environment",
+			attributes: map[string]string{"auth_method": "identity", "username": "admin"},
+			env:        map[string]string{IdsecSecretEnvVar: "pass"},
+		},
+		{
+			name:       "auth_method_from_environment",
+			attributes: map[string]string{"service_user": "svc"},
+			env:        map[string]string{IdsecAuthMethodEnvVar: "identity_service_user"},
+			wantErrors: []string{"service_token is required"},
+		},
+		{
+			name:       "pvwa_missing_url",
+			attributes: map[string]string{"auth_method": "pvwa", "username": "admin", "secret": "pass"},
+			wantErrors: []string{"pvwa_url is required"},
+		},
+		{
+			name:             "secret_from_secret_source",
+			attributes:       map[string]string{"auth_method": "identity", "username": "admin"},
+			withSecretSource: true,
+		},
+		{
+			name:             "secret_conflicts_with_secret_source",
+			attributes:       map[string]string{"auth_method": "identity", "username": "admin", "secret": "pass"},
+			withSecretSource: true,
+			wantErrors:       []string{"secret cannot be set together with secret_source"},
+		},
+		{
+			name: "auth_method_not_set",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			unsetCredentialEnvVars(t)
+			for envVar, value := range tt.env {
+				t.Setenv(envVar, value)
+			}
+			req := terraformprovider.ValidateConfigRequest{Config: testProviderConfig(t, p, tt.attributes, tt.withSecretSource)}
+			resp := &terraformprovider.ValidateConfigResponse{}
+			authCredentialsValidator{}.ValidateProvider(context.Background(), req, resp)
+			errors := resp.Diagnostics.Errors()
+			if len(errors) != len(tt.wantErrors) {
+				t.Fatalf("expected %d errors, got %v", len(tt.wantErrors), errors)
+			}
+			for i, want := range tt.wantErrors {
+				if !strings.Contains(errors[i].Detail(), want) {
+					t.Errorf("expected an error containing %q, got %q", want, errors[i].Detail())
+				}
+			}
+		})
+	}
+}
+
+func TestAuthCredentialsValidatorProfile(t *testing.T) {
+	unsetCredentialEnvVars(t)
+	profilesPath := filepath.Join(t.TempDir(), "config.toml")
+	content := "[profiles.dev]\nauth_method = \"pvwa\"\nusername = \"admin\"\npvwa_url = \"https://pvwa.example.com\"\n"
+	if err := os.WriteFile(profilesPath, []byte(content), 0o600); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	t.Setenv(IdsecConfigFileEnvVar, profilesPath)
+
+	req := terraformprovider.ValidateConfigRequest{Config: testProviderConfig(t, &IdsecProvider{}, map[string]string{"profile": "dev", "secret": "pass"}, false)}
+	resp := &terraformprovider.ValidateConfigResponse{}
+	authCredentialsValidator{}.ValidateProvider(context.Background(), req, resp)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("expected the profile to provide the auth method, username and PVWA URL, got %v", resp.Diagnostics)
+	}
+}
+
+// TestResolveCredentialsMissing tests that credentials still missing once resolved while configuring,
+// e.g. unknown at validate time, fail the configuration.
+func TestResolveCredentialsMissing(t *testing.T) {
+	unsetCredentialEnvVars(t)
+	tests := []struct {
+		name     string
+		config   IdsecProviderSchema
+		expected string
+	}{
+		{
+			name:     "identity_without_secret",
+			config:   IdsecProviderSchema{AuthMethod: types.StringValue("identity"), UserName: types.StringValue("admin")},
+			expected: "Username and Secret are required",
+		},
+		{
+			name:     "identity_service_user_without_token",
+			config:   IdsecProviderSchema{AuthMethod: types.StringValue("identity_service_user"), ServiceUser: types.StringValue("svc-terraform")},
+			expected: "Service User and Service Token are required",
+		},
+		{
+			name:     "pvwa_without_url",
+			config:   IdsecProviderSchema{AuthMethod: types.StringValue("pvwa"), UserName: types.StringValue("admin"), Secret: types.StringValue("pass")},
+			expected: "PVWA URL is required",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := tt.config
+			creds, diags := (&IdsecProvider{}).resolveCredentials(context.Background(), &config)
+			if creds != nil || !diags.HasError() || !strings.Contains(diags[0].Detail(), tt.expected) {
+				t.Errorf("expected %q, got %v", tt.expected, diags)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/cyberark/terraform-provider-idsec/internal/schemas"
+)
+
+// providerDefaultPlaceholder stands for a provider default in the input validated by ValidateConfig, as
+// the provider is not configured while configurations are validated.
+const providerDefaultPlaceholder = "provider-default"
+
+// parseProviderDefaults checks the attribute names of the defaults provider attribute.
+func parseProviderDefaults(values map[string]string) (map[string]string, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	defaults := make(map[string]string, len(values))
+	for name, value := range values {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			return nil, fmt.Errorf("attribute names must not be empty")
+		}
+		defaults[name] = value
+	}
+	return defaults, nil
+}
+
+// providerDefaultModifier plans the value set in the defaults of the provider for an attribute left out of
+// the configuration. The provider is configured before resources are planned, so the defaults are known
+// by then, in the settings the resource was created with. Without a default, attributes the models
+// require fail the plan of a create, and otherwise keep their value in state.
+type providerDefaultModifier struct {
+	attribute string
+	required  bool
+	settings  *providerSettings
+}
+
+// Description returns a human-readable description of the plan modifier.
+func (m providerDefaultModifier) Description(_ context.Context) string {
+	return fmt.Sprintf("When not configured, %s defaults to the value set for it in the defaults of the provider.", m.attribute)
+}
+
+// MarkdownDescription returns a markdown-formatted description of the plan modifier.
+func (m providerDefaultModifier) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("When not configured, `%s` defaults to the value set for it in the `defaults` of the provider.", m.attribute)
+}
+
+// PlanModifyString plans the provider default of the attribute when it is not configured.
+func (m providerDefaultModifier) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.Plan.Raw.IsNull() || !req.ConfigValue.IsNull() {
+		return
+	}
+	if value, ok := m.settings.defaults[m.attribute]; ok {
+		resp.PlanValue = types.StringValue(value)
+		return
+	}
+	if !m.required {
+		return
+	}
+	if req.State.Raw.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Missing Required Attribute",
+			fmt.Sprintf("%s must be set, either on the resource or in the defaults of the provider.", m.attribute),
+		)
+		return
+	}
+	if resp.PlanValue.IsUnknown() {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+// addProviderDefaults lets the top-level string attributes of the ProviderDefaultAttributes of the action
+// definition inherit the defaults of the provider. Required attributes become Optional and Computed, as
+// the default is only known once the provider is configured.
+func (s *IdsecResource) addProviderDefaults(resourceSchema *schema.Schema) {
+	for _, name := range s.actionDefinition.ProviderDefaultAttributes {
+		attribute, ok := resourceSchema.Attributes[name].(schema.StringAttribute)
+		if !ok || (!attribute.Required && !attribute.Computed) {
+			continue
+		}
+		modifier := providerDefaultModifier{attribute: name, required: attribute.Required, settings: s.getSettings()}
+		attribute.Required = false
+		attribute.Optional = true
+		attribute.Computed = true
+		inherited := "Defaults to the " + name + " set in the defaults of the provider."
+		if description := strings.TrimSpace(attribute.Description); description == "" {
+			attribute.Description = inherited
+		} else if strings.HasSuffix(description, ".") {
+			attribute.Description = description + " " + inherited
+		} else {
+			attribute.Description = description + ". " + inherited
+		}
+		attribute.PlanModifiers = append(attribute.PlanModifiers, modifier)
+		resourceSchema.Attributes[name] = attribute
+	}
+}
+
+// applyProviderDefaultPlaceholders sets the provider defaults, or a placeholder when the provider is not
+// configured yet, on the attributes of the decoded create input left out of the configuration, so the SDK
+// validation rules requiring them pass.
+func (s *IdsecResource) applyProviderDefaultPlaceholders(ctx context.Context, config *tfsdk.Config, input interface{}) {
+	for _, name := range s.actionDefinition.ProviderDefaultAttributes {
+		var configured types.String
+		if diags := config.GetAttribute(ctx, path.Root(name), &configured); diags.HasError() || !configured.IsNull() {
+			continue
+		}
+		value, ok := s.getSettings().defaults[name]
+		if !ok || value == "" {
+			value = providerDefaultPlaceholder
+		}
+		schemas.SetEmptyStringAttribute(input, name, value)
+	}
+}
@@ -0,0 +1,119 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/cyberark/terraform-provider-idsec/internal/actions"
+)
+
+type providerDefaultsTestModel struct {
+	Name     string `json:"name" mapstructure:"name" validate:"required"`
+	SafeName string `json:"safe_name" mapstructure:"safe_name" validate:"required"`
+}
+
+func providerDefaultsTestResource(t *testing.T) (*IdsecResource, resource.SchemaResponse) {
+	t.Helper()
+	operations := []actions.IdsecServiceActionOperation{actions.CreateOperation, actions.ReadOperation}
+	return CreateTestResourceWithSchema(t, &providerDefaultsTestModel{}, operations, func(actionDefinition *actions.IdsecServiceTerraformResourceActionDefinition) {
+		actionDefinition.ProviderDefaultAttributes = []string{"safe_name", "missing"}
+	})
+}
+
+func TestParseProviderDefaults(t *testing.T) {
+	defaults, err := parseProviderDefaults(map[string]string{" safe_name ": "crown-jewels"})
+	if err != nil || defaults["safe_name"] != "crown-jewels" {
+		t.Errorf("unexpected defaults %v, %v", defaults, err)
+	}
+	if _, err := parseProviderDefaults(map[string]string{" ": "crown-jewels"}); err == nil {
+		t.Error("expected an error for an empty attribute name")
+	}
+}
+
+func TestIdsecResource_ProviderDefaultsSchema(t *testing.T) {
+	_, schemaResp := providerDefaultsTestResource(t)
+	safeName := schemaResp.Schema.Attributes["safe_name"].(schema.StringAttribute)
+	if safeName.Required || !safeName.Optional || !safeName.Computed {
+		t.Errorf("expected safe_name to become optional and computed, got %+v", safeName)
+	}
+	if safeName.Description != "Defaults to the safe_name set in the defaults of the provider." {
+		t.Errorf("unexpected description %q", safeName.Description)
+	}
+	if name := schemaResp.Schema.Attributes["name"].(schema.StringAttribute); !name.Required {
+		t.Error("expected the attributes not inheriting defaults to stay required")
+	}
+}
+
+func TestProviderDefaultModifier(t *testing.T) {
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"safe_name": tftypes.String}}
+	existing := tftypes.NewValue(objectType, map[string]tftypes.Value{"safe_name": tftypes.NewValue(tftypes.String, "old-safe")})
+	tests := []struct {
+		name      string
+		defaults  map[string]string
+		config    types.String
+		state     tftypes.Value
+		planned   types.String
+		expected  types.String
+		wantError bool
+	}{
+		{name: "inherits_default", defaults: map[string]string{"safe_name": "crown-jewels"}, config: types.StringNull(), state: tftypes.NewValue(objectType, nil), planned: types.StringUnknown(), expected: types.StringValue("crown-jewels")},
+		{name: "configured_value_wins", defaults: map[string]string{"safe_name": "crown-jewels"}, config: types.StringValue("other"), state: tftypes.NewValue(objectType, nil), planned: types.StringValue("other"), expected: types.StringValue("other")},
+		{name: "missing_on_create", config: types.StringNull(), state: tftypes.NewValue(objectType, nil), planned: types.StringUnknown(), expected: types.StringUnknown(), wantError: true},
+		{name: "missing_keeps_state", config: types.StringNull(), state: existing, planned: types.StringUnknown(), expected: types.StringValue("old-safe")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stateValue := types.StringNull()
+			if !tt.state.IsNull() {
+				stateValue = types.StringValue("old-safe")
+			}
+			req := planmodifier.StringRequest{
+				Path:        path.Root("safe_name"),
+				Plan:        tfsdk.Plan{Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{"safe_name": tftypes.NewValue(tftypes.String, tftypes.UnknownValue)})},
+				State:       tfsdk.State{Raw: tt.state},
+				ConfigValue: tt.config,
+				StateValue:  stateValue,
+				PlanValue:   tt.planned,
+			}
+			resp := &planmodifier.StringResponse{PlanValue: tt.planned}
+			providerDefaultModifier{attribute: "safe_name", required: true, settings: &providerSettings{defaults: tt.defaults}}.PlanModifyString(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Fatalf("expected error=%t, got %v", tt.wantError, resp.Diagnostics)
+			}
+			if !resp.PlanValue.Equal(tt.expected) {
+				t.Errorf("expected %s, got %s", tt.expected, resp.PlanValue)
+			}
+		})
+	}
+}
+
+func TestIdsecResource_ApplyProviderDefaultPlaceholders(t *testing.T) {
+	ctx := context.Background()
+	idsecRes, schemaResp := providerDefaultsTestResource(t)
+	config := tfsdk.Config{Schema: schemaResp.Schema, Raw: CreateTestResourceValue(schemaResp, map[string]tftypes.Value{
+		"name": tftypes.NewValue(tftypes.String, "web"),
+	})}
+
+	input := &providerDefaultsTestModel{Name: "web"}
+	idsecRes.applyProviderDefaultPlaceholders(ctx, &config, input)
+	if input.SafeName != providerDefaultPlaceholder {
+		t.Errorf("expected the placeholder before the provider is configured, got %q", input.SafeName)
+	}
+
+	idsecRes.settings = &providerSettings{defaults: map[string]string{"safe_name": "crown-jewels"}}
+	input = &providerDefaultsTestModel{Name: "web"}
+	idsecRes.applyProviderDefaultPlaceholders(ctx, &config, input)
+	if input.SafeName != "crown-jewels" {
+		t.Errorf("expected the provider default, got %q", input.SafeName)
+	}
+}
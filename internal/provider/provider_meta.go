@@ -0,0 +1,88 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	terraformprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/provider/metaschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/cyberark/idsec-sdk-golang/pkg/services"
+)
+
+// moduleHeader is the request header carrying the module of the resource or data source to the Idsec APIs.
+const moduleHeader = "X-Idsec-Terraform-Module"
+
+var _ terraformprovider.ProviderWithMetaSchema = &IdsecProvider{}
+
+// IdsecProviderMetaSchema describes the provider_meta block a module sets in its terraform block.
+type IdsecProviderMetaSchema struct {
+	ModuleName    types.String `tfsdk:"module_name"`
+	ModuleVersion types.String `tfsdk:"module_version"`
+}
+
+// MetaSchema defines the provider_meta block, with which modules identify themselves so the objects
+// they manage can be attributed to them in the tenant.
+func (p *IdsecProvider) MetaSchema(ctx context.Context, req terraformprovider.MetaSchemaRequest, resp *terraformprovider.MetaSchemaResponse) {
+	resp.Schema = metaschema.Schema{
+		Attributes: map[string]metaschema.Attribute{
+			"module_name": metaschema.StringAttribute{
+				Optional:            true,
+				Description:         "Name of the module managing the resources, sent to the Idsec APIs with the requests of its resources and data sources.",
+				MarkdownDescription: "Name of the module managing the resources, sent to the Idsec APIs with the requests of its resources and data sources.",
+			},
+			"module_version": metaschema.StringAttribute{
+				Optional:            true,
+				Description:         "Version of the module managing the resources.",
+				MarkdownDescription: "Version of the module managing the resources.",
+			},
+		},
+	}
+}
+
+// moduleFromProviderMeta returns the module set in the provider_meta block of the module of a resource
+// or data source, e.g. "safes/1.4.0", or an empty string when the module sets no name.
+func moduleFromProviderMeta(ctx context.Context, providerMeta tfsdk.Config) string {
+	if providerMeta.Raw.IsNull() || !providerMeta.Raw.IsKnown() {
+		return ""
+	}
+	var meta IdsecProviderMetaSchema
+	if diags := providerMeta.Get(ctx, &meta); diags.HasError() {
+		tflog.Warn(ctx, "Failed to read provider_meta, the module is not attributed")
+		return ""
+	}
+	name := strings.TrimSpace(meta.ModuleName.ValueString())
+	if name == "" {
+		return ""
+	}
+	if version := strings.TrimSpace(meta.ModuleVersion.ValueString()); version != "" {
+		return fmt.Sprintf("%s/%s", name, version)
+	}
+	return name
+}
+
+// moduleAttribution attributes the current operation to the module of the resource or data source: the
+// module is added to the log fields of the returned context and to the telemetry context, and the returned
+// update adds it to the User-Agent as a comment and to the module header, for the caller to scope to the
+// requests of the operation. It returns a nil update when the module sets no name.
+func (h *IdsecServiceHelper) moduleAttribution(ctx context.Context, service services.IdsecService, providerMeta tfsdk.Config) (context.Context, requestHeadersUpdate) {
+	module := moduleFromProviderMeta(ctx, providerMeta)
+	if module == "" {
+		return ctx, nil
+	}
+	ctx = tflog.SetField(ctx, "terraform_module", module)
+	tflog.Debug(ctx, "Attributing operation to module")
+	h.addTelemetryContextField(service, "terraform_module", "tfm", module)
+	return ctx, func(current map[string]string) map[string]string {
+		return map[string]string{
+			"User-Agent": strings.TrimSpace(fmt.Sprintf("%s (module %s)", current["User-Agent"], module)),
+			moduleHeader: module,
+		}
+	}
+}
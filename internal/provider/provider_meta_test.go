@@ -0,0 +1,80 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	terraformprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testProviderMeta builds the provider_meta of a module setting the given attributes.
+func testProviderMeta(t *testing.T, values map[string]string) tfsdk.Config {
+	t.Helper()
+	ctx := context.Background()
+	schemaResp := &terraformprovider.MetaSchemaResponse{}
+	(&IdsecProvider{}).MetaSchema(ctx, terraformprovider.MetaSchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	attributes := map[string]tftypes.Value{}
+	for name := range objectType.AttributeTypes {
+		attributes[name] = tftypes.NewValue(tftypes.String, nil)
+		if value, ok := values[name]; ok {
+			attributes[name] = tftypes.NewValue(tftypes.String, value)
+		}
+	}
+	return tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, attributes)}
+}
+
+func TestModuleFromProviderMeta(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		meta     tfsdk.Config
+		expected string
+	}{
+		{name: "name_and_version", meta: testProviderMeta(t, map[string]string{"module_name": "safes", "module_version": "1.4.0"}), expected: "safes/1.4.0"},
+		{name: "name_only", meta: testProviderMeta(t, map[string]string{"module_name": "safes"}), expected: "safes"},
+		{name: "version_only", meta: testProviderMeta(t, map[string]string{"module_version": "1.4.0"}), expected: ""},
+		{name: "not_set", meta: tfsdk.Config{}, expected: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := moduleFromProviderMeta(context.Background(), tt.meta); got != tt.expected {
+				t.Errorf("Expected module %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestModuleAttribution(t *testing.T) {
+	t.Parallel()
+
+	service := &mockServiceWithClient{client: &mockHeadersClient{headers: map[string]string{"User-Agent": "sdk terraform-provider-idsec/1.0.0"}}}
+	helper := &IdsecServiceHelper{}
+
+	_, moduleHeaders := helper.moduleAttribution(context.Background(), service, testProviderMeta(t, map[string]string{"module_name": "safes", "module_version": "1.4.0"}))
+	restore := helper.scopeRequestHeaders(service, moduleHeaders)
+	expected := map[string]string{"User-Agent": "sdk terraform-provider-idsec/1.0.0 (module safes/1.4.0)", moduleHeader: "safes/1.4.0"}
+	if !reflect.DeepEqual(service.client.headers, expected) {
+		t.Errorf("Expected %v, got %v", expected, service.client.headers)
+	}
+
+	// The attribution ends with the operation.
+	restore()
+	expected = map[string]string{"User-Agent": "sdk terraform-provider-idsec/1.0.0"}
+	if !reflect.DeepEqual(service.client.headers, expected) {
+		t.Errorf("Expected %v, got %v", expected, service.client.headers)
+	}
+
+	// Resources outside of an attributed module send no attribution.
+	if _, moduleHeaders := helper.moduleAttribution(context.Background(), service, tfsdk.Config{}); moduleHeaders != nil {
+		t.Error("Expected no module headers without provider_meta")
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"time"
+)
+
+// providerSettings holds the settings of a provider configuration read by its resources, data sources,
+// list resources and actions while they run. The provider passes the same settings to every one of them
+// as it creates them, and Configure fills them in, as resources are created before the provider is
+// configured, e.g. to read their schema.
+type providerSettings struct {
+	// requestHeaders holds the extra headers configured on the provider, applied to the clients of every
+	// service used by resources and data sources.
+	requestHeaders map[string]string
+	// userAgent is appended to the User-Agent of every service client so tenant-side audit logs can
+	// attribute changes to a specific provider build and Terraform version.
+	userAgent string
+	// changeReason holds the provider level change reason, passed to the reason/comment field of
+	// operations that declare one, unless the resource sets that field itself.
+	changeReason string
+	// correlationID holds the correlation ID of the Terraform run. Every resource and data source
+	// operation derives its own sub-ID from it.
+	correlationID string
+	// consistencyRetries holds how many times a create or update failing with a reference-not-found error
+	// is retried, to absorb the eventual consistency of objects created earlier in the same apply.
+	consistencyRetries int64
+	// destroyRetries holds how many times a delete failing with a throttling error is retried.
+	destroyRetries int64
+	// retryableErrorRules holds the rules configured through the retryable_errors provider attribute,
+	// extending the built-in retry classification.
+	retryableErrorRules []retryRule
+	// strictSchemaSync decides whether API response attributes that are missing from a schema are raised
+	// as warning diagnostics instead of only being logged at debug level.
+	strictSchemaSync bool
+	// validateReferences decides whether attributes referencing objects of other services are looked up
+	// on the tenant while planning, so dangling references fail the plan instead of the apply.
+	validateReferences bool
+	// validateUniqueNames decides whether the names of resources being created or renamed are looked up
+	// on the tenant while planning, for services whose names are unique across the tenant.
+	validateUniqueNames bool
+	// ignoreUnavailableServices decides whether data sources of services that are not enabled on the
+	// tenant are skipped with a warning instead of failing.
+	ignoreUnavailableServices bool
+	// recoverPanics decides whether panics raised while calling SDK actions or converting their results
+	// are turned into diagnostics of the failing resource, instead of crashing the provider and every
+	// other operation of the run with it.
+	recoverPanics bool
+	// destroyQueue is the queue built from the destroy_concurrency provider attribute. A nil queue leaves
+	// deletes unbounded.
+	destroyQueue *destroyQueue
+	// serviceConcurrency is the limiter built from the service_concurrency provider attribute. A nil
+	// limiter leaves all services unbounded.
+	serviceConcurrency *serviceLimiter
+	// serviceTimeouts holds the default operation timeouts of the service_timeouts provider attribute,
+	// keyed by service name or service family.
+	serviceTimeouts map[string]time.Duration
+	// defaults holds the values of the defaults provider attribute, keyed by attribute name.
+	defaults map[string]string
+	// circuitBreaker is the breaker built from the circuit_breaker_threshold provider attribute. A nil
+	// breaker never opens.
+	circuitBreaker *circuitBreaker
+	// planSummaryFile is the file the summaries of planned changes are appended to, or "" to not write
+	// them.
+	planSummaryFile string
+	// operationHooks holds the hooks invoked around resource creates, updates and deletes. A nil
+	// configuration disables hooks.
+	operationHooks *operationHookConfig
+	// credentialsRefresher re-resolves the credentials of the configured provider and authenticates again
+	// when they changed since, reporting whether the auth holds credentials renewed after since. It is set
+	// once the provider authenticated.
+	credentialsRefresher func(ctx context.Context, since time.Time) bool
+}
+
+// newProviderSettings returns the settings of a provider that is not configured yet.
+func newProviderSettings() *providerSettings {
+	return &providerSettings{recoverPanics: IdsecRecoverPanicsDefault}
+}
+
+// getSettings returns the settings of the provider that created the resource, data source or action, or
+// those of a provider that is not configured yet when it was created on its own.
+func (h *IdsecServiceHelper) getSettings() *providerSettings {
+	if h.settings == nil {
+		return newProviderSettings()
+	}
+	return h.settings
+}
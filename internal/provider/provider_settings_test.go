@@ -0,0 +1,52 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+)
+
+// settingsHolder is implemented by the resources, data sources, list resources and actions reading the
+// settings of the provider.
+type settingsHolder interface {
+	getSettings() *providerSettings
+}
+
+// TestIdsecProvider_SharesSettings tests that everything the provider creates reads the settings of the
+// provider, including the resources supporting resource identity.
+func TestIdsecProvider_SharesSettings(t *testing.T) {
+	ctx := context.Background()
+	p := NewIdsecProvider(IdsecProviderConfig{})().(*IdsecProvider)
+
+	var created []any
+	for _, newResource := range p.Resources(ctx) {
+		created = append(created, newResource())
+	}
+	for _, newDataSource := range p.DataSources(ctx) {
+		created = append(created, newDataSource())
+	}
+	for _, newListResource := range p.ListResources(ctx) {
+		created = append(created, newListResource())
+	}
+	for _, newAction := range p.Actions(ctx) {
+		created = append(created, newAction())
+	}
+	identityResources := 0
+	for _, c := range created {
+		if _, ok := c.(*IdsecResourceWithIdentity); ok {
+			identityResources++
+		}
+		holder, ok := c.(settingsHolder)
+		if !ok {
+			continue
+		}
+		if holder.getSettings() != p.settings {
+			t.Errorf("Expected %T to read the settings of the provider", c)
+		}
+	}
+	if identityResources == 0 {
+		t.Error("Expected resources supporting resource identity")
+	}
+}
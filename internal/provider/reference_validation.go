@@ -0,0 +1,141 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/cyberark/idsec-sdk-golang/pkg/services"
+	"github.com/cyberark/terraform-provider-idsec/internal/actions"
+	"github.com/cyberark/terraform-provider-idsec/internal/schemas"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+)
+
+// attributeReferences returns the references declared by the create and update schemas of the resource
+// and by its action definition, once per attribute.
+func (s *IdsecResource) attributeReferences() []schemas.AttributeReference {
+	var references []schemas.AttributeReference
+	seen := map[string]bool{}
+	for _, operation := range []actions.IdsecServiceActionOperation{actions.CreateOperation, actions.UpdateOperation} {
+		operationSchema, err := s.schemaForOperation(operation)
+		if err != nil {
+			continue
+		}
+		for _, reference := range schemas.AttributeReferences(operationSchema, s.actionDefinition.ReferenceAttributes) {
+			if seen[reference.Attribute] {
+				continue
+			}
+			seen[reference.Attribute] = true
+			references = append(references, reference)
+		}
+	}
+	return references
+}
+
+// referenceValueString renders a planned string or number attribute as the value sent to the lookup action.
+// The second return value is false when the value is null, unknown, empty or of another type.
+func referenceValueString(value attr.Value) (string, bool) {
+	if value == nil || value.IsNull() || value.IsUnknown() {
+		return "", false
+	}
+	switch v := value.(type) {
+	case types.String:
+		return v.ValueString(), v.ValueString() != ""
+	case types.Int64:
+		return strconv.FormatInt(v.ValueInt64(), 10), true
+	default:
+		return "", false
+	}
+}
+
+// validatePlannedReferences looks up the objects referenced by planned attributes when validate_references
+// is enabled, so references to objects missing from the tenant fail the plan instead of the apply.
+// Attributes whose value did not change since the last apply are not looked up again.
+func (s *IdsecResource) validatePlannedReferences(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !s.getSettings().validateReferences || req.Plan.Raw.IsNull() || s.idsecAPI == nil {
+		return
+	}
+	for _, reference := range s.attributeReferences() {
+		attributePath := path.Root(reference.Attribute)
+		var planned attr.Value
+		if diags := req.Plan.GetAttribute(ctx, attributePath, &planned); diags.HasError() {
+			continue
+		}
+		value, ok := referenceValueString(planned)
+		if !ok {
+			continue
+		}
+		if !req.State.Raw.IsNull() {
+			var current attr.Value
+			if diags := req.State.GetAttribute(ctx, attributePath, &current); !diags.HasError() && current != nil && current.Equal(planned) {
+				continue
+			}
+		}
+		s.validateReference(ctx, reference, value, &resp.Diagnostics)
+	}
+}
+
+// validateReference calls the lookup action of the reference with the planned value. A reference-not-found
+// error is reported as an attribute error; other failures only produce a warning, since they say nothing
+// about the existence of the referenced object.
+func (s *IdsecResource) validateReference(ctx context.Context, reference schemas.AttributeReference, value string, diagnostics *diag.Diagnostics) {
+	attributePath := path.Root(reference.Attribute)
+	err := s.lookupReference(ctx, reference, value)
+	if err == nil {
+		return
+	}
+	if isReferenceNotFoundError(err) {
+		diagnostics.AddAttributeError(
+			attributePath,
+			"Invalid Reference",
+			fmt.Sprintf("The value %q of %s references an object that was not found by %s %s: %s", value, reference.Attribute, reference.Service, reference.Action, err.Error()),
+		)
+		return
+	}
+	tflog.Warn(ctx, fmt.Sprintf("Failed to validate reference of %s: %s", reference.Attribute, err.Error()))
+	diagnostics.AddAttributeWarning(
+		attributePath,
+		"Reference Not Validated",
+		fmt.Sprintf("Unable to look up %q referenced by %s with %s %s: %s", value, reference.Attribute, reference.Service, reference.Action, err.Error()),
+	)
+}
+
+// lookupReference resolves the service and action of the reference and calls the action with an input
+// holding the value in the input field of the reference.
+func (s *IdsecResource) lookupReference(ctx context.Context, reference schemas.AttributeReference, value string) error {
+	helper := IdsecServiceHelper{serviceConfig: &services.IdsecServiceConfig{ServiceName: reference.Service}, settings: s.settings}
+	if err := helper.configureService(s.idsecAPI); err != nil {
+		return err
+	}
+	titleCase := cases.Title(language.English)
+	actionName := strings.ReplaceAll(titleCase.String(reference.Action), "-", "")
+	actionMethod, err := schemas.FindMethodByName(reflect.ValueOf(helper.getService()), actionName)
+	if err != nil || actionMethod == nil || !actionMethod.IsValid() {
+		return fmt.Errorf("action method %s not found on service %s", actionName, reference.Service)
+	}
+	if actionMethod.Type().NumIn() != 1 {
+		return fmt.Errorf("action method %s must take a single input", actionName)
+	}
+	inputType := actionMethod.Type().In(0)
+	prototype := reflect.Zero(inputType).Interface()
+	if inputType.Kind() == reflect.Pointer {
+		prototype = reflect.New(inputType.Elem()).Interface()
+	}
+	input, err := schemas.StructFromStringMap(map[string]string{reference.InputField: value}, prototype)
+	if err != nil {
+		return err
+	}
+	return callResultError(s.callActionMethod(ctx, *actionMethod, []reflect.Value{reflect.ValueOf(input)}))
+}
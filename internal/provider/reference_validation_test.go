@@ -0,0 +1,47 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestReferenceValueString(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    attr.Value
+		expected string
+		ok       bool
+	}{
+		{name: "success_string", value: types.StringValue("Safe1"), expected: "Safe1", ok: true},
+		{name: "success_int64", value: types.Int64Value(42), expected: "42", ok: true},
+		{name: "skip_empty_string", value: types.StringValue("")},
+		{name: "skip_null", value: types.StringNull()},
+		{name: "skip_unknown", value: types.StringUnknown()},
+		{name: "skip_other_type", value: types.BoolValue(true)},
+		{name: "skip_nil", value: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, ok := referenceValueString(tt.value)
+			if ok != tt.ok || value != tt.expected {
+				t.Errorf("expected (%q, %v), got (%q, %v)", tt.expected, tt.ok, value, ok)
+			}
+		})
+	}
+}
+
+func TestModifyPlanSkipsReferenceValidationWhenDisabled(t *testing.T) {
+	r := &IdsecResource{IdsecServiceHelper: IdsecServiceHelper{settings: &providerSettings{validateReferences: false}}}
+	resp := &resource.ModifyPlanResponse{}
+	r.ModifyPlan(context.Background(), resource.ModifyPlanRequest{}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Errorf("expected no diagnostics, got %v", resp.Diagnostics)
+	}
+}
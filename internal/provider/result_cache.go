@@ -0,0 +1,183 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+)
+
+// resultCacheEntry holds the values returned by one action method call, the items of the channels
+// among them drained into slices. done is closed once the call completed, so concurrent reads of the
+// same key wait for the call in flight instead of repeating it.
+type resultCacheEntry struct {
+	done    chan struct{}
+	result  []reflect.Value
+	items   map[int][]reflect.Value
+	expires time.Time
+}
+
+// resultCache memoizes the results of data source action calls within a Terraform run, keyed by
+// service, action and input, so data sources reading the same collection share a single API call.
+// Each provider instance owns one, reset when the provider is configured.
+type resultCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]*resultCacheEntry
+	now     func() time.Time
+}
+
+// newResultCache creates a result cache, disabled until configured with a ttl.
+func newResultCache() *resultCache {
+	return &resultCache{
+		entries: map[string]*resultCacheEntry{},
+		now:     time.Now,
+	}
+}
+
+// configure drops the cached results and keeps the results of later calls for ttl. A ttl of zero
+// disables caching.
+func (c *resultCache) configure(ttl time.Duration) {
+	if c == nil {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ttl = ttl
+	c.entries = map[string]*resultCacheEntry{}
+}
+
+// resultCacheKey builds the cache key of an action call from its service, action and JSON encoded input.
+// The second return value is false when the input cannot be encoded, in which case the call is not cached.
+func resultCacheKey(serviceName string, actionName string, input interface{}) (string, bool) {
+	encodedInput, err := json.Marshal(input)
+	if err != nil {
+		return "", false
+	}
+	return fmt.Sprintf("%s/%s/%s", serviceName, actionName, encodedInput), true
+}
+
+// resultStore persists cached results beyond the provider process, so later Terraform runs reuse them.
+type resultStore interface {
+	// load returns the stored result of key, the items of its channels and when it was fetched.
+	load(key string) (result []reflect.Value, items map[int][]reflect.Value, fetchedAt time.Time, ok bool)
+	// save stores the result of key fetched at fetchedAt. Failing to store it only loses the caching.
+	save(key string, result []reflect.Value, items map[int][]reflect.Value, fetchedAt time.Time)
+}
+
+// call returns the cached result of key when present and not expired, and otherwise calls fn and caches
+// its result. ttl, when positive, overrides the ttl of the cache for this call, and store, when not nil,
+// is read before calling fn and keeps the result for later runs. Results holding an error are not kept.
+// The channels of a result, such as the pages of a list, are drained once and every caller is handed a
+// new channel of their items. The second return value reports whether the result came from the cache.
+func (c *resultCache) call(key string, ttl time.Duration, store resultStore, fn func() []reflect.Value) ([]reflect.Value, bool) {
+	if c == nil {
+		return fn(), false
+	}
+	c.mu.Lock()
+	if ttl <= 0 {
+		ttl = c.ttl
+	}
+	if ttl <= 0 {
+		c.mu.Unlock()
+		return fn(), false
+	}
+	if entry, ok := c.entries[key]; ok {
+		c.mu.Unlock()
+		<-entry.done
+		c.mu.Lock()
+		if c.entries[key] == entry && entry.result != nil && c.now().Before(entry.expires) {
+			c.mu.Unlock()
+			return entry.replay(), true
+		}
+		if c.entries[key] == entry {
+			delete(c.entries, key)
+		}
+	}
+	entry := &resultCacheEntry{done: make(chan struct{})}
+	c.entries[key] = entry
+	c.mu.Unlock()
+	// Closed even when fn panics, so the calls waiting for it are not blocked forever
+	defer close(entry.done)
+	cached := false
+	defer func() {
+		if !cached {
+			c.mu.Lock()
+			if c.entries[key] == entry {
+				delete(c.entries, key)
+			}
+			c.mu.Unlock()
+		}
+	}()
+
+	if store != nil {
+		if result, items, fetchedAt, ok := store.load(key); ok {
+			if age := c.now().Sub(fetchedAt); age >= 0 && age < ttl {
+				c.mu.Lock()
+				entry.result, entry.items = result, items
+				entry.expires = fetchedAt.Add(ttl)
+				cached = true
+				c.mu.Unlock()
+				return entry.replay(), true
+			}
+		}
+	}
+	result := fn()
+	if callResultError(result) != nil {
+		return result, false
+	}
+	entry.drain(result)
+	fetchedAt := c.now()
+	if store != nil {
+		store.save(key, result, entry.items, fetchedAt)
+	}
+
+	c.mu.Lock()
+	entry.result = result
+	entry.expires = fetchedAt.Add(ttl)
+	cached = true
+	c.mu.Unlock()
+	return entry.replay(), false
+}
+
+// drain reads the channels of result until they are closed, keeping their items.
+func (e *resultCacheEntry) drain(result []reflect.Value) {
+	for i, value := range result {
+		if value.Kind() != reflect.Chan || value.IsNil() {
+			continue
+		}
+		var items []reflect.Value
+		for {
+			item, ok := value.Recv()
+			if !ok {
+				break
+			}
+			items = append(items, item)
+		}
+		if e.items == nil {
+			e.items = map[int][]reflect.Value{}
+		}
+		e.items[i] = items
+	}
+}
+
+// replay returns the cached result, with a new closed channel holding the drained items in place of
+// each channel.
+func (e *resultCacheEntry) replay() []reflect.Value {
+	result := make([]reflect.Value, len(e.result))
+	copy(result, e.result)
+	for i, items := range e.items {
+		channelType := result[i].Type()
+		channel := reflect.MakeChan(reflect.ChanOf(reflect.BothDir, channelType.Elem()), len(items))
+		for _, item := range items {
+			channel.Send(item)
+		}
+		channel.Close()
+		result[i] = channel.Convert(channelType)
+	}
+	return result
+}
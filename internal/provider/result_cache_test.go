@@ -0,0 +1,172 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+type testCacheInput struct {
+	SafeName string `json:"safe_name"`
+}
+
+func countingCall(calls *int32, err error) func() []reflect.Value {
+	return func() []reflect.Value {
+		atomic.AddInt32(calls, 1)
+		errValue := reflect.Zero(reflect.TypeOf((*error)(nil)).Elem())
+		if err != nil {
+			errValue = reflect.ValueOf(&err).Elem()
+		}
+		return []reflect.Value{reflect.ValueOf(&testCacheInput{SafeName: "result"}), errValue}
+	}
+}
+
+// testResultCache returns a result cache keeping results for ttl.
+func testResultCache(ttl time.Duration) *resultCache {
+	cache := newResultCache()
+	cache.configure(ttl)
+	return cache
+}
+
+func TestResultCacheKey(t *testing.T) {
+	first, ok := resultCacheKey("pcloud-safes", "get", &testCacheInput{SafeName: "a"})
+	if !ok {
+		t.Fatal("expected input to be encodable")
+	}
+	second, _ := resultCacheKey("pcloud-safes", "get", &testCacheInput{SafeName: "b"})
+	other, _ := resultCacheKey("pcloud-accounts", "get", &testCacheInput{SafeName: "a"})
+	if first == second || first == other {
+		t.Errorf("expected distinct keys, got %q, %q and %q", first, second, other)
+	}
+	if _, ok := resultCacheKey("pcloud-safes", "get", make(chan int)); ok {
+		t.Error("expected unencodable input to be reported")
+	}
+}
+
+func TestResultCacheCall(t *testing.T) {
+	t.Run("success_reuses_result_within_ttl", func(t *testing.T) {
+		var calls int32
+		cache := testResultCache(time.Minute)
+		cache.call("key", 0, nil, countingCall(&calls, nil))
+		_, cached := cache.call("key", 0, nil, countingCall(&calls, nil))
+		if !cached || calls != 1 {
+			t.Errorf("expected one call and a cached result, got %d calls, cached=%v", calls, cached)
+		}
+	})
+
+	t.Run("success_refetches_after_ttl", func(t *testing.T) {
+		var calls int32
+		now := time.Now()
+		cache := testResultCache(time.Minute)
+		cache.now = func() time.Time { return now }
+		cache.call("key", 0, nil, countingCall(&calls, nil))
+		now = now.Add(2 * time.Minute)
+		_, cached := cache.call("key", 0, nil, countingCall(&calls, nil))
+		if cached || calls != 2 {
+			t.Errorf("expected two calls after expiry, got %d calls, cached=%v", calls, cached)
+		}
+	})
+
+	t.Run("success_errors_not_cached", func(t *testing.T) {
+		var calls int32
+		cache := testResultCache(time.Minute)
+		cache.call("key", 0, nil, countingCall(&calls, errors.New("boom")))
+		_, cached := cache.call("key", 0, nil, countingCall(&calls, nil))
+		if cached || calls != 2 {
+			t.Errorf("expected failed call to be repeated, got %d calls, cached=%v", calls, cached)
+		}
+	})
+
+	t.Run("success_disabled_by_default", func(t *testing.T) {
+		var calls int32
+		cache := newResultCache()
+		cache.call("key", 0, nil, countingCall(&calls, nil))
+		cache.call("key", 0, nil, countingCall(&calls, nil))
+		if calls != 2 {
+			t.Errorf("expected every call to reach the API, got %d calls", calls)
+		}
+	})
+
+	t.Run("success_collapses_concurrent_calls", func(t *testing.T) {
+		var calls int32
+		cache := testResultCache(time.Minute)
+		release := make(chan struct{})
+		slowCall := func() []reflect.Value {
+			<-release
+			return countingCall(&calls, nil)()
+		}
+		var wg sync.WaitGroup
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				cache.call("key", 0, nil, slowCall)
+			}()
+		}
+		time.Sleep(20 * time.Millisecond)
+		close(release)
+		wg.Wait()
+		if calls != 1 {
+			t.Errorf("expected concurrent identical calls to share one API call, got %d", calls)
+		}
+	})
+
+	t.Run("success_replays_channels", func(t *testing.T) {
+		var calls int32
+		cache := testResultCache(time.Minute)
+		listCall := func() []reflect.Value {
+			atomic.AddInt32(&calls, 1)
+			pages := make(chan *testCacheInput, 2)
+			pages <- &testCacheInput{SafeName: "a"}
+			pages <- &testCacheInput{SafeName: "b"}
+			close(pages)
+			var readOnly <-chan *testCacheInput = pages
+			return []reflect.Value{reflect.ValueOf(readOnly), reflect.Zero(reflect.TypeOf((*error)(nil)).Elem())}
+		}
+		for i := 0; i < 2; i++ {
+			result, _ := cache.call("key", 0, nil, listCall)
+			pages, ok := result[0].Interface().(<-chan *testCacheInput)
+			if !ok {
+				t.Fatalf("expected a receive-only channel, got %v", result[0].Type())
+			}
+			var names []string
+			for page := range pages {
+				names = append(names, page.SafeName)
+			}
+			if len(names) != 2 || names[0] != "a" || names[1] != "b" {
+				t.Errorf("expected every caller to receive the pages, got %v", names)
+			}
+		}
+		if calls != 1 {
+			t.Errorf("expected the list to be fetched once, got %d calls", calls)
+		}
+	})
+
+	t.Run("success_panic_releases_waiters", func(t *testing.T) {
+		var calls int32
+		cache := testResultCache(time.Minute)
+		func() {
+			defer func() { _ = recover() }()
+			cache.call("key", 0, nil, func() []reflect.Value { panic("boom") })
+		}()
+		done := make(chan struct{})
+		go func() {
+			defer close(done)
+			cache.call("key", 0, nil, countingCall(&calls, nil))
+		}()
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("expected a call after a panicking one not to block")
+		}
+		if calls != 1 {
+			t.Errorf("expected the call to be made again, got %d calls", calls)
+		}
+	})
+}
@@ -0,0 +1,80 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/cyberark/terraform-provider-idsec/internal/actions"
+)
+
+// statusCodeRulePattern matches retry rules naming an HTTP status code, e.g. "503", or a status class, e.g. "5xx".
+var statusCodeRulePattern = regexp.MustCompile(`^[1-5]([0-9]{2}|xx)$`)
+
+// retryRule is a user configured rule making matching errors retriable. It matches either the HTTP
+// status code reported by the Idsec APIs as "[503]" in error messages, or a regular expression
+// matched against the whole error message.
+type retryRule struct {
+	rule    string
+	pattern *regexp.Regexp
+}
+
+// parseRetryRule parses a status code, status class or regular expression rule.
+func parseRetryRule(rule string) (retryRule, error) {
+	rule = strings.TrimSpace(rule)
+	if rule == "" {
+		return retryRule{}, fmt.Errorf("rules must not be empty")
+	}
+	if statusCodeRulePattern.MatchString(strings.ToLower(rule)) {
+		code := strings.ReplaceAll(strings.ToLower(rule), "x", "[0-9]")
+		return retryRule{rule: rule, pattern: regexp.MustCompile(`\[` + code + `\]`)}, nil
+	}
+	pattern, err := regexp.Compile(rule)
+	if err != nil {
+		return retryRule{}, fmt.Errorf("rule %q is not a status code or a valid regular expression: %w", rule, err)
+	}
+	return retryRule{rule: rule, pattern: pattern}, nil
+}
+
+// parseRetryRules parses the rules of the retryable_errors provider attribute.
+func parseRetryRules(rules []string) ([]retryRule, error) {
+	parsed := make([]retryRule, 0, len(rules))
+	for _, rule := range rules {
+		retryRule, err := parseRetryRule(rule)
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, retryRule)
+	}
+	return parsed, nil
+}
+
+// retryableReason classifies err as retriable or not for operation, returning a short description of
+// the matching class. Reference-not-found errors are retried for creates and updates: the service
+// rejected the operation without creating anything, so retrying a create cannot create the object
+// twice. Throttling errors are retried for deletes only, while errors matching one of the configured
+// rules are retried for every operation but creates, as retrying a create that reached the service may
+// create the object twice.
+func retryableReason(operation actions.IdsecServiceActionOperation, err error, rules []retryRule) (string, bool) {
+	if err == nil {
+		return "", false
+	}
+	if (operation == actions.CreateOperation || operation == actions.UpdateOperation) && isReferenceNotFoundError(err) {
+		return "a reference not found error", true
+	}
+	if operation == actions.DeleteOperation && isThrottlingError(err) {
+		return "a throttling error", true
+	}
+	if operation == actions.CreateOperation {
+		return "", false
+	}
+	for _, rule := range rules {
+		if rule.pattern.MatchString(err.Error()) {
+			return fmt.Sprintf("an error matching retryable rule %q", rule.rule), true
+		}
+	}
+	return "", false
+}
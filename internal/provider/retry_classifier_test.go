@@ -0,0 +1,87 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/cyberark/terraform-provider-idsec/internal/actions"
+)
+
+func TestParseRetryRule(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		rule      string
+		message   string
+		matches   bool
+		wantError bool
+	}{
+		{name: "status_code", rule: "503", message: "failed to create safe - [503] - [unavailable]", matches: true},
+		{name: "status_code_other_status", rule: "503", message: "failed to create safe - [500] - [error]", matches: false},
+		{name: "status_code_not_bracketed", rule: "503", message: "safe 5031 is locked", matches: false},
+		{name: "status_class", rule: "5xx", message: "failed to update policy - [502] - [bad gateway]", matches: true},
+		{name: "status_class_uppercase", rule: "5XX", message: "failed to update policy - [504] - [timeout]", matches: true},
+		{name: "regex", rule: "(?i)row lock", message: "Deadlock: Row Lock timeout", matches: true},
+		{name: "regex_no_match", rule: "row lock", message: "permission denied", matches: false},
+		{name: "error_empty", rule: " ", wantError: true},
+		{name: "error_invalid_regex", rule: "([a-", wantError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rule, err := parseRetryRule(tt.rule)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("Expected error=%v, got %v", tt.wantError, err)
+			}
+			if err != nil {
+				return
+			}
+			if got := rule.pattern.MatchString(tt.message); got != tt.matches {
+				t.Errorf("Expected match=%v, got %v", tt.matches, got)
+			}
+		})
+	}
+}
+
+// TestRetryableErrorRules tests that configured rules make matching errors retriable for every operation.
+func TestRetryableErrorRules(t *testing.T) {
+	previousDelay := consistencyRetryBaseDelay
+	t.Cleanup(func() { consistencyRetryBaseDelay = previousDelay })
+	consistencyRetryBaseDelay = time.Millisecond
+
+	rules, err := parseRetryRules([]string{"429", "tenant is busy"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if _, retriable := retryableReason(actions.ReadOperation, errors.New("failed to get safe - [429] - [too many requests]"), rules); !retriable {
+		t.Error("Expected a status code rule to make a read retriable")
+	}
+	if _, retriable := retryableReason(actions.CreateOperation, errors.New("failed to add safe - [429] - [too many requests]"), rules); retriable {
+		t.Error("Expected rules not to make creates retriable")
+	}
+	if _, retriable := retryableReason(actions.DeleteOperation, errors.New("failed to delete safe - [404] - [safe not found]"), rules); retriable {
+		t.Error("Expected reference not found errors of deletes to stay non retriable")
+	}
+
+	calls := 0
+	method := func() (string, error) {
+		calls++
+		if calls < 3 {
+			return "", errors.New("tenant is busy")
+		}
+		return "ok", nil
+	}
+	result := retryForConsistency(context.Background(), actions.DeleteOperation, 3, rules, func() []reflect.Value { return reflect.ValueOf(method).Call(nil) })
+	if calls != 3 || callResultError(result) != nil {
+		t.Errorf("Expected the delete to succeed on the third call, got %d calls and %v", calls, callResultError(result))
+	}
+}
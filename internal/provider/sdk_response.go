@@ -0,0 +1,22 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/cyberark/terraform-provider-idsec/internal/schemas"
+)
+
+// addSDKResponseAttributes adds the expose_sdk_response and sdk_response_json attributes to the schema of
+// a resource, unless the models already declare an attribute of either name.
+func addSDKResponseAttributes(resourceSchema *schema.Schema) {
+	if _, exists := resourceSchema.Attributes[schemas.ExposeSDKResponseAttributeName]; exists {
+		return
+	}
+	if _, exists := resourceSchema.Attributes[schemas.SDKResponseAttributeName]; exists {
+		return
+	}
+	resourceSchema.Attributes[schemas.ExposeSDKResponseAttributeName] = schemas.ExposeSDKResponseAttribute()
+	resourceSchema.Attributes[schemas.SDKResponseAttributeName] = schemas.SDKResponseAttribute()
+}
@@ -0,0 +1,22 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/cyberark/terraform-provider-idsec/internal/schemas"
+)
+
+func TestIdsecResource_SDKResponseAttributes(t *testing.T) {
+	_, schemaResp := deleteProtectionTestResource(t, deleteProtectionOperations)
+	expose, exists := schemaResp.Schema.Attributes[schemas.ExposeSDKResponseAttributeName]
+	if !exists || !expose.IsOptional() || expose.IsComputed() {
+		t.Errorf("expected an optional, non-computed expose_sdk_response attribute, got %#v", expose)
+	}
+	sdkResponse, exists := schemaResp.Schema.Attributes[schemas.SDKResponseAttributeName]
+	if !exists || !sdkResponse.IsComputed() || sdkResponse.IsOptional() || !sdkResponse.IsSensitive() {
+		t.Errorf("expected a computed-only, sensitive sdk_response_json attribute, got %#v", sdkResponse)
+	}
+}
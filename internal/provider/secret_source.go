@@ -0,0 +1,329 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Environment variables read by the Vault secret source, as set by the Vault CLI.
+const (
+	vaultAddrEnvVar      = "VAULT_ADDR"
+	vaultTokenEnvVar     = "VAULT_TOKEN" // #nosec G101
+	vaultNamespaceEnvVar = "VAULT_NAMESPACE"
+)
+
+// Defaults of the Vault secret source.
+const (
+	defaultVaultMount        = "secret"
+	defaultVaultAppRoleMount = "approle"
+	secretSourceTimeout      = 30 * time.Second
+)
+
+// IdsecSecretSourceModel describes the secret_source block of the provider configuration.
+type IdsecSecretSourceModel struct {
+	Vault  types.Object `tfsdk:"vault"`
+	Conjur types.Object `tfsdk:"conjur"`
+}
+
+// credentialSource is an external secret store the provider credential is read from.
+type credentialSource interface {
+	// storeName names the secret store in diagnostics.
+	storeName() string
+	// readSecret returns the credential held by the store.
+	readSecret(ctx context.Context, client *http.Client) (string, error)
+}
+
+// IdsecVaultSecretSourceModel describes the vault block of secret_source.
+type IdsecVaultSecretSourceModel struct {
+	Address      types.String `tfsdk:"address"`
+	Namespace    types.String `tfsdk:"namespace"`
+	Mount        types.String `tfsdk:"mount"`
+	Path         types.String `tfsdk:"path"`
+	Field        types.String `tfsdk:"field"`
+	Token        types.String `tfsdk:"token"`
+	AppRoleMount types.String `tfsdk:"approle_mount"`
+	RoleID       types.String `tfsdk:"role_id"`
+	SecretID     types.String `tfsdk:"secret_id"`
+}
+
+// vaultSecretSource is the parsed vault block. The secret is read from field of the KV v2 secret at
+// path of mount, logging in with AppRole when a role ID is set, and with token otherwise.
+type vaultSecretSource struct {
+	address      string
+	namespace    string
+	mount        string
+	path         string
+	field        string
+	token        string
+	appRoleMount string
+	roleID       string
+	secretID     string
+}
+
+// secretSourceHTTPClient is the client secret sources are read with.
+var secretSourceHTTPClient = &http.Client{Timeout: secretSourceTimeout}
+
+// newSecretSource validates the secret_source block, which configures exactly one secret store. It
+// returns nil when the block is not set. defaultField is the name of the credential the store provides.
+func newSecretSource(ctx context.Context, sourceBlock types.Object, defaultField string) (credentialSource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if sourceBlock.IsNull() || sourceBlock.IsUnknown() {
+		return nil, diags
+	}
+	var model IdsecSecretSourceModel
+	diags.Append(sourceBlock.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	hasVault := !model.Vault.IsNull() && !model.Vault.IsUnknown()
+	hasConjur := !model.Conjur.IsNull() && !model.Conjur.IsUnknown()
+	switch {
+	case hasVault && hasConjur:
+		diags.AddError("Invalid Configuration", "secret_source accepts only one of vault or conjur.")
+		return nil, diags
+	case hasVault:
+		return newVaultSecretSource(ctx, model.Vault, defaultField)
+	case hasConjur:
+		return newConjurSecretSource(ctx, model.Conjur)
+	default:
+		diags.AddError("Invalid Configuration", "secret_source requires one of vault or conjur.")
+		return nil, diags
+	}
+}
+
+// newVaultSecretSource validates the vault block of secret_source, defaulting the address, token and
+// namespace to the environment variables of the Vault CLI. defaultField is the field read when the
+// block sets none.
+func newVaultSecretSource(ctx context.Context, vaultBlock types.Object, defaultField string) (credentialSource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var model IdsecVaultSecretSourceModel
+	diags.Append(vaultBlock.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	source := &vaultSecretSource{
+		address:      strings.TrimRight(stringOrEnv(model.Address, vaultAddrEnvVar), "/"),
+		namespace:    stringOrEnv(model.Namespace, vaultNamespaceEnvVar),
+		mount:        strings.Trim(stringOrDefault(model.Mount, defaultVaultMount), "/"),
+		path:         strings.Trim(model.Path.ValueString(), "/"),
+		field:        stringOrDefault(model.Field, defaultField),
+		token:        stringOrEnv(model.Token, vaultTokenEnvVar),
+		appRoleMount: strings.Trim(stringOrDefault(model.AppRoleMount, defaultVaultAppRoleMount), "/"),
+		roleID:       model.RoleID.ValueString(),
+		secretID:     model.SecretID.ValueString(),
+	}
+	if source.address == "" {
+		diags.AddError("Invalid Configuration", fmt.Sprintf("secret_source.vault requires address, or the %s environment variable.", vaultAddrEnvVar))
+		return nil, diags
+	}
+	if !strings.HasPrefix(source.address, "https://") && !strings.HasPrefix(source.address, "http://") {
+		diags.AddError("Invalid Configuration", fmt.Sprintf("secret_source.vault.address must be an http or https URL, got %q.", source.address))
+		return nil, diags
+	}
+	if source.path == "" {
+		diags.AddError("Invalid Configuration", "secret_source.vault requires path.")
+		return nil, diags
+	}
+	if (source.roleID == "") != (source.secretID == "") {
+		diags.AddError("Invalid Configuration", "secret_source.vault requires both role_id and secret_id for AppRole authentication.")
+		return nil, diags
+	}
+	if source.roleID == "" && source.token == "" {
+		diags.AddError("Invalid Configuration", fmt.Sprintf("secret_source.vault requires token, the %s environment variable, or role_id and secret_id.", vaultTokenEnvVar))
+		return nil, diags
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Reading provider credential from Vault secret %s/%s", source.mount, source.path))
+	return source, diags
+}
+
+// stringOrEnv returns the value of a configured string, or of envVar when it is not configured.
+func stringOrEnv(value types.String, envVar string) string {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueString()
+	}
+	return os.Getenv(envVar)
+}
+
+// stringOrDefault returns the value of a configured string, or defaultValue when it is not configured.
+func stringOrDefault(value types.String, defaultValue string) string {
+	if value.ValueString() != "" {
+		return value.ValueString()
+	}
+	return defaultValue
+}
+
+func (s *vaultSecretSource) storeName() string {
+	return "Vault"
+}
+
+// readSecret logs in when AppRole is configured and returns the field of the KV v2 secret.
+func (s *vaultSecretSource) readSecret(ctx context.Context, client *http.Client) (string, error) {
+	token := s.token
+	if s.roleID != "" {
+		var err error
+		token, err = s.appRoleLogin(ctx, client)
+		if err != nil {
+			return "", err
+		}
+	}
+	var response struct {
+		Data struct {
+			Data map[string]interface{} `json:"data"`
+		} `json:"data"`
+	}
+	secretURL := fmt.Sprintf("%s/v1/%s/data/%s", s.address, s.mount, escapeVaultPath(s.path))
+	if err := s.do(ctx, client, http.MethodGet, secretURL, token, nil, &response); err != nil {
+		return "", fmt.Errorf("failed to read Vault secret %s/%s: %w", s.mount, s.path, err)
+	}
+	value, ok := response.Data.Data[s.field]
+	if !ok {
+		return "", fmt.Errorf("vault secret %s/%s has no field %q", s.mount, s.path, s.field)
+	}
+	secret, ok := value.(string)
+	if !ok || secret == "" {
+		return "", fmt.Errorf("field %q of Vault secret %s/%s is not a non-empty string", s.field, s.mount, s.path)
+	}
+	return secret, nil
+}
+
+// appRoleLogin exchanges the AppRole role and secret IDs for a Vault token.
+func (s *vaultSecretSource) appRoleLogin(ctx context.Context, client *http.Client) (string, error) {
+	body, err := json.Marshal(map[string]string{"role_id": s.roleID, "secret_id": s.secretID})
+	if err != nil {
+		return "", err
+	}
+	var response struct {
+		Auth struct {
+			ClientToken string `json:"client_token"`
+		} `json:"auth"`
+	}
+	loginURL := fmt.Sprintf("%s/v1/auth/%s/login", s.address, s.appRoleMount)
+	if err := s.do(ctx, client, http.MethodPost, loginURL, "", body, &response); err != nil {
+		return "", fmt.Errorf("failed to log in to Vault with AppRole: %w", err)
+	}
+	if response.Auth.ClientToken == "" {
+		return "", fmt.Errorf("vault AppRole login returned no token")
+	}
+	return response.Auth.ClientToken, nil
+}
+
+// do sends a request to the Vault API and decodes its JSON response into result.
+func (s *vaultSecretSource) do(ctx context.Context, client *http.Client, method string, requestURL string, token string, body []byte, result interface{}) error {
+	request, err := http.NewRequestWithContext(ctx, method, requestURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		request.Header.Set("X-Vault-Token", token)
+	}
+	if s.namespace != "" {
+		request.Header.Set("X-Vault-Namespace", s.namespace)
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	payload, err := io.ReadAll(io.LimitReader(response.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		var vaultErr struct {
+			Errors []string `json:"errors"`
+		}
+		_ = json.Unmarshal(payload, &vaultErr)
+		if len(vaultErr.Errors) > 0 {
+			return fmt.Errorf("status %d: %s", response.StatusCode, strings.Join(vaultErr.Errors, "; "))
+		}
+		return fmt.Errorf("status %d", response.StatusCode)
+	}
+	return json.Unmarshal(payload, result)
+}
+
+// escapeVaultPath escapes each segment of a secret path, keeping the separators.
+func escapeVaultPath(path string) string {
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
+
+// secretSourceCredential returns the name of the credential a secret source provides for an auth
+// method, which is also the field read from the secret when the source sets none.
+func secretSourceCredential(authMethod string) string {
+	if authMethod == "identity_service_user" {
+		return "service_token"
+	}
+	return "secret"
+}
+
+// secretSourceHoldsCredential reports whether the secret_source block sets a credential of its secret
+// store itself, the Vault token or AppRole secret ID, or the Conjur API key, rather than leaving it to the
+// environment.
+func secretSourceHoldsCredential(ctx context.Context, sourceBlock types.Object) bool {
+	if sourceBlock.IsNull() || sourceBlock.IsUnknown() {
+		return false
+	}
+	var model IdsecSecretSourceModel
+	if sourceBlock.As(ctx, &model, basetypes.ObjectAsOptions{}).HasError() {
+		return true
+	}
+	if !model.Vault.IsNull() && !model.Vault.IsUnknown() {
+		var vault IdsecVaultSecretSourceModel
+		if model.Vault.As(ctx, &vault, basetypes.ObjectAsOptions{}).HasError() || !vault.Token.IsNull() || !vault.SecretID.IsNull() {
+			return true
+		}
+	}
+	if !model.Conjur.IsNull() && !model.Conjur.IsUnknown() {
+		var conjur IdsecConjurSecretSourceModel
+		if model.Conjur.As(ctx, &conjur, basetypes.ObjectAsOptions{}).HasError() || !conjur.APIKey.IsNull() {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveSecretSource reads the credential of the auth method from the configured secret source into
+// config. A credential configured in the provider block conflicts with the secret source, while one
+// set in the environment is superseded by it.
+func resolveSecretSource(ctx context.Context, config *IdsecProviderSchema, client *http.Client) diag.Diagnostics {
+	credential := secretSourceCredential(config.AuthMethod.ValueString())
+	source, diags := newSecretSource(ctx, config.SecretSource, credential)
+	if diags.HasError() || source == nil {
+		return diags
+	}
+	target := &config.Secret
+	if credential == "service_token" {
+		target = &config.ServiceToken
+	}
+	if !target.IsNull() {
+		diags.AddError("Invalid Configuration", fmt.Sprintf("%s cannot be set together with secret_source.", credential))
+		return diags
+	}
+	secret, err := source.readSecret(ctx, client)
+	if err != nil {
+		diags.AddError("Secret Source Error", fmt.Sprintf("Failed to read %s from %s: %s.", credential, source.storeName(), err.Error()))
+		return diags
+	}
+	*target = types.StringValue(secret)
+	return diags
+}
@@ -0,0 +1,188 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Environment variables read by the Conjur secret source, as set for the Conjur CLI and SDKs.
+const (
+	conjurApplianceURLEnvVar   = "CONJUR_APPLIANCE_URL"
+	conjurAccountEnvVar        = "CONJUR_ACCOUNT"
+	conjurAuthnLoginEnvVar     = "CONJUR_AUTHN_LOGIN"
+	conjurAuthnAPIKeyEnvVar    = "CONJUR_AUTHN_API_KEY" // #nosec G101
+	conjurAuthnTokenFileEnvVar = "CONJUR_AUTHN_TOKEN_FILE"
+	conjurCertFileEnvVar       = "CONJUR_CERT_FILE"
+)
+
+// IdsecConjurSecretSourceModel describes the conjur block of secret_source.
+type IdsecConjurSecretSourceModel struct {
+	ApplianceURL    types.String `tfsdk:"appliance_url"`
+	Account         types.String `tfsdk:"account"`
+	Login           types.String `tfsdk:"login"`
+	APIKey          types.String `tfsdk:"api_key"`
+	AccessTokenFile types.String `tfsdk:"access_token_file"`
+	CertFile        types.String `tfsdk:"cert_file"`
+	VariableID      types.String `tfsdk:"variable_id"`
+}
+
+// conjurSecretSource is the parsed conjur block. The secret is the value of a Conjur variable, read with
+// an access token obtained by authenticating the host identity login with its API key, or read from
+// access token file when one is set, as written by the Conjur Kubernetes authenticator.
+type conjurSecretSource struct {
+	applianceURL    string
+	account         string
+	login           string
+	apiKey          string
+	accessTokenFile string
+	certFile        string
+	variableID      string
+}
+
+// newConjurSecretSource validates the conjur block of secret_source, defaulting its settings to the
+// environment variables of the Conjur CLI.
+func newConjurSecretSource(ctx context.Context, conjurBlock types.Object) (credentialSource, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var model IdsecConjurSecretSourceModel
+	diags.Append(conjurBlock.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	source := &conjurSecretSource{
+		applianceURL:    strings.TrimRight(stringOrEnv(model.ApplianceURL, conjurApplianceURLEnvVar), "/"),
+		account:         stringOrEnv(model.Account, conjurAccountEnvVar),
+		login:           stringOrEnv(model.Login, conjurAuthnLoginEnvVar),
+		apiKey:          stringOrEnv(model.APIKey, conjurAuthnAPIKeyEnvVar),
+		accessTokenFile: stringOrEnv(model.AccessTokenFile, conjurAuthnTokenFileEnvVar),
+		certFile:        stringOrEnv(model.CertFile, conjurCertFileEnvVar),
+		variableID:      strings.Trim(model.VariableID.ValueString(), "/"),
+	}
+	if source.applianceURL == "" {
+		diags.AddError("Invalid Configuration", fmt.Sprintf("secret_source.conjur requires appliance_url, or the %s environment variable.", conjurApplianceURLEnvVar))
+		return nil, diags
+	}
+	if !strings.HasPrefix(source.applianceURL, "https://") && !strings.HasPrefix(source.applianceURL, "http://") {
+		diags.AddError("Invalid Configuration", fmt.Sprintf("secret_source.conjur.appliance_url must be an http or https URL, got %q.", source.applianceURL))
+		return nil, diags
+	}
+	if source.account == "" {
+		diags.AddError("Invalid Configuration", fmt.Sprintf("secret_source.conjur requires account, or the %s environment variable.", conjurAccountEnvVar))
+		return nil, diags
+	}
+	if source.variableID == "" {
+		diags.AddError("Invalid Configuration", "secret_source.conjur requires variable_id.")
+		return nil, diags
+	}
+	if source.accessTokenFile == "" && (source.login == "" || source.apiKey == "") {
+		diags.AddError("Invalid Configuration", fmt.Sprintf("secret_source.conjur requires login and api_key, or access_token_file, or the %s and %s environment variables.", conjurAuthnLoginEnvVar, conjurAuthnAPIKeyEnvVar))
+		return nil, diags
+	}
+	tflog.Debug(ctx, fmt.Sprintf("Reading provider credential from Conjur variable %s", source.variableID))
+	return source, diags
+}
+
+func (s *conjurSecretSource) storeName() string {
+	return "Conjur"
+}
+
+// readSecret authenticates to Conjur and returns the value of the variable.
+func (s *conjurSecretSource) readSecret(ctx context.Context, client *http.Client) (string, error) {
+	client, err := s.httpClient(client)
+	if err != nil {
+		return "", err
+	}
+	accessToken, err := s.accessToken(ctx, client)
+	if err != nil {
+		return "", err
+	}
+	variableURL := fmt.Sprintf("%s/secrets/%s/variable/%s", s.applianceURL, url.PathEscape(s.account), url.PathEscape(s.variableID))
+	value, err := s.do(ctx, client, http.MethodGet, variableURL, "Token token=\""+base64.StdEncoding.EncodeToString(accessToken)+"\"", nil)
+	if err != nil {
+		return "", fmt.Errorf("failed to read Conjur variable %s: %w", s.variableID, err)
+	}
+	if len(value) == 0 {
+		return "", fmt.Errorf("conjur variable %s is empty", s.variableID)
+	}
+	return string(value), nil
+}
+
+// accessToken returns the Conjur access token, read from the access token file or obtained by
+// authenticating the login with its API key.
+func (s *conjurSecretSource) accessToken(ctx context.Context, client *http.Client) ([]byte, error) {
+	if s.accessTokenFile != "" {
+		token, err := os.ReadFile(s.accessTokenFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read Conjur access token file: %w", err)
+		}
+		return bytes.TrimSpace(token), nil
+	}
+	authenticateURL := fmt.Sprintf("%s/authn/%s/%s/authenticate", s.applianceURL, url.PathEscape(s.account), url.PathEscape(s.login))
+	token, err := s.do(ctx, client, http.MethodPost, authenticateURL, "", []byte(s.apiKey))
+	if err != nil {
+		return nil, fmt.Errorf("failed to authenticate to Conjur as %s: %w", s.login, err)
+	}
+	return token, nil
+}
+
+// httpClient returns client, trusting the certificate of cert file when one is set, as self-hosted
+// Conjur servers commonly use certificates of a private authority.
+func (s *conjurSecretSource) httpClient(client *http.Client) (*http.Client, error) {
+	if s.certFile == "" {
+		return client, nil
+	}
+	certificate, err := os.ReadFile(s.certFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Conjur certificate file: %w", err)
+	}
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(certificate) {
+		return nil, fmt.Errorf("conjur certificate file %s contains no PEM certificate", s.certFile)
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	return &http.Client{Timeout: client.Timeout, Transport: transport}, nil
+}
+
+// do sends a request to the Conjur API and returns its raw response body.
+func (s *conjurSecretSource) do(ctx context.Context, client *http.Client, method string, requestURL string, authorization string, body []byte) ([]byte, error) {
+	request, err := http.NewRequestWithContext(ctx, method, requestURL, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	if authorization != "" {
+		request.Header.Set("Authorization", authorization)
+	}
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	payload, err := io.ReadAll(io.LimitReader(response.Body, 1<<20))
+	if err != nil {
+		return nil, err
+	}
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return nil, fmt.Errorf("status %d", response.StatusCode)
+	}
+	return payload, nil
+}
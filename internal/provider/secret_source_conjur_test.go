@@ -0,0 +1,161 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const testConjurAccessToken = `{"protected":"eyJhbGciOiJjb25qdXIub3JnL3Nsb3NpbG8vdjIifQ==","payload":"e30=","signature":"c2ln"}`
+
+// testConjurSecretSource builds a secret_source block with a conjur block setting the given attributes.
+func testConjurSecretSource(t *testing.T, conjur map[string]string) types.Object {
+	t.Helper()
+	values := map[string]attr.Value{}
+	for name := range testConjurAttrTypes {
+		values[name] = types.StringNull()
+		if value, ok := conjur[name]; ok {
+			values[name] = types.StringValue(value)
+		}
+	}
+	conjurObject, diags := types.ObjectValue(testConjurAttrTypes, values)
+	if diags.HasError() {
+		t.Fatalf("failed to build conjur block: %v", diags)
+	}
+	sourceObject, diags := types.ObjectValue(testSecretSourceAttrTypes, map[string]attr.Value{
+		"vault":  types.ObjectNull(testVaultAttrTypes),
+		"conjur": conjurObject,
+	})
+	if diags.HasError() {
+		t.Fatalf("failed to build secret_source block: %v", diags)
+	}
+	return sourceObject
+}
+
+// newTestConjurServer serves the variable terraform/idsec/service-token of account myorg to the
+// host/terraform identity authenticating with the API key "api-key-1".
+func newTestConjurServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.EscapedPath() == "/authn/myorg/host%2Fterraform/authenticate":
+			apiKey, _ := io.ReadAll(r.Body)
+			if string(apiKey) != "api-key-1" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			_, _ = w.Write([]byte(testConjurAccessToken))
+		case r.Method == http.MethodGet && strings.HasPrefix(r.URL.EscapedPath(), "/secrets/myorg/variable/"):
+			expected := `Token token="` + base64.StdEncoding.EncodeToString([]byte(testConjurAccessToken)) + `"`
+			if r.Header.Get("Authorization") != expected {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			switch r.URL.EscapedPath() {
+			case "/secrets/myorg/variable/terraform%2Fidsec%2Fservice-token":
+				_, _ = w.Write([]byte("t0ken"))
+			case "/secrets/myorg/variable/terraform%2Fidsec%2Fempty":
+				w.WriteHeader(http.StatusOK)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestResolveSecretSourceConjur(t *testing.T) {
+	t.Parallel()
+
+	server := newTestConjurServer(t)
+	tokenFile := filepath.Join(t.TempDir(), "access-token")
+	if err := os.WriteFile(tokenFile, []byte(testConjurAccessToken+"\n"), 0o600); err != nil {
+		t.Fatalf("failed to write access token file: %v", err)
+	}
+	base := func(extra map[string]string) map[string]string {
+		conjur := map[string]string{"appliance_url": server.URL, "account": "myorg", "variable_id": "terraform/idsec/service-token"}
+		for name, value := range extra {
+			conjur[name] = value
+		}
+		return conjur
+	}
+	tests := []struct {
+		name      string
+		conjur    map[string]string
+		wantToken string
+		wantErr   string
+	}{
+		{name: "api_key_authentication", conjur: base(map[string]string{"login": "host/terraform", "api_key": "api-key-1"}), wantToken: "t0ken"},
+		{name: "access_token_file", conjur: base(map[string]string{"access_token_file": tokenFile}), wantToken: "t0ken"},
+		{name: "invalid_api_key", conjur: base(map[string]string{"login": "host/terraform", "api_key": "wrong"}), wantErr: "failed to authenticate to Conjur as host/terraform: status 401"},
+		{name: "missing_variable", conjur: base(map[string]string{"login": "host/terraform", "api_key": "api-key-1", "variable_id": "terraform/idsec/missing"}), wantErr: "status 404"},
+		{name: "empty_variable", conjur: base(map[string]string{"login": "host/terraform", "api_key": "api-key-1", "variable_id": "terraform/idsec/empty"}), wantErr: "is empty"},
+		{name: "missing_identity", conjur: base(nil), wantErr: "requires login and api_key, or access_token_file"},
+		{name: "missing_variable_id", conjur: map[string]string{"appliance_url": server.URL, "account": "myorg", "access_token_file": tokenFile}, wantErr: "requires variable_id"},
+		{name: "invalid_cert_file", conjur: base(map[string]string{"access_token_file": tokenFile, "cert_file": tokenFile}), wantErr: "contains no PEM certificate"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			config := &IdsecProviderSchema{
+				AuthMethod:   types.StringValue("identity_service_user"),
+				ServiceToken: types.StringNull(),
+				SecretSource: testConjurSecretSource(t, tt.conjur),
+			}
+			diags := resolveSecretSource(context.Background(), config, server.Client())
+			if tt.wantErr != "" {
+				if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, diags)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if config.ServiceToken.ValueString() != tt.wantToken {
+				t.Errorf("expected service token %q, got %q", tt.wantToken, config.ServiceToken.ValueString())
+			}
+		})
+	}
+}
+
+func TestNewSecretSourceRequiresOneStore(t *testing.T) {
+	t.Parallel()
+
+	vault := testSecretSource(t, map[string]string{"address": "https://vault.example.com", "path": "terraform/idsec", "token": "root-token"})
+	conjur := testConjurSecretSource(t, map[string]string{"appliance_url": "https://conjur.example.com", "account": "myorg", "variable_id": "idsec", "access_token_file": "/token"})
+	both, diags := types.ObjectValue(testSecretSourceAttrTypes, map[string]attr.Value{
+		"vault":  vault.Attributes()["vault"],
+		"conjur": conjur.Attributes()["conjur"],
+	})
+	if diags.HasError() {
+		t.Fatalf("failed to build secret_source block: %v", diags)
+	}
+	neither, diags := types.ObjectValue(testSecretSourceAttrTypes, map[string]attr.Value{
+		"vault":  types.ObjectNull(testVaultAttrTypes),
+		"conjur": types.ObjectNull(testConjurAttrTypes),
+	})
+	if diags.HasError() {
+		t.Fatalf("failed to build secret_source block: %v", diags)
+	}
+	for name, block := range map[string]types.Object{"both": both, "neither": neither} {
+		if _, diags := newSecretSource(context.Background(), block, "secret"); !diags.HasError() {
+			t.Errorf("expected secret_source with %s stores to be rejected", name)
+		}
+	}
+}
@@ -0,0 +1,245 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var testVaultAttrTypes = map[string]attr.Type{
+	"address":       types.StringType,
+	"namespace":     types.StringType,
+	"mount":         types.StringType,
+	"path":          types.StringType,
+	"field":         types.StringType,
+	"token":         types.StringType,
+	"approle_mount": types.StringType,
+	"role_id":       types.StringType,
+	"secret_id":     types.StringType,
+}
+
+var testConjurAttrTypes = map[string]attr.Type{
+	"appliance_url":     types.StringType,
+	"account":           types.StringType,
+	"login":             types.StringType,
+	"api_key":           types.StringType,
+	"access_token_file": types.StringType,
+	"cert_file":         types.StringType,
+	"variable_id":       types.StringType,
+}
+
+var testSecretSourceAttrTypes = map[string]attr.Type{
+	"vault":  types.ObjectType{AttrTypes: testVaultAttrTypes},
+	"conjur": types.ObjectType{AttrTypes: testConjurAttrTypes},
+}
+
+// testSecretSource builds a secret_source block with a vault block setting the given attributes.
+func testSecretSource(t *testing.T, vault map[string]string) types.Object {
+	t.Helper()
+	values := map[string]attr.Value{}
+	for name := range testVaultAttrTypes {
+		values[name] = types.StringNull()
+		if value, ok := vault[name]; ok {
+			values[name] = types.StringValue(value)
+		}
+	}
+	vaultObject, diags := types.ObjectValue(testVaultAttrTypes, values)
+	if diags.HasError() {
+		t.Fatalf("failed to build vault block: %v", diags)
+	}
+	sourceObject, diags := types.ObjectValue(testSecretSourceAttrTypes, map[string]attr.Value{
+		"vault":  vaultObject,
+		"conjur": types.ObjectNull(testConjurAttrTypes),
+	})
+	if diags.HasError() {
+		t.Fatalf("failed to build secret_source block: %v", diags)
+	}
+	return sourceObject
+}
+
+// newTestVaultServer serves a KV v2 secret at secret/terraform/idsec, readable with the token
+// "root-token" or with the token returned by an AppRole login with role-1/secret-1.
+func newTestVaultServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/auth/approle/login":
+			var body map[string]string
+			_ = json.NewDecoder(r.Body).Decode(&body)
+			if body["role_id"] != "role-1" || body["secret_id"] != "secret-1" {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = w.Write([]byte(`{"errors":["invalid role or secret ID"]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"auth":{"client_token":"approle-token"}}`))
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/secret/data/terraform/idsec":
+			token := r.Header.Get("X-Vault-Token")
+			if token != "root-token" && token != "approle-token" {
+				w.WriteHeader(http.StatusForbidden)
+				_, _ = w.Write([]byte(`{"errors":["permission denied"]}`))
+				return
+			}
+			if r.Header.Get("X-Vault-Namespace") != "" && r.Header.Get("X-Vault-Namespace") != "team-a" {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			_, _ = w.Write([]byte(`{"data":{"data":{"secret":"s3cr3t","service_token":"t0ken","pin":1234}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestResolveSecretSource(t *testing.T) {
+	t.Parallel()
+
+	server := newTestVaultServer(t)
+	tests := []struct {
+		name       string
+		authMethod string
+		vault      map[string]string
+		secret     types.String
+		wantSecret string
+		wantToken  string
+		wantErr    string
+	}{
+		{
+			name:       "token_reads_secret",
+			authMethod: "identity",
+			vault:      map[string]string{"address": server.URL, "path": "terraform/idsec", "token": "root-token"},
+			wantSecret: "s3cr3t",
+		},
+		{
+			name:       "approle_reads_service_token",
+			authMethod: "identity_service_user",
+			vault:      map[string]string{"address": server.URL + "/", "path": "/terraform/idsec", "role_id": "role-1", "secret_id": "secret-1", "namespace": "team-a"},
+			wantToken:  "t0ken",
+		},
+		{
+			name:       "custom_field",
+			authMethod: "pvwa",
+			vault:      map[string]string{"address": server.URL, "path": "terraform/idsec", "token": "root-token", "field": "service_token"},
+			wantSecret: "t0ken",
+		},
+		{
+			name:       "missing_field",
+			authMethod: "identity",
+			vault:      map[string]string{"address": server.URL, "path": "terraform/idsec", "token": "root-token", "field": "password"},
+			wantErr:    `has no field "password"`,
+		},
+		{
+			name:       "non_string_field",
+			authMethod: "identity",
+			vault:      map[string]string{"address": server.URL, "path": "terraform/idsec", "token": "root-token", "field": "pin"},
+			wantErr:    "is not a non-empty string",
+		},
+		{
+			name:       "permission_denied",
+			authMethod: "identity",
+			vault:      map[string]string{"address": server.URL, "path": "terraform/idsec", "token": "wrong"},
+			wantErr:    "permission denied",
+		},
+		{
+			name:       "approle_login_failure",
+			authMethod: "identity",
+			vault:      map[string]string{"address": server.URL, "path": "terraform/idsec", "role_id": "role-1", "secret_id": "wrong"},
+			wantErr:    "invalid role or secret ID",
+		},
+		{
+			name:       "conflicts_with_configured_secret",
+			authMethod: "identity",
+			vault:      map[string]string{"address": server.URL, "path": "terraform/idsec", "token": "root-token"},
+			secret:     types.StringValue("configured"),
+			wantErr:    "secret cannot be set together with secret_source",
+		},
+		{
+			name:       "missing_path",
+			authMethod: "identity",
+			vault:      map[string]string{"address": server.URL, "token": "root-token"},
+			wantErr:    "requires path",
+		},
+		{
+			name:       "partial_approle",
+			authMethod: "identity",
+			vault:      map[string]string{"address": server.URL, "path": "terraform/idsec", "role_id": "role-1"},
+			wantErr:    "requires both role_id and secret_id",
+		},
+		{
+			name:       "invalid_address",
+			authMethod: "identity",
+			vault:      map[string]string{"address": "vault.example.com", "path": "terraform/idsec", "token": "root-token"},
+			wantErr:    "must be an http or https URL",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			config := &IdsecProviderSchema{
+				AuthMethod:   types.StringValue(tt.authMethod),
+				Secret:       tt.secret,
+				ServiceToken: types.StringNull(),
+				SecretSource: testSecretSource(t, tt.vault),
+			}
+			diags := resolveSecretSource(context.Background(), config, server.Client())
+			if tt.wantErr != "" {
+				if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), tt.wantErr) {
+					t.Fatalf("expected error containing %q, got %v", tt.wantErr, diags)
+				}
+				return
+			}
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if config.Secret.ValueString() != tt.wantSecret {
+				t.Errorf("expected secret %q, got %q", tt.wantSecret, config.Secret.ValueString())
+			}
+			if config.ServiceToken.ValueString() != tt.wantToken {
+				t.Errorf("expected service token %q, got %q", tt.wantToken, config.ServiceToken.ValueString())
+			}
+		})
+	}
+}
+
+func TestResolveSecretSourceVaultEnvironment(t *testing.T) {
+	server := newTestVaultServer(t)
+	t.Setenv(vaultAddrEnvVar, server.URL)
+	t.Setenv(vaultTokenEnvVar, "root-token")
+	config := &IdsecProviderSchema{
+		AuthMethod:   types.StringValue("identity"),
+		Secret:       types.StringNull(),
+		SecretSource: testSecretSource(t, map[string]string{"path": "terraform/idsec"}),
+	}
+	if diags := resolveSecretSource(context.Background(), config, server.Client()); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if config.Secret.ValueString() != "s3cr3t" {
+		t.Errorf("expected the secret read with the Vault environment, got %q", config.Secret.ValueString())
+	}
+}
+
+func TestResolveSecretSourceNotConfigured(t *testing.T) {
+	t.Parallel()
+
+	config := &IdsecProviderSchema{
+		AuthMethod:   types.StringValue("identity"),
+		Secret:       types.StringValue("configured"),
+		SecretSource: types.ObjectNull(testSecretSourceAttrTypes),
+	}
+	if diags := resolveSecretSource(context.Background(), config, http.DefaultClient); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if config.Secret.ValueString() != "configured" {
+		t.Errorf("expected the configured secret to be kept, got %q", config.Secret.ValueString())
+	}
+}
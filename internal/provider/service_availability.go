@@ -0,0 +1,68 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// serviceUnavailableErrorPatterns are the error fragments returned by the Idsec APIs when a service
+// is not enabled on the tenant, usually because the tenant is not licensed for it. A plain 403 is not
+// one of them: it is returned as well when the user lacks a permission on an object of an enabled
+// service, which must stay an error.
+var serviceUnavailableErrorPatterns = []string{
+	"service is not enabled",
+	"service not enabled",
+	"not licensed",
+	"not entitled",
+	"not subscribed",
+}
+
+// isServiceUnavailableError reports whether err indicates the service is not enabled on the tenant.
+func isServiceUnavailableError(err error) bool {
+	return matchesErrorPatterns(err, serviceUnavailableErrorPatterns)
+}
+
+// serviceUnavailableDetail describes a service-unavailable error, naming the service and what is needed to use it.
+func serviceUnavailableDetail(serviceName string, err error) string {
+	return fmt.Sprintf(
+		"The %s service is not available on this tenant. The tenant must be licensed for the service and the service enabled, "+
+			"and the authenticated user must be granted access to it. Remove the resources and data sources of this service or "+
+			"contact your CyberArk administrator.\n\nUnderlying error: %s",
+		serviceName, err.Error())
+}
+
+// nullifyUnknownValues replaces unknown values of a data source state with nulls, so a data source skipped
+// because its service is unavailable still returns a wholly known result.
+func nullifyUnknownValues(raw tftypes.Value) (tftypes.Value, error) {
+	return tftypes.Transform(raw, func(_ *tftypes.AttributePath, value tftypes.Value) (tftypes.Value, error) {
+		if !value.IsKnown() {
+			return tftypes.NewValue(value.Type(), nil), nil
+		}
+		return value, nil
+	})
+}
+
+// skipUnavailableService completes a read of a data source whose service is not enabled on the tenant with
+// a warning, returning the configured arguments and null computed attributes.
+func (s *IdsecDataSource) skipUnavailableService(ctx context.Context, err error, config tfsdk.Config, resp *datasource.ReadResponse) {
+	typeName := s.getTerraformTypeName(s.actionDefinition.ActionName)
+	tflog.Warn(ctx, fmt.Sprintf("Skipping %s, service %s is not enabled on the tenant: %s", typeName, s.serviceConfig.ServiceName, err.Error()))
+	resp.Diagnostics.AddWarning(
+		"Service Not Enabled",
+		fmt.Sprintf("%s was skipped because ignore_unavailable_services is set. %s", typeName, serviceUnavailableDetail(s.serviceConfig.ServiceName, err)),
+	)
+	raw, transformErr := nullifyUnknownValues(config.Raw)
+	if transformErr != nil {
+		resp.Diagnostics.AddError("State Conversion Error", fmt.Sprintf("Failed to build state of skipped data source: %s", transformErr.Error()))
+		return
+	}
+	resp.State.Raw = raw
+}
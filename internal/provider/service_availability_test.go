@@ -0,0 +1,92 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/cyberark/idsec-sdk-golang/pkg/services"
+	"github.com/cyberark/terraform-provider-idsec/internal/actions"
+)
+
+func TestIsServiceUnavailableError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{name: "forbidden_status", err: errors.New("failed to retrieve safe - [403] - [{}]")},
+		{name: "forbidden_permission", err: errors.New("failed to retrieve safe - [403] - [403 Forbidden: missing the View Safe Members permission]")},
+		{name: "not_licensed", err: errors.New("The tenant is not licensed for this service"), expected: true},
+		{name: "not_enabled", err: errors.New("failed to list policies - [403] - [The service is not enabled on the tenant]"), expected: true},
+		{name: "feature_not_enabled", err: errors.New("failed to update safe - [400] - [Auto purge is not enabled for this safe]")},
+		{name: "not_found", err: errors.New("failed to retrieve safe - [404] - [{}]")},
+		{name: "nil", err: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isServiceUnavailableError(tt.err); got != tt.expected {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestIdsecDataSourceSkipUnavailableService(t *testing.T) {
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"safe_id":   tftypes.String,
+		"safe_name": tftypes.String,
+	}}
+	testSchema := schema.Schema{Attributes: map[string]schema.Attribute{
+		"safe_id":   schema.StringAttribute{Required: true},
+		"safe_name": schema.StringAttribute{Computed: true},
+	}}
+	dataSource := &IdsecDataSource{
+		IdsecServiceHelper: IdsecServiceHelper{serviceConfig: &services.IdsecServiceConfig{ServiceName: "pcloud-safes"}},
+		serviceConfig:      &services.IdsecServiceConfig{ServiceName: "pcloud-safes"},
+		actionDefinition: &actions.IdsecServiceTerraformDataSourceActionDefinition{
+			IdsecServiceBaseTerraformActionDefinition: actions.IdsecServiceBaseTerraformActionDefinition{
+				IdsecServiceBaseActionDefinition: actions.IdsecServiceBaseActionDefinition{ActionName: "pcloud-safe"},
+			},
+		},
+	}
+	req := datasource.ReadRequest{Config: tfsdk.Config{
+		Schema: testSchema,
+		Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"safe_id":   tftypes.NewValue(tftypes.String, "Safe1"),
+			"safe_name": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+		}),
+	}}
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: testSchema, Raw: tftypes.NewValue(objectType, nil)}}
+
+	dataSource.skipUnavailableService(context.Background(), errors.New("failed to list safes - [403] - [The service is not enabled on the tenant]"), req.Config, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("expected no errors, got %v", resp.Diagnostics)
+	}
+	if resp.Diagnostics.WarningsCount() != 1 || !strings.Contains(resp.Diagnostics.Warnings()[0].Detail(), "pcloud-safes") {
+		t.Errorf("expected a warning naming the service, got %v", resp.Diagnostics)
+	}
+	if !resp.State.Raw.IsFullyKnown() {
+		t.Error("expected unknown values to be replaced by nulls")
+	}
+	var safeID string
+	var attrs map[string]tftypes.Value
+	if err := resp.State.Raw.As(&attrs); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := attrs["safe_id"].As(&safeID); err != nil || safeID != "Safe1" {
+		t.Errorf("expected configured safe_id to be kept, got %q (%v)", safeID, err)
+	}
+	if !attrs["safe_name"].IsNull() {
+		t.Error("expected computed safe_name to be null")
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// serviceLimiter bounds the number of concurrent API operations of services misbehaving under parallel
+// requests, e.g. with row-lock errors on parallel creates. Limits are keyed by service name or by service
+// family, "sia" limiting all of "sia-access", "sia-settings", etc. together.
+type serviceLimiter struct {
+	semaphores map[string]chan struct{}
+}
+
+// newServiceLimiter creates a limiter allowing limits[key] concurrent operations for each key.
+// It returns nil when no limit is configured.
+func newServiceLimiter(limits map[string]int64) (*serviceLimiter, error) {
+	if len(limits) == 0 {
+		return nil, nil
+	}
+	limiter := &serviceLimiter{semaphores: make(map[string]chan struct{}, len(limits))}
+	for key, limit := range limits {
+		key = strings.TrimSpace(key)
+		if key == "" {
+			return nil, fmt.Errorf("service names must not be empty")
+		}
+		if limit < 1 {
+			return nil, fmt.Errorf("the limit of service %q must be at least 1, got %d", key, limit)
+		}
+		limiter.semaphores[key] = make(chan struct{}, limit)
+	}
+	return limiter, nil
+}
+
+// serviceKeyFor returns the key of settings keyed by service name or family that applies to a service:
+// the service name itself before the longest service family it belongs to, and false when none does.
+func serviceKeyFor[V any](settings map[string]V, serviceName string) (string, bool) {
+	if _, ok := settings[serviceName]; ok {
+		return serviceName, true
+	}
+	var match string
+	for key := range settings {
+		if strings.HasPrefix(serviceName, key+"-") && len(key) > len(match) {
+			match = key
+		}
+	}
+	return match, match != ""
+}
+
+// semaphoreFor returns the semaphore of a service, or nil for unbounded services.
+func (l *serviceLimiter) semaphoreFor(serviceName string) chan struct{} {
+	key, ok := serviceKeyFor(l.semaphores, serviceName)
+	if !ok {
+		return nil
+	}
+	return l.semaphores[key]
+}
+
+// acquire waits for a free operation slot of the service, or for ctx to be done. The returned function
+// releases the slot and must be called once the operation completed.
+func (l *serviceLimiter) acquire(ctx context.Context, serviceName string) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+	semaphore := l.semaphoreFor(serviceName)
+	if semaphore == nil {
+		return func() {}, nil
+	}
+	select {
+	case semaphore <- struct{}{}:
+		return func() { <-semaphore }, nil
+	case <-ctx.Done():
+		return nil, fmt.Errorf("timed out waiting for a concurrency slot of service %s: %w", serviceName, ctx.Err())
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNewServiceLimiter(t *testing.T) {
+	tests := []struct {
+		name      string
+		limits    map[string]int64
+		wantNil   bool
+		wantError bool
+	}{
+		{name: "success_no_limits", limits: nil, wantNil: true},
+		{name: "success_limits", limits: map[string]int64{"sia": 2}},
+		{name: "error_zero_limit", limits: map[string]int64{"sia": 0}, wantError: true},
+		{name: "error_empty_service", limits: map[string]int64{" ": 1}, wantError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			limiter, err := newServiceLimiter(tt.limits)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("expected error=%v, got %v", tt.wantError, err)
+			}
+			if !tt.wantError && (limiter == nil) != tt.wantNil {
+				t.Errorf("expected nil limiter=%v, got %v", tt.wantNil, limiter)
+			}
+		})
+	}
+}
+
+func TestServiceLimiterSemaphoreFor(t *testing.T) {
+	limiter, err := newServiceLimiter(map[string]int64{"sia": 2, "sia-settings": 1, "pcloud-safes": 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if limiter.semaphoreFor("sia-access") != limiter.semaphores["sia"] {
+		t.Error("expected sia-access to share the sia family limit")
+	}
+	if limiter.semaphoreFor("sia-settings") != limiter.semaphores["sia-settings"] {
+		t.Error("expected sia-settings to use its own limit")
+	}
+	if limiter.semaphoreFor("siam") != nil || limiter.semaphoreFor("pcloud-accounts") != nil {
+		t.Error("expected services outside the configured families to be unbounded")
+	}
+}
+
+func TestServiceLimiterAcquire(t *testing.T) {
+	limiter, err := newServiceLimiter(map[string]int64{"sia": 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var running, peak int32
+	var wg sync.WaitGroup
+	for i := 0; i < 6; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			release, err := limiter.acquire(context.Background(), "sia-access")
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			current := atomic.AddInt32(&running, 1)
+			for {
+				previous := atomic.LoadInt32(&peak)
+				if current <= previous || atomic.CompareAndSwapInt32(&peak, previous, current) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			atomic.AddInt32(&running, -1)
+			release()
+		}()
+	}
+	wg.Wait()
+	if peak > 2 {
+		t.Errorf("expected at most 2 concurrent operations, got %d", peak)
+	}
+
+	release, _ := limiter.acquire(context.Background(), "sia-access")
+	defer release()
+	second, _ := limiter.acquire(context.Background(), "sia-access")
+	defer second()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := limiter.acquire(ctx, "sia-access"); err == nil {
+		t.Error("expected an error when the context is done before a slot frees up")
+	}
+
+	var unbounded *serviceLimiter
+	if release, err := unbounded.acquire(context.Background(), "sia-access"); err != nil || release == nil {
+		t.Errorf("expected a nil limiter to never block, got %v", err)
+	}
+}
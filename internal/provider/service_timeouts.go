@@ -0,0 +1,39 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// parseServiceTimeouts parses the durations of the service_timeouts provider attribute.
+func parseServiceTimeouts(values map[string]string) (map[string]time.Duration, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	timeouts := make(map[string]time.Duration, len(values))
+	for key, value := range values {
+		key = strings.TrimSpace(key)
+		if key == "" {
+			return nil, fmt.Errorf("service names must not be empty")
+		}
+		timeout, err := time.ParseDuration(value)
+		if err != nil || timeout <= 0 {
+			return nil, fmt.Errorf("the timeout of service %q must be a positive duration, got %q", key, value)
+		}
+		timeouts[key] = timeout
+	}
+	return timeouts, nil
+}
+
+// serviceTimeout returns the default operation timeout configured for a service, and false when none is.
+func (ps *providerSettings) serviceTimeout(serviceName string) (time.Duration, bool) {
+	key, ok := serviceKeyFor(ps.serviceTimeouts, serviceName)
+	if !ok {
+		return 0, false
+	}
+	return ps.serviceTimeouts[key], true
+}
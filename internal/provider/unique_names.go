@@ -0,0 +1,82 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/cyberark/terraform-provider-idsec/internal/schemas"
+)
+
+// uniqueNameLookups returns the lookups of the attributes the action definition declares unique across
+// the tenant, sorted by attribute. Malformed lookup specs are ignored.
+func (s *IdsecResource) uniqueNameLookups() []schemas.AttributeReference {
+	var lookups []schemas.AttributeReference
+	for _, attribute := range slices.Sorted(maps.Keys(s.actionDefinition.UniqueNameAttributes)) {
+		if lookup, ok := schemas.ParseAttributeReference(attribute, s.actionDefinition.UniqueNameAttributes[attribute]); ok {
+			lookups = append(lookups, lookup)
+		}
+	}
+	return lookups
+}
+
+// validatePlannedUniqueNames looks up the planned values of attributes unique across the tenant when
+// validate_unique_names is enabled, so a name already taken fails the plan instead of the create or
+// rename. Values that did not change since the last apply belong to the resource itself and are not
+// looked up; names still unknown, such as names generated from a prefix, cannot be.
+func (s *IdsecResource) validatePlannedUniqueNames(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if !s.getSettings().validateUniqueNames || req.Plan.Raw.IsNull() || s.idsecAPI == nil {
+		return
+	}
+	for _, lookup := range s.uniqueNameLookups() {
+		attributePath := path.Root(lookup.Attribute)
+		var planned attr.Value
+		if diags := req.Plan.GetAttribute(ctx, attributePath, &planned); diags.HasError() {
+			continue
+		}
+		value, ok := referenceValueString(planned)
+		if !ok {
+			continue
+		}
+		if !req.State.Raw.IsNull() {
+			var current attr.Value
+			if diags := req.State.GetAttribute(ctx, attributePath, &current); !diags.HasError() && current != nil && current.Equal(planned) {
+				continue
+			}
+		}
+		reportUniqueNameLookup(ctx, lookup, value, s.lookupReference(ctx, lookup, value), &resp.Diagnostics)
+	}
+}
+
+// reportUniqueNameLookup turns the result of looking a planned name up into diagnostics: an object found
+// means the name is taken and fails the plan, while a not-found error means it is free. Other failures
+// only produce a warning, since they say nothing about whether the name is taken.
+func reportUniqueNameLookup(ctx context.Context, lookup schemas.AttributeReference, value string, err error, diagnostics *diag.Diagnostics) {
+	attributePath := path.Root(lookup.Attribute)
+	switch {
+	case err == nil:
+		diagnostics.AddAttributeError(
+			attributePath,
+			"Object Already Exists",
+			fmt.Sprintf("An object with %s %q already exists on the tenant, as found by %s %s. Choose another name, or import the existing object to manage it.", lookup.Attribute, value, lookup.Service, lookup.Action),
+		)
+	case isReferenceNotFoundError(err):
+		tflog.Debug(ctx, fmt.Sprintf("Name %q of %s is not taken", value, lookup.Attribute))
+	default:
+		tflog.Warn(ctx, fmt.Sprintf("Failed to validate uniqueness of %s: %s", lookup.Attribute, err.Error()))
+		diagnostics.AddAttributeWarning(
+			attributePath,
+			"Name Uniqueness Not Validated",
+			fmt.Sprintf("Unable to look up %q of %s with %s %s: %s", value, lookup.Attribute, lookup.Service, lookup.Action, err.Error()),
+		)
+	}
+}
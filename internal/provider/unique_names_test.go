@@ -0,0 +1,74 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/cyberark/terraform-provider-idsec/internal/schemas"
+)
+
+func TestReportUniqueNameLookup(t *testing.T) {
+	lookup := schemas.AttributeReference{Attribute: "safe_name", Service: "pcloud-safes", Action: "safe", InputField: "safe_name"}
+	tests := []struct {
+		name         string
+		err          error
+		wantError    string
+		wantWarning  string
+		wantNoOutput bool
+	}{
+		{name: "taken", wantError: "Object Already Exists"},
+		{name: "free", err: errors.New("failed to get safe - [404] - [safe Finance was not found]"), wantNoOutput: true},
+		{name: "lookup_failed", err: errors.New("connection reset by peer"), wantWarning: "Name Uniqueness Not Validated"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			reportUniqueNameLookup(context.Background(), lookup, "Finance", tt.err, &diags)
+			if tt.wantNoOutput && len(diags) != 0 {
+				t.Fatalf("expected no diagnostics, got %v", diags)
+			}
+			if tt.wantError != "" && (diags.ErrorsCount() != 1 || diags.Errors()[0].Summary() != tt.wantError) {
+				t.Errorf("expected error %q, got %v", tt.wantError, diags)
+			}
+			if tt.wantWarning != "" && (diags.WarningsCount() != 1 || diags.Warnings()[0].Summary() != tt.wantWarning) {
+				t.Errorf("expected warning %q, got %v", tt.wantWarning, diags)
+			}
+		})
+	}
+}
+
+func TestIdsecResource_UniqueNameLookups(t *testing.T) {
+	actionDefinition := CreateTestActionDefinition("test-action", "Test action description")
+	actionDefinition.UniqueNameAttributes = map[string]string{
+		"safe_name":   "pcloud-safes.safe",
+		"policy_name": "policy.policy-by-name.name",
+		"broken":      "no-action",
+	}
+	idsecRes := CreateTestIdsecResource(CreateTestServiceConfig("test-service"), actionDefinition).(*IdsecResource)
+	want := []schemas.AttributeReference{
+		{Attribute: "policy_name", Service: "policy", Action: "policy-by-name", InputField: "name"},
+		{Attribute: "safe_name", Service: "pcloud-safes", Action: "safe", InputField: "safe_name"},
+	}
+	if got := idsecRes.uniqueNameLookups(); !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestModifyPlanSkipsUniqueNameValidationWhenDisabled(t *testing.T) {
+	actionDefinition := CreateTestActionDefinition("test-action", "Test action description")
+	actionDefinition.UniqueNameAttributes = map[string]string{"app_id": "test-service.app"}
+	idsecRes := CreateTestIdsecResource(CreateTestServiceConfig("test-service"), actionDefinition).(*IdsecResource)
+	idsecRes.settings = &providerSettings{validateUniqueNames: false}
+	resp := &resource.ModifyPlanResponse{}
+	idsecRes.validatePlannedUniqueNames(context.Background(), resource.ModifyPlanRequest{}, resp)
+	if len(resp.Diagnostics) != 0 {
+		t.Errorf("expected no diagnostics, got %v", resp.Diagnostics)
+	}
+}
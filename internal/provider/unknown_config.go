@@ -0,0 +1,59 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	terraformprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// unknownProviderAttributes returns the sorted names of the provider attributes whose value, or part of
+// it, is not known yet, e.g. a subdomain read from terraform_remote_state of a workspace not applied yet.
+func unknownProviderAttributes(raw tftypes.Value) []string {
+	if raw.IsFullyKnown() {
+		return nil
+	}
+	var attributes map[string]tftypes.Value
+	if err := raw.As(&attributes); err != nil {
+		return []string{"(provider configuration)"}
+	}
+	var names []string
+	for name, value := range attributes {
+		if !value.IsFullyKnown() {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// deferUnknownConfiguration handles provider configurations holding values only known during apply. The
+// provider cannot authenticate without them, so it is left unconfigured: Terraform clients supporting
+// deferred actions defer the resources and data sources of the provider to a later plan, and older
+// clients get a warning, planning new resources without API calls while operations needing the API fail.
+// It returns true when the configuration is not fully known.
+func deferUnknownConfiguration(ctx context.Context, req terraformprovider.ConfigureRequest, resp *terraformprovider.ConfigureResponse) bool {
+	unknown := unknownProviderAttributes(req.Config.Raw)
+	if len(unknown) == 0 {
+		return false
+	}
+	if req.ClientCapabilities.DeferralAllowed {
+		tflog.Info(ctx, fmt.Sprintf("Deferring provider configuration, values of %s are not known yet", strings.Join(unknown, ", ")))
+		resp.Deferred = &terraformprovider.Deferred{Reason: terraformprovider.DeferredReasonProviderConfigUnknown}
+		return true
+	}
+	resp.Diagnostics.AddWarning(
+		"Provider Configuration Not Known Yet",
+		fmt.Sprintf("The values of %s are not known until apply, so the provider is not authenticated during this plan. "+
+			"New resources are planned without calling the API, while reading existing resources and data sources fails. "+
+			"The provider is configured with the final values during apply.", strings.Join(unknown, ", ")),
+	)
+	return true
+}
@@ -0,0 +1,79 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	terraformprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// testUnknownProviderConfig builds a provider configuration leaving every attribute null, except the
+// given ones which are not known yet.
+func testUnknownProviderConfig(t *testing.T, p *IdsecProvider, unknown ...string) tfsdk.Config {
+	t.Helper()
+	ctx := context.Background()
+	schemaResp := &terraformprovider.SchemaResponse{}
+	p.Schema(ctx, terraformprovider.SchemaRequest{}, schemaResp)
+	objectType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attrType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	for _, name := range unknown {
+		values[name] = tftypes.NewValue(objectType.AttributeTypes[name], tftypes.UnknownValue)
+	}
+	return tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objectType, values)}
+}
+
+func TestConfigureUnknownProviderConfig(t *testing.T) {
+	p := &IdsecProvider{}
+
+	t.Run("deferred_when_allowed", func(t *testing.T) {
+		req := terraformprovider.ConfigureRequest{
+			Config:             testUnknownProviderConfig(t, p, "subdomain"),
+			ClientCapabilities: terraformprovider.ConfigureProviderClientCapabilities{DeferralAllowed: true},
+		}
+		resp := &terraformprovider.ConfigureResponse{}
+		p.Configure(context.Background(), req, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %v", resp.Diagnostics)
+		}
+		if resp.Deferred == nil || resp.Deferred.Reason != terraformprovider.DeferredReasonProviderConfigUnknown {
+			t.Fatalf("expected configuration to be deferred, got %v", resp.Deferred)
+		}
+		if resp.ResourceData != nil || resp.DataSourceData != nil {
+			t.Error("expected the provider to be left unconfigured")
+		}
+	})
+
+	t.Run("warning_without_deferral", func(t *testing.T) {
+		req := terraformprovider.ConfigureRequest{Config: testUnknownProviderConfig(t, p, "username", "subdomain")}
+		resp := &terraformprovider.ConfigureResponse{}
+		p.Configure(context.Background(), req, resp)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected error: %v", resp.Diagnostics)
+		}
+		if resp.Deferred != nil {
+			t.Errorf("expected no deferral, got %v", resp.Deferred)
+		}
+		warnings := resp.Diagnostics.Warnings()
+		if len(warnings) != 1 || !strings.Contains(warnings[0].Detail(), "subdomain, username") {
+			t.Errorf("expected a warning naming the unknown attributes, got %v", warnings)
+		}
+	})
+}
+
+func TestUnknownProviderAttributesKnownConfig(t *testing.T) {
+	t.Parallel()
+
+	config := testUnknownProviderConfig(t, &IdsecProvider{})
+	if unknown := unknownProviderAttributes(config.Raw); len(unknown) != 0 {
+		t.Errorf("expected no unknown attributes, got %v", unknown)
+	}
+}
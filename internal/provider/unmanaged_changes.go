@@ -0,0 +1,72 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/cyberark/terraform-provider-idsec/internal/schemas"
+)
+
+// topLevelAttributes returns the attributes of a known object value, or an empty map for null and unknown values.
+func topLevelAttributes(value tftypes.Value) map[string]tftypes.Value {
+	attributes := map[string]tftypes.Value{}
+	if value.IsNull() || !value.IsKnown() {
+		return attributes
+	}
+	if err := value.As(&attributes); err != nil {
+		return map[string]tftypes.Value{}
+	}
+	return attributes
+}
+
+// unmanagedChangedAttributes returns the sorted names of the top-level attributes left unset in config
+// whose value in current differs from prior, i.e. the attributes the API changed on its own during an
+// update. Attributes in ignored, such as computed-only outputs, are expected to change and are skipped.
+func unmanagedChangedAttributes(prior, current, config tftypes.Value, ignored []string) []string {
+	priorAttributes := topLevelAttributes(prior)
+	currentAttributes := topLevelAttributes(current)
+	configAttributes := topLevelAttributes(config)
+	var changed []string
+	for name, currentValue := range currentAttributes {
+		if slices.Contains(ignored, name) {
+			continue
+		}
+		if configValue, ok := configAttributes[name]; !ok || !configValue.IsNull() {
+			continue
+		}
+		priorValue, ok := priorAttributes[name]
+		if !ok || priorValue.Equal(currentValue) {
+			continue
+		}
+		changed = append(changed, name)
+	}
+	sort.Strings(changed)
+	return changed
+}
+
+// warnUnmanagedChanges warns about attributes the API changed during an update although the
+// configuration does not set them, e.g. server-side defaults, so the resulting state churn is explained.
+func (s *IdsecResource) warnUnmanagedChanges(ctx context.Context, prior, current, config tftypes.Value, diagnostics *diag.Diagnostics) {
+	ignored := append([]string{schemas.SyntheticIDAttributeName}, s.getComputedAttributes()...)
+	changed := unmanagedChangedAttributes(prior, current, config, ignored)
+	if len(changed) == 0 {
+		return
+	}
+	typeName := s.getTerraformTypeName(s.actionDefinition.ActionName)
+	tflog.Info(ctx, fmt.Sprintf("API changed unmanaged attributes of %s: %s", typeName, strings.Join(changed, ", ")))
+	diagnostics.AddWarning(
+		"Unmanaged Attributes Changed",
+		fmt.Sprintf("The API changed attributes of %s that are not set in the configuration: %s. "+
+			"These values are set server-side, e.g. by defaults or policies. Set them in the configuration to manage them explicitly.",
+			typeName, strings.Join(changed, ", ")),
+	)
+}
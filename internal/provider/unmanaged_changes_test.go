@@ -0,0 +1,65 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestUnmanagedChangedAttributes(t *testing.T) {
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"name":             tftypes.String,
+		"description":      tftypes.String,
+		"retention_days":   tftypes.Number,
+		"last_modified_at": tftypes.String,
+	}}
+	object := func(name, description, retention, modified interface{}) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"name":             tftypes.NewValue(tftypes.String, name),
+			"description":      tftypes.NewValue(tftypes.String, description),
+			"retention_days":   tftypes.NewValue(tftypes.Number, retention),
+			"last_modified_at": tftypes.NewValue(tftypes.String, modified),
+		})
+	}
+	tests := []struct {
+		name    string
+		prior   tftypes.Value
+		current tftypes.Value
+		config  tftypes.Value
+		want    []string
+	}{
+		{
+			name:    "server_default_changed",
+			prior:   object("safe", "old", 7, "t1"),
+			current: object("safe", "new", 30, "t2"),
+			config:  object("safe", "new", nil, nil),
+			want:    []string{"retention_days"},
+		},
+		{
+			name:    "configured_attributes_ignored",
+			prior:   object("safe", "old", 7, "t1"),
+			current: object("vault", "new", 30, "t1"),
+			config:  object("vault", "new", 30, nil),
+			want:    nil,
+		},
+		{
+			name:    "unchanged",
+			prior:   object("safe", "old", 7, "t1"),
+			current: object("safe", "old", 7, "t1"),
+			config:  object("safe", nil, nil, nil),
+			want:    nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := unmanagedChangedAttributes(tt.prior, tt.current, tt.config, []string{"last_modified_at"})
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
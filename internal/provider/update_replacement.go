@@ -0,0 +1,82 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/cyberark/terraform-provider-idsec/internal/actions"
+	"github.com/cyberark/terraform-provider-idsec/internal/schemas"
+)
+
+// replacedAttributes returns the sorted names of the top-level attributes set in config whose planned
+// value differs from the prior state. Attributes in ignored, such as computed-only outputs, are skipped.
+func replacedAttributes(plan, state, config tftypes.Value, ignored []string) []string {
+	planAttributes := topLevelAttributes(plan)
+	stateAttributes := topLevelAttributes(state)
+	configAttributes := topLevelAttributes(config)
+	var replaced []string
+	for name, planned := range planAttributes {
+		if slices.Contains(ignored, name) {
+			continue
+		}
+		if configValue, ok := configAttributes[name]; !ok || configValue.IsNull() {
+			continue
+		}
+		if prior, ok := stateAttributes[name]; ok && prior.Equal(planned) {
+			continue
+		}
+		replaced = append(replaced, name)
+	}
+	sort.Strings(replaced)
+	return replaced
+}
+
+// requireReplaceWithoutUpdate plans a replacement of resources without an update operation when a
+// configured attribute changes, instead of an update that would silently keep the prior state.
+func (s *IdsecResource) requireReplaceWithoutUpdate(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() || slices.Contains(s.actionDefinition.SupportedOperations, actions.UpdateOperation) {
+		return
+	}
+	ignored := append([]string{schemas.SyntheticIDAttributeName}, s.getComputedAttributes()...)
+	for _, name := range replacedAttributes(req.Plan.Raw, req.State.Raw, req.Config.Raw, ignored) {
+		tflog.Debug(ctx, fmt.Sprintf("Attribute %s changed on a resource without an update operation, replacement required", name))
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root(name))
+	}
+}
+
+// warnReplaceImpact warns, when a ForceNew attribute set in the configuration changes, of what the API
+// destroys with the replacement, as described by the replace_impact tag of the attribute. Terraform only
+// shows that the attribute forces replacement, not that e.g. the memberships of a safe go with it.
+func (s *IdsecResource) warnReplaceImpact(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+	forceNew := s.getForceNewAttributes()
+	if len(forceNew) == 0 {
+		return
+	}
+	createSchema, _ := s.schemaForOperation(actions.CreateOperation)
+	updateSchema, _ := s.schemaForOperation(actions.UpdateOperation)
+	impacts := schemas.ReplaceImpacts(createSchema, updateSchema, s.actionDefinition.StateSchema)
+	for _, name := range replacedAttributes(req.Plan.Raw, req.State.Raw, req.Config.Raw, nil) {
+		impact, ok := impacts[name]
+		if !ok || !slices.Contains(forceNew, name) {
+			continue
+		}
+		tflog.Debug(ctx, fmt.Sprintf("ForceNew attribute %s changed, warning of the replacement impact", name))
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root(name),
+			"Resource Replacement",
+			fmt.Sprintf("Changing %s %s.", name, impact),
+		)
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/cyberark/terraform-provider-idsec/internal/actions"
+)
+
+var replacementTestType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+	"app_id":      tftypes.String,
+	"description": tftypes.String,
+	"location":    tftypes.String,
+}}
+
+func replacementTestObject(appID, description, location interface{}) tftypes.Value {
+	return tftypes.NewValue(replacementTestType, map[string]tftypes.Value{
+		"app_id":      tftypes.NewValue(tftypes.String, appID),
+		"description": tftypes.NewValue(tftypes.String, description),
+		"location":    tftypes.NewValue(tftypes.String, location),
+	})
+}
+
+func TestReplacedAttributes(t *testing.T) {
+	tests := []struct {
+		name   string
+		plan   tftypes.Value
+		state  tftypes.Value
+		config tftypes.Value
+		want   []string
+	}{
+		{
+			name:   "configured_change",
+			plan:   replacementTestObject("app", "new", `\Applications`),
+			state:  replacementTestObject("app", "old", `\Applications`),
+			config: replacementTestObject("app", "new", nil),
+			want:   []string{"description"},
+		},
+		{
+			name:   "unknown_configured_value",
+			plan:   replacementTestObject("app", tftypes.UnknownValue, `\Applications`),
+			state:  replacementTestObject("app", "old", `\Applications`),
+			config: replacementTestObject("app", tftypes.UnknownValue, nil),
+			want:   []string{"description"},
+		},
+		{
+			name:   "unconfigured_and_ignored_changes",
+			plan:   replacementTestObject(tftypes.UnknownValue, "old", tftypes.UnknownValue),
+			state:  replacementTestObject("app", "old", `\Applications`),
+			config: replacementTestObject("app", "old", nil),
+			want:   nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := replacedAttributes(tt.plan, tt.state, tt.config, []string{"app_id"})
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestIdsecResource_ModifyPlanRequiresReplaceWithoutUpdate(t *testing.T) {
+	req := resource.ModifyPlanRequest{
+		Plan:   tfsdk.Plan{Raw: replacementTestObject("app", "new", `\Applications`)},
+		State:  tfsdk.State{Raw: replacementTestObject("app", "old", `\Applications`)},
+		Config: tfsdk.Config{Raw: replacementTestObject("app", "new", nil)},
+	}
+	tests := []struct {
+		name       string
+		operations []actions.IdsecServiceActionOperation
+		want       []path.Path
+	}{
+		{
+			name:       "without_update",
+			operations: []actions.IdsecServiceActionOperation{actions.CreateOperation, actions.ReadOperation, actions.DeleteOperation},
+			want:       []path.Path{path.Root("description")},
+		},
+		{
+			name:       "with_update",
+			operations: []actions.IdsecServiceActionOperation{actions.CreateOperation, actions.ReadOperation, actions.UpdateOperation, actions.DeleteOperation},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actionDefinition := CreateTestActionDefinitionWithImportIDAndOperations("test-action", "Test action description", "app_id", tt.operations)
+			idsecRes := CreateTestIdsecResource(CreateTestServiceConfig("test-service"), actionDefinition).(*IdsecResource)
+			resp := &resource.ModifyPlanResponse{}
+			idsecRes.ModifyPlan(context.Background(), req, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("expected no errors, got %v", resp.Diagnostics)
+			}
+			if !reflect.DeepEqual(resp.RequiresReplace, path.Paths(tt.want)) && len(resp.RequiresReplace)+len(tt.want) > 0 {
+				t.Errorf("expected replacement of %v, got %v", tt.want, resp.RequiresReplace)
+			}
+		})
+	}
+}
+
+type replaceImpactTestModel struct {
+	AppID       string `json:"app_id" mapstructure:"app_id"`
+	Description string `json:"description" mapstructure:"description"`
+	Location    string `json:"location" mapstructure:"location" replace_impact:"deletes and recreates the application and its permissions"`
+}
+
+func TestIdsecResource_ModifyPlanWarnsReplaceImpact(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      resource.ModifyPlanRequest
+		wantWarn bool
+	}{
+		{
+			name: "force_new_attribute_changed",
+			req: resource.ModifyPlanRequest{
+				Plan:   tfsdk.Plan{Raw: replacementTestObject("app", "desc", `\Finance`)},
+				State:  tfsdk.State{Raw: replacementTestObject("app", "desc", `\Applications`)},
+				Config: tfsdk.Config{Raw: replacementTestObject("app", "desc", `\Finance`)},
+			},
+			wantWarn: true,
+		},
+		{
+			name: "other_attribute_changed",
+			req: resource.ModifyPlanRequest{
+				Plan:   tfsdk.Plan{Raw: replacementTestObject("app", "new", `\Applications`)},
+				State:  tfsdk.State{Raw: replacementTestObject("app", "old", `\Applications`)},
+				Config: tfsdk.Config{Raw: replacementTestObject("app", "new", `\Applications`)},
+			},
+		},
+		{
+			name: "create",
+			req: resource.ModifyPlanRequest{
+				Plan:   tfsdk.Plan{Raw: replacementTestObject("app", "desc", `\Finance`)},
+				State:  tfsdk.State{Raw: tftypes.NewValue(replacementTestType, nil)},
+				Config: tfsdk.Config{Raw: replacementTestObject("app", "desc", `\Finance`)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actionDefinition := CreateTestActionDefinitionWithImportIDAndOperations("test-action", "Test action description", "app_id",
+				[]actions.IdsecServiceActionOperation{actions.CreateOperation, actions.ReadOperation, actions.UpdateOperation, actions.DeleteOperation})
+			actionDefinition.StateSchema = &replaceImpactTestModel{}
+			actionDefinition.ForceNewAttributes = []string{"location"}
+			idsecRes := CreateTestIdsecResource(CreateTestServiceConfig("test-service"), actionDefinition).(*IdsecResource)
+			resp := &resource.ModifyPlanResponse{}
+			idsecRes.ModifyPlan(context.Background(), tt.req, resp)
+			warnings := resp.Diagnostics.Warnings()
+			if !tt.wantWarn {
+				if len(warnings) != 0 {
+					t.Errorf("expected no warnings, got %v", warnings)
+				}
+				return
+			}
+			if len(warnings) != 1 {
+				t.Fatalf("expected 1 warning, got %v", resp.Diagnostics)
+			}
+			want := "Changing location deletes and recreates the application and its permissions."
+			if warnings[0].Detail() != want {
+				t.Errorf("expected detail %q, got %q", want, warnings[0].Detail())
+			}
+		})
+	}
+}
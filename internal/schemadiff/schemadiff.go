@@ -0,0 +1,227 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+// Package schemadiff compares snapshots of the provider schema between releases. A snapshot records the
+// resources, data sources and actions of the provider with the paths of their attributes, and a diff
+// lists what was added, deprecated or removed, as release notes report it.
+package schemadiff
+
+import (
+	"encoding/json"
+	"os"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+// Kinds of schemas of a snapshot, as named in release notes.
+const (
+	KindProvider   = "provider"
+	KindResource   = "resource"
+	KindDataSource = "data-source"
+	KindAction     = "action"
+)
+
+// Attribute is an attribute of a schema snapshot.
+type Attribute struct {
+	Deprecated bool `json:"deprecated,omitempty"`
+	Required   bool `json:"required,omitempty"`
+}
+
+// Schema is the snapshot of the schema of the provider, a resource, a data source or an action. Its
+// attributes are keyed by path, nested attributes and blocks joining names with dots.
+type Schema struct {
+	Deprecated bool                 `json:"deprecated,omitempty"`
+	Attributes map[string]Attribute `json:"attributes"`
+}
+
+// Snapshot is the snapshot of the provider schema.
+type Snapshot struct {
+	Provider    Schema            `json:"provider"`
+	Resources   map[string]Schema `json:"resources"`
+	DataSources map[string]Schema `json:"data_sources"`
+	Actions     map[string]Schema `json:"actions"`
+}
+
+// FromProviderSchema builds the snapshot of a GetProviderSchema response.
+func FromProviderSchema(resp *tfprotov6.GetProviderSchemaResponse) Snapshot {
+	snapshot := Snapshot{
+		Provider:    fromSchema(resp.Provider),
+		Resources:   map[string]Schema{},
+		DataSources: map[string]Schema{},
+		Actions:     map[string]Schema{},
+	}
+	for name, schema := range resp.ResourceSchemas {
+		snapshot.Resources[name] = fromSchema(schema)
+	}
+	for name, schema := range resp.DataSourceSchemas {
+		snapshot.DataSources[name] = fromSchema(schema)
+	}
+	for name, schema := range resp.ActionSchemas {
+		if schema != nil {
+			snapshot.Actions[name] = fromSchema(schema.Schema)
+		}
+	}
+	return snapshot
+}
+
+// fromSchema builds the snapshot of a schema, which is empty when it is nil.
+func fromSchema(schema *tfprotov6.Schema) Schema {
+	snapshot := Schema{Attributes: map[string]Attribute{}}
+	if schema == nil || schema.Block == nil {
+		return snapshot
+	}
+	snapshot.Deprecated = schema.Block.Deprecated
+	addBlock(snapshot.Attributes, "", schema.Block)
+	return snapshot
+}
+
+// addBlock adds the attributes and nested blocks of block to attributes, under prefix.
+func addBlock(attributes map[string]Attribute, prefix string, block *tfprotov6.SchemaBlock) {
+	addAttributes(attributes, prefix, block.Attributes)
+	for _, nested := range block.BlockTypes {
+		attributes[prefix+nested.TypeName] = Attribute{Deprecated: nested.Block != nil && nested.Block.Deprecated, Required: nested.MinItems > 0}
+		if nested.Block != nil {
+			addBlock(attributes, prefix+nested.TypeName+".", nested.Block)
+		}
+	}
+}
+
+// addAttributes adds schema attributes and their nested attributes to attributes, under prefix.
+func addAttributes(attributes map[string]Attribute, prefix string, schemaAttributes []*tfprotov6.SchemaAttribute) {
+	for _, attr := range schemaAttributes {
+		attributes[prefix+attr.Name] = Attribute{Deprecated: attr.Deprecated, Required: attr.Required}
+		if attr.NestedType != nil {
+			addAttributes(attributes, prefix+attr.Name+".", attr.NestedType.Attributes)
+		}
+	}
+}
+
+// ReadSnapshot reads a snapshot written by WriteSnapshot.
+func ReadSnapshot(path string) (Snapshot, error) {
+	var snapshot Snapshot
+	content, err := os.ReadFile(path) // #nosec G304
+	if err != nil {
+		return snapshot, err
+	}
+	err = json.Unmarshal(content, &snapshot)
+	return snapshot, err
+}
+
+// WriteSnapshot writes a snapshot as indented JSON, with keys sorted so snapshots diff cleanly.
+func WriteSnapshot(path string, snapshot Snapshot) error {
+	content, err := json.MarshalIndent(snapshot, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(content, '\n'), 0o644) // #nosec G306
+}
+
+// Change is an entry of the diff of two snapshots. Attribute is empty for changes of a whole schema.
+type Change struct {
+	Kind      string
+	Name      string
+	Attribute string
+}
+
+// Diff lists the changes between two snapshots, sorted by kind (provider, resources, data sources, then
+// actions), name and attribute.
+type Diff struct {
+	// Added are new resources, data sources and actions, and new attributes of existing ones.
+	Added []Change
+	// Deprecated are schemas and attributes deprecated since the old snapshot.
+	Deprecated []Change
+	// Removed are schemas and attributes of the old snapshot missing from the new one.
+	Removed []Change
+	// NowRequired are existing attributes that became required.
+	NowRequired []Change
+}
+
+// IsEmpty reports whether the snapshots have no differences release notes report.
+func (d Diff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Deprecated) == 0 && len(d.Removed) == 0 && len(d.NowRequired) == 0
+}
+
+// Compare returns the diff from the old snapshot to the new one.
+func Compare(oldSnapshot Snapshot, newSnapshot Snapshot) Diff {
+	var diff Diff
+	diff.compareSchema(KindProvider, "", oldSnapshot.Provider, newSnapshot.Provider)
+	diff.compareSchemas(KindResource, oldSnapshot.Resources, newSnapshot.Resources)
+	diff.compareSchemas(KindDataSource, oldSnapshot.DataSources, newSnapshot.DataSources)
+	diff.compareSchemas(KindAction, oldSnapshot.Actions, newSnapshot.Actions)
+	for _, changes := range [][]Change{diff.Added, diff.Deprecated, diff.Removed, diff.NowRequired} {
+		sortChanges(changes)
+	}
+	return diff
+}
+
+// compareSchemas compares the schemas of one kind.
+func (d *Diff) compareSchemas(kind string, oldSchemas map[string]Schema, newSchemas map[string]Schema) {
+	for name, newSchema := range newSchemas {
+		oldSchema, ok := oldSchemas[name]
+		if !ok {
+			d.Added = append(d.Added, Change{Kind: kind, Name: name})
+			continue
+		}
+		d.compareSchema(kind, name, oldSchema, newSchema)
+	}
+	for name := range oldSchemas {
+		if _, ok := newSchemas[name]; !ok {
+			d.Removed = append(d.Removed, Change{Kind: kind, Name: name})
+		}
+	}
+}
+
+// compareSchema compares two snapshots of the same schema. Attributes nested in new or removed
+// attributes are not reported on their own.
+func (d *Diff) compareSchema(kind string, name string, oldSchema Schema, newSchema Schema) {
+	if newSchema.Deprecated && !oldSchema.Deprecated {
+		d.Deprecated = append(d.Deprecated, Change{Kind: kind, Name: name})
+	}
+	for path, newAttr := range newSchema.Attributes {
+		oldAttr, ok := oldSchema.Attributes[path]
+		switch {
+		case !ok:
+			if _, parentExists := oldSchema.Attributes[parentPath(path)]; parentPath(path) == "" || parentExists {
+				d.Added = append(d.Added, Change{Kind: kind, Name: name, Attribute: path})
+			}
+		case newAttr.Deprecated && !oldAttr.Deprecated:
+			d.Deprecated = append(d.Deprecated, Change{Kind: kind, Name: name, Attribute: path})
+		case newAttr.Required && !oldAttr.Required:
+			d.NowRequired = append(d.NowRequired, Change{Kind: kind, Name: name, Attribute: path})
+		}
+	}
+	for path := range oldSchema.Attributes {
+		if _, ok := newSchema.Attributes[path]; ok {
+			continue
+		}
+		if _, parentExists := newSchema.Attributes[parentPath(path)]; parentPath(path) == "" || parentExists {
+			d.Removed = append(d.Removed, Change{Kind: kind, Name: name, Attribute: path})
+		}
+	}
+}
+
+// parentPath returns the path of the attribute an attribute is nested in, empty for top level attributes.
+func parentPath(path string) string {
+	for i := len(path) - 1; i >= 0; i-- {
+		if path[i] == '.' {
+			return path[:i]
+		}
+	}
+	return ""
+}
+
+// kindOrder orders changes as release notes list them.
+var kindOrder = map[string]int{KindProvider: 0, KindResource: 1, KindDataSource: 2, KindAction: 3}
+
+func sortChanges(changes []Change) {
+	sort.Slice(changes, func(i, j int) bool {
+		if changes[i].Kind != changes[j].Kind {
+			return kindOrder[changes[i].Kind] < kindOrder[changes[j].Kind]
+		}
+		if changes[i].Name != changes[j].Name {
+			return changes[i].Name < changes[j].Name
+		}
+		return changes[i].Attribute < changes[j].Attribute
+	})
+}
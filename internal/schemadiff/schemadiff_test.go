@@ -0,0 +1,112 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemadiff
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+func TestFromProviderSchema(t *testing.T) {
+	t.Parallel()
+
+	resp := &tfprotov6.GetProviderSchemaResponse{
+		ResourceSchemas: map[string]*tfprotov6.Schema{
+			"idsec_safe": {Block: &tfprotov6.SchemaBlock{
+				Attributes: []*tfprotov6.SchemaAttribute{
+					{Name: "name", Required: true},
+					{Name: "members", NestedType: &tfprotov6.SchemaObject{Attributes: []*tfprotov6.SchemaAttribute{{Name: "member_name", Deprecated: true}}}},
+				},
+				BlockTypes: []*tfprotov6.SchemaNestedBlock{
+					{TypeName: "timeouts", Block: &tfprotov6.SchemaBlock{Attributes: []*tfprotov6.SchemaAttribute{{Name: "create"}}}},
+				},
+			}},
+		},
+	}
+	snapshot := FromProviderSchema(resp)
+	want := map[string]Attribute{
+		"name":                {Required: true},
+		"members":             {},
+		"members.member_name": {Deprecated: true},
+		"timeouts":            {},
+		"timeouts.create":     {},
+	}
+	if got := snapshot.Resources["idsec_safe"].Attributes; !reflect.DeepEqual(got, want) {
+		t.Errorf("expected attributes %v, got %v", want, got)
+	}
+	if len(snapshot.Provider.Attributes) != 0 || len(snapshot.DataSources) != 0 {
+		t.Errorf("expected an empty provider schema and no data sources, got %+v", snapshot)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	t.Parallel()
+
+	oldSnapshot := Snapshot{
+		Provider: Schema{Attributes: map[string]Attribute{"subdomain": {}}},
+		Resources: map[string]Schema{
+			"idsec_safe":    {Attributes: map[string]Attribute{"name": {}, "description": {}, "legacy": {}, "legacy.value": {}, "owner": {}}},
+			"idsec_removed": {Attributes: map[string]Attribute{"id": {}}},
+		},
+		DataSources: map[string]Schema{"idsec_safe": {Attributes: map[string]Attribute{"name": {}}}},
+	}
+	newSnapshot := Snapshot{
+		Provider: Schema{Attributes: map[string]Attribute{"subdomain": {}, "profile": {}}},
+		Resources: map[string]Schema{
+			"idsec_safe":   {Attributes: map[string]Attribute{"name": {}, "description": {Deprecated: true}, "owner": {Required: true}, "retention": {}, "retention.days": {}}},
+			"idsec_member": {Attributes: map[string]Attribute{"id": {}}},
+		},
+		DataSources: map[string]Schema{"idsec_safe": {Deprecated: true, Attributes: map[string]Attribute{"name": {}}}},
+	}
+	diff := Compare(oldSnapshot, newSnapshot)
+	expected := Diff{
+		Added: []Change{
+			{Kind: KindProvider, Attribute: "profile"},
+			{Kind: KindResource, Name: "idsec_member"},
+			{Kind: KindResource, Name: "idsec_safe", Attribute: "retention"},
+		},
+		Deprecated: []Change{
+			{Kind: KindResource, Name: "idsec_safe", Attribute: "description"},
+			{Kind: KindDataSource, Name: "idsec_safe"},
+		},
+		Removed: []Change{
+			{Kind: KindResource, Name: "idsec_removed"},
+			{Kind: KindResource, Name: "idsec_safe", Attribute: "legacy"},
+		},
+		NowRequired: []Change{
+			{Kind: KindResource, Name: "idsec_safe", Attribute: "owner"},
+		},
+	}
+	if !reflect.DeepEqual(diff, expected) {
+		t.Errorf("expected diff %+v, got %+v", expected, diff)
+	}
+	if !Compare(newSnapshot, newSnapshot).IsEmpty() {
+		t.Error("expected no changes between identical snapshots")
+	}
+}
+
+func TestSnapshotRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	snapshot := Snapshot{
+		Provider:    Schema{Attributes: map[string]Attribute{"subdomain": {}}},
+		Resources:   map[string]Schema{"idsec_safe": {Deprecated: true, Attributes: map[string]Attribute{"name": {Required: true}}}},
+		DataSources: map[string]Schema{},
+		Actions:     map[string]Schema{},
+	}
+	path := filepath.Join(t.TempDir(), "schema.json")
+	if err := WriteSnapshot(path, snapshot); err != nil {
+		t.Fatalf("failed to write snapshot: %v", err)
+	}
+	read, err := ReadSnapshot(path)
+	if err != nil {
+		t.Fatalf("failed to read snapshot: %v", err)
+	}
+	if !reflect.DeepEqual(read, snapshot) {
+		t.Errorf("expected snapshot %+v, got %+v", snapshot, read)
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright CyberArk 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+// StructFromStringMap decodes a map of string values, keyed by Terraform attribute name, into a new
+// instance of the prototype's type. Values are weakly typed, so "true" or "10" decode into bool and
+// numeric fields. A pointer prototype yields a pointer to the decoded struct.
+func StructFromStringMap(values map[string]string, prototype interface{}) (interface{}, error) {
+	protoType := reflect.TypeOf(prototype)
+	newStruct := reflect.New(protoType)
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		WeaklyTypedInput: true,
+		Result:           newStruct.Interface(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create decoder: %w", err)
+	}
+	decodeValues := make(map[string]string, len(values))
+	for key, value := range values {
+		decodeValues[key] = value
+	}
+	structType := protoType
+	for structType.Kind() == reflect.Pointer {
+		structType = structType.Elem()
+	}
+	if structType.Kind() == reflect.Struct {
+		// Fields renamed by a tfname tag are decoded from their SDK name
+		for _, field := range resolveFieldsSquashed(structType) {
+			name := resolveFieldName(field)
+			if value, ok := decodeValues[name]; ok && field.Tag.Get(TerraformNameTag) != "" {
+				delete(decodeValues, name)
+				decodeValues[decodeFieldName(field)] = value
+			}
+		}
+	}
+	if err := decoder.Decode(decodeValues); err != nil {
+		return nil, fmt.Errorf("failed to decode map to struct: %w", err)
+	}
+	return newStruct.Elem().Interface(), nil
+}
+
+// LookupAttributeValue resolves a dot-separated Terraform attribute path (e.g. "status" or
+// "metadata.state" or "members.0.name") on an SDK model and returns the value as a string.
+// Numeric segments index into lists. The second return value reports whether the path was found.
+func LookupAttributeValue(input interface{}, attributePath string) (string, bool) {
+	value := reflect.ValueOf(input)
+	for _, segment := range strings.Split(attributePath, ".") {
+		for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+			if value.IsNil() {
+				return "", false
+			}
+			value = value.Elem()
+		}
+		switch value.Kind() {
+		case reflect.Struct:
+			field, found := findStructFieldByName(value, segment)
+			if !found {
+				return "", false
+			}
+			value = field
+		case reflect.Map:
+			key, err := stringToMapKey(segment, value.Type().Key())
+			if err != nil {
+				return "", false
+			}
+			value = value.MapIndex(key)
+			if !value.IsValid() {
+				return "", false
+			}
+		case reflect.Slice, reflect.Array:
+			index, err := strconv.Atoi(segment)
+			if err != nil || index < 0 || index >= value.Len() {
+				return "", false
+			}
+			value = value.Index(index)
+		default:
+			return "", false
+		}
+	}
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return "", true
+		}
+		value = value.Elem()
+	}
+	return fmt.Sprint(value.Interface()), true
+}
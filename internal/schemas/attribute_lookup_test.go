@@ -0,0 +1,82 @@
+// Copyright CyberArk 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"reflect"
+	"testing"
+)
+
+type lookupTestMember struct {
+	Name string `mapstructure:"name"`
+}
+
+type lookupTestModel struct {
+	Status   string             `mapstructure:"status"`
+	Enabled  *bool              `mapstructure:"enabled"`
+	Count    int                `mapstructure:"count"`
+	Metadata *lookupTestMember  `mapstructure:"metadata"`
+	Members  []lookupTestMember `mapstructure:"members"`
+	Labels   map[string]string  `mapstructure:"labels"`
+}
+
+func TestLookupAttributeValue(t *testing.T) {
+	t.Parallel()
+
+	enabled := true
+	model := &lookupTestModel{
+		Status:   "ONLINE",
+		Enabled:  &enabled,
+		Count:    3,
+		Metadata: &lookupTestMember{Name: "meta"},
+		Members:  []lookupTestMember{{Name: "first"}, {Name: "second"}},
+		Labels:   map[string]string{"env": "prod"},
+	}
+
+	tests := []struct {
+		name          string
+		path          string
+		expectedValue string
+		expectedFound bool
+	}{
+		{name: "success_top_level_string", path: "status", expectedValue: "ONLINE", expectedFound: true},
+		{name: "success_pointer_bool", path: "enabled", expectedValue: "true", expectedFound: true},
+		{name: "success_int", path: "count", expectedValue: "3", expectedFound: true},
+		{name: "success_nested_struct", path: "metadata.name", expectedValue: "meta", expectedFound: true},
+		{name: "success_list_index", path: "members.1.name", expectedValue: "second", expectedFound: true},
+		{name: "success_map_key", path: "labels.env", expectedValue: "prod", expectedFound: true},
+		{name: "error_unknown_attribute", path: "unknown", expectedFound: false},
+		{name: "error_index_out_of_range", path: "members.5.name", expectedFound: false},
+		{name: "error_missing_map_key", path: "labels.region", expectedFound: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			value, found := LookupAttributeValue(model, tt.path)
+			if found != tt.expectedFound {
+				t.Fatalf("expected found=%v, got %v", tt.expectedFound, found)
+			}
+			if value != tt.expectedValue {
+				t.Errorf("expected value %q, got %q", tt.expectedValue, value)
+			}
+		})
+	}
+}
+
+func TestStructFromStringMap(t *testing.T) {
+	t.Parallel()
+
+	result, err := StructFromStringMap(map[string]string{"status": "ONLINE", "enabled": "true", "count": "7"}, &lookupTestModel{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	model, ok := result.(*lookupTestModel)
+	if !ok {
+		t.Fatalf("expected *lookupTestModel, got %s", reflect.TypeOf(result))
+	}
+	if model.Status != "ONLINE" || model.Enabled == nil || !*model.Enabled || model.Count != 7 {
+		t.Errorf("unexpected decoded model: %+v", model)
+	}
+}
@@ -48,6 +48,58 @@ func SplitImportIDAttributes(importID string) []string {
 	return attributes
 }
 
+// DefaultImportIDDelimiter separates the values of a composite import ID when the action definition
+// declares no delimiter of its own.
+const DefaultImportIDDelimiter = ":"
+
+// ParseCompositeImportID splits an import ID into one value per import attribute, in the order of the
+// attributes, e.g. "safe-1/alice" into ["safe-1", "alice"] for attributes [safe_id member_name] and
+// delimiter "/". An import ID of a single attribute is returned whole, so it may contain the delimiter.
+// Errors describe the expected format of the import ID.
+func ParseCompositeImportID(importID string, attributes []string, delimiter string) ([]string, error) {
+	if len(attributes) == 0 {
+		return nil, fmt.Errorf("no import attributes are configured")
+	}
+	if delimiter == "" {
+		delimiter = DefaultImportIDDelimiter
+	}
+	if len(attributes) == 1 {
+		return []string{importID}, nil
+	}
+	expected := fmt.Sprintf("expected %s, e.g. %s",
+		strings.Join(attributes, delimiter), strings.Join(exampleImportValues(len(attributes)), delimiter))
+	if !strings.Contains(importID, delimiter) {
+		return nil, fmt.Errorf("import ID %q must hold %d values separated by %q: %s", importID, len(attributes), delimiter, expected)
+	}
+	values := strings.Split(importID, delimiter)
+	if len(values) != len(attributes) {
+		return nil, fmt.Errorf("import ID %q has %d part(s) separated by %q but %d are required: %s", importID, len(values), delimiter, len(attributes), expected)
+	}
+	for i, value := range values {
+		if strings.TrimSpace(value) == "" {
+			return nil, fmt.Errorf("import ID %q has no value for %s: %s", importID, attributes[i], expected)
+		}
+	}
+	return values, nil
+}
+
+// FormatCompositeImportID joins the values of the import attributes into an import ID, the inverse of
+// ParseCompositeImportID.
+func FormatCompositeImportID(values []string, delimiter string) string {
+	if delimiter == "" {
+		delimiter = DefaultImportIDDelimiter
+	}
+	return strings.Join(values, delimiter)
+}
+
+func exampleImportValues(count int) []string {
+	values := make([]string, count)
+	for i := range values {
+		values[i] = fmt.Sprintf("value%d", i+1)
+	}
+	return values
+}
+
 // ValidateStateSchemaImportAttribute verifies that attributePath exists on the Terraform state
 // schema and resolves to a string or integer field suitable for import ID values.
 func ValidateStateSchemaImportAttribute(stateSchema interface{}, attributePath string) error {
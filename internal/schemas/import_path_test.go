@@ -4,6 +4,8 @@
 package schemas
 
 import (
+	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -104,6 +106,86 @@ func TestSplitImportIDAttributes(t *testing.T) {
 	}
 }
 
+func TestParseCompositeImportID(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		importID      string
+		attributes    []string
+		delimiter     string
+		expected      []string
+		errorContains string
+	}{
+		{
+			name:       "single_attribute_keeps_delimiter",
+			importID:   "a:b",
+			attributes: []string{"id"},
+			expected:   []string{"a:b"},
+		},
+		{
+			name:       "default_delimiter",
+			importID:   "safe-1:alice",
+			attributes: []string{"safe_id", "member_name"},
+			expected:   []string{"safe-1", "alice"},
+		},
+		{
+			name:       "custom_delimiter",
+			importID:   "policy-1/rule:a",
+			attributes: []string{"policy_id", "rule_name"},
+			delimiter:  "/",
+			expected:   []string{"policy-1", "rule:a"},
+		},
+		{
+			name:          "missing_delimiter",
+			importID:      "safe-1",
+			attributes:    []string{"safe_id", "member_name"},
+			delimiter:     "/",
+			errorContains: "expected safe_id/member_name, e.g. value1/value2",
+		},
+		{
+			name:          "too_many_parts",
+			importID:      "a:b:c",
+			attributes:    []string{"safe_id", "member_name"},
+			errorContains: "has 3 part(s)",
+		},
+		{
+			name:          "empty_part",
+			importID:      "safe-1:",
+			attributes:    []string{"safe_id", "member_name"},
+			errorContains: "no value for member_name",
+		},
+		{
+			name:          "no_attributes",
+			importID:      "safe-1",
+			errorContains: "no import attributes",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := ParseCompositeImportID(tt.importID, tt.attributes, tt.delimiter)
+			if tt.errorContains != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errorContains) {
+					t.Fatalf("expected error containing %q, got %v", tt.errorContains, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Fatalf("expected %v, got %v", tt.expected, got)
+			}
+			if len(tt.attributes) > 1 && FormatCompositeImportID(got, tt.delimiter) != tt.importID {
+				t.Fatalf("expected %q to format back to the import ID", got)
+			}
+		})
+	}
+}
+
 func TestValidateStateSchemaImportAttribute_policy_metadata(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,36 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// GenerateActionSchemaFromStruct generates the schema of a Terraform action from the input model of
+// an imperative SDK action, such as a credentials rotation. Imperative inputs are identifiers and
+// flags, so, as for list filters, only primitive fields and lists or maps of primitives are exposed.
+// A nil model yields an empty schema, for actions that take no input.
+func GenerateActionSchemaFromStruct(inputModel interface{}) actionschema.Schema {
+	attributes := map[string]actionschema.Attribute{}
+	for _, flatAttr := range flatModelAttributes(inputModel) {
+		desc, isRequired := flatAttr.description, flatAttr.required
+		switch attrType := flatAttr.attrType.(type) {
+		case types.ListType:
+			attributes[flatAttr.name] = actionschema.ListAttribute{ElementType: attrType.ElemType, Description: desc, Required: isRequired, Optional: !isRequired}
+		case types.MapType:
+			attributes[flatAttr.name] = actionschema.MapAttribute{ElementType: attrType.ElemType, Description: desc, Required: isRequired, Optional: !isRequired}
+		case basetypes.StringType:
+			attributes[flatAttr.name] = actionschema.StringAttribute{Description: desc, Required: isRequired, Optional: !isRequired}
+		case basetypes.BoolType:
+			attributes[flatAttr.name] = actionschema.BoolAttribute{Description: desc, Required: isRequired, Optional: !isRequired}
+		case basetypes.Int64Type:
+			attributes[flatAttr.name] = actionschema.Int64Attribute{Description: desc, Required: isRequired, Optional: !isRequired}
+		case basetypes.Float64Type:
+			attributes[flatAttr.name] = actionschema.Float64Attribute{Description: desc, Required: isRequired, Optional: !isRequired}
+		}
+	}
+	return actionschema.Schema{Attributes: attributes}
+}
@@ -0,0 +1,37 @@
+// Copyright CyberArk 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"testing"
+
+	actionschema "github.com/hashicorp/terraform-plugin-framework/action/schema"
+)
+
+func TestGenerateActionSchemaFromStruct(t *testing.T) {
+	t.Parallel()
+
+	type rotateInput struct {
+		AccountID string `mapstructure:"account_id" desc:"The account" validate:"required"`
+		Immediate bool   `mapstructure:"immediate"`
+		Metadata  struct {
+			Owner string `mapstructure:"owner"`
+		} `mapstructure:"metadata"`
+	}
+
+	schema := GenerateActionSchemaFromStruct(&rotateInput{})
+	accountID, ok := schema.Attributes["account_id"].(actionschema.StringAttribute)
+	if !ok || !accountID.Required || accountID.Description != "The account" {
+		t.Errorf("unexpected account_id attribute: %#v", schema.Attributes["account_id"])
+	}
+	if immediate, ok := schema.Attributes["immediate"].(actionschema.BoolAttribute); !ok || !immediate.Optional {
+		t.Errorf("expected immediate to be an optional bool, got %#v", schema.Attributes["immediate"])
+	}
+	if _, ok := schema.Attributes["metadata"]; ok {
+		t.Errorf("expected nested struct to be skipped")
+	}
+	if len(GenerateActionSchemaFromStruct(nil).Attributes) != 0 {
+		t.Errorf("expected empty schema for nil input")
+	}
+}
@@ -0,0 +1,22 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// AdoptExistingAttributeName is the name of the attribute adopting the existing object when a create fails
+// because the object already exists.
+const AdoptExistingAttributeName = "adopt_existing"
+
+// AdoptExistingAttribute returns the optional adopt_existing attribute.
+func AdoptExistingAttribute() schema.BoolAttribute {
+	description := "Whether to adopt the existing object when the create fails because it already exists. The object is read and, where the resource supports updates, updated to the configuration instead of failing the create, which eases bringing objects created outside Terraform under management. The object is read from the attributes of the import ID of the resource, and is only adopted when they are all configured."
+	return schema.BoolAttribute{
+		Optional:            true,
+		Description:         description,
+		MarkdownDescription: description,
+	}
+}
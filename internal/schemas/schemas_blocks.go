@@ -0,0 +1,146 @@
+// Copyright CyberArk 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// convertNestedAttributesToBlocks moves the nested attributes named in blockAttrs out of attributes and
+// returns them as blocks, so they are configured with HCL block syntax instead of attribute syntax:
+// list and set nested attributes become ListNestedBlock and SetNestedBlock, single nested attributes
+// become SingleNestedBlock. Dotted names (e.g. "rules.conditions") address nested attributes inside a
+// converted block. Computed attributes, including the optional ones the API fills in when omitted, are
+// left untouched: blocks cannot be computed, so a value set by the API would be reported as inconsistent.
+func convertNestedAttributesToBlocks(attributes map[string]schema.Attribute, blockAttrs []string) map[string]schema.Block {
+	if len(blockAttrs) == 0 {
+		return nil
+	}
+	childBlockAttrs := map[string][]string{}
+	for _, blockAttr := range blockAttrs {
+		name, child, _ := strings.Cut(blockAttr, ".")
+		if _, ok := childBlockAttrs[name]; !ok {
+			childBlockAttrs[name] = nil
+		}
+		if child != "" {
+			childBlockAttrs[name] = append(childBlockAttrs[name], child)
+		}
+	}
+	blocks := map[string]schema.Block{}
+	for name, children := range childBlockAttrs {
+		block, ok := nestedAttributeToBlock(attributes[name], children)
+		if !ok {
+			continue
+		}
+		blocks[name] = block
+		delete(attributes, name)
+	}
+	return blocks
+}
+
+// nestedAttributeToBlock converts a single nested attribute to its block counterpart, recursively
+// converting the nested attributes named in children. Required nested attributes gain a minimum size
+// validator, since blocks cannot be marked as required.
+func nestedAttributeToBlock(attribute schema.Attribute, children []string) (schema.Block, bool) {
+	if attribute == nil || attribute.IsComputed() || (!attribute.IsOptional() && !attribute.IsRequired()) {
+		return nil, false
+	}
+	minItems := int64(1)
+	switch attr := attribute.(type) {
+	case schema.ListNestedAttribute:
+		nestedAttrs := copyAttributes(attr.NestedObject.Attributes)
+		validators := attr.Validators
+		if attr.Required {
+			validators = append([]validator.List{ListSizeValidator{Min: &minItems}}, validators...)
+		}
+		return schema.ListNestedBlock{
+			NestedObject: schema.NestedBlockObject{
+				Attributes:    nestedAttrs,
+				Blocks:        convertNestedAttributesToBlocks(nestedAttrs, children),
+				Validators:    attr.NestedObject.Validators,
+				PlanModifiers: attr.NestedObject.PlanModifiers,
+			},
+			Description:         attr.Description,
+			MarkdownDescription: attr.MarkdownDescription,
+			DeprecationMessage:  attr.DeprecationMessage,
+			Validators:          validators,
+			PlanModifiers:       attr.PlanModifiers,
+		}, true
+	case schema.SetNestedAttribute:
+		nestedAttrs := copyAttributes(attr.NestedObject.Attributes)
+		validators := attr.Validators
+		if attr.Required {
+			validators = append([]validator.Set{SetSizeValidator{Min: &minItems}}, validators...)
+		}
+		return schema.SetNestedBlock{
+			NestedObject: schema.NestedBlockObject{
+				Attributes:    nestedAttrs,
+				Blocks:        convertNestedAttributesToBlocks(nestedAttrs, children),
+				Validators:    attr.NestedObject.Validators,
+				PlanModifiers: attr.NestedObject.PlanModifiers,
+			},
+			Description:         attr.Description,
+			MarkdownDescription: attr.MarkdownDescription,
+			DeprecationMessage:  attr.DeprecationMessage,
+			Validators:          validators,
+			PlanModifiers:       attr.PlanModifiers,
+		}, true
+	case schema.SingleNestedAttribute:
+		nestedAttrs := copyAttributes(attr.Attributes)
+		return schema.SingleNestedBlock{
+			Attributes:          nestedAttrs,
+			Blocks:              convertNestedAttributesToBlocks(nestedAttrs, children),
+			Description:         attr.Description,
+			MarkdownDescription: attr.MarkdownDescription,
+			DeprecationMessage:  attr.DeprecationMessage,
+			Validators:          attr.Validators,
+			PlanModifiers:       attr.PlanModifiers,
+		}, true
+	default:
+		return nil, false
+	}
+}
+
+// copyAttributes returns a shallow copy of attributes, so converting nested attributes to blocks does not
+// alter schemas shared with other callers.
+func copyAttributes(attributes map[string]schema.Attribute) map[string]schema.Attribute {
+	copied := make(map[string]schema.Attribute, len(attributes))
+	for name, attribute := range attributes {
+		copied[name] = attribute
+	}
+	return copied
+}
+
+// EmptyNullBlocks replaces null top-level list and set block values of a state object with empty
+// collections. Terraform represents absent repeated blocks as empty collections, never as null, so
+// a null value converted from a missing API field would otherwise be reported as an inconsistent result.
+func EmptyNullBlocks(ctx context.Context, object types.Object, blocks map[string]schema.Block) (types.Object, diag.Diagnostics) {
+	if len(blocks) == 0 || object.IsNull() || object.IsUnknown() {
+		return object, nil
+	}
+	attributes := make(map[string]attr.Value, len(object.Attributes()))
+	for name, value := range object.Attributes() {
+		attributes[name] = value
+	}
+	for name, block := range blocks {
+		value, ok := attributes[name]
+		if !ok || !value.IsNull() {
+			continue
+		}
+		switch b := block.(type) {
+		case schema.ListNestedBlock:
+			attributes[name] = types.ListValueMust(b.NestedObject.Type(), []attr.Value{})
+		case schema.SetNestedBlock:
+			attributes[name] = types.SetValueMust(b.NestedObject.Type(), []attr.Value{})
+		}
+	}
+	return types.ObjectValue(object.AttributeTypes(ctx), attributes)
+}
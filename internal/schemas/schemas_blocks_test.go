@@ -0,0 +1,101 @@
+// Copyright CyberArk 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type blocksTestCondition struct {
+	Key   string `mapstructure:"key"`
+	Value string `mapstructure:"value"`
+}
+
+type blocksTestRule struct {
+	Name       string                `mapstructure:"name"`
+	Conditions []blocksTestCondition `mapstructure:"conditions" validate:"required"`
+}
+
+type blocksTestModel struct {
+	Name     string            `mapstructure:"name" validate:"required"`
+	Rules    []blocksTestRule  `mapstructure:"rules" validate:"required"`
+	Settings *blocksTestRule   `mapstructure:"settings"`
+	Labels   map[string]string `mapstructure:"labels"`
+}
+
+func TestGenerateResourceSchemaFromStruct_BlockAttributes(t *testing.T) {
+	t.Parallel()
+
+	s := GenerateResourceSchemaFromStruct(&blocksTestModel{}, nil, nil, ResourceSchemaOptions{BlockAttrs: []string{"rules", "rules.conditions", "settings", "labels", "name"}})
+
+	if _, ok := s.Attributes["rules"]; ok {
+		t.Errorf("expected rules to be removed from attributes")
+	}
+	rules, ok := s.Blocks["rules"].(schema.ListNestedBlock)
+	if !ok {
+		t.Fatalf("expected rules to be a list nested block, got %#v", s.Blocks["rules"])
+	}
+	if len(rules.Validators) == 0 {
+		t.Errorf("expected required rules block to have a minimum size validator")
+	}
+	if _, ok := rules.NestedObject.Blocks["conditions"].(schema.ListNestedBlock); !ok {
+		t.Errorf("expected rules.conditions to be a nested list block, got %#v", rules.NestedObject.Blocks["conditions"])
+	}
+	if _, ok := rules.NestedObject.Attributes["conditions"]; ok {
+		t.Errorf("expected rules.conditions to be removed from the nested attributes")
+	}
+	// Optional attributes are computed, and blocks cannot be
+	if _, ok := s.Blocks["settings"]; ok {
+		t.Errorf("expected the optional computed settings not to be converted to a block")
+	}
+	if _, ok := s.Attributes["settings"]; !ok {
+		t.Errorf("expected settings to stay an attribute")
+	}
+	// Non-nested attributes are never converted
+	if _, ok := s.Attributes["labels"]; !ok {
+		t.Errorf("expected labels to stay an attribute")
+	}
+	if _, ok := s.Attributes["name"]; !ok {
+		t.Errorf("expected name to stay an attribute")
+	}
+
+	attrTypes := ResourceSchemaToSchemaAttrTypes(s)
+	if _, ok := attrTypes["rules"].(types.ListType); !ok {
+		t.Errorf("expected attribute types to include the rules block, got %#v", attrTypes["rules"])
+	}
+}
+
+func TestEmptyNullBlocks(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	s := GenerateResourceSchemaFromStruct(&blocksTestModel{}, nil, nil, ResourceSchemaOptions{BlockAttrs: []string{"rules"}})
+	attrTypes := ResourceSchemaToSchemaAttrTypes(s)
+	values := map[string]attr.Value{}
+	for name, attrType := range attrTypes {
+		nullVal, err := getNullValue(attrType)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		values[name] = nullVal
+	}
+	object := types.ObjectValueMust(attrTypes, values)
+
+	result, diags := EmptyNullBlocks(ctx, object, s.Blocks)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	rules, ok := result.Attributes()["rules"].(types.List)
+	if !ok || rules.IsNull() || len(rules.Elements()) != 0 {
+		t.Errorf("expected rules to be an empty list, got %#v", result.Attributes()["rules"])
+	}
+	if !result.Attributes()["name"].IsNull() {
+		t.Errorf("expected name to stay null")
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// CacheTTLAttributeName is the name of the data source argument reusing the result of a previous read
+// for a while instead of calling the API on every refresh.
+const CacheTTLAttributeName = "cache_ttl"
+
+// CacheTTLAttribute returns the cache_ttl argument added to data sources without sensitive attributes.
+func CacheTTLAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "Duration to reuse the result of a previous read with the same arguments for, e.g. 15m, instead " +
+			"of calling the API on every refresh. Results are stored unencrypted under ~/.idsec/cache/data_sources, " +
+			"so later runs on the same machine reuse them. Defaults to no caching",
+		MarkdownDescription: "Duration to reuse the result of a previous read with the same arguments for, e.g. `15m`, instead " +
+			"of calling the API on every refresh. Results are stored unencrypted under `~/.idsec/cache/data_sources`, " +
+			"so later runs on the same machine reuse them. Defaults to no caching",
+		Optional:   true,
+		Validators: []validator.String{DurationValidator{}},
+	}
+}
+
+// HasSensitiveDataSourceAttributes reports whether any attribute of a data source schema, nested ones
+// included, is sensitive.
+func HasSensitiveDataSourceAttributes(attributes map[string]schema.Attribute) bool {
+	for _, attribute := range attributes {
+		if attribute.IsSensitive() {
+			return true
+		}
+		var nested map[string]schema.Attribute
+		switch typed := attribute.(type) {
+		case schema.SingleNestedAttribute:
+			nested = typed.Attributes
+		case schema.ListNestedAttribute:
+			nested = typed.NestedObject.Attributes
+		case schema.SetNestedAttribute:
+			nested = typed.NestedObject.Attributes
+		case schema.MapNestedAttribute:
+			nested = typed.NestedObject.Attributes
+		}
+		if HasSensitiveDataSourceAttributes(nested) {
+			return true
+		}
+	}
+	return false
+}
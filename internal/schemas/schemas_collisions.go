@@ -0,0 +1,85 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// AttributeNameCollision describes struct fields of one SDK model level mapping to the same snake_case
+// attribute name, e.g. `ID` and `Id`. Only one of them can be represented in the generated schema.
+type AttributeNameCollision struct {
+	// Path is the dotted attribute path of the colliding attribute, e.g. "rules.id".
+	Path string
+	// Type is the Go struct type declaring the fields.
+	Type string
+	// Fields are the Go field names mapping to the attribute.
+	Fields []string
+}
+
+// String renders the collision for diagnostics.
+func (c AttributeNameCollision) String() string {
+	return fmt.Sprintf("attribute %q is generated from fields %s of %s", c.Path, strings.Join(c.Fields, ", "), c.Type)
+}
+
+// FindAttributeNameCollisions walks the models and their nested structs and returns the attributes
+// generated from more than one struct field. Each model is checked on its own, as the same attribute
+// declared by the create, update and state models is merged on purpose.
+func FindAttributeNameCollisions(models ...interface{}) []AttributeNameCollision {
+	var collisions []AttributeNameCollision
+	reported := map[string]bool{}
+	for _, model := range models {
+		if model == nil {
+			continue
+		}
+		for _, collision := range findTypeCollisions(reflect.TypeOf(model), "", map[reflect.Type]bool{}) {
+			key := collision.String()
+			if reported[key] {
+				continue
+			}
+			reported[key] = true
+			collisions = append(collisions, collision)
+		}
+	}
+	return collisions
+}
+
+// findTypeCollisions returns the collisions of a struct type and its nested structs, with attribute
+// paths prefixed by prefix. visiting guards against recursive types.
+func findTypeCollisions(modelType reflect.Type, prefix string, visiting map[reflect.Type]bool) []AttributeNameCollision {
+	for modelType.Kind() == reflect.Pointer || modelType.Kind() == reflect.Slice || modelType.Kind() == reflect.Array || modelType.Kind() == reflect.Map {
+		modelType = modelType.Elem()
+	}
+	if modelType.Kind() != reflect.Struct || visiting[modelType] {
+		return nil
+	}
+	visiting[modelType] = true
+	defer delete(visiting, modelType)
+
+	var collisions []AttributeNameCollision
+	fieldsByName := map[string][]string{}
+	var names []string
+	for _, field := range resolveFieldsSquashed(modelType) {
+		name := resolveFieldName(field)
+		if _, exists := fieldsByName[name]; !exists {
+			names = append(names, name)
+		}
+		// A field redeclared over a squashed embedded struct shadows it rather than colliding with it
+		if !slices.Contains(fieldsByName[name], field.Name) {
+			fieldsByName[name] = append(fieldsByName[name], field.Name)
+		}
+	}
+	for _, name := range names {
+		if len(fieldsByName[name]) > 1 {
+			collisions = append(collisions, AttributeNameCollision{Path: prefix + name, Type: modelType.Name(), Fields: fieldsByName[name]})
+		}
+	}
+	for _, field := range resolveFieldsSquashed(modelType) {
+		collisions = append(collisions, findTypeCollisions(field.Type, prefix+resolveFieldName(field)+".", visiting)...)
+	}
+	return collisions
+}
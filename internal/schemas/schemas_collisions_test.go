@@ -0,0 +1,76 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"reflect"
+	"testing"
+)
+
+type testCollidingNested struct {
+	URL string
+	Url string
+}
+
+type testCollidingModel struct {
+	ID     string
+	Id     string
+	Name   string                `mapstructure:"name"`
+	Rules  []testCollidingNested `mapstructure:"rules"`
+	Parent *testCollidingModel   `mapstructure:"parent"`
+}
+
+type testShadowedBase struct {
+	Name string `mapstructure:"name"`
+}
+
+type testShadowingModel struct {
+	testShadowedBase `mapstructure:",squash"`
+	Name             string `mapstructure:"name"`
+}
+
+func TestFindAttributeNameCollisions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		models   []interface{}
+		expected []AttributeNameCollision
+	}{
+		{
+			name:   "success_top_level_and_nested_collisions",
+			models: []interface{}{&testCollidingModel{}},
+			expected: []AttributeNameCollision{
+				{Path: "id", Type: "testCollidingModel", Fields: []string{"ID", "Id"}},
+				{Path: "rules.url", Type: "testCollidingNested", Fields: []string{"URL", "Url"}},
+			},
+		},
+		{
+			name:   "success_collision_reported_once_across_models",
+			models: []interface{}{testCollidingNested{}, &testCollidingNested{}},
+			expected: []AttributeNameCollision{
+				{Path: "url", Type: "testCollidingNested", Fields: []string{"URL", "Url"}},
+			},
+		},
+		{
+			name:   "success_squashed_field_shadowed",
+			models: []interface{}{&testShadowingModel{}},
+		},
+		{
+			name:   "success_nil_model",
+			models: []interface{}{nil},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			result := FindAttributeNameCollisions(tt.models...)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
@@ -5,11 +5,15 @@ package schemas
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"math"
+	"math/big"
 	"reflect"
 	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -39,6 +43,7 @@ func resolveFieldsSquashed(schema reflect.Type) []reflect.StructField {
 		if field.PkgPath != "" { // unexported field
 			continue
 		}
+		field, _ = flattenedField(field)
 		fields = append(fields, field)
 	}
 	return fields
@@ -63,12 +68,23 @@ func resolveFieldsValueSquashed(value reflect.Value) []reflect.Value {
 		if fieldType.PkgPath != "" { // unexported field
 			continue
 		}
+		if flattened, ok := flattenedField(fieldType); ok {
+			field = flattenedFieldValue(field, flattened)
+		}
 		fields = append(fields, field)
 	}
 	return fields
 }
 
+// TerraformNameTag is the model field tag overriding the attribute name generated for a field, for API
+// fields whose names do not translate to idiomatic Terraform names, e.g. `tfname:"cpm_disabled"` on an
+// `isCPMDisabled` field. The field keeps its SDK name for decoding and serialization.
+const TerraformNameTag = "tfname"
+
 func resolveFieldName(field reflect.StructField) string {
+	if tfName := field.Tag.Get(TerraformNameTag); tfName != "" {
+		return tfName
+	}
 	fieldName := strings.Split(field.Tag.Get("mapstructure"), ",")[0]
 	if fieldName != "" {
 		return strcase.ToSnake(fieldName)
@@ -84,6 +100,61 @@ func resolveFieldName(field reflect.StructField) string {
 	return strcase.ToSnake(field.Name)
 }
 
+// decodeFieldName returns the key of field in the maps decoded into models by mapstructure. It is the
+// resolved attribute name, except for fields renamed by a tfname tag, which are decoded from the name
+// mapstructure knows them by.
+func decodeFieldName(field reflect.StructField) string {
+	if field.Tag.Get(TerraformNameTag) == "" {
+		return resolveFieldName(field)
+	}
+	if name := strings.Split(field.Tag.Get("mapstructure"), ",")[0]; name != "" {
+		return name
+	}
+	return field.Name
+}
+
+// sendsZeroValue reports whether the zero value of a model field set in the configuration is sent to the
+// API, i.e. whether its json tag lacks omitempty. Such fields, e.g. `enabled` or `max_sessions`, are how
+// a feature is disabled, so an explicit false, 0 or "" must reach the payload. Fields without a json tag
+// are not serialized to the API as such and keep omitting zero values.
+func sendsZeroValue(field *reflect.StructField) bool {
+	if field == nil {
+		return false
+	}
+	jsonTag, ok := field.Tag.Lookup("json")
+	if !ok || jsonTag == "-" {
+		return false
+	}
+	return !slices.Contains(strings.Split(jsonTag, ",")[1:], "omitempty")
+}
+
+// applyExplicitZeroValues sets the fields of target whose zero value is set in the plan and sent to the
+// API, which the merge of plan and state would otherwise replace with their prior state values.
+func applyExplicitZeroValues(planDataMap map[string]interface{}, planValue reflect.Value, target reflect.Value) {
+	planFields := resolveFieldsSquashed(planValue.Type())
+	planFieldValues := resolveFieldsValueSquashed(planValue)
+	for i := range planFields {
+		field := planFields[i]
+		if planDataMap[decodeFieldName(field)] == nil || !sendsZeroValue(&field) {
+			continue
+		}
+		planFieldValue := planFieldValues[i]
+		if !planFieldValue.IsValid() || planFieldValue.Kind() == reflect.Pointer || !planFieldValue.IsZero() {
+			continue
+		}
+		if targetField := settableFlattenedField(target, field, false); targetField.IsValid() && targetField.CanSet() && targetField.Type() == planFieldValue.Type() {
+			targetField.Set(planFieldValue)
+		}
+	}
+}
+
+// hasSensitiveTag reports whether an SDK model field is tagged `sensitive:"true"`, marking it sensitive in
+// the generated schemas in addition to the SensitiveAttributes of the action definition.
+func hasSensitiveTag(field reflect.StructField) bool {
+	sensitive, err := strconv.ParseBool(field.Tag.Get("sensitive"))
+	return err == nil && sensitive
+}
+
 func isType[T any](t attr.Type) bool {
 	_, ok := t.(T)
 	return ok
@@ -146,12 +217,30 @@ func getNullValue(t attr.Type) (attr.Value, error) {
 	}
 }
 
+// errUnknownElement reports a collection element whose value is not known yet, e.g. an element referencing
+// an attribute of a resource that is not created yet.
+var errUnknownElement = errors.New("element value is unknown until apply and cannot be sent to the API")
+
+// objectToMap converts a Terraform object to a map decoded into prototype by mapstructure. Null attributes
+// map to nil and known values of pointer fields are kept as pointers even when zero, so optional *bool
+// fields are tri-state: null leaves the setting to the API, false turns it off and true turns it on.
+// Unknown attributes, such as computed nested objects while creating, are left out, so a partially known
+// object maps to a map of its known attributes. An unknown object or collection element has no value to
+// leave out of a map and is an error.
 func objectToMap(obj types.Object, prototype interface{}) (map[string]interface{}, error) {
-	if obj.IsNull() || obj.IsUnknown() {
-		return nil, fmt.Errorf("object is null or unknown")
+	if obj.IsNull() {
+		return nil, fmt.Errorf("object is null")
+	}
+	if obj.IsUnknown() {
+		return nil, fmt.Errorf("object is unknown")
 	}
 	result := make(map[string]interface{})
-	for key, val := range obj.Attributes() {
+	for attrName, val := range obj.Attributes() {
+		key := attrName
+		actualField := findFieldByName(prototype, attrName)
+		if actualField != nil && actualField.Tag.Get(TerraformNameTag) != "" {
+			key = decodeFieldName(*actualField)
+		}
 		if val.IsNull() {
 			result[key] = nil
 			continue
@@ -159,14 +248,25 @@ func objectToMap(obj types.Object, prototype interface{}) (map[string]interface{
 		if val.IsUnknown() {
 			continue
 		}
-		goVal, err := attrToInterface(key, val, prototype)
+		if obj, ok := val.(types.Object); ok && actualField != nil {
+			if union, ok := fieldUnion(*actualField); ok {
+				variant, err := unionFromObject(union, obj)
+				if err != nil {
+					return nil, withAttributePath(attrName, err)
+				}
+				if variant != nil {
+					result[key] = variant
+				}
+				continue
+			}
+		}
+		goVal, err := attrToInterface(attrName, val, prototype)
 		if err != nil {
-			return nil, fmt.Errorf("error converting attribute %q: %w", key, err)
+			return nil, withAttributePath(attrName, err)
 		}
 		if goVal == nil {
 			continue
 		}
-		actualField := findFieldByName(prototype, key)
 		if actualField != nil && actualField.Type.Kind() == reflect.Pointer {
 			goValReflect := reflect.ValueOf(goVal)
 			if goValReflect.Kind() != reflect.Pointer {
@@ -183,12 +283,17 @@ func objectToMap(obj types.Object, prototype interface{}) (map[string]interface{
 					result[key] = goValReflect.Elem().Interface()
 				}
 			} else {
-				if goValReflect.Kind() == reflect.Bool || !goValReflect.IsZero() {
+				if goValReflect.Kind() == reflect.Bool || !goValReflect.IsZero() || sendsZeroValue(actualField) {
 					result[key] = goVal
 				}
 			}
 		}
 	}
+	for key, field := range flattenedFieldsByKey(prototype) {
+		if value, ok := result[key]; ok && value != nil {
+			result[key] = wrapFlattenedValue(field, value)
+		}
+	}
 	return result, nil
 }
 
@@ -200,7 +305,8 @@ func findFieldByName(schema interface{}, name string) *reflect.StructField {
 	if schemaType == nil {
 		return nil
 	}
-	if schemaType.Kind() == reflect.Pointer {
+	// Prototypes of optional nested objects are pointers to pointer fields, e.g. **Conditions
+	for schemaType.Kind() == reflect.Pointer {
 		schemaType = schemaType.Elem()
 	}
 	if schemaType.Kind() != reflect.Struct {
@@ -212,6 +318,13 @@ func findFieldByName(schema interface{}, name string) *reflect.StructField {
 		if field.PkgPath != "" && !field.Anonymous {
 			continue
 		}
+		if tfName := field.Tag.Get(TerraformNameTag); tfName != "" {
+			if tfName == name {
+				field, _ = flattenedField(field)
+				return &field
+			}
+			continue
+		}
 		flagName := field.Tag.Get("mapstructure")
 		if flagName == "" {
 			flagName = field.Tag.Get("json")
@@ -220,6 +333,7 @@ func findFieldByName(schema interface{}, name string) *reflect.StructField {
 			flagName = field.Name
 		}
 		if strings.Split(flagName, ",")[0] == name {
+			field, _ = flattenedField(field)
 			return &field
 		}
 		if field.Tag.Get("mapstructure") == ",squash" {
@@ -230,6 +344,24 @@ func findFieldByName(schema interface{}, name string) *reflect.StructField {
 	return nil
 }
 
+// isByteSlicePrototype reports whether a value converted by attrToInterface targets a byte slice, either
+// through the struct field it is decoded into or, for list and map elements, through the prototype itself.
+func isByteSlicePrototype(actualField *reflect.StructField, prototype interface{}) bool {
+	var targetType reflect.Type
+	if actualField != nil {
+		targetType = actualField.Type
+	} else if prototype != nil {
+		targetType = reflect.TypeOf(prototype)
+	}
+	if targetType == nil {
+		return false
+	}
+	for targetType.Kind() == reflect.Pointer {
+		targetType = targetType.Elem()
+	}
+	return isByteSliceType(targetType)
+}
+
 func attrToInterface(key string, val attr.Value, prototype interface{}) (interface{}, error) {
 	if val.IsNull() || val.IsUnknown() {
 		return nil, nil
@@ -237,8 +369,18 @@ func attrToInterface(key string, val attr.Value, prototype interface{}) (interfa
 	actualField := findFieldByName(prototype, key)
 	switch v := val.(type) {
 	case types.String:
+		if isByteSlicePrototype(actualField, prototype) {
+			decoded, err := base64.StdEncoding.DecodeString(v.ValueString())
+			if err != nil {
+				return nil, fmt.Errorf("value is not valid base64: %w", err)
+			}
+			return decoded, nil
+		}
 		return v.ValueString(), nil
 	case types.Number:
+		if actualField != nil && (actualField.Type == jsonNumberType || actualField.Type == reflect.PointerTo(jsonNumberType)) {
+			return json.Number(v.ValueBigFloat().Text('g', -1)), nil
+		}
 		value, _ := v.ValueBigFloat().Float64()
 		return value, nil
 	case types.Int32:
@@ -280,12 +422,32 @@ func attrToInterface(key string, val attr.Value, prototype interface{}) (interfa
 			elemPrototype = reflect.New(actualField.Type.Elem()).Interface()
 		}
 		for k, elem := range attrMap {
+			if elem.IsUnknown() {
+				return nil, withAttributePath(keyPathSegment(k), errUnknownElement)
+			}
 			converted, err := attrToInterface(k, elem, elemPrototype)
 			if err != nil {
-				return nil, err
+				return nil, withAttributePath(keyPathSegment(k), err)
 			}
 			m[k] = converted
 		}
+		if actualField != nil && actualField.Type.Kind() == reflect.Map && actualField.Type.Key().Kind() != reflect.String {
+			// Terraform map keys are strings; parse them back into the key type of the SDK model
+			keyType := actualField.Type.Key()
+			typed := reflect.MakeMapWithSize(reflect.MapOf(keyType, reflect.TypeOf((*interface{})(nil)).Elem()), len(m))
+			for k, converted := range m {
+				typedKey, err := stringToMapKey(k, keyType)
+				if err != nil {
+					return nil, withAttributePath(keyPathSegment(k), err)
+				}
+				elemValue := reflect.Zero(typed.Type().Elem())
+				if converted != nil {
+					elemValue = reflect.ValueOf(converted)
+				}
+				typed.SetMapIndex(typedKey, elemValue)
+			}
+			return typed.Interface(), nil
+		}
 		return m, nil
 	case types.List, types.Set, types.Tuple:
 		var elems []attr.Value
@@ -313,9 +475,13 @@ func attrToInterface(key string, val attr.Value, prototype interface{}) (interfa
 			}
 		}
 		for i, elem := range elems {
+			// Leaving unknown elements out would shift the others, and decoding them would send zero values
+			if elem.IsUnknown() {
+				return nil, withAttributePath(indexPathSegment(i), errUnknownElement)
+			}
 			converted, err := attrToInterface("", elem, elemPrototype)
 			if err != nil {
-				return nil, err
+				return nil, withAttributePath(indexPathSegment(i), err)
 			}
 			list[i] = converted
 		}
@@ -325,13 +491,26 @@ func attrToInterface(key string, val attr.Value, prototype interface{}) (interfa
 	}
 }
 
+// reflectTypeToTerraformType returns the Terraform type of a Go type. Terraform types cannot be recursive,
+// so a struct referencing itself, e.g. through a parent or children field, is an error.
 func reflectTypeToTerraformType(t reflect.Type) (attr.Type, error) {
+	return reflectTypeToTerraformTypeVisiting(t, map[reflect.Type]bool{})
+}
+
+// reflectTypeToTerraformTypeVisiting converts t, visiting holding the structs being converted around it.
+func reflectTypeToTerraformTypeVisiting(t reflect.Type, visiting map[reflect.Type]bool) (attr.Type, error) {
 	if t == nil {
 		return types.StringType, nil
 	}
 	for t.Kind() == reflect.Pointer {
 		t = t.Elem()
 	}
+	if t == jsonNumberType {
+		return types.NumberType, nil
+	}
+	if isByteSliceType(t) {
+		return types.StringType, nil
+	}
 	switch t.Kind() {
 	case reflect.String:
 		return types.StringType, nil
@@ -339,22 +518,29 @@ func reflectTypeToTerraformType(t reflect.Type) (attr.Type, error) {
 		return types.BoolType, nil
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 		return types.Int64Type, nil
+	case reflect.Float32, reflect.Float64:
+		return types.Float64Type, nil
 	case reflect.Slice, reflect.Array:
-		elemType, err := reflectTypeToTerraformType(t.Elem())
+		elemType, err := reflectTypeToTerraformTypeVisiting(t.Elem(), visiting)
 		if err != nil {
 			return nil, err
 		}
 		return types.ListType{ElemType: elemType}, nil
 	case reflect.Map:
-		if t.Key().Kind() != reflect.String {
-			return nil, fmt.Errorf("map key type must be string")
+		if !isSupportedMapKeyKind(t.Key().Kind()) {
+			return nil, fmt.Errorf("map key type must be a string or an integer")
 		}
-		elemType, err := reflectTypeToTerraformType(t.Elem())
+		elemType, err := reflectTypeToTerraformTypeVisiting(t.Elem(), visiting)
 		if err != nil {
 			return nil, err
 		}
 		return types.MapType{ElemType: elemType}, nil
 	case reflect.Struct:
+		if visiting[t] {
+			return nil, fmt.Errorf("type %s references itself, which Terraform types cannot represent", t)
+		}
+		visiting[t] = true
+		defer delete(visiting, t)
 		attrTypes := map[string]attr.Type{}
 		actualFields := resolveFieldsSquashed(t)
 		for i := range actualFields {
@@ -362,7 +548,7 @@ func reflectTypeToTerraformType(t reflect.Type) (attr.Type, error) {
 			if field.PkgPath != "" {
 				continue
 			}
-			fieldType, err := reflectTypeToTerraformType(field.Type)
+			fieldType, err := reflectTypeToTerraformTypeVisiting(field.Type, visiting)
 			if err != nil {
 				return nil, err
 			}
@@ -415,6 +601,10 @@ func convertGoValueToAttr(ctx context.Context, val interface{}) (attr.Value, err
 		v = v.Elem()
 	}
 
+	if isByteSliceType(v.Type()) {
+		return types.StringValue(base64.StdEncoding.EncodeToString(v.Bytes())), nil
+	}
+
 	switch v.Kind() {
 	case reflect.String:
 		return types.StringValue(v.String()), nil
@@ -436,18 +626,22 @@ func convertGoValueToAttr(ctx context.Context, val interface{}) (attr.Value, err
 		}
 		return convertGoValueToAttr(ctx, v.Elem().Interface())
 	case reflect.Map:
-		if v.Type().Key().Kind() != reflect.String {
+		if !isSupportedMapKeyKind(v.Type().Key().Kind()) {
 			break
 		}
 		attrTypes := make(map[string]attr.Type, v.Len())
 		attrValues := make(map[string]attr.Value, v.Len())
 		for _, key := range v.MapKeys() {
+			keyString, err := mapKeyToString(key)
+			if err != nil {
+				return nil, err
+			}
 			elemAttr, err := convertGoValueToAttr(ctx, v.MapIndex(key).Interface())
 			if err != nil {
-				return nil, fmt.Errorf("map key %q: %w", key.String(), err)
+				return nil, withAttributePath(keyPathSegment(keyString), err)
 			}
-			attrTypes[key.String()] = elemAttr.Type(ctx)
-			attrValues[key.String()] = elemAttr
+			attrTypes[keyString] = elemAttr.Type(ctx)
+			attrValues[keyString] = elemAttr
 		}
 		objVal, diag := types.ObjectValue(attrTypes, attrValues)
 		if diag.HasError() {
@@ -467,7 +661,7 @@ func convertGoValueToAttr(ctx context.Context, val interface{}) (attr.Value, err
 		for i := 0; i < v.Len(); i++ {
 			elemAttr, err := convertGoValueToAttr(ctx, v.Index(i).Interface())
 			if err != nil {
-				return nil, fmt.Errorf("slice index %d: %w", i, err)
+				return nil, withAttributePath(indexPathSegment(i), err)
 			}
 			elemTypes[i] = elemAttr.Type(ctx)
 			elems[i] = elemAttr
@@ -477,6 +671,29 @@ func convertGoValueToAttr(ctx context.Context, val interface{}) (attr.Value, err
 			return nil, fmt.Errorf("failed to build tuple: %v", diag)
 		}
 		return tupleVal, nil
+	case reflect.Struct:
+		// Structs below the MaxDepthTag bound of a recursive field become objects named like their attributes
+		actualFields := resolveFieldsSquashed(v.Type())
+		actualFieldValues := resolveFieldsValueSquashed(v)
+		attrTypes := make(map[string]attr.Type, len(actualFields))
+		attrValues := make(map[string]attr.Value, len(actualFields))
+		for i := range actualFields {
+			if !actualFieldValues[i].IsValid() || !actualFieldValues[i].CanInterface() {
+				continue
+			}
+			fieldName := resolveFieldName(actualFields[i])
+			fieldAttr, err := convertGoValueToAttr(ctx, actualFieldValues[i].Interface())
+			if err != nil {
+				return nil, withAttributePath(fieldName, err)
+			}
+			attrTypes[fieldName] = fieldAttr.Type(ctx)
+			attrValues[fieldName] = fieldAttr
+		}
+		objVal, diag := types.ObjectValue(attrTypes, attrValues)
+		if diag.HasError() {
+			return nil, fmt.Errorf("failed to build object from struct: %v", diag)
+		}
+		return objVal, nil
 	}
 
 	// Fallback: represent unknown types as their string form.
@@ -493,6 +710,12 @@ func interfaceTypeToAttr(ctx context.Context, val interface{}, t attr.Type) (att
 	}
 	switch {
 	case t.Equal(types.StringType):
+		if valReflect.IsValid() && isByteSliceType(valReflect.Type()) {
+			if valReflect.IsNil() {
+				return types.StringNull(), nil
+			}
+			return types.StringValue(base64.StdEncoding.EncodeToString(valReflect.Bytes())), nil
+		}
 		return types.StringValue(fmt.Sprintf("%v", valReflect.String())), nil
 	case t.Equal(types.Int64Type):
 		switch valReflect.Kind() {
@@ -509,6 +732,33 @@ func interfaceTypeToAttr(ctx context.Context, val interface{}, t attr.Type) (att
 		}
 	case t.Equal(types.BoolType):
 		return types.BoolValue(valReflect.Bool()), nil
+	case t.Equal(types.Float64Type):
+		switch valReflect.Kind() {
+		case reflect.Float32, reflect.Float64:
+			return types.Float64Value(valReflect.Float()), nil
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return types.Float64Value(float64(valReflect.Int())), nil
+		default:
+			return nil, fmt.Errorf("unsupported kind %v for Float64Type", valReflect.Kind())
+		}
+	case t.Equal(types.NumberType):
+		switch valReflect.Kind() {
+		case reflect.String:
+			if valReflect.String() == "" {
+				return types.NumberNull(), nil
+			}
+			numberValue, _, err := big.ParseFloat(valReflect.String(), 10, 512, big.ToNearestEven)
+			if err != nil {
+				return nil, fmt.Errorf("invalid number %q: %w", valReflect.String(), err)
+			}
+			return types.NumberValue(numberValue), nil
+		case reflect.Float32, reflect.Float64:
+			return types.NumberValue(big.NewFloat(valReflect.Float())), nil
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return types.NumberValue(new(big.Float).SetInt64(valReflect.Int())), nil
+		default:
+			return nil, fmt.Errorf("unsupported kind %v for NumberType", valReflect.Kind())
+		}
 	case isType[types.ObjectType](t):
 		typed, err := asType[types.ObjectType](t)
 		if err != nil {
@@ -528,9 +778,9 @@ func interfaceTypeToAttr(ctx context.Context, val interface{}, t attr.Type) (att
 			}
 			tagName := resolveFieldName(actualFields[i])
 			if attrType, ok := attrs[tagName]; ok {
-				attrVal, err := interfaceTypeToAttr(ctx, field.Interface(), attrType)
+				attrVal, err := fieldToAttr(ctx, actualFields[i], field.Interface(), attrType)
 				if err != nil {
-					return nil, fmt.Errorf("field '%s': %w", tagName, err)
+					return nil, withAttributePath(tagName, err)
 				}
 				values[tagName] = attrVal
 			} else {
@@ -551,7 +801,7 @@ func interfaceTypeToAttr(ctx context.Context, val interface{}, t attr.Type) (att
 		for i := 0; i < valReflect.Len(); i++ {
 			elemAttr, err := interfaceTypeToAttr(ctx, valReflect.Index(i).Interface(), typed.ElemType)
 			if err != nil {
-				return nil, err
+				return nil, withAttributePath(indexPathSegment(i), err)
 			}
 			elems = append(elems, elemAttr)
 		}
@@ -569,7 +819,7 @@ func interfaceTypeToAttr(ctx context.Context, val interface{}, t attr.Type) (att
 		for i := 0; i < valReflect.Len(); i++ {
 			elemAttr, err := interfaceTypeToAttr(ctx, valReflect.Index(i).Interface(), typed.ElemType)
 			if err != nil {
-				return nil, err
+				return nil, withAttributePath(indexPathSegment(i), err)
 			}
 			elems = append(elems, elemAttr)
 		}
@@ -587,7 +837,7 @@ func interfaceTypeToAttr(ctx context.Context, val interface{}, t attr.Type) (att
 		for i := 0; i < valReflect.Len(); i++ {
 			elemAttr, err := interfaceTypeToAttr(ctx, valReflect.Index(i).Interface(), typed.ElemTypes[i])
 			if err != nil {
-				return nil, err
+				return nil, withAttributePath(indexPathSegment(i), err)
 			}
 			elems = append(elems, elemAttr)
 		}
@@ -603,11 +853,15 @@ func interfaceTypeToAttr(ctx context.Context, val interface{}, t attr.Type) (att
 		}
 		result := make(map[string]attr.Value)
 		for _, key := range valReflect.MapKeys() {
-			elemAttr, err := interfaceTypeToAttr(ctx, valReflect.MapIndex(key).Interface(), typed.ElemType)
+			keyString, err := mapKeyToString(key)
 			if err != nil {
 				return nil, err
 			}
-			result[key.String()] = elemAttr
+			elemAttr, err := interfaceTypeToAttr(ctx, valReflect.MapIndex(key).Interface(), typed.ElemType)
+			if err != nil {
+				return nil, withAttributePath(keyPathSegment(keyString), err)
+			}
+			result[keyString] = elemAttr
 		}
 		mapVal, diag := types.MapValue(typed.ElemType, result)
 		if diag.HasError() {
@@ -768,7 +1022,7 @@ func StructFromPlanObject(ctx context.Context, plan *tfsdk.Plan, prototype inter
 	}
 	dataMap, err := objectToMap(planObj, prototype)
 	if err != nil {
-		return nil, fmt.Errorf("failed to convert plan object to map: %v", err)
+		return nil, fmt.Errorf("failed to convert plan object to map: %w", err)
 	}
 	protoType := reflect.TypeOf(prototype)
 	if protoType.Kind() == reflect.Pointer {
@@ -791,7 +1045,7 @@ func StructFromStateObject(ctx context.Context, state *tfsdk.State, prototype in
 	}
 	dataMap, err := objectToMap(stateObj, prototype)
 	if err != nil {
-		return nil, fmt.Errorf("failed to convert plan object to map: %v - %v", diags, err)
+		return nil, fmt.Errorf("failed to convert plan object to map: %v - %w", diags, err)
 	}
 	protoType := reflect.TypeOf(prototype)
 	if protoType.Kind() == reflect.Pointer {
@@ -814,7 +1068,7 @@ func StructFromConfigObject(ctx context.Context, config *tfsdk.Config, prototype
 	}
 	dataMap, err := objectToMap(stateObj, prototype)
 	if err != nil {
-		return nil, fmt.Errorf("failed to convert plan object to map: %v - %v", diags, err)
+		return nil, fmt.Errorf("failed to convert plan object to map: %v - %w", diags, err)
 	}
 	protoType := reflect.TypeOf(prototype)
 	newStruct := reflect.New(protoType).Interface()
@@ -839,11 +1093,11 @@ func StructFromPlanAndStateObject(ctx context.Context, plan *tfsdk.Plan, state *
 	}
 	stateDataMap, err := objectToMap(stateObj, statePrototype)
 	if err != nil {
-		return nil, fmt.Errorf("failed to convert state object to map: %v - %v", diags, err)
+		return nil, fmt.Errorf("failed to convert state object to map: %v - %w", diags, err)
 	}
 	planDataMap, err := objectToMap(planObj, planPrototype)
 	if err != nil {
-		return nil, fmt.Errorf("failed to convert plan object to map: %v - %v", diags, err)
+		return nil, fmt.Errorf("failed to convert plan object to map: %v - %w", diags, err)
 	}
 	planReflectedPrototype := reflect.TypeOf(planPrototype)
 	if planReflectedPrototype.Kind() == reflect.Pointer {
@@ -870,9 +1124,15 @@ func StructFromPlanAndStateObject(ctx context.Context, plan *tfsdk.Plan, state *
 	actualValueFields := resolveFieldsValueSquashed(stateValue)
 	for i := range actualFields {
 		field := actualFields[i]
-		if newField := planFinalizedStruct.FieldByName(field.Name); newField.IsValid() && newField.CanSet() {
+		if newField := settableFlattenedField(planFinalizedStruct, field, !actualValueFields[i].IsZero()); newField.IsValid() && newField.CanSet() {
 			if newField.Type().Kind() == reflect.Pointer && actualValueFields[i].Kind() != reflect.Pointer {
 				actualValueFields[i] = actualValueFields[i].Addr()
+			} else if newField.Type().Kind() != reflect.Pointer && actualValueFields[i].Kind() == reflect.Pointer {
+				// Flattened fields of a pointer to a struct are pointers to the field of the struct
+				if actualValueFields[i].IsNil() {
+					continue
+				}
+				actualValueFields[i] = actualValueFields[i].Elem()
 			}
 			newField.Set(actualValueFields[i])
 		}
@@ -882,10 +1142,11 @@ func StructFromPlanAndStateObject(ctx context.Context, plan *tfsdk.Plan, state *
 	actualPlanValueFields := resolveFieldsValueSquashed(planValue)
 	for i := 0; i < len(actualPlanFields); i++ {
 		field := actualPlanFields[i]
-		if newField := planFinalizedStruct.FieldByName(field.Name); newField.IsValid() && newField.CanSet() {
-			setTargetValueFromPlanAndState(actualPlanValueFields[i], stateValue.FieldByName(field.Name), newField)
+		if newField := settableFlattenedField(planFinalizedStruct, field, !actualPlanValueFields[i].IsZero()); newField.IsValid() && newField.CanSet() {
+			setTargetValueFromPlanAndState(actualPlanValueFields[i], settableFlattenedField(stateValue, field, false), newField)
 		}
 	}
+	applyExplicitZeroValues(planDataMap, planValue, planFinalizedStruct)
 	return planFinalizedStruct.Addr().Interface(), nil
 }
 
@@ -1016,6 +1277,36 @@ func clearComputedAttributes(structVal reflect.Value, computedAttrs []string, sk
 	}
 }
 
+// SetEmptyStringAttribute sets the top-level string (or *string) field of target whose resolved
+// snake_case name matches name, but only when the field is currently empty. Returns whether the
+// value was set, so a value supplied by the user is never overwritten.
+func SetEmptyStringAttribute(target interface{}, name string, value string) bool {
+	if target == nil || value == "" {
+		return false
+	}
+	field, found := findStructFieldByName(reflect.ValueOf(target), name)
+	if !found || !field.CanSet() {
+		return false
+	}
+	switch {
+	case field.Kind() == reflect.String:
+		if field.String() != "" {
+			return false
+		}
+		field.SetString(value)
+	case field.Kind() == reflect.Pointer && field.Type().Elem().Kind() == reflect.String:
+		if !field.IsNil() && field.Elem().String() != "" {
+			return false
+		}
+		strVal := reflect.New(field.Type().Elem())
+		strVal.Elem().SetString(value)
+		field.Set(strVal)
+	default:
+		return false
+	}
+	return true
+}
+
 // findStructFieldByName returns the settable field of structVal whose resolved snake_case name
 // matches name, transparently descending into squashed (embedded) structs. The second return value
 // reports whether a matching field was found.
@@ -1062,8 +1353,11 @@ func findStructFieldByName(structVal reflect.Value, name string) (reflect.Value,
 	return reflect.Value{}, false
 }
 
-// StructToStateObject converts a Go struct to a Terraform state object.
-func StructToStateObject(ctx context.Context, input interface{}, state *tfsdk.State, plan *tfsdk.Plan, schemaAttrs map[string]attr.Type) (types.Object, error) {
+// StructToStateObject converts a Go struct to a Terraform state object. The top-level string attributes
+// listed in emptyAsNull are stored as null when empty, unless planned, or in state without a plan, as "".
+// Slices tagged with UnorderedTag are converted sorted, and fields tagged with StateOnlyTag keep their
+// planned value, or their value in state without a plan.
+func StructToStateObject(ctx context.Context, input interface{}, state *tfsdk.State, plan *tfsdk.Plan, schemaAttrs map[string]attr.Type, emptyAsNull []string) (types.Object, error) {
 	var stateObj types.Object
 	var planObj types.Object
 	if state != nil {
@@ -1078,7 +1372,7 @@ func StructToStateObject(ctx context.Context, input interface{}, state *tfsdk.St
 			return types.Object{}, fmt.Errorf("object value getting error: %v", diags)
 		}
 	}
-	val := reflect.ValueOf(input)
+	val := reflect.ValueOf(sortUnordered(input))
 	if val.Kind() == reflect.Pointer {
 		val = val.Elem()
 	}
@@ -1095,13 +1389,19 @@ func StructToStateObject(ctx context.Context, input interface{}, state *tfsdk.St
 			tflog.Warn(ctx, fmt.Sprintf("Field '%s' not found in schema attributes", tagName))
 			continue
 		}
+		if isStateOnlyConfig(field) {
+			if kept, ok := stateOnlyValue(tagName, planObj, plan != nil, stateObj, state != nil); ok {
+				valueMap[tagName] = kept
+				continue
+			}
+		}
 		if !fieldVal.IsValid() || !fieldVal.CanInterface() {
 			valueMap[tagName], _ = getNullValue(schemaAttrs[tagName])
 			continue
 		}
-		attrVal, err := interfaceTypeToAttr(ctx, fieldVal.Interface(), attrType)
+		attrVal, err := fieldToAttr(ctx, field, fieldVal.Interface(), attrType)
 		if err != nil {
-			return types.Object{}, fmt.Errorf("field '%s': %w", tagName, err)
+			return types.Object{}, withAttributePath(tagName, err)
 		}
 		valueMap[tagName] = attrVal
 	}
@@ -1127,6 +1427,16 @@ func StructToStateObject(ctx context.Context, input interface{}, state *tfsdk.St
 			valueMap[attrName] = nullVal
 		}
 	}
+	switch {
+	case plan != nil:
+		keepEquivalentJSONStrings(valueMap, planObj.Attributes())
+		canonicalizeEmptyStrings(valueMap, planObj.Attributes(), emptyAsNull)
+	case state != nil:
+		keepEquivalentJSONStrings(valueMap, stateObj.Attributes())
+		canonicalizeEmptyStrings(valueMap, stateObj.Attributes(), emptyAsNull)
+	default:
+		canonicalizeEmptyStrings(valueMap, nil, emptyAsNull)
+	}
 	objVal, diag := types.ObjectValue(schemaAttrs, valueMap)
 	if diag.HasError() {
 		return types.Object{}, fmt.Errorf("object value creation error: %v", diag)
@@ -1134,6 +1444,74 @@ func StructToStateObject(ctx context.Context, input interface{}, state *tfsdk.St
 	return objVal, nil
 }
 
+// UnmappedAttributePaths returns the dotted paths of fields on the SDK response struct that
+// have no matching attribute in the schema, and are therefore dropped by StructToStateObject.
+// Nested objects, and lists, sets and maps of objects, are walked so fields added to nested
+// SDK models are reported too. Only the fields of the struct type are known, not the ones the
+// API returned. The result is sorted.
+func UnmappedAttributePaths(input interface{}, schemaAttrs map[string]attr.Type) []string {
+	if input == nil {
+		return nil
+	}
+	var paths []string
+	collectUnmappedAttributePaths(reflect.TypeOf(input), schemaAttrs, "", &paths)
+	slices.Sort(paths)
+	return paths
+}
+
+func collectUnmappedAttributePaths(typ reflect.Type, attrTypes map[string]attr.Type, prefix string, paths *[]string) {
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+	if typ.Kind() != reflect.Struct {
+		return
+	}
+	for _, field := range resolveFieldsSquashed(typ) {
+		fieldName := resolveFieldName(field)
+		fieldPath := fieldName
+		if prefix != "" {
+			fieldPath = prefix + "." + fieldName
+		}
+		attrType, ok := attrTypes[fieldName]
+		if !ok {
+			*paths = append(*paths, fieldPath)
+			continue
+		}
+		if nestedType, nestedAttrTypes := nestedObjectAttrTypes(field.Type, attrType); nestedAttrTypes != nil {
+			collectUnmappedAttributePaths(nestedType, nestedAttrTypes, fieldPath, paths)
+		}
+	}
+}
+
+// nestedObjectAttrTypes resolves the struct type and object attribute types behind a field
+// that maps to an object, or to a list, set or map of objects. Returns nil attribute types
+// when the field does not hold a nested object.
+func nestedObjectAttrTypes(fieldType reflect.Type, attrType attr.Type) (reflect.Type, map[string]attr.Type) {
+	for fieldType.Kind() == reflect.Pointer {
+		fieldType = fieldType.Elem()
+	}
+	switch fieldType.Kind() {
+	case reflect.Slice, reflect.Array, reflect.Map:
+		var elemType attr.Type
+		switch typed := attrType.(type) {
+		case types.ListType:
+			elemType = typed.ElemType
+		case types.SetType:
+			elemType = typed.ElemType
+		case types.MapType:
+			elemType = typed.ElemType
+		default:
+			return nil, nil
+		}
+		return nestedObjectAttrTypes(fieldType.Elem(), elemType)
+	case reflect.Struct:
+		if objType, ok := attrType.(types.ObjectType); ok {
+			return fieldType, objType.AttrTypes
+		}
+	}
+	return nil, nil
+}
+
 // mergePlanAndStateMap recursively merges plan attributes into existing state attributes.
 //
 // This function performs a deep merge of Terraform plan values into existing state values,
@@ -1540,8 +1918,9 @@ func containsUnknownValue(_ context.Context, val attr.Value) bool {
 	return false
 }
 
-// MergePlanToStateObject merges a Terraform plan object with a state object.
-func MergePlanToStateObject(ctx context.Context, plan *tfsdk.Plan, stateResult types.Object, schemaAttrs map[string]attr.Type) (types.Object, error) {
+// MergePlanToStateObject merges a Terraform plan object with a state object. The empty string attributes
+// listed in emptyAsNull follow the plan, as in StructToStateObject.
+func MergePlanToStateObject(ctx context.Context, plan *tfsdk.Plan, stateResult types.Object, schemaAttrs map[string]attr.Type, emptyAsNull []string) (types.Object, error) {
 	var planObj types.Object
 	diags := plan.Get(ctx, &planObj)
 	if diags.HasError() {
@@ -1564,6 +1943,7 @@ func MergePlanToStateObject(ctx context.Context, plan *tfsdk.Plan, stateResult t
 			mergedAttrsValues[key] = nullVal
 		}
 	}
+	canonicalizeEmptyStrings(mergedAttrsValues, planObj.Attributes(), emptyAsNull)
 	for key := range mergedAttrsValues {
 		if _, exists := schemaAttrs[key]; !exists {
 			delete(mergedAttrsValues, key)
@@ -1577,6 +1957,23 @@ func MergePlanToStateObject(ctx context.Context, plan *tfsdk.Plan, stateResult t
 	return objVal, nil
 }
 
+// canonicalizeEmptyStrings replaces the empty or null values of the string attributes named by names with
+// "" when their value in prior, the plan or prior state, is "", and with null otherwise, for APIs
+// returning "" for unset values or dropping empty ones.
+func canonicalizeEmptyStrings(values map[string]attr.Value, prior map[string]attr.Value, names []string) {
+	for _, name := range names {
+		value, ok := values[name].(types.String)
+		if !ok || value.IsUnknown() || (!value.IsNull() && value.ValueString() != "") {
+			continue
+		}
+		if priorValue, ok := prior[name].(types.String); ok && !priorValue.IsNull() && !priorValue.IsUnknown() && priorValue.ValueString() == "" {
+			values[name] = types.StringValue("")
+			continue
+		}
+		values[name] = types.StringNull()
+	}
+}
+
 // SchemaByPath retrieves a schema value by its path in a nested structure.
 func SchemaByPath(schema interface{}, path string) (interface{}, error) {
 	keys := strings.Split(path, ".")
@@ -1620,6 +2017,8 @@ func SchemaByPath(schema interface{}, path string) (interface{}, error) {
 	return current, nil
 }
 
+// DeepCopy returns a copy of v sharing no pointers, slices or maps with it. Values referencing themselves,
+// e.g. a child pointing back to its parent, are copied once, the copy referencing itself the same way.
 func DeepCopy(v interface{}) interface{} {
 	if v == nil {
 		return nil
@@ -1628,10 +2027,16 @@ func DeepCopy(v interface{}) interface{} {
 	if !val.IsValid() {
 		return nil
 	}
-	return deepCopy(val).Interface()
+	return deepCopy(val, map[copiedReference]reflect.Value{}).Interface()
 }
 
-func deepCopy(v reflect.Value) reflect.Value {
+// copiedReference identifies a pointer or map already copied by deepCopy.
+type copiedReference struct {
+	address uintptr
+	typ     reflect.Type
+}
+
+func deepCopy(v reflect.Value, copies map[copiedReference]reflect.Value) reflect.Value {
 	if !v.IsValid() {
 		return v
 	}
@@ -1641,16 +2046,20 @@ func deepCopy(v reflect.Value) reflect.Value {
 		if v.IsNil() {
 			return reflect.Zero(v.Type())
 		}
-		elemCopy := deepCopy(v.Elem())
-		ptrCopy := reflect.New(elemCopy.Type())
-		ptrCopy.Elem().Set(elemCopy)
-		return ptrCopy.Convert(v.Type())
+		reference := copiedReference{address: v.Pointer(), typ: v.Type()}
+		if existing, ok := copies[reference]; ok {
+			return existing
+		}
+		ptrCopy := reflect.New(v.Type().Elem()).Convert(v.Type())
+		copies[reference] = ptrCopy
+		ptrCopy.Elem().Set(deepCopy(v.Elem(), copies))
+		return ptrCopy
 
 	case reflect.Interface:
 		if v.IsNil() {
 			return reflect.Zero(v.Type())
 		}
-		innerCopy := deepCopy(v.Elem())
+		innerCopy := deepCopy(v.Elem(), copies)
 		return innerCopy.Convert(v.Type())
 
 	case reflect.Slice:
@@ -1659,14 +2068,14 @@ func deepCopy(v reflect.Value) reflect.Value {
 		}
 		cpy := reflect.MakeSlice(v.Type(), v.Len(), v.Cap())
 		for i := 0; i < v.Len(); i++ {
-			cpy.Index(i).Set(deepCopy(v.Index(i)))
+			cpy.Index(i).Set(deepCopy(v.Index(i), copies))
 		}
 		return cpy
 
 	case reflect.Array:
 		cpy := reflect.New(v.Type()).Elem()
 		for i := 0; i < v.Len(); i++ {
-			cpy.Index(i).Set(deepCopy(v.Index(i)))
+			cpy.Index(i).Set(deepCopy(v.Index(i), copies))
 		}
 		return cpy
 
@@ -1674,10 +2083,15 @@ func deepCopy(v reflect.Value) reflect.Value {
 		if v.IsNil() {
 			return reflect.Zero(v.Type())
 		}
+		reference := copiedReference{address: v.Pointer(), typ: v.Type()}
+		if existing, ok := copies[reference]; ok {
+			return existing
+		}
 		cpy := reflect.MakeMapWithSize(v.Type(), v.Len())
+		copies[reference] = cpy
 		for _, key := range v.MapKeys() {
-			valCopy := deepCopy(v.MapIndex(key))
-			keyCopy := deepCopy(key)
+			valCopy := deepCopy(v.MapIndex(key), copies)
+			keyCopy := deepCopy(key, copies)
 			cpy.SetMapIndex(keyCopy, valCopy)
 		}
 		return cpy
@@ -1688,7 +2102,7 @@ func deepCopy(v reflect.Value) reflect.Value {
 			if !cpy.Field(i).CanSet() {
 				continue
 			}
-			cpy.Field(i).Set(deepCopy(v.Field(i)))
+			cpy.Field(i).Set(deepCopy(v.Field(i), copies))
 		}
 		return cpy
 
@@ -7,10 +7,14 @@ import (
 	"context"
 	"encoding/json"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 func TestDeepCopy(t *testing.T) {
@@ -1900,6 +1904,57 @@ func TestObjectToMap(t *testing.T) {
 			prototype:     &SimpleStruct{},
 			expectedError: true,
 		},
+		{
+			name: "success_unknown_nested_object_skipped",
+			input: types.ObjectValueMust(
+				map[string]attr.Type{
+					"tags":   types.ListType{ElemType: types.StringType},
+					"nested": types.ObjectType{AttrTypes: map[string]attr.Type{"id": types.StringType}},
+				},
+				map[string]attr.Value{
+					"tags":   types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringValue("b")}),
+					"nested": types.ObjectUnknown(map[string]attr.Type{"id": types.StringType}),
+				},
+			),
+			prototype: &struct {
+				Tags []string `mapstructure:"tags"`
+			}{},
+			expectedError: false,
+			validateFunc: func(t *testing.T, result map[string]interface{}) {
+				if tags := result["tags"].([]interface{}); len(tags) != 2 || tags[0] != "a" || tags[1] != "b" {
+					t.Errorf("Expected tags [a b], got %v", tags)
+				}
+				if _, ok := result["nested"]; ok {
+					t.Errorf("Expected unknown nested object to be skipped, got %v", result["nested"])
+				}
+			},
+		},
+		{
+			name: "error_unknown_list_element",
+			input: types.ObjectValueMust(
+				map[string]attr.Type{"tags": types.ListType{ElemType: types.StringType}},
+				map[string]attr.Value{
+					"tags": types.ListValueMust(types.StringType, []attr.Value{types.StringValue("a"), types.StringUnknown(), types.StringValue("b")}),
+				},
+			),
+			prototype: &struct {
+				Tags []string `mapstructure:"tags"`
+			}{},
+			expectedError: true,
+		},
+		{
+			name: "error_unknown_map_element",
+			input: types.ObjectValueMust(
+				map[string]attr.Type{"labels": types.MapType{ElemType: types.StringType}},
+				map[string]attr.Value{
+					"labels": types.MapValueMust(types.StringType, map[string]attr.Value{"env": types.StringValue("dev"), "owner": types.StringUnknown()}),
+				},
+			),
+			prototype: &struct {
+				Labels map[string]string `mapstructure:"labels"`
+			}{},
+			expectedError: true,
+		},
 		{
 			name: "success_deeply_nested_objects",
 			input: types.ObjectValueMust(
@@ -3139,6 +3194,78 @@ func TestFindStructFieldByNameShadowing(t *testing.T) {
 	}
 }
 
+// TestUnmappedAttributePaths verifies that response fields without a schema attribute are
+// reported, including fields nested inside objects and lists of objects.
+func TestUnmappedAttributePaths(t *testing.T) {
+	t.Parallel()
+
+	type member struct {
+		Name  string `mapstructure:"name"`
+		Extra string `mapstructure:"extra"`
+	}
+	type owner struct {
+		ID      string `mapstructure:"id"`
+		Country string `mapstructure:"country"`
+	}
+	type response struct {
+		ID        string   `mapstructure:"id"`
+		CreatedBy string   `mapstructure:"created_by"`
+		Owner     *owner   `mapstructure:"owner"`
+		Members   []member `mapstructure:"members"`
+		Hidden    string   `mapstructure:"-"`
+	}
+
+	schemaAttrs := map[string]attr.Type{
+		"id":    types.StringType,
+		"owner": types.ObjectType{AttrTypes: map[string]attr.Type{"id": types.StringType}},
+		"members": types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{
+			"name": types.StringType,
+		}}},
+	}
+
+	got := UnmappedAttributePaths(&response{}, schemaAttrs)
+	want := []string{"created_by", "members.extra", "owner.country"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+
+	if got := UnmappedAttributePaths(nil, schemaAttrs); got != nil {
+		t.Errorf("expected nil for nil input, got %v", got)
+	}
+}
+
+// TestSetEmptyStringAttribute verifies that string and *string fields are only set when empty,
+// including fields promoted from squashed embeds.
+func TestSetEmptyStringAttribute(t *testing.T) {
+	t.Parallel()
+
+	type audit struct {
+		Comment *string `mapstructure:"comment"`
+	}
+	type target struct {
+		audit  `mapstructure:",squash"`
+		Reason string `mapstructure:"reason"`
+		Count  int    `mapstructure:"count"`
+	}
+
+	tgt := &target{}
+	if !SetEmptyStringAttribute(tgt, "reason", "CHG-1") || tgt.Reason != "CHG-1" {
+		t.Errorf("expected reason to be set, got %q", tgt.Reason)
+	}
+	if SetEmptyStringAttribute(tgt, "reason", "CHG-2") || tgt.Reason != "CHG-1" {
+		t.Errorf("expected user set reason to be kept, got %q", tgt.Reason)
+	}
+	if !SetEmptyStringAttribute(tgt, "comment", "CHG-1") || tgt.Comment == nil || *tgt.Comment != "CHG-1" {
+		t.Errorf("expected squashed comment to be set, got %v", tgt.Comment)
+	}
+	if SetEmptyStringAttribute(tgt, "count", "1") {
+		t.Errorf("expected non-string field to be skipped")
+	}
+	if SetEmptyStringAttribute(tgt, "missing", "1") {
+		t.Errorf("expected missing field to be skipped")
+	}
+}
+
 // Helper function for creating bool pointers in tests.
 func boolPtr(b bool) *bool {
 	return &b
@@ -3153,3 +3280,294 @@ func stringPtr(s string) *string {
 func intPtr(i int) *int {
 	return &i
 }
+
+type testExplicitZeroModel struct {
+	Name        string `json:"name" mapstructure:"name"`
+	Enabled     bool   `json:"enabled" mapstructure:"enabled"`
+	MaxSessions int    `json:"max_sessions" mapstructure:"max_sessions"`
+	Description string `json:"description,omitempty" mapstructure:"description"`
+}
+
+func TestObjectToMapExplicitZeroValues(t *testing.T) {
+	t.Parallel()
+
+	obj := types.ObjectValueMust(
+		map[string]attr.Type{"name": types.StringType, "max_sessions": types.Int64Type, "description": types.StringType},
+		map[string]attr.Value{"name": types.StringValue(""), "max_sessions": types.Int64Value(0), "description": types.StringValue("")},
+	)
+	result, err := objectToMap(obj, &testExplicitZeroModel{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if value, ok := result["max_sessions"]; !ok || value != int64(0) {
+		t.Errorf("expected max_sessions without omitempty to be sent as 0, got %v", result)
+	}
+	if value, ok := result["name"]; !ok || value != "" {
+		t.Errorf("expected name without omitempty to be sent as an empty string, got %v", result)
+	}
+	if _, ok := result["description"]; ok {
+		t.Errorf("expected description with omitempty to be omitted, got %v", result)
+	}
+}
+
+func TestStructFromPlanAndStateObjectExplicitZeroValues(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	generated := GenerateResourceSchemaFromStruct(&testExplicitZeroModel{}, nil, nil, ResourceSchemaOptions{})
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"name": tftypes.String, "enabled": tftypes.Bool, "max_sessions": tftypes.Number, "description": tftypes.String,
+	}}
+	state := &tfsdk.State{Schema: generated, Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"name":         tftypes.NewValue(tftypes.String, "web"),
+		"enabled":      tftypes.NewValue(tftypes.Bool, true),
+		"max_sessions": tftypes.NewValue(tftypes.Number, 5),
+		"description":  tftypes.NewValue(tftypes.String, "front"),
+	})}
+	plan := &tfsdk.Plan{Schema: generated, Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"name":         tftypes.NewValue(tftypes.String, "web"),
+		"enabled":      tftypes.NewValue(tftypes.Bool, false),
+		"max_sessions": tftypes.NewValue(tftypes.Number, 0),
+		"description":  tftypes.NewValue(tftypes.String, nil),
+	})}
+
+	result, err := StructFromPlanAndStateObject(ctx, plan, state, &testExplicitZeroModel{}, &testExplicitZeroModel{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	model := result.(*testExplicitZeroModel)
+	if model.Enabled {
+		t.Error("expected enabled to be disabled by the plan")
+	}
+	if model.MaxSessions != 0 {
+		t.Errorf("expected max_sessions set to 0 in the plan to override the state, got %d", model.MaxSessions)
+	}
+	if model.Description != "front" {
+		t.Errorf("expected description unset in the plan to keep its state value, got %q", model.Description)
+	}
+}
+
+type testTriStateConditions struct {
+	MFARequired *bool `json:"mfa_required,omitempty" mapstructure:"mfa_required,omitempty"`
+	MaxSessions *int  `json:"max_sessions,omitempty" mapstructure:"max_sessions,omitempty"`
+}
+
+type testTriStateModel struct {
+	Enabled    *bool                   `json:"enabled,omitempty" mapstructure:"enabled,omitempty"`
+	Conditions *testTriStateConditions `json:"conditions,omitempty" mapstructure:"conditions,omitempty"`
+}
+
+func TestPointerBoolTriState(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	generated := GenerateResourceSchemaFromStruct(&testTriStateModel{}, nil, nil, ResourceSchemaOptions{})
+	if _, ok := generated.Attributes["enabled"].(schema.BoolAttribute); !ok {
+		t.Fatalf("expected enabled to be a bool attribute, got %T", generated.Attributes["enabled"])
+	}
+	conditionsType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"mfa_required": tftypes.Bool, "max_sessions": tftypes.Number}}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"enabled": tftypes.Bool, "conditions": conditionsType}}
+	conditions := tftypes.NewValue(conditionsType, map[string]tftypes.Value{
+		"mfa_required": tftypes.NewValue(tftypes.Bool, false),
+		"max_sessions": tftypes.NewValue(tftypes.Number, 0),
+	})
+
+	tests := []struct {
+		name     string
+		enabled  tftypes.Value
+		expected *bool
+	}{
+		{name: "null_is_omitted", enabled: tftypes.NewValue(tftypes.Bool, nil), expected: nil},
+		{name: "false_is_sent", enabled: tftypes.NewValue(tftypes.Bool, false), expected: boolPtr(false)},
+		{name: "true_is_sent", enabled: tftypes.NewValue(tftypes.Bool, true), expected: boolPtr(true)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			plan := &tfsdk.Plan{Schema: generated, Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"enabled":    tt.enabled,
+				"conditions": conditions,
+			})}
+			result, err := StructFromPlanObject(ctx, plan, &testTriStateModel{})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			model := result.(*testTriStateModel)
+			if !reflect.DeepEqual(model.Enabled, tt.expected) {
+				t.Errorf("expected enabled %v, got %v", tt.expected, model.Enabled)
+			}
+			if model.Conditions == nil || model.Conditions.MFARequired == nil || *model.Conditions.MFARequired {
+				t.Errorf("expected nested mfa_required to be sent as false, got %+v", model.Conditions)
+			}
+			if model.Conditions.MaxSessions == nil || *model.Conditions.MaxSessions != 0 {
+				t.Errorf("expected nested max_sessions to be sent as 0, got %v", model.Conditions.MaxSessions)
+			}
+
+			attrValue, err := interfaceTypeToAttr(ctx, model.Enabled, types.BoolType)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tt.expected == nil && !attrValue.IsNull() {
+				t.Errorf("expected a nil pointer to be stored as null, got %v", attrValue)
+			}
+			if tt.expected != nil && !attrValue.Equal(types.BoolValue(*tt.expected)) {
+				t.Errorf("expected %v to be stored as is, got %v", *tt.expected, attrValue)
+			}
+		})
+	}
+}
+
+type testTerraformNameModel struct {
+	SafeName      string `json:"safeName" mapstructure:"safe_name"`
+	IsCPMDisabled bool   `json:"isCPMDisabled" mapstructure:"isCPMDisabled" tfname:"cpm_disabled"`
+}
+
+func TestTerraformNameTag(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	generated := GenerateResourceSchemaFromStruct(&testTerraformNameModel{}, nil, nil, ResourceSchemaOptions{})
+	if _, ok := generated.Attributes["cpm_disabled"].(schema.BoolAttribute); !ok {
+		t.Fatalf("expected the tfname tag to name the attribute cpm_disabled, got %v", generated.Attributes)
+	}
+	if _, ok := generated.Attributes["is_cpm_disabled"]; ok {
+		t.Error("expected no attribute named after the mapstructure tag")
+	}
+
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"safe_name": tftypes.String, "cpm_disabled": tftypes.Bool}}
+	plan := &tfsdk.Plan{Schema: generated, Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"safe_name":    tftypes.NewValue(tftypes.String, "web"),
+		"cpm_disabled": tftypes.NewValue(tftypes.Bool, true),
+	})}
+	result, err := StructFromPlanObject(ctx, plan, &testTerraformNameModel{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if model := result.(*testTerraformNameModel); model.SafeName != "web" || !model.IsCPMDisabled {
+		t.Errorf("expected cpm_disabled to decode into IsCPMDisabled, got %+v", model)
+	}
+
+	stateObj, err := StructToStateObject(ctx, &testTerraformNameModel{SafeName: "web", IsCPMDisabled: true}, nil, nil,
+		map[string]attr.Type{"safe_name": types.StringType, "cpm_disabled": types.BoolType}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if value := stateObj.Attributes()["cpm_disabled"]; !value.Equal(types.BoolValue(true)) {
+		t.Errorf("expected IsCPMDisabled to be stored as cpm_disabled, got %v", stateObj)
+	}
+
+	fromImport, err := StructFromStringMap(map[string]string{"cpm_disabled": "true"}, testTerraformNameModel{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if model := fromImport.(testTerraformNameModel); !model.IsCPMDisabled {
+		t.Errorf("expected cpm_disabled to decode from a string map, got %+v", model)
+	}
+}
+
+type testEmptyAsNullModel struct {
+	Name        string `json:"name" mapstructure:"name" validate:"required"`
+	Description string `json:"description,omitempty" mapstructure:"description"`
+	Comment     string `json:"comment,omitempty" mapstructure:"comment"`
+}
+
+func TestStructToStateObjectEmptyAsNull(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	generated := GenerateResourceSchemaFromStruct(&testEmptyAsNullModel{}, nil, nil, ResourceSchemaOptions{})
+	attrTypes := ResourceSchemaToSchemaAttrTypes(generated)
+	objectType := generated.Type().TerraformType(ctx)
+	priorValue := func(description interface{}) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"name":        tftypes.NewValue(tftypes.String, "db"),
+			"description": tftypes.NewValue(tftypes.String, description),
+			"comment":     tftypes.NewValue(tftypes.String, nil),
+		})
+	}
+	apiModel := &testEmptyAsNullModel{Name: "db"}
+	emptyAsNull := []string{"description"}
+
+	tests := []struct {
+		name  string
+		state *tfsdk.State
+		plan  *tfsdk.Plan
+		want  types.String
+	}{
+		{name: "import", want: types.StringNull()},
+		{name: "read_of_null", state: &tfsdk.State{Schema: generated, Raw: priorValue(nil)}, want: types.StringNull()},
+		{name: "read_of_empty", state: &tfsdk.State{Schema: generated, Raw: priorValue("")}, want: types.StringValue("")},
+		{name: "plan_of_empty", plan: &tfsdk.Plan{Schema: generated, Raw: priorValue("")}, want: types.StringValue("")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			stateObj, err := StructToStateObject(ctx, apiModel, tt.state, tt.plan, attrTypes, emptyAsNull)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := stateObj.Attributes()["description"]; !got.Equal(tt.want) {
+				t.Errorf("expected description %v, got %v", tt.want, got)
+			}
+			if got := stateObj.Attributes()["comment"]; !got.Equal(types.StringValue("")) {
+				t.Errorf("expected the unlisted comment to keep the empty string, got %v", got)
+			}
+		})
+	}
+
+	apiState := types.ObjectValueMust(attrTypes, map[string]attr.Value{
+		"name": types.StringValue("db"), "description": types.StringNull(), "comment": types.StringNull(),
+	})
+	merged, err := MergePlanToStateObject(ctx, &tfsdk.Plan{Schema: generated, Raw: priorValue("")}, apiState, attrTypes, emptyAsNull)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := merged.Attributes()["description"]; !got.Equal(types.StringValue("")) {
+		t.Errorf("expected the planned empty description to be kept, got %v", got)
+	}
+}
+
+type testTreeNode struct {
+	Name     string          `json:"name" mapstructure:"name"`
+	Parent   *testTreeNode   `json:"parent,omitempty" mapstructure:"parent"`
+	Children []*testTreeNode `json:"children,omitempty" mapstructure:"children"`
+}
+
+func TestDeepCopyCyclicValue(t *testing.T) {
+	t.Parallel()
+
+	root := &testTreeNode{Name: "root"}
+	child := &testTreeNode{Name: "child", Parent: root}
+	root.Children = []*testTreeNode{child}
+	groups := map[string]interface{}{"name": "admins"}
+	groups["self"] = groups
+
+	copied := DeepCopy(root).(*testTreeNode)
+	if copied == root || copied.Children[0] == child {
+		t.Fatal("expected the nodes to be copied")
+	}
+	if copied.Children[0].Parent != copied {
+		t.Error("expected the copied child to reference the copied root")
+	}
+	copiedGroups := DeepCopy(groups).(map[string]interface{})
+	copiedGroups["name"] = "operators"
+	if groups["name"] != "admins" || copiedGroups["self"].(map[string]interface{})["name"] != "operators" {
+		t.Error("expected the copied map to reference itself and not the original")
+	}
+}
+
+func TestReflectTypeToTerraformTypeRecursive(t *testing.T) {
+	t.Parallel()
+
+	_, err := reflectTypeToTerraformType(reflect.TypeOf(testTreeNode{}))
+	if err == nil || !strings.Contains(err.Error(), "schemas.testTreeNode references itself") {
+		t.Errorf("expected an error naming the recursive type, got %v", err)
+	}
+	type pair struct {
+		Left  testEmptyAsNullModel `json:"left"`
+		Right testEmptyAsNullModel `json:"right"`
+	}
+	if _, err := reflectTypeToTerraformType(reflect.TypeOf(pair{})); err != nil {
+		t.Errorf("expected a type used twice not to be recursive, got %v", err)
+	}
+}
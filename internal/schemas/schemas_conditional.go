@@ -0,0 +1,200 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// WhenTag is the model field tag restricting an attribute to the values of a sibling discriminator
+// attribute, for models holding the settings of every variant of an object, e.g. `when:"type=aws"` on the
+// AWS settings of a multi-cloud target. The attribute cannot be set unless the discriminator has one of the
+// values, separated by |, and a trailing ",required" also requires it then, e.g. `when:"type=aws|gcp,required"`.
+const WhenTag = "when"
+
+// ConditionalAttributeValidator validates an attribute against the value of the sibling attribute it is
+// conditioned on by a WhenTag: it fails when the attribute is set while the sibling has none of the values
+// and, when required, when the attribute is not set while the sibling has one of them. Unknown values are
+// validated once known.
+type ConditionalAttributeValidator struct {
+	Attribute string
+	Values    []string
+	Required  bool
+}
+
+var (
+	_ validator.String  = ConditionalAttributeValidator{}
+	_ validator.Bool    = ConditionalAttributeValidator{}
+	_ validator.Int64   = ConditionalAttributeValidator{}
+	_ validator.Float64 = ConditionalAttributeValidator{}
+	_ validator.Number  = ConditionalAttributeValidator{}
+	_ validator.List    = ConditionalAttributeValidator{}
+	_ validator.Set     = ConditionalAttributeValidator{}
+	_ validator.Map     = ConditionalAttributeValidator{}
+	_ validator.Object  = ConditionalAttributeValidator{}
+	_ validator.Dynamic = ConditionalAttributeValidator{}
+)
+
+// parseWhenTag parses the WhenTag of a field, and returns false for fields without a valid tag.
+func parseWhenTag(field reflect.StructField) (ConditionalAttributeValidator, bool) {
+	condition, required := strings.CutSuffix(field.Tag.Get(WhenTag), ",required")
+	attribute, values, found := strings.Cut(condition, "=")
+	attribute = strings.TrimSpace(attribute)
+	if !found || attribute == "" || values == "" {
+		return ConditionalAttributeValidator{}, false
+	}
+	return ConditionalAttributeValidator{Attribute: attribute, Values: strings.Split(values, "|"), Required: required}, true
+}
+
+// describeValues returns the values of the condition as `"aws"` or `"aws" or "gcp"`.
+func (v ConditionalAttributeValidator) describeValues() string {
+	quoted := make([]string, len(v.Values))
+	for i, value := range v.Values {
+		quoted[i] = fmt.Sprintf("%q", value)
+	}
+	return strings.Join(quoted, " or ")
+}
+
+// Description describes the validation.
+func (v ConditionalAttributeValidator) Description(ctx context.Context) string {
+	if v.Required {
+		return fmt.Sprintf("Required when %s is %s, and cannot be set otherwise.", v.Attribute, v.describeValues())
+	}
+	return fmt.Sprintf("Can only be set when %s is %s.", v.Attribute, v.describeValues())
+}
+
+// MarkdownDescription describes the validation in markdown.
+func (v ConditionalAttributeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// conditionValueString returns the string form of a discriminator value compared to the values of a condition.
+func conditionValueString(value attr.Value) string {
+	if stringValuable, ok := value.(basetypes.StringValuable); ok {
+		if stringValue, diags := stringValuable.ToStringValue(context.Background()); !diags.HasError() {
+			return stringValue.ValueString()
+		}
+	}
+	return value.String()
+}
+
+// validate checks an attribute at attrPath of config against the condition.
+func (v ConditionalAttributeValidator) validate(ctx context.Context, config tfsdk.Config, attrPath path.Path, value attr.Value, diagnostics *diag.Diagnostics) {
+	if value == nil || value.IsUnknown() {
+		return
+	}
+	var discriminator attr.Value
+	if diags := config.GetAttribute(ctx, attrPath.ParentPath().AtName(v.Attribute), &discriminator); diags.HasError() || discriminator == nil || discriminator.IsUnknown() {
+		return
+	}
+	matches := !discriminator.IsNull() && slices.Contains(v.Values, conditionValueString(discriminator))
+	switch {
+	case !value.IsNull() && !matches:
+		actual := "not set"
+		if !discriminator.IsNull() {
+			actual = fmt.Sprintf("%q", conditionValueString(discriminator))
+		}
+		diagnostics.AddAttributeError(
+			attrPath,
+			"Invalid Attribute Combination",
+			fmt.Sprintf("%s can only be set when %s is %s, but %s is %s.", attrPath, v.Attribute, v.describeValues(), v.Attribute, actual),
+		)
+	case value.IsNull() && matches && v.Required:
+		diagnostics.AddAttributeError(
+			attrPath,
+			"Missing Attribute Configuration",
+			fmt.Sprintf("%s must be set when %s is %s.", attrPath, v.Attribute, conditionValueString(discriminator)),
+		)
+	}
+}
+
+// ValidateString validates string attributes.
+func (v ConditionalAttributeValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	v.validate(ctx, req.Config, req.Path, req.ConfigValue, &resp.Diagnostics)
+}
+
+// ValidateBool validates bool attributes.
+func (v ConditionalAttributeValidator) ValidateBool(ctx context.Context, req validator.BoolRequest, resp *validator.BoolResponse) {
+	v.validate(ctx, req.Config, req.Path, req.ConfigValue, &resp.Diagnostics)
+}
+
+// ValidateInt64 validates int64 attributes.
+func (v ConditionalAttributeValidator) ValidateInt64(ctx context.Context, req validator.Int64Request, resp *validator.Int64Response) {
+	v.validate(ctx, req.Config, req.Path, req.ConfigValue, &resp.Diagnostics)
+}
+
+// ValidateFloat64 validates float64 attributes.
+func (v ConditionalAttributeValidator) ValidateFloat64(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	v.validate(ctx, req.Config, req.Path, req.ConfigValue, &resp.Diagnostics)
+}
+
+// ValidateNumber validates number attributes.
+func (v ConditionalAttributeValidator) ValidateNumber(ctx context.Context, req validator.NumberRequest, resp *validator.NumberResponse) {
+	v.validate(ctx, req.Config, req.Path, req.ConfigValue, &resp.Diagnostics)
+}
+
+// ValidateList validates list attributes.
+func (v ConditionalAttributeValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	v.validate(ctx, req.Config, req.Path, req.ConfigValue, &resp.Diagnostics)
+}
+
+// ValidateSet validates set attributes.
+func (v ConditionalAttributeValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	v.validate(ctx, req.Config, req.Path, req.ConfigValue, &resp.Diagnostics)
+}
+
+// ValidateMap validates map attributes.
+func (v ConditionalAttributeValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	v.validate(ctx, req.Config, req.Path, req.ConfigValue, &resp.Diagnostics)
+}
+
+// ValidateObject validates object attributes.
+func (v ConditionalAttributeValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	v.validate(ctx, req.Config, req.Path, req.ConfigValue, &resp.Diagnostics)
+}
+
+// ValidateDynamic validates dynamic attributes.
+func (v ConditionalAttributeValidator) ValidateDynamic(ctx context.Context, req validator.DynamicRequest, resp *validator.DynamicResponse) {
+	v.validate(ctx, req.Config, req.Path, req.ConfigValue, &resp.Diagnostics)
+}
+
+// applyConditionalValidators adds a ConditionalAttributeValidator to the attributes of the fields tagged with
+// WhenTag, and describes the condition in their description. Attributes whose type takes no validators are
+// left as is.
+func applyConditionalValidators(attributes map[string]schema.Attribute, fields []reflect.StructField) {
+	for _, field := range fields {
+		condition, ok := parseWhenTag(field)
+		if !ok {
+			continue
+		}
+		name := resolveFieldName(field)
+		attribute, exists := attributes[name]
+		if !exists {
+			continue
+		}
+		updated := reflect.New(reflect.TypeOf(attribute)).Elem()
+		updated.Set(reflect.ValueOf(attribute))
+		validators := updated.FieldByName("Validators")
+		if !validators.IsValid() || !reflect.TypeOf(condition).Implements(validators.Type().Elem()) {
+			continue
+		}
+		validators.Set(reflect.Append(validators, reflect.ValueOf(condition)))
+		if description := updated.FieldByName("Description"); description.IsValid() && description.Kind() == reflect.String {
+			description.SetString(strings.TrimSpace(description.String() + " " + condition.Description(context.Background())))
+		}
+		attributes[name] = updated.Interface().(schema.Attribute)
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testConditionalModel struct {
+	Type      string `json:"type" mapstructure:"type" validate:"required"`
+	AccountID string `json:"account_id,omitempty" mapstructure:"account_id" when:"type=aws,required"`
+	ProjectID string `json:"project_id,omitempty" mapstructure:"project_id" when:"type=gcp|azure"`
+}
+
+func TestParseWhenTag(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		tag      reflect.StructTag
+		expected ConditionalAttributeValidator
+		ok       bool
+	}{
+		{tag: `when:"type=aws"`, expected: ConditionalAttributeValidator{Attribute: "type", Values: []string{"aws"}}, ok: true},
+		{tag: `when:"type=gcp|azure,required"`, expected: ConditionalAttributeValidator{Attribute: "type", Values: []string{"gcp", "azure"}, Required: true}, ok: true},
+		{tag: `when:"type"`},
+		{tag: `when:"=aws"`},
+		{tag: `json:"type"`},
+	}
+	for _, tt := range tests {
+		actual, ok := parseWhenTag(reflect.StructField{Name: "Field", Tag: tt.tag})
+		if ok != tt.ok || !reflect.DeepEqual(actual, tt.expected) {
+			t.Errorf("expected %+v (%v) for %q, got %+v (%v)", tt.expected, tt.ok, tt.tag, actual, ok)
+		}
+	}
+}
+
+func TestGenerateSchemaConditionalAttributes(t *testing.T) {
+	t.Parallel()
+
+	generated := GenerateResourceSchemaFromStruct(&testConditionalModel{}, nil, nil, ResourceSchemaOptions{})
+	accountID, ok := generated.Attributes["account_id"].(schema.StringAttribute)
+	if !ok {
+		t.Fatalf("expected account_id to be a string, got %T", generated.Attributes["account_id"])
+	}
+	if !hasConditionalValidator(accountID.Validators) {
+		t.Errorf("expected account_id to have a conditional validator, got %v", accountID.Validators)
+	}
+	if accountID.Description != `Required when type is "aws", and cannot be set otherwise.` {
+		t.Errorf("expected the condition in the description, got %q", accountID.Description)
+	}
+	if typeAttr := generated.Attributes["type"].(schema.StringAttribute); hasConditionalValidator(typeAttr.Validators) {
+		t.Error("expected the discriminator not to have a conditional validator")
+	}
+}
+
+func hasConditionalValidator(validators []validator.String) bool {
+	for _, v := range validators {
+		if _, ok := v.(ConditionalAttributeValidator); ok {
+			return true
+		}
+	}
+	return false
+}
+
+func TestConditionalAttributeValidator(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	generated := GenerateResourceSchemaFromStruct(&testConditionalModel{}, nil, nil, ResourceSchemaOptions{})
+	objectType := generated.Type().TerraformType(ctx).(tftypes.Object)
+
+	tests := []struct {
+		name         string
+		discriminant interface{}
+		attribute    string
+		value        interface{}
+		condition    ConditionalAttributeValidator
+		expected     string
+	}{
+		{name: "set when matching", discriminant: "aws", attribute: "account_id", value: "123", condition: ConditionalAttributeValidator{Attribute: "type", Values: []string{"aws"}, Required: true}},
+		{name: "set when not matching", discriminant: "gcp", attribute: "account_id", value: "123", condition: ConditionalAttributeValidator{Attribute: "type", Values: []string{"aws"}}, expected: "Invalid Attribute Combination"},
+		{name: "set without discriminator", discriminant: nil, attribute: "account_id", value: "123", condition: ConditionalAttributeValidator{Attribute: "type", Values: []string{"aws"}}, expected: "Invalid Attribute Combination"},
+		{name: "required missing", discriminant: "aws", attribute: "account_id", value: nil, condition: ConditionalAttributeValidator{Attribute: "type", Values: []string{"aws"}, Required: true}, expected: "Missing Attribute Configuration"},
+		{name: "optional missing", discriminant: "azure", attribute: "project_id", value: nil, condition: ConditionalAttributeValidator{Attribute: "type", Values: []string{"gcp", "azure"}}},
+		{name: "unset when not matching", discriminant: "gcp", attribute: "account_id", value: nil, condition: ConditionalAttributeValidator{Attribute: "type", Values: []string{"aws"}, Required: true}},
+		{name: "unknown discriminator", discriminant: tftypes.UnknownValue, attribute: "account_id", value: "123", condition: ConditionalAttributeValidator{Attribute: "type", Values: []string{"aws"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			values := map[string]tftypes.Value{}
+			for name, attrType := range objectType.AttributeTypes {
+				values[name] = tftypes.NewValue(attrType, nil)
+			}
+			values["type"] = tftypes.NewValue(tftypes.String, tt.discriminant)
+			values[tt.attribute] = tftypes.NewValue(tftypes.String, tt.value)
+			config := tfsdk.Config{Schema: generated, Raw: tftypes.NewValue(objectType, values)}
+
+			configValue := types.StringNull()
+			if tt.value != nil {
+				configValue = types.StringValue(tt.value.(string))
+			}
+			resp := &validator.StringResponse{}
+			tt.condition.ValidateString(ctx, validator.StringRequest{Config: config, Path: path.Root(tt.attribute), ConfigValue: configValue}, resp)
+			if tt.expected == "" {
+				if resp.Diagnostics.HasError() {
+					t.Errorf("expected no error, got %v", resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.ErrorsCount() != 1 || resp.Diagnostics.Errors()[0].Summary() != tt.expected {
+				t.Errorf("expected a %q error, got %v", tt.expected, resp.Diagnostics)
+			}
+		})
+	}
+}
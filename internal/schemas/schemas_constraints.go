@@ -0,0 +1,179 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/convert"
+	"github.com/zclconf/go-cty/cty/function"
+	"github.com/zclconf/go-cty/cty/function/stdlib"
+)
+
+// constraintFunctions are the functions constraint expressions can call.
+var constraintFunctions = map[string]function.Function{
+	"len":      stdlib.LengthFunc,
+	"length":   stdlib.LengthFunc,
+	"sum":      sumFunc,
+	"min":      stdlib.MinFunc,
+	"max":      stdlib.MaxFunc,
+	"contains": stdlib.ContainsFunc,
+	"distinct": stdlib.DistinctFunc,
+}
+
+// sumFunc returns the sum of the numbers of a list, e.g. sum(rules[*].weight). Null elements are skipped.
+var sumFunc = function.New(&function.Spec{
+	Description: "Returns the sum of the numbers of a list.",
+	Params: []function.Parameter{
+		{Name: "values", Type: cty.DynamicPseudoType},
+	},
+	Type: function.StaticReturnType(cty.Number),
+	Impl: func(args []cty.Value, retType cty.Type) (cty.Value, error) {
+		values := args[0]
+		if !values.CanIterateElements() {
+			return cty.NilVal, fmt.Errorf("sum requires a list of numbers, got %s", values.Type().FriendlyName())
+		}
+		total := cty.Zero
+		for it := values.ElementIterator(); it.Next(); {
+			_, value := it.Element()
+			if value.IsNull() {
+				continue
+			}
+			number, err := convert.Convert(value, cty.Number)
+			if err != nil {
+				return cty.NilVal, fmt.Errorf("sum requires a list of numbers: %s", err.Error())
+			}
+			if !number.IsKnown() {
+				return cty.UnknownVal(cty.Number), nil
+			}
+			total = total.Add(number)
+		}
+		return total, nil
+	},
+})
+
+// ParseConstraint parses a constraint expression, e.g. "len(rules) <= max_rules", and returns the sorted
+// names of the top-level attributes it references.
+func ParseConstraint(expression string) ([]string, error) {
+	parsed, diags := hclsyntax.ParseExpression([]byte(expression), "constraint", hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, fmt.Errorf("invalid constraint %q: %s", expression, diags.Error())
+	}
+	seen := map[string]bool{}
+	var attributes []string
+	for _, traversal := range parsed.Variables() {
+		if name := traversal.RootName(); !seen[name] {
+			seen[name] = true
+			attributes = append(attributes, name)
+		}
+	}
+	sort.Strings(attributes)
+	return attributes, nil
+}
+
+// ConstraintAttributePaths parses a constraint expression and returns the paths of the attributes it
+// references, down to their first index or splat, e.g. metadata.policy_tags for
+// "len(metadata.policy_tags) <= 20" and rules for "sum(rules[*].weight) == 100".
+func ConstraintAttributePaths(expression string) ([]path.Path, error) {
+	parsed, diags := hclsyntax.ParseExpression([]byte(expression), "constraint", hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, fmt.Errorf("invalid constraint %q: %s", expression, diags.Error())
+	}
+	var paths []path.Path
+	for _, traversal := range parsed.Variables() {
+		attributePath := path.Root(traversal.RootName())
+		for _, step := range traversal[1:] {
+			name, ok := step.(hcl.TraverseAttr)
+			if !ok {
+				break
+			}
+			attributePath = attributePath.AtName(name.Name)
+		}
+		paths = append(paths, attributePath)
+	}
+	sort.Slice(paths, func(i, j int) bool { return paths[i].String() < paths[j].String() })
+	return paths, nil
+}
+
+// EvaluateConstraint evaluates a constraint expression over model, a converted input struct whose
+// attributes are addressed by their Terraform names. It returns an error when the expression does not
+// evaluate to a known bool, e.g. when it calls len on a null attribute.
+func EvaluateConstraint(expression string, model interface{}) (bool, error) {
+	parsed, diags := hclsyntax.ParseExpression([]byte(expression), "constraint", hcl.InitialPos)
+	if diags.HasErrors() {
+		return false, fmt.Errorf("invalid constraint %q: %s", expression, diags.Error())
+	}
+	modelValue := constraintValue(reflect.ValueOf(model))
+	variables := map[string]cty.Value{}
+	if modelValue.Type().IsObjectType() && !modelValue.IsNull() {
+		variables = modelValue.AsValueMap()
+	}
+	result, diags := parsed.Value(&hcl.EvalContext{Variables: variables, Functions: constraintFunctions})
+	if diags.HasErrors() {
+		return false, fmt.Errorf("failed to evaluate constraint %q: %s", expression, diags.Error())
+	}
+	result, err := convert.Convert(result, cty.Bool)
+	if err != nil {
+		return false, fmt.Errorf("constraint %q does not evaluate to a bool: %s", expression, err.Error())
+	}
+	if result.IsNull() || !result.IsKnown() {
+		return false, fmt.Errorf("constraint %q evaluates to no value", expression)
+	}
+	return result.True(), nil
+}
+
+// constraintValue converts a model value to the value constraint expressions see: structs and maps become
+// objects keyed by attribute name, slices become tuples, and nil pointers and interfaces become null. Nil
+// slices and maps are empty, as the converted struct does not tell them from empty ones.
+func constraintValue(value reflect.Value) cty.Value {
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return cty.NullVal(cty.DynamicPseudoType)
+		}
+		value = value.Elem()
+	}
+	switch value.Kind() {
+	case reflect.String:
+		return cty.StringVal(value.String())
+	case reflect.Bool:
+		return cty.BoolVal(value.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cty.NumberIntVal(value.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return cty.NumberUIntVal(value.Uint())
+	case reflect.Float32, reflect.Float64:
+		return cty.NumberFloatVal(value.Float())
+	case reflect.Slice, reflect.Array:
+		elements := make([]cty.Value, 0, value.Len())
+		for i := 0; i < value.Len(); i++ {
+			elements = append(elements, constraintValue(value.Index(i)))
+		}
+		return cty.TupleVal(elements)
+	case reflect.Map:
+		if value.Type().Key().Kind() != reflect.String {
+			return cty.NullVal(cty.DynamicPseudoType)
+		}
+		attributes := make(map[string]cty.Value, value.Len())
+		iter := value.MapRange()
+		for iter.Next() {
+			attributes[iter.Key().String()] = constraintValue(iter.Value())
+		}
+		return cty.ObjectVal(attributes)
+	case reflect.Struct:
+		fields := resolveFieldsSquashed(value.Type())
+		fieldValues := resolveFieldsValueSquashed(value)
+		attributes := make(map[string]cty.Value, len(fields))
+		for i, field := range fields {
+			attributes[resolveFieldName(field)] = constraintValue(fieldValues[i])
+		}
+		return cty.ObjectVal(attributes)
+	}
+	return cty.NullVal(cty.DynamicPseudoType)
+}
@@ -0,0 +1,96 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+type testConstraintRule struct {
+	Name   string `mapstructure:"name"`
+	Weight int    `mapstructure:"weight"`
+}
+
+type testConstraintModel struct {
+	MaxRules     int                  `mapstructure:"max_rules"`
+	Rules        []testConstraintRule `mapstructure:"rules"`
+	Owners       []string             `mapstructure:"owners"`
+	Timeout      *int                 `mapstructure:"timeout"`
+	IsCPMEnabled bool                 `mapstructure:"isCPMEnabled" tfname:"cpm_enabled"`
+}
+
+func TestParseConstraint(t *testing.T) {
+	t.Parallel()
+
+	attributes, err := ParseConstraint("len(rules) <= max_rules && (cpm_enabled || sum(rules[*].weight) > 0)")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(attributes, []string{"cpm_enabled", "max_rules", "rules"}) {
+		t.Errorf("unexpected attributes %v", attributes)
+	}
+	if _, err := ParseConstraint("len(rules) <="); err == nil {
+		t.Error("expected an error for an invalid expression")
+	}
+}
+
+func TestConstraintAttributePaths(t *testing.T) {
+	t.Parallel()
+
+	paths, err := ConstraintAttributePaths("len(metadata.policy_tags) <= 20 && sum(rules[*].weight) == 100")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []path.Path{path.Root("metadata").AtName("policy_tags"), path.Root("rules")}
+	if !reflect.DeepEqual(paths, expected) {
+		t.Errorf("expected %v, got %v", expected, paths)
+	}
+}
+
+func TestEvaluateConstraint(t *testing.T) {
+	t.Parallel()
+
+	model := &testConstraintModel{
+		MaxRules:     2,
+		Rules:        []testConstraintRule{{Name: "a", Weight: 60}, {Name: "b", Weight: 40}},
+		Owners:       []string{"alice", "bob", "alice"},
+		IsCPMEnabled: true,
+	}
+	tests := []struct {
+		expression string
+		satisfied  bool
+		err        bool
+	}{
+		{expression: "len(rules) <= max_rules", satisfied: true},
+		{expression: "length(rules) < max_rules"},
+		{expression: "sum(rules[*].weight) == 100", satisfied: true},
+		{expression: "max(rules[0].weight, rules[1].weight) <= 50"},
+		{expression: "len(distinct(owners)) == len(owners)"},
+		{expression: "contains(rules[*].name, \"b\")", satisfied: true},
+		{expression: "cpm_enabled", satisfied: true},
+		{expression: "len(timeout) > 0", err: true},
+		{expression: "timeout == null", satisfied: true},
+		{expression: "missing > 0", err: true},
+		{expression: "max_rules", err: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expression, func(t *testing.T) {
+			t.Parallel()
+			satisfied, err := EvaluateConstraint(tt.expression, model)
+			if (err != nil) != tt.err {
+				t.Fatalf("expected error=%t, got %v", tt.err, err)
+			}
+			if satisfied != tt.satisfied {
+				t.Errorf("expected satisfied=%t, got %t", tt.satisfied, satisfied)
+			}
+		})
+	}
+
+	if satisfied, err := EvaluateConstraint("len(rules) == 0", &testConstraintModel{}); err != nil || !satisfied {
+		t.Errorf("expected a nil slice to be empty, got %t, %v", satisfied, err)
+	}
+}
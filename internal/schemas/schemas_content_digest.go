@@ -0,0 +1,120 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// contentDigestSuffix is appended to the name of a content attribute to name the attribute holding its
+// digest, e.g. "certificate_body_sha256" for "certificate_body".
+const contentDigestSuffix = "_sha256"
+
+// ContentDigestAttributeName returns the name of the attribute holding the digest of the content attribute
+// of the given name.
+func ContentDigestAttributeName(contentAttribute string) string {
+	return contentAttribute + contentDigestSuffix
+}
+
+// ContentDigest returns the SHA-256 digest of content as lowercase hex, as computed by the filesha256 and
+// sha256 Terraform functions, so it can be compared with the digest of a local file.
+func ContentDigest(content string) string {
+	sum := sha256.Sum256([]byte(content))
+	return hex.EncodeToString(sum[:])
+}
+
+// AddContentDigestAttributes adds a computed <name>_sha256 attribute for each of the given top-level
+// string attributes holding content such as scripts or certificates. The digest changes with the content,
+// so it can be referenced by replace_triggered_by or compared with filesha256 instead of the content
+// itself. Attributes that are not top-level strings, or whose digest attribute already exists, are skipped.
+func AddContentDigestAttributes(resourceSchema *schema.Schema, contentAttributes []string) {
+	for _, contentAttribute := range contentAttributes {
+		if _, ok := resourceSchema.Attributes[contentAttribute].(schema.StringAttribute); !ok {
+			continue
+		}
+		digestAttribute := ContentDigestAttributeName(contentAttribute)
+		if _, exists := resourceSchema.Attributes[digestAttribute]; exists {
+			continue
+		}
+		resourceSchema.Attributes[digestAttribute] = schema.StringAttribute{
+			Description:         "SHA-256 digest of " + contentAttribute + ", in hex as computed by filesha256.",
+			MarkdownDescription: "SHA-256 digest of `" + contentAttribute + "`, in hex as computed by `filesha256`.",
+			Computed:            true,
+			PlanModifiers:       []planmodifier.String{contentDigestModifier{contentAttribute: contentAttribute}},
+		}
+	}
+}
+
+// contentDigestModifier plans the digest of a content attribute from its planned value, so a change of the
+// content shows as a change of the digest, and keeps it unknown while the content is.
+type contentDigestModifier struct {
+	contentAttribute string
+}
+
+func (m contentDigestModifier) Description(_ context.Context) string {
+	return fmt.Sprintf("Follows the digest of %s.", m.contentAttribute)
+}
+
+func (m contentDigestModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m contentDigestModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.Plan.Raw.IsNull() || !req.PlanValue.IsUnknown() {
+		return
+	}
+	var content types.String
+	if diags := req.Plan.GetAttribute(ctx, path.Root(m.contentAttribute), &content); diags.HasError() {
+		return
+	}
+	resp.PlanValue = contentDigestValue(content)
+}
+
+// contentDigestValue returns the digest of a content value, null for null content and unknown for unknown
+// content.
+func contentDigestValue(content types.String) types.String {
+	switch {
+	case content.IsUnknown():
+		return types.StringUnknown()
+	case content.IsNull():
+		return types.StringNull()
+	default:
+		return types.StringValue(ContentDigest(content.ValueString()))
+	}
+}
+
+// SetContentDigests sets the <name>_sha256 attributes of a state object from the content attributes of the
+// given names, as returned by the API or, when it does not return them, as applied. Content attributes
+// without a digest attribute in the object are left as is.
+func SetContentDigests(obj types.Object, contentAttributes []string) (types.Object, error) {
+	if obj.IsNull() || obj.IsUnknown() || len(contentAttributes) == 0 {
+		return obj, nil
+	}
+	attrTypes := obj.AttributeTypes(context.Background())
+	attributes := obj.Attributes()
+	for _, contentAttribute := range contentAttributes {
+		digestAttribute := ContentDigestAttributeName(contentAttribute)
+		if attrTypes[digestAttribute] != types.StringType {
+			continue
+		}
+		content, ok := attributes[contentAttribute].(types.String)
+		if !ok {
+			continue
+		}
+		attributes[digestAttribute] = contentDigestValue(content)
+	}
+	result, diags := types.ObjectValue(attrTypes, attributes)
+	if diags.HasError() {
+		return obj, fmt.Errorf("failed to set content digests: %v", diags)
+	}
+	return result, nil
+}
@@ -0,0 +1,90 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestAddContentDigestAttributes(t *testing.T) {
+	t.Parallel()
+
+	resourceSchema := schema.Schema{Attributes: map[string]schema.Attribute{
+		"certificate_body": schema.StringAttribute{Optional: true},
+		"labels":           schema.MapAttribute{Optional: true, ElementType: types.StringType},
+	}}
+	AddContentDigestAttributes(&resourceSchema, []string{"certificate_body", "labels", "missing"})
+	digest, ok := resourceSchema.Attributes["certificate_body_sha256"].(schema.StringAttribute)
+	if !ok || !digest.Computed || len(digest.PlanModifiers) != 1 {
+		t.Fatalf("expected a computed certificate_body_sha256 attribute, got %#v", resourceSchema.Attributes["certificate_body_sha256"])
+	}
+	if len(resourceSchema.Attributes) != 3 {
+		t.Errorf("expected only strings to get a digest attribute, got %d attributes", len(resourceSchema.Attributes))
+	}
+
+	ctx := context.Background()
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"certificate_body":        tftypes.String,
+		"certificate_body_sha256": tftypes.String,
+		"labels":                  tftypes.Map{ElementType: tftypes.String},
+	}}
+	tests := []struct {
+		name     string
+		content  tftypes.Value
+		expected types.String
+	}{
+		{name: "known", content: tftypes.NewValue(tftypes.String, "hello"), expected: types.StringValue("2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824")},
+		{name: "unknown", content: tftypes.NewValue(tftypes.String, tftypes.UnknownValue), expected: types.StringUnknown()},
+		{name: "null", content: tftypes.NewValue(tftypes.String, nil), expected: types.StringNull()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			plan := tfsdk.Plan{Schema: resourceSchema, Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"certificate_body":        tt.content,
+				"certificate_body_sha256": tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"labels":                  tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, nil),
+			})}
+			req := planmodifier.StringRequest{Plan: plan, PlanValue: types.StringUnknown()}
+			resp := &planmodifier.StringResponse{PlanValue: req.PlanValue}
+			digest.PlanModifiers[0].PlanModifyString(ctx, req, resp)
+			if !resp.PlanValue.Equal(tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, resp.PlanValue)
+			}
+		})
+	}
+}
+
+func TestSetContentDigests(t *testing.T) {
+	t.Parallel()
+
+	attrTypes := map[string]attr.Type{
+		"certificate_body":        types.StringType,
+		"certificate_body_sha256": types.StringType,
+		"script":                  types.StringType,
+	}
+	obj, _ := types.ObjectValue(attrTypes, map[string]attr.Value{
+		"certificate_body":        types.StringValue("hello"),
+		"certificate_body_sha256": types.StringUnknown(),
+		"script":                  types.StringValue("echo"),
+	})
+	result, err := SetContentDigests(obj, []string{"certificate_body", "script"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := result.Attributes()["certificate_body_sha256"]; !got.Equal(types.StringValue(ContentDigest("hello"))) {
+		t.Errorf("expected the digest of the content, got %v", got)
+	}
+	if len(result.Attributes()) != 3 {
+		t.Errorf("expected no attribute to be added, got %v", result.Attributes())
+	}
+}
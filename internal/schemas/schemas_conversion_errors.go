@@ -0,0 +1,57 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// AttributeConversionError is returned when a value cannot be converted between its Terraform and SDK
+// representations. Path names the nested attribute that failed, e.g. "rules[3].conditions.ip_ranges",
+// with list indexes in brackets and map keys quoted in brackets.
+type AttributeConversionError struct {
+	Path string
+	Err  error
+}
+
+// Error returns the message of the conversion error, prefixed with the path of the attribute.
+func (e *AttributeConversionError) Error() string {
+	return fmt.Sprintf("attribute %s: %v", e.Path, e.Err)
+}
+
+// Unwrap returns the underlying conversion error.
+func (e *AttributeConversionError) Unwrap() error {
+	return e.Err
+}
+
+// withAttributePath prefixes the path of a conversion error with segment, the attribute name, index or
+// key of the value whose child failed to convert. Each converter adds the segments of its children while
+// the error is returned, so the error names the full path once it reaches the top-level attribute.
+func withAttributePath(segment string, err error) error {
+	if err == nil || segment == "" {
+		return err
+	}
+	var conversionErr *AttributeConversionError
+	if errors.As(err, &conversionErr) && conversionErr == err {
+		if strings.HasPrefix(conversionErr.Path, "[") {
+			conversionErr.Path = segment + conversionErr.Path
+		} else {
+			conversionErr.Path = segment + "." + conversionErr.Path
+		}
+		return conversionErr
+	}
+	return &AttributeConversionError{Path: segment, Err: err}
+}
+
+// indexPathSegment returns the path segment of a list, set or tuple element.
+func indexPathSegment(index int) string {
+	return fmt.Sprintf("[%d]", index)
+}
+
+// keyPathSegment returns the path segment of a map element.
+func keyPathSegment(key string) string {
+	return fmt.Sprintf("[%q]", key)
+}
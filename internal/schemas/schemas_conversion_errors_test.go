@@ -0,0 +1,70 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type testConversionConditions struct {
+	IPRanges []string `mapstructure:"ip_ranges"`
+}
+
+type testConversionRule struct {
+	Name       string                   `mapstructure:"name"`
+	Conditions testConversionConditions `mapstructure:"conditions"`
+}
+
+type testConversionPolicy struct {
+	Rules []testConversionRule `mapstructure:"rules"`
+}
+
+type testConversionCertificates struct {
+	Certificates map[string][]byte `mapstructure:"certificates"`
+}
+
+func TestInterfaceTypeToAttrErrorPath(t *testing.T) {
+	ctx := context.Background()
+	conditionsType := types.ObjectType{AttrTypes: map[string]attr.Type{"ip_ranges": types.ListType{ElemType: types.Int64Type}}}
+	policyType := types.ObjectType{AttrTypes: map[string]attr.Type{
+		"rules": types.ListType{ElemType: types.ObjectType{AttrTypes: map[string]attr.Type{
+			"name":       types.StringType,
+			"conditions": conditionsType,
+		}}},
+	}}
+	policy := testConversionPolicy{Rules: []testConversionRule{
+		{Name: "allow"},
+		{Name: "office", Conditions: testConversionConditions{IPRanges: []string{"10.0.0.0/8"}}},
+	}}
+
+	_, err := interfaceTypeToAttr(ctx, policy, policyType)
+	var conversionErr *AttributeConversionError
+	if !errors.As(err, &conversionErr) {
+		t.Fatalf("expected an AttributeConversionError, got %v", err)
+	}
+	if conversionErr.Path != "rules[1].conditions.ip_ranges[0]" {
+		t.Errorf("expected the path of the failing attribute, got %q", conversionErr.Path)
+	}
+}
+
+func TestObjectToMapErrorPath(t *testing.T) {
+	certificates, _ := types.MapValue(types.StringType, map[string]attr.Value{"root": types.StringValue("not base64!")})
+	obj, _ := types.ObjectValue(map[string]attr.Type{"certificates": types.MapType{ElemType: types.StringType}}, map[string]attr.Value{
+		"certificates": certificates,
+	})
+
+	_, err := objectToMap(obj, &testConversionCertificates{})
+	var conversionErr *AttributeConversionError
+	if !errors.As(err, &conversionErr) {
+		t.Fatalf("expected an AttributeConversionError, got %v", err)
+	}
+	if conversionErr.Path != `certificates["root"]` {
+		t.Errorf("expected the path of the failing map element, got %q", conversionErr.Path)
+	}
+}
@@ -4,16 +4,18 @@
 package schemas
 
 import (
+	"maps"
 	"reflect"
 	"slices"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-func dataSourceSchemaAttrsFromStruct(inputModel interface{}, setAsComputed bool, sensitiveAttrs []string, extraRequiredAttrs []string, computedAsSetAttrs []string) map[string]schema.Attribute {
+func dataSourceSchemaAttrsFromStruct(inputModel interface{}, setAsComputed bool, sensitiveAttrs []string, extraRequiredAttrs []string, computedAsSetAttrs []string, pathPrefix string) map[string]schema.Attribute {
 	modelType := reflect.TypeOf(inputModel)
 	if modelType.Kind() == reflect.Pointer {
 		modelType = modelType.Elem()
@@ -29,11 +31,46 @@ func dataSourceSchemaAttrsFromStruct(inputModel interface{}, setAsComputed bool,
 		validate := field.Tag.Get("validate")
 		choices := field.Tag.Get("choices")
 		fieldName := resolveFieldName(field)
+		fieldPath := fieldName
+		if pathPrefix != "" {
+			fieldPath = pathPrefix + "." + fieldName
+		}
 		isRequired := strings.Contains(required, "true") || strings.Contains(validate, "required") || slices.Contains(extraRequiredAttrs, fieldName)
-		isSensitive := slices.Contains(sensitiveAttrs, fieldName)
+		isSensitive := slices.Contains(sensitiveAttrs, fieldName) || hasSensitiveTag(field)
+		if maxDepth, exceeded := exceedsMaxDepth(field, fieldPath); exceeded {
+			attributes[fieldName] = applyDeprecation(schema.DynamicAttribute{
+				Description: maxDepthDescription(desc, maxDepth),
+				Optional:    !isRequired || setAsComputed,
+				Required:    isRequired && !setAsComputed,
+				Computed:    !isRequired || setAsComputed,
+				Sensitive:   isSensitive,
+			}, depInfo)
+			continue
+		}
 		if fieldType.Kind() == reflect.Pointer {
 			fieldType = fieldType.Elem()
 		}
+		if fieldType == jsonNumberType {
+			attributes[fieldName] = applyDeprecation(schema.NumberAttribute{
+				Description: desc,
+				Optional:    !isRequired || setAsComputed,
+				Required:    isRequired && !setAsComputed,
+				Computed:    !isRequired || setAsComputed,
+				Sensitive:   isSensitive,
+			}, depInfo)
+			continue
+		}
+		if isByteSliceType(fieldType) {
+			attributes[fieldName] = applyDeprecation(schema.StringAttribute{
+				Description: desc,
+				Optional:    !isRequired || setAsComputed,
+				Required:    isRequired && !setAsComputed,
+				Computed:    !isRequired || setAsComputed,
+				Sensitive:   isSensitive,
+				Validators:  []validator.String{Base64Validator{}},
+			}, depInfo)
+			continue
+		}
 		switch fieldType.Kind() {
 		case reflect.String:
 			if setAsComputed {
@@ -56,6 +93,13 @@ func dataSourceSchemaAttrsFromStruct(inputModel interface{}, setAsComputed bool,
 			if choices != "" {
 				strAttr.Validators = append(strAttr.Validators, StringInChoicesValidator{Choices: strings.Split(choices, ",")})
 			}
+			if formatValidator, ok := FormatValidatorOf(field); ok {
+				strAttr.Validators = append(strAttr.Validators, formatValidator)
+			}
+			if refType, ok := ReferenceIDTypeOf(field); ok {
+				strAttr.CustomType = refType
+				strAttr.Description = refType.Describe(strAttr.Description)
+			}
 			attributes[fieldName] = applyDeprecation(strAttr, depInfo)
 		case reflect.Bool:
 			if setAsComputed {
@@ -96,6 +140,25 @@ func dataSourceSchemaAttrsFromStruct(inputModel interface{}, setAsComputed bool,
 				Sensitive:   isSensitive,
 			}
 			attributes[fieldName] = applyDeprecation(int64Attr, depInfo)
+		case reflect.Float32, reflect.Float64:
+			if setAsComputed {
+				floatAttr := schema.Float64Attribute{
+					Description: desc,
+					Optional:    true,
+					Computed:    true,
+					Sensitive:   isSensitive,
+				}
+				attributes[fieldName] = applyDeprecation(floatAttr, depInfo)
+				continue
+			}
+			float64Attr := schema.Float64Attribute{
+				Description: desc,
+				Optional:    !isRequired,
+				Required:    isRequired,
+				Computed:    !isRequired,
+				Sensitive:   isSensitive,
+			}
+			attributes[fieldName] = applyDeprecation(float64Attr, depInfo)
 		case reflect.Slice, reflect.Array:
 			// Inner dynamic types are not supported in terraform
 			if hasInterfaceInnerType(fieldType) {
@@ -117,12 +180,12 @@ func dataSourceSchemaAttrsFromStruct(inputModel interface{}, setAsComputed bool,
 				}, depInfo)
 				continue
 			}
-			if slices.Contains(simpleTypes, fieldType.Elem().Kind()) {
+			if slices.Contains(simpleTypes, fieldType.Elem().Kind()) || isByteSliceType(fieldType.Elem()) {
 				terraType, err := reflectTypeToTerraformType(fieldType.Elem())
 				if err != nil {
 					continue
 				}
-				if slices.Contains(computedAsSetAttrs, fieldName) {
+				if slices.Contains(computedAsSetAttrs, fieldName) || isUnordered(field) {
 					sliceAttr := schema.SetAttribute{
 						ElementType: terraType,
 						Description: desc,
@@ -134,6 +197,9 @@ func dataSourceSchemaAttrsFromStruct(inputModel interface{}, setAsComputed bool,
 					if choices != "" {
 						sliceAttr.Validators = append(sliceAttr.Validators, SliceInSetValidator{Choices: strings.Split(choices, ",")})
 					}
+					if formatValidator, ok := FormatValidatorOf(field); ok {
+						sliceAttr.Validators = append(sliceAttr.Validators, formatValidator)
+					}
 					attributes[fieldName] = applyDeprecation(sliceAttr, depInfo)
 				} else {
 					if setAsComputed {
@@ -158,6 +224,9 @@ func dataSourceSchemaAttrsFromStruct(inputModel interface{}, setAsComputed bool,
 					if choices != "" {
 						sliceAttr.Validators = append(sliceAttr.Validators, SliceInChoicesValidator{Choices: strings.Split(choices, ",")})
 					}
+					if formatValidator, ok := FormatValidatorOf(field); ok {
+						sliceAttr.Validators = append(sliceAttr.Validators, formatValidator)
+					}
 					attributes[fieldName] = applyDeprecation(sliceAttr, depInfo)
 				}
 			}
@@ -188,9 +257,9 @@ func dataSourceSchemaAttrsFromStruct(inputModel interface{}, setAsComputed bool,
 			}
 			if fieldType.Elem().Kind() == reflect.Struct {
 				// Handle nested structs by recursively generating their schema
-				nestedSchemaAttrs := dataSourceSchemaAttrsFromStruct(reflect.New(fieldType.Elem()).Elem().Interface(), setAsComputed, sensitiveAttrs, extraRequiredAttrs, computedAsSetAttrs)
+				nestedSchemaAttrs := dataSourceSchemaAttrsFromStruct(reflect.New(fieldType.Elem()).Elem().Interface(), setAsComputed, sensitiveAttrs, extraRequiredAttrs, computedAsSetAttrs, fieldPath)
 				// Mirror the resource schema: order-insensitive nested object slices are modeled as sets.
-				if slices.Contains(computedAsSetAttrs, fieldName) {
+				if slices.Contains(computedAsSetAttrs, fieldName) || isUnordered(field) {
 					setAttr := schema.SetNestedAttribute{
 						NestedObject: schema.NestedAttributeObject{
 							Attributes: nestedSchemaAttrs,
@@ -233,6 +302,10 @@ func dataSourceSchemaAttrsFromStruct(inputModel interface{}, setAsComputed bool,
 				}, depInfo)
 			}
 		case reflect.Map:
+			// Terraform maps are keyed by strings, integer keys are stringified
+			if !isSupportedMapKeyKind(fieldType.Key().Kind()) {
+				continue
+			}
 			// Inner dynamic types are not supported in terraform
 			if hasInterfaceInnerType(fieldType) {
 				if setAsComputed {
@@ -253,7 +326,7 @@ func dataSourceSchemaAttrsFromStruct(inputModel interface{}, setAsComputed bool,
 				}, depInfo)
 				continue
 			}
-			if slices.Contains(simpleTypes, fieldType.Elem().Kind()) {
+			if slices.Contains(simpleTypes, fieldType.Elem().Kind()) || isByteSliceType(fieldType.Elem()) {
 				terraType, err := reflectTypeToTerraformType(fieldType.Elem())
 				if err != nil {
 					continue
@@ -296,7 +369,7 @@ func dataSourceSchemaAttrsFromStruct(inputModel interface{}, setAsComputed bool,
 					Sensitive:   isSensitive,
 				}, depInfo)
 			} else if fieldType.Elem().Kind() == reflect.Struct {
-				nestedAttrs := dataSourceSchemaAttrsFromStruct(reflect.New(fieldType.Elem()).Elem().Interface(), setAsComputed, sensitiveAttrs, extraRequiredAttrs, computedAsSetAttrs)
+				nestedAttrs := dataSourceSchemaAttrsFromStruct(reflect.New(fieldType.Elem()).Elem().Interface(), setAsComputed, sensitiveAttrs, extraRequiredAttrs, computedAsSetAttrs, fieldPath)
 				if setAsComputed {
 					complexMapAttr := schema.MapNestedAttribute{
 						NestedObject: schema.NestedAttributeObject{
@@ -324,7 +397,7 @@ func dataSourceSchemaAttrsFromStruct(inputModel interface{}, setAsComputed bool,
 			}
 		case reflect.Struct:
 			// Handle nested structs by recursively generating their schema
-			nestedSchemaAttrs := dataSourceSchemaAttrsFromStruct(reflect.New(fieldType).Elem().Interface(), setAsComputed, sensitiveAttrs, extraRequiredAttrs, computedAsSetAttrs)
+			nestedSchemaAttrs := dataSourceSchemaAttrsFromStruct(reflect.New(fieldType).Elem().Interface(), setAsComputed, sensitiveAttrs, extraRequiredAttrs, computedAsSetAttrs, fieldPath)
 			if setAsComputed {
 				attributes[fieldName] = applyDeprecation(schema.SingleNestedAttribute{
 					Attributes:  nestedSchemaAttrs,
@@ -344,6 +417,27 @@ func dataSourceSchemaAttrsFromStruct(inputModel interface{}, setAsComputed bool,
 				Sensitive:   isSensitive,
 			}, depInfo)
 		case reflect.Interface:
+			if union, ok := fieldUnion(field); ok {
+				variantAttrs := map[string]schema.Attribute{}
+				for _, name := range union.variantNames() {
+					variantAttrs[name] = schema.SingleNestedAttribute{
+						Attributes:  dataSourceSchemaAttrsFromStruct(union.variantPrototype(name), setAsComputed, sensitiveAttrs, extraRequiredAttrs, computedAsSetAttrs, fieldPath+"."+name),
+						Description: union.variantDescription(name),
+						Optional:    true,
+						Sensitive:   isSensitive,
+					}
+				}
+				attributes[fieldName] = applyDeprecation(schema.SingleNestedAttribute{
+					Attributes:  variantAttrs,
+					Description: desc,
+					Optional:    !isRequired || setAsComputed,
+					Required:    isRequired && !setAsComputed,
+					Computed:    !isRequired || setAsComputed,
+					Sensitive:   isSensitive,
+					Validators:  []validator.Object{union.validator()},
+				}, depInfo)
+				continue
+			}
 			if setAsComputed {
 				attributes[fieldName] = applyDeprecation(schema.DynamicAttribute{
 					Description: desc,
@@ -364,6 +458,7 @@ func dataSourceSchemaAttrsFromStruct(inputModel interface{}, setAsComputed bool,
 			continue
 		}
 	}
+	applyDataSourceJSONStringValidators(attributes)
 	return attributes
 }
 
@@ -497,11 +592,13 @@ func forceComputedAttributesReadOnlyDataSource(attributes map[string]schema.Attr
 	}
 }
 
-// collectAllNestedAttributePaths recursively collects all attribute paths within a nested attribute.
+// collectAllNestedAttributePaths recursively collects all attribute paths within a nested attribute, in
+// sorted order.
 func collectAllNestedAttributePaths(attrs map[string]schema.Attribute, prefix string) []string {
 	paths := make([]string, 0)
 
-	for key, attr := range attrs {
+	for _, key := range slices.Sorted(maps.Keys(attrs)) {
+		attr := attrs[key]
 		fullPath := key
 		if prefix != "" {
 			fullPath = prefix + "." + key
@@ -532,11 +629,13 @@ func collectAllNestedAttributePaths(attrs map[string]schema.Attribute, prefix st
 }
 
 // mergeNestedAttributesAndFindReadOnly recursively merges nested attributes from state model into input model
-// and returns a list of attribute paths (using dot notation) that exist only in the state model.
+// and returns a list of attribute paths (using dot notation) that exist only in the state model, sorted
+// per nesting level.
 func mergeNestedAttributesAndFindReadOnly(inputAttrs map[string]schema.Attribute, stateAttrs map[string]schema.Attribute, prefix string) []string {
 	readOnlyAttrs := make([]string, 0)
 
-	for key, stateAttr := range stateAttrs {
+	for _, key := range slices.Sorted(maps.Keys(stateAttrs)) {
+		stateAttr := stateAttrs[key]
 		fullPath := key
 		if prefix != "" {
 			fullPath = prefix + "." + key
@@ -620,9 +719,9 @@ func mergeNestedAttributesAndFindReadOnly(inputAttrs map[string]schema.Attribute
 func GenerateDataSourceSchemaFromStruct(inputModel interface{}, stateModel interface{}, sensitiveAttrs []string, extraRequiredAttrs []string, computedAsSetAttrs []string) schema.Schema {
 	inputModelAttrs := make(map[string]schema.Attribute)
 	if inputModel != nil {
-		inputModelAttrs = dataSourceSchemaAttrsFromStruct(inputModel, false, sensitiveAttrs, extraRequiredAttrs, computedAsSetAttrs)
+		inputModelAttrs = dataSourceSchemaAttrsFromStruct(inputModel, false, sensitiveAttrs, extraRequiredAttrs, computedAsSetAttrs, "")
 	}
-	outputModelAttrs := dataSourceSchemaAttrsFromStruct(stateModel, true, sensitiveAttrs, extraRequiredAttrs, computedAsSetAttrs)
+	outputModelAttrs := dataSourceSchemaAttrsFromStruct(stateModel, true, sensitiveAttrs, extraRequiredAttrs, computedAsSetAttrs, "")
 
 	// Track which attributes are only in the state model (read-only)
 	// This function will merge nested attributes and identify read-only ones
@@ -4,10 +4,14 @@
 package schemas
 
 import (
+	"context"
 	"slices"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // Test helper structs for data source testing
@@ -187,6 +191,33 @@ func TestCollectAllNestedAttributePaths(t *testing.T) {
 	}
 }
 
+// TestNestedAttributePathsSorted tests that the collected attribute paths do not depend on map iteration order.
+func TestNestedAttributePathsSorted(t *testing.T) {
+	t.Parallel()
+
+	nested := func() map[string]schema.Attribute {
+		return map[string]schema.Attribute{
+			"zeta":  schema.StringAttribute{},
+			"alpha": schema.StringAttribute{},
+			"mid": schema.SingleNestedAttribute{Attributes: map[string]schema.Attribute{
+				"y": schema.StringAttribute{},
+				"b": schema.StringAttribute{},
+			}},
+			"beta":  schema.StringAttribute{},
+			"gamma": schema.StringAttribute{},
+		}
+	}
+	expected := []string{"alpha", "beta", "gamma", "mid", "mid.b", "mid.y", "zeta"}
+	for i := 0; i < 10; i++ {
+		if paths := collectAllNestedAttributePaths(nested(), ""); !slices.Equal(paths, expected) {
+			t.Fatalf("collectAllNestedAttributePaths() = %v, want %v", paths, expected)
+		}
+		if paths := mergeNestedAttributesAndFindReadOnly(map[string]schema.Attribute{}, nested(), ""); !slices.Equal(paths, expected) {
+			t.Fatalf("mergeNestedAttributesAndFindReadOnly() = %v, want %v", paths, expected)
+		}
+	}
+}
+
 // TestMergeNestedAttributesAndFindReadOnly tests the mergeNestedAttributesAndFindReadOnly function.
 func TestMergeNestedAttributesAndFindReadOnly(t *testing.T) {
 	t.Parallel()
@@ -393,6 +424,19 @@ func TestGenerateDataSourceSchemaFromStructNestedAttributes(t *testing.T) {
 		computedAsSetAttrs []string
 		validateFunc       func(t *testing.T, result schema.Schema)
 	}{
+		{
+			name:       "success_sensitive_tag",
+			inputModel: &testSensitiveTagModel{},
+			stateModel: &testSensitiveTagModel{},
+			validateFunc: func(t *testing.T, result schema.Schema) {
+				if !result.Attributes["password"].IsSensitive() {
+					t.Error("Expected password to be sensitive")
+				}
+				if result.Attributes["username"].IsSensitive() {
+					t.Error("Expected username to not be sensitive")
+				}
+			},
+		},
 		{
 			name:       "success_nested_attribute_only_in_state",
 			inputModel: &testDataSourceInputModel{},
@@ -586,3 +630,39 @@ func TestGenerateDataSourceSchemaFromStructNestedAttributes(t *testing.T) {
 		})
 	}
 }
+
+func TestAtLeastOneOfValidator(t *testing.T) {
+	t.Parallel()
+
+	lookupSchema := schema.Schema{Attributes: map[string]schema.Attribute{
+		"role_id":   schema.StringAttribute{Optional: true},
+		"role_name": schema.StringAttribute{Optional: true},
+	}}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"role_id": tftypes.String, "role_name": tftypes.String}}
+	tests := []struct {
+		name      string
+		roleID    interface{}
+		roleName  interface{}
+		wantError bool
+	}{
+		{name: "success_id", roleID: "role-1"},
+		{name: "success_name", roleName: "Admins"},
+		{name: "success_unknown", roleName: tftypes.UnknownValue},
+		{name: "error_none", wantError: true},
+		{name: "error_empty_strings", roleID: "", roleName: "", wantError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			config := tfsdk.Config{Schema: lookupSchema, Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"role_id":   tftypes.NewValue(tftypes.String, tt.roleID),
+				"role_name": tftypes.NewValue(tftypes.String, tt.roleName),
+			})}
+			resp := &datasource.ValidateConfigResponse{}
+			AtLeastOneOfValidator{Attributes: []string{"role_id", "role_name"}}.ValidateDataSource(context.Background(), datasource.ValidateConfigRequest{Config: config}, resp)
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("expected error=%v, got diagnostics %v", tt.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// PreventDestroyAPISideAttributeName is the name of the attribute protecting a resource from deletion.
+const PreventDestroyAPISideAttributeName = "prevent_destroy_api_side"
+
+// PreventDestroyAPISideAttribute returns the optional prevent_destroy_api_side attribute. Unlike the
+// prevent_destroy lifecycle argument, its value is kept in state, so it also guards resources removed from
+// the configuration.
+func PreventDestroyAPISideAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional:    true,
+		Description: "Whether to protect the resource from deletion. While true, destroying or replacing the resource fails, even after it is removed from the configuration; set it to false and apply before destroying the resource.",
+	}
+}
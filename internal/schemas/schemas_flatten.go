@@ -0,0 +1,137 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"reflect"
+	"strconv"
+)
+
+// FlattenTag is the model field tag inlining a struct with a single field at the level of the field holding
+// it, e.g. `flatten:"true"` on a `Name NameWrapper` field whose struct only holds a `Value string` makes
+// `name` a string attribute rather than an object with a single `value` attribute. Fields holding structs
+// with more than one field are not flattened.
+const FlattenTag = "flatten"
+
+// flattenedField returns the field a field tagged with FlattenTag is generated and converted as: the single
+// field of the struct it holds, named and tagged as the field itself. A pointer to the struct makes the
+// flattened field a pointer, so an absent struct maps to null. The second return value is false for fields
+// that are not flattened.
+func flattenedField(field reflect.StructField) (reflect.StructField, bool) {
+	wrappedField, ok := flattenWrappedField(field)
+	if !ok {
+		return field, false
+	}
+	flattened := field
+	flattened.Type = wrappedField.Type
+	if field.Type.Kind() == reflect.Pointer && flattened.Type.Kind() != reflect.Pointer {
+		flattened.Type = reflect.PointerTo(flattened.Type)
+	}
+	return flattened, true
+}
+
+// flattenWrappedField returns the single field of the struct held by a field tagged with FlattenTag, as
+// declared in the struct, and false when the field is not flattened.
+func flattenWrappedField(field reflect.StructField) (reflect.StructField, bool) {
+	flatten, err := strconv.ParseBool(field.Tag.Get(FlattenTag))
+	if err != nil || !flatten {
+		return field, false
+	}
+	wrapperType := field.Type
+	if wrapperType.Kind() == reflect.Pointer {
+		wrapperType = wrapperType.Elem()
+	}
+	if wrapperType.Kind() != reflect.Struct {
+		return field, false
+	}
+	wrappedFields := resolveFieldsSquashed(wrapperType)
+	if len(wrappedFields) != 1 {
+		return field, false
+	}
+	wrappedField, ok := wrapperType.FieldByName(wrappedFields[0].Name)
+	return wrappedField, ok
+}
+
+// flattenedFieldValue returns the value of the single field of the struct held by value, a field tagged with
+// FlattenTag, typed as flattenedField returns it. A nil pointer to the struct gives a nil pointer.
+func flattenedFieldValue(value reflect.Value, flattened reflect.StructField) reflect.Value {
+	wrapper := value
+	if wrapper.Kind() == reflect.Pointer {
+		if wrapper.IsNil() {
+			return reflect.Zero(flattened.Type)
+		}
+		wrapper = wrapper.Elem()
+	}
+	wrapped := resolveFieldsValueSquashed(wrapper)[0]
+	if flattened.Type.Kind() != reflect.Pointer || wrapped.Kind() == reflect.Pointer {
+		return wrapped
+	}
+	if wrapped.CanAddr() {
+		return wrapped.Addr()
+	}
+	pointer := reflect.New(wrapped.Type())
+	pointer.Elem().Set(wrapped)
+	return pointer
+}
+
+// settableFlattenedField returns the field of structVal a resolved field is set through, which for a field
+// tagged with FlattenTag is the single field of the struct it holds. A nil pointer to that struct is only
+// allocated when allocate is true, so a flattened field left unset keeps the struct absent.
+func settableFlattenedField(structVal reflect.Value, field reflect.StructField, allocate bool) reflect.Value {
+	target := structVal.FieldByName(field.Name)
+	if !target.IsValid() {
+		return target
+	}
+	structField, _ := structVal.Type().FieldByName(field.Name)
+	if _, ok := flattenedField(structField); !ok {
+		return target
+	}
+	if target.Kind() == reflect.Pointer {
+		if target.IsNil() {
+			if !allocate || !target.CanSet() {
+				return reflect.Value{}
+			}
+			target.Set(reflect.New(target.Type().Elem()))
+		}
+		target = target.Elem()
+	}
+	wrappedField, _ := flattenWrappedField(structField)
+	return settableFlattenedField(target, wrappedField, allocate)
+}
+
+// wrapFlattenedValue wraps the decoded value of an attribute of a field tagged with FlattenTag in the map
+// the struct it holds is decoded from by mapstructure.
+func wrapFlattenedValue(field reflect.StructField, value interface{}) interface{} {
+	wrappedField, ok := flattenWrappedField(field)
+	if !ok {
+		return value
+	}
+	return map[string]interface{}{decodeFieldName(wrappedField): wrapFlattenedValue(wrappedField, value)}
+}
+
+// flattenedFieldsByKey returns the fields of a prototype struct tagged with FlattenTag, including those of
+// squashed structs, by the key their attribute is decoded from.
+func flattenedFieldsByKey(prototype interface{}) map[string]reflect.StructField {
+	fields := map[string]reflect.StructField{}
+	prototypeType := reflect.TypeOf(prototype)
+	for prototypeType != nil && prototypeType.Kind() == reflect.Pointer {
+		prototypeType = prototypeType.Elem()
+	}
+	if prototypeType == nil || prototypeType.Kind() != reflect.Struct {
+		return fields
+	}
+	for i := 0; i < prototypeType.NumField(); i++ {
+		field := prototypeType.Field(i)
+		if field.Tag.Get("mapstructure") == ",squash" {
+			for key, squashed := range flattenedFieldsByKey(reflect.New(field.Type).Interface()) {
+				fields[key] = squashed
+			}
+			continue
+		}
+		if _, ok := flattenWrappedField(field); ok && field.PkgPath == "" {
+			fields[decodeFieldName(field)] = field
+		}
+	}
+	return fields
+}
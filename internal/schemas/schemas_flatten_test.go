@@ -0,0 +1,135 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testFlattenName struct {
+	Value string `json:"value" mapstructure:"value"`
+}
+
+type testFlattenLimits struct {
+	MaxSessions int `json:"max_sessions" mapstructure:"max_sessions"`
+	MaxRetries  int `json:"max_retries" mapstructure:"max_retries"`
+}
+
+type testFlattenModel struct {
+	ID          string             `json:"id" mapstructure:"id"`
+	Name        testFlattenName    `json:"name" mapstructure:"name" flatten:"true"`
+	Description *testFlattenName   `json:"description,omitempty" mapstructure:"description" flatten:"true"`
+	Limits      *testFlattenLimits `json:"limits,omitempty" mapstructure:"limits" flatten:"true"`
+}
+
+var testFlattenObjectType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+	"id":          tftypes.String,
+	"name":        tftypes.String,
+	"description": tftypes.String,
+	"limits":      tftypes.Object{AttributeTypes: map[string]tftypes.Type{"max_sessions": tftypes.Number, "max_retries": tftypes.Number}},
+}}
+
+func testFlattenValue(id, name, description interface{}) tftypes.Value {
+	return tftypes.NewValue(testFlattenObjectType, map[string]tftypes.Value{
+		"id":          tftypes.NewValue(tftypes.String, id),
+		"name":        tftypes.NewValue(tftypes.String, name),
+		"description": tftypes.NewValue(tftypes.String, description),
+		"limits":      tftypes.NewValue(testFlattenObjectType.AttributeTypes["limits"], nil),
+	})
+}
+
+func TestGenerateSchemaFlatten(t *testing.T) {
+	t.Parallel()
+
+	generated := GenerateResourceSchemaFromStruct(&testFlattenModel{}, nil, nil, ResourceSchemaOptions{})
+	if _, ok := generated.Attributes["name"].(schema.StringAttribute); !ok {
+		t.Errorf("expected the flattened name to be a string, got %T", generated.Attributes["name"])
+	}
+	if _, ok := generated.Attributes["description"].(schema.StringAttribute); !ok {
+		t.Errorf("expected the flattened pointer description to be a string, got %T", generated.Attributes["description"])
+	}
+	if _, ok := generated.Attributes["limits"].(schema.SingleNestedAttribute); !ok {
+		t.Errorf("expected limits, which has two fields, not to be flattened, got %T", generated.Attributes["limits"])
+	}
+}
+
+func TestStructToStateObjectFlatten(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	generated := GenerateResourceSchemaFromStruct(&testFlattenModel{}, nil, nil, ResourceSchemaOptions{})
+	schemaAttrs := ResourceSchemaToSchemaAttrTypes(generated)
+
+	stateObj, err := StructToStateObject(ctx, &testFlattenModel{ID: "1", Name: testFlattenName{Value: "web"}}, nil, nil, schemaAttrs, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if value := stateObj.Attributes()["name"]; !value.Equal(types.StringValue("web")) {
+		t.Errorf("expected name to be stored as the value of its struct, got %v", value)
+	}
+	if value := stateObj.Attributes()["description"]; !value.IsNull() {
+		t.Errorf("expected a nil description struct to be stored as null, got %v", value)
+	}
+
+	stateObj, err = StructToStateObject(ctx, &testFlattenModel{ID: "1", Description: &testFlattenName{Value: "front"}}, nil, nil, schemaAttrs, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if value := stateObj.Attributes()["description"]; !value.Equal(types.StringValue("front")) {
+		t.Errorf("expected description to be stored as the value of its struct, got %v", value)
+	}
+}
+
+func TestStructFromPlanObjectFlatten(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	generated := GenerateResourceSchemaFromStruct(&testFlattenModel{}, nil, nil, ResourceSchemaOptions{})
+
+	plan := &tfsdk.Plan{Schema: generated, Raw: testFlattenValue("1", "web", "front")}
+	result, err := StructFromPlanObject(ctx, plan, &testFlattenModel{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	model := result.(*testFlattenModel)
+	if model.Name.Value != "web" || model.Description == nil || model.Description.Value != "front" {
+		t.Errorf("expected the flattened attributes to decode into their structs, got %+v", model)
+	}
+
+	plan = &tfsdk.Plan{Schema: generated, Raw: testFlattenValue("1", "web", nil)}
+	result, err = StructFromPlanObject(ctx, plan, &testFlattenModel{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if model := result.(*testFlattenModel); model.Description != nil {
+		t.Errorf("expected a null description to leave its struct absent, got %+v", model.Description)
+	}
+}
+
+func TestStructFromPlanAndStateObjectFlatten(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	generated := GenerateResourceSchemaFromStruct(&testFlattenModel{}, nil, nil, ResourceSchemaOptions{})
+	state := &tfsdk.State{Schema: generated, Raw: testFlattenValue("1", "web", "front")}
+	plan := &tfsdk.Plan{Schema: generated, Raw: testFlattenValue("1", "api", nil)}
+
+	result, err := StructFromPlanAndStateObject(ctx, plan, state, &testFlattenModel{}, &testFlattenModel{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	model := result.(*testFlattenModel)
+	if model.Name.Value != "api" {
+		t.Errorf("expected the planned name, got %q", model.Name.Value)
+	}
+	if model.Description == nil || model.Description.Value != "front" {
+		t.Errorf("expected description unset in the plan to keep its state value, got %+v", model.Description)
+	}
+}
@@ -0,0 +1,245 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"net/netip"
+	"net/url"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// FormatTag is the model field tag declaring the format of a string field, or of the elements of a
+// string slice, e.g. `format:"cidr"`. The generated attribute gets the matching format validator, so
+// malformed values fail validation instead of the API call.
+const FormatTag = "format"
+
+// FormatValidator validates strings of a format, alone or as the elements of lists and sets.
+type FormatValidator interface {
+	validator.String
+	validator.List
+	validator.Set
+}
+
+// formatValidators are the validators a format tag can select, keyed by tag value.
+var formatValidators = map[string]FormatValidator{
+	"cidr":  CIDRValidator{},
+	"ip":    IPValidator{},
+	"url":   URLValidator{},
+	"email": EmailValidator{},
+}
+
+// FormatValidatorOf returns the validator of the format declared by the format tag of a model field. The
+// second return value is false when the field has no format tag or its format is unknown.
+func FormatValidatorOf(field reflect.StructField) (FormatValidator, bool) {
+	formatValidator, ok := formatValidators[field.Tag.Get(FormatTag)]
+	return formatValidator, ok
+}
+
+// CIDRValidator ensures a string is an IPv4 or IPv6 CIDR block, e.g. "10.0.0.0/16".
+type CIDRValidator struct{}
+
+// Description returns a description of the validator.
+func (v CIDRValidator) Description(ctx context.Context) string {
+	return "Value must be a CIDR block, e.g. 10.0.0.0/16"
+}
+
+// MarkdownDescription returns a markdown description of the validator.
+func (v CIDRValidator) MarkdownDescription(ctx context.Context) string {
+	return "Value must be a CIDR block, e.g. `10.0.0.0/16`"
+}
+
+// ValidateString checks the configured string is a CIDR block.
+func (v CIDRValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	validateFormatString(req, resp, "Invalid CIDR Value", checkCIDR)
+}
+
+// ValidateList checks all strings in the list are CIDR blocks.
+func (v CIDRValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	validateFormatList(req, resp, "Invalid CIDR Value in List", checkCIDR)
+}
+
+// ValidateSet checks all strings in the set are CIDR blocks.
+func (v CIDRValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	validateFormatSet(req, resp, "Invalid CIDR Value in Set", checkCIDR)
+}
+
+// checkCIDR returns an error when value is not a CIDR block.
+func checkCIDR(value string) error {
+	if _, err := netip.ParsePrefix(value); err != nil {
+		return fmt.Errorf("value must be a CIDR block such as 10.0.0.0/16, got %q", value)
+	}
+	return nil
+}
+
+// IPValidator ensures a string is an IPv4 or IPv6 address, e.g. "10.0.0.1".
+type IPValidator struct{}
+
+// Description returns a description of the validator.
+func (v IPValidator) Description(ctx context.Context) string {
+	return "Value must be an IP address, e.g. 10.0.0.1"
+}
+
+// MarkdownDescription returns a markdown description of the validator.
+func (v IPValidator) MarkdownDescription(ctx context.Context) string {
+	return "Value must be an IP address, e.g. `10.0.0.1`"
+}
+
+// ValidateString checks the configured string is an IP address.
+func (v IPValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	validateFormatString(req, resp, "Invalid IP Address Value", checkIP)
+}
+
+// ValidateList checks all strings in the list are IP addresses.
+func (v IPValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	validateFormatList(req, resp, "Invalid IP Address Value in List", checkIP)
+}
+
+// ValidateSet checks all strings in the set are IP addresses.
+func (v IPValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	validateFormatSet(req, resp, "Invalid IP Address Value in Set", checkIP)
+}
+
+// checkIP returns an error when value is not an IP address. A CIDR block is rejected with a hint, as
+// it is the common mistake.
+func checkIP(value string) error {
+	if _, err := netip.ParseAddr(value); err != nil {
+		if _, prefixErr := netip.ParsePrefix(value); prefixErr == nil {
+			return fmt.Errorf("value must be a single IP address, got the CIDR block %q", value)
+		}
+		return fmt.Errorf("value must be an IP address such as 10.0.0.1, got %q", value)
+	}
+	return nil
+}
+
+// URLValidator ensures a string is an absolute URL, with a scheme and a host, e.g.
+// "https://hooks.example.com/notify".
+type URLValidator struct{}
+
+// Description returns a description of the validator.
+func (v URLValidator) Description(ctx context.Context) string {
+	return "Value must be an absolute URL, e.g. https://hooks.example.com/notify"
+}
+
+// MarkdownDescription returns a markdown description of the validator.
+func (v URLValidator) MarkdownDescription(ctx context.Context) string {
+	return "Value must be an absolute URL, e.g. `https://hooks.example.com/notify`"
+}
+
+// ValidateString checks the configured string is an absolute URL.
+func (v URLValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	validateFormatString(req, resp, "Invalid URL Value", checkURL)
+}
+
+// ValidateList checks all strings in the list are absolute URLs.
+func (v URLValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	validateFormatList(req, resp, "Invalid URL Value in List", checkURL)
+}
+
+// ValidateSet checks all strings in the set are absolute URLs.
+func (v URLValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	validateFormatSet(req, resp, "Invalid URL Value in Set", checkURL)
+}
+
+// checkURL returns an error when value is not an absolute URL.
+func checkURL(value string) error {
+	parsed, err := url.Parse(value)
+	if err != nil {
+		return fmt.Errorf("value must be a URL: %s", err.Error())
+	}
+	if parsed.Scheme == "" || parsed.Host == "" {
+		return fmt.Errorf("value must be an absolute URL with a scheme and a host such as https://hooks.example.com/notify, got %q", value)
+	}
+	return nil
+}
+
+// EmailValidator ensures a string is a bare email address, e.g. "admin@example.com", without a display
+// name or angle brackets.
+type EmailValidator struct{}
+
+// Description returns a description of the validator.
+func (v EmailValidator) Description(ctx context.Context) string {
+	return "Value must be an email address, e.g. admin@example.com"
+}
+
+// MarkdownDescription returns a markdown description of the validator.
+func (v EmailValidator) MarkdownDescription(ctx context.Context) string {
+	return "Value must be an email address, e.g. `admin@example.com`"
+}
+
+// ValidateString checks the configured string is an email address.
+func (v EmailValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	validateFormatString(req, resp, "Invalid Email Value", checkEmail)
+}
+
+// ValidateList checks all strings in the list are email addresses.
+func (v EmailValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	validateFormatList(req, resp, "Invalid Email Value in List", checkEmail)
+}
+
+// ValidateSet checks all strings in the set are email addresses.
+func (v EmailValidator) ValidateSet(ctx context.Context, req validator.SetRequest, resp *validator.SetResponse) {
+	validateFormatSet(req, resp, "Invalid Email Value in Set", checkEmail)
+}
+
+// checkEmail returns an error when value is not a bare email address.
+func checkEmail(value string) error {
+	address, err := mail.ParseAddress(value)
+	if err != nil || address.Name != "" || address.Address != value {
+		return fmt.Errorf("value must be an email address such as admin@example.com, got %q", value)
+	}
+	return nil
+}
+
+// validateFormatString reports the error of check for the configured string.
+func validateFormatString(req validator.StringRequest, resp *validator.StringResponse, summary string, check func(string) error) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if err := check(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(req.Path, summary, err.Error())
+	}
+}
+
+// validateFormatList reports the error of check for the first invalid string in the configured list.
+func validateFormatList(req validator.ListRequest, resp *validator.ListResponse, summary string, check func(string) error) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	for i, element := range req.ConfigValue.Elements() {
+		if err := checkFormatElement(element, check); err != nil {
+			resp.Diagnostics.AddAttributeError(req.Path.AtListIndex(i), summary, err.Error())
+			return
+		}
+	}
+}
+
+// validateFormatSet reports the error of check for the first invalid string in the configured set.
+func validateFormatSet(req validator.SetRequest, resp *validator.SetResponse, summary string, check func(string) error) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	for _, element := range req.ConfigValue.Elements() {
+		if err := checkFormatElement(element, check); err != nil {
+			resp.Diagnostics.AddAttributeError(req.Path.AtSetValue(element), summary, err.Error())
+			return
+		}
+	}
+}
+
+// checkFormatElement returns the error of check for a string element. Null and unknown elements, and
+// elements of other types, are not checked.
+func checkFormatElement(element attr.Value, check func(string) error) error {
+	value, ok := element.(basetypes.StringValue)
+	if !ok || value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	return check(value.ValueString())
+}
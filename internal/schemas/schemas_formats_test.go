@@ -0,0 +1,120 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type testNetworkTarget struct {
+	Address  string   `json:"address" mapstructure:"address" format:"ip"`
+	Subnets  []string `json:"subnets" mapstructure:"subnets" format:"cidr"`
+	Webhook  string   `json:"webhook" mapstructure:"webhook" format:"url"`
+	Contacts []string `json:"contacts" mapstructure:"contacts" format:"email" unordered:"true"`
+	Name     string   `json:"name" mapstructure:"name" format:"hostname"`
+}
+
+func TestFormatValidators(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		validator FormatValidator
+		value     types.String
+		valid     bool
+	}{
+		{name: "cidr_ipv4", validator: CIDRValidator{}, value: types.StringValue("10.0.0.0/16"), valid: true},
+		{name: "cidr_ipv6", validator: CIDRValidator{}, value: types.StringValue("2001:db8::/32"), valid: true},
+		{name: "cidr_address", validator: CIDRValidator{}, value: types.StringValue("10.0.0.1"), valid: false},
+		{name: "cidr_prefix_too_long", validator: CIDRValidator{}, value: types.StringValue("10.0.0.0/33"), valid: false},
+		{name: "ip_ipv4", validator: IPValidator{}, value: types.StringValue("10.0.0.1"), valid: true},
+		{name: "ip_ipv6", validator: IPValidator{}, value: types.StringValue("2001:db8::1"), valid: true},
+		{name: "ip_cidr", validator: IPValidator{}, value: types.StringValue("10.0.0.0/16"), valid: false},
+		{name: "ip_hostname", validator: IPValidator{}, value: types.StringValue("server.example.com"), valid: false},
+		{name: "url_https", validator: URLValidator{}, value: types.StringValue("https://hooks.example.com/notify?channel=ops"), valid: true},
+		{name: "url_no_scheme", validator: URLValidator{}, value: types.StringValue("hooks.example.com/notify"), valid: false},
+		{name: "url_relative", validator: URLValidator{}, value: types.StringValue("/notify"), valid: false},
+		{name: "email", validator: EmailValidator{}, value: types.StringValue("admin@example.com"), valid: true},
+		{name: "email_display_name", validator: EmailValidator{}, value: types.StringValue("Admin <admin@example.com>"), valid: false},
+		{name: "email_no_domain", validator: EmailValidator{}, value: types.StringValue("admin"), valid: false},
+		{name: "null", validator: EmailValidator{}, value: types.StringNull(), valid: true},
+		{name: "unknown", validator: CIDRValidator{}, value: types.StringUnknown(), valid: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			resp := &validator.StringResponse{}
+			tt.validator.ValidateString(context.Background(), validator.StringRequest{Path: path.Root("value"), ConfigValue: tt.value}, resp)
+			if resp.Diagnostics.HasError() == tt.valid {
+				t.Errorf("expected valid=%t, got diagnostics %v", tt.valid, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestFormatValidatorsCollections(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	list := types.ListValueMust(types.StringType, []attr.Value{types.StringValue("10.0.0.0/8"), types.StringUnknown(), types.StringValue("10.0.0.1")})
+	listResp := &validator.ListResponse{}
+	CIDRValidator{}.ValidateList(ctx, validator.ListRequest{Path: path.Root("subnets"), ConfigValue: list}, listResp)
+	if listResp.Diagnostics.ErrorsCount() != 1 {
+		t.Fatalf("expected one error, got %v", listResp.Diagnostics)
+	}
+	if errPath := listResp.Diagnostics.Errors()[0].(interface{ Path() path.Path }).Path(); !errPath.Equal(path.Root("subnets").AtListIndex(2)) {
+		t.Errorf("expected the error on the invalid element, got %s", errPath)
+	}
+
+	set := types.SetValueMust(types.StringType, []attr.Value{types.StringValue("admin@example.com"), types.StringValue("ops@example.com")})
+	setResp := &validator.SetResponse{}
+	EmailValidator{}.ValidateSet(ctx, validator.SetRequest{Path: path.Root("contacts"), ConfigValue: set}, setResp)
+	if setResp.Diagnostics.HasError() {
+		t.Errorf("unexpected diagnostics %v", setResp.Diagnostics)
+	}
+}
+
+func TestFormatTag(t *testing.T) {
+	t.Parallel()
+
+	generated := GenerateResourceSchemaFromStruct(&testNetworkTarget{}, nil, nil, ResourceSchemaOptions{})
+	if !hasValidator(generated.Attributes["address"].(schema.StringAttribute).Validators, IPValidator{}) {
+		t.Error("expected the IP validator on address")
+	}
+	if !hasValidator(generated.Attributes["webhook"].(schema.StringAttribute).Validators, URLValidator{}) {
+		t.Error("expected the URL validator on webhook")
+	}
+	if !hasValidator(generated.Attributes["subnets"].(schema.ListAttribute).Validators, CIDRValidator{}) {
+		t.Error("expected the CIDR validator on subnets")
+	}
+	if !hasValidator(generated.Attributes["contacts"].(schema.SetAttribute).Validators, EmailValidator{}) {
+		t.Error("expected the email validator on contacts")
+	}
+	if validators := generated.Attributes["name"].(schema.StringAttribute).Validators; len(validators) != 0 {
+		t.Errorf("expected no validator for an unknown format, got %v", validators)
+	}
+
+	dataSource := GenerateDataSourceSchemaFromStruct(&testNetworkTarget{}, &testNetworkTarget{}, nil, nil, nil)
+	if !hasValidator(dataSource.Attributes["address"].(datasourceschema.StringAttribute).Validators, IPValidator{}) {
+		t.Error("expected the IP validator on the data source address")
+	}
+}
+
+// hasValidator reports whether validators holds want.
+func hasValidator[T any](validators []T, want FormatValidator) bool {
+	for _, v := range validators {
+		if any(v) == any(want) {
+			return true
+		}
+	}
+	return false
+}
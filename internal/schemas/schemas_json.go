@@ -0,0 +1,149 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// JSONStringValidator validates the JSON strings given to dynamic attributes, e.g. with jsonencode() or a
+// heredoc, which are decoded before being sent. Strings starting with { or [ must be valid JSON, and are
+// reported with the line and column of the first error; other strings are taken as plain values.
+type JSONStringValidator struct{}
+
+var _ validator.Dynamic = JSONStringValidator{}
+
+// Description describes the validation.
+func (v JSONStringValidator) Description(ctx context.Context) string {
+	return "Strings starting with { or [ must be valid JSON."
+}
+
+// MarkdownDescription describes the validation in markdown.
+func (v JSONStringValidator) MarkdownDescription(ctx context.Context) string {
+	return "Strings starting with `{` or `[` must be valid JSON."
+}
+
+// ValidateDynamic validates dynamic attributes.
+func (v JSONStringValidator) ValidateDynamic(ctx context.Context, req validator.DynamicRequest, resp *validator.DynamicResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() || req.ConfigValue.IsUnderlyingValueUnknown() {
+		return
+	}
+	value, ok := req.ConfigValue.UnderlyingValue().(types.String)
+	if !ok || value.IsNull() || value.IsUnknown() || !looksLikeJSON(value.ValueString()) {
+		return
+	}
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(value.ValueString()), &decoded); err != nil {
+		line, column := jsonErrorPosition(value.ValueString(), err)
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON",
+			fmt.Sprintf("%s is not valid JSON at line %d, column %d: %s.", req.Path, line, column, err.Error()),
+		)
+	}
+}
+
+// looksLikeJSON reports whether a string is a JSON object or array rather than a plain value.
+func looksLikeJSON(value string) bool {
+	trimmed := strings.TrimSpace(value)
+	return strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[")
+}
+
+// jsonErrorPosition returns the 1-based line and column of a JSON decoding error in document.
+func jsonErrorPosition(document string, err error) (int, int) {
+	offset := int64(len(document))
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &syntaxErr):
+		offset = syntaxErr.Offset
+	case errors.As(err, &typeErr):
+		offset = typeErr.Offset
+	}
+	offset = min(max(offset, 0), int64(len(document)))
+	before := document[:offset]
+	line := strings.Count(before, "\n") + 1
+	column := len(before) - strings.LastIndex(before, "\n")
+	return line, column
+}
+
+// normalizedJSON returns the compact JSON form of a decoded value, with object keys sorted, so the same
+// document formatted differently, such as by jsonencode() or in a heredoc, gives the same bytes.
+func normalizedJSON(value interface{}) ([]byte, bool) {
+	encoded, err := json.Marshal(value)
+	return encoded, err == nil
+}
+
+// equivalentJSONString reports whether prior is a dynamic JSON string holding the same document as value,
+// a dynamic value read from the API.
+func equivalentJSONString(prior attr.Value, value attr.Value) bool {
+	priorDynamic, ok := prior.(types.Dynamic)
+	if !ok || priorDynamic.IsNull() || priorDynamic.IsUnknown() {
+		return false
+	}
+	priorString, ok := priorDynamic.UnderlyingValue().(types.String)
+	if !ok || priorString.IsNull() || priorString.IsUnknown() || !looksLikeJSON(priorString.ValueString()) {
+		return false
+	}
+	dynamic, ok := value.(types.Dynamic)
+	if !ok || dynamic.IsNull() || dynamic.IsUnknown() {
+		return false
+	}
+	var priorDecoded interface{}
+	if err := json.Unmarshal([]byte(priorString.ValueString()), &priorDecoded); err != nil {
+		return false
+	}
+	decoded, err := attrToInterface("", dynamic, nil)
+	if err != nil {
+		return false
+	}
+	priorJSON, ok := normalizedJSON(priorDecoded)
+	if !ok {
+		return false
+	}
+	valueJSON, ok := normalizedJSON(decoded)
+	return ok && bytes.Equal(priorJSON, valueJSON)
+}
+
+// keepEquivalentJSONStrings keeps the prior JSON strings of dynamic attributes read back as the same
+// document, so a value given with jsonencode() or in a heredoc does not drift to the form the API returns.
+func keepEquivalentJSONStrings(values map[string]attr.Value, prior map[string]attr.Value) {
+	for name, value := range values {
+		if priorValue, ok := prior[name]; ok && equivalentJSONString(priorValue, value) {
+			values[name] = priorValue
+		}
+	}
+}
+
+// applyJSONStringValidators adds a JSONStringValidator to the configurable dynamic attributes.
+func applyJSONStringValidators(attributes map[string]schema.Attribute) {
+	for name, attribute := range attributes {
+		if dynamic, ok := attribute.(schema.DynamicAttribute); ok && (dynamic.Optional || dynamic.Required) {
+			dynamic.Validators = append(dynamic.Validators, JSONStringValidator{})
+			attributes[name] = dynamic
+		}
+	}
+}
+
+// applyDataSourceJSONStringValidators adds a JSONStringValidator to the configurable dynamic attributes of
+// a data source.
+func applyDataSourceJSONStringValidators(attributes map[string]datasourceschema.Attribute) {
+	for name, attribute := range attributes {
+		if dynamic, ok := attribute.(datasourceschema.DynamicAttribute); ok && (dynamic.Optional || dynamic.Required) {
+			dynamic.Validators = append(dynamic.Validators, JSONStringValidator{})
+			attributes[name] = dynamic
+		}
+	}
+}
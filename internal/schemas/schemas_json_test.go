@@ -0,0 +1,99 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testJSONModel struct {
+	ID       string                 `json:"id" mapstructure:"id"`
+	Document map[string]interface{} `json:"document,omitempty" mapstructure:"document"`
+}
+
+func TestJSONStringValidator(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		value    types.Dynamic
+		expected string
+	}{
+		{name: "jsonencode", value: types.DynamicValue(types.StringValue(`{"a":1,"b":[true]}`))},
+		{name: "heredoc", value: types.DynamicValue(types.StringValue("{\n  \"a\": 1,\n  \"b\": [true]\n}\n"))},
+		{name: "plain string", value: types.DynamicValue(types.StringValue("value"))},
+		{name: "object", value: types.DynamicValue(types.ObjectValueMust(nil, nil))},
+		{name: "unknown", value: types.DynamicValue(types.StringUnknown())},
+		{name: "invalid heredoc", value: types.DynamicValue(types.StringValue("{\n  \"a\": 1,\n  \"b\": [true,]\n}\n")), expected: "line 3, column 15"},
+		{name: "truncated", value: types.DynamicValue(types.StringValue("[\n  1,")), expected: "line 2, column 5"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &validator.DynamicResponse{}
+			JSONStringValidator{}.ValidateDynamic(context.Background(), validator.DynamicRequest{Path: path.Root("document"), ConfigValue: tt.value}, resp)
+			if tt.expected == "" {
+				if resp.Diagnostics.HasError() {
+					t.Errorf("expected no error, got %v", resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.ErrorsCount() != 1 || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), tt.expected) {
+				t.Errorf("expected an error at %s, got %v", tt.expected, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestGenerateSchemaJSONStringValidator(t *testing.T) {
+	t.Parallel()
+
+	generated := GenerateResourceSchemaFromStruct(&testJSONModel{}, nil, nil, ResourceSchemaOptions{})
+	document, ok := generated.Attributes["document"].(schema.DynamicAttribute)
+	if !ok {
+		t.Fatalf("expected document to be dynamic, got %T", generated.Attributes["document"])
+	}
+	if len(document.Validators) != 1 {
+		t.Errorf("expected the JSON string validator, got %v", document.Validators)
+	}
+}
+
+func TestStructToStateObjectKeepsEquivalentJSONStrings(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	generated := GenerateResourceSchemaFromStruct(&testJSONModel{}, nil, nil, ResourceSchemaOptions{})
+	schemaAttrs := ResourceSchemaToSchemaAttrTypes(generated)
+	objectType := generated.Type().TerraformType(ctx).(tftypes.Object)
+
+	heredoc := "{\n  \"b\": [true],\n  \"a\": 1\n}\n"
+	state := &tfsdk.State{Schema: generated, Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"id":       tftypes.NewValue(tftypes.String, "1"),
+		"document": tftypes.NewValue(tftypes.String, heredoc),
+	})}
+
+	stateObj, err := StructToStateObject(ctx, &testJSONModel{ID: "1", Document: map[string]interface{}{"a": 1, "b": []interface{}{true}}}, state, nil, schemaAttrs, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if value := stateObj.Attributes()["document"]; !value.Equal(types.DynamicValue(types.StringValue(heredoc))) {
+		t.Errorf("expected the equivalent heredoc to be kept, got %v", value)
+	}
+
+	stateObj, err = StructToStateObject(ctx, &testJSONModel{ID: "1", Document: map[string]interface{}{"a": 2, "b": []interface{}{true}}}, state, nil, schemaAttrs, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if value := stateObj.Attributes()["document"]; value.Equal(types.DynamicValue(types.StringValue(heredoc))) {
+		t.Error("expected a changed document to replace the heredoc")
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// RestrictKnownAfterApply makes the top-level Optional+Computed attributes of a resource schema that are
+// not in allowlist plain Optional attributes, so attributes the API never sets on its own stay null in
+// the plan instead of showing as "known after apply". Attributes with a default value and computed-only
+// attributes are left as is. It returns the sorted names of the attributes it changed.
+func RestrictKnownAfterApply(resourceSchema *schema.Schema, allowlist []string) []string {
+	var restricted []string
+	for name, attribute := range resourceSchema.Attributes {
+		if slices.Contains(allowlist, name) {
+			continue
+		}
+		value := reflect.ValueOf(attribute)
+		if value.Kind() != reflect.Struct {
+			continue
+		}
+		copied := reflect.New(value.Type()).Elem()
+		copied.Set(value)
+		optional := copied.FieldByName("Optional")
+		computed := copied.FieldByName("Computed")
+		if !optional.IsValid() || !computed.IsValid() || !optional.Bool() || !computed.Bool() {
+			continue
+		}
+		if defaultValue := copied.FieldByName("Default"); defaultValue.IsValid() && !defaultValue.IsNil() {
+			continue
+		}
+		computed.SetBool(false)
+		resourceSchema.Attributes[name] = copied.Interface().(schema.Attribute)
+		restricted = append(restricted, name)
+	}
+	sort.Strings(restricted)
+	return restricted
+}
+
+// ConfiguredOnlyAttributeNames returns the sorted names of the top-level attributes of a resource schema
+// that are not Computed, whose state value must therefore match the configuration.
+func ConfiguredOnlyAttributeNames(resourceSchema schema.Schema) []string {
+	var names []string
+	for name, attribute := range resourceSchema.Attributes {
+		if !attribute.IsComputed() {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// PinPlannedAttributes sets the named attributes of a state object to their planned values, so values the
+// API returns for attributes that are not Computed do not conflict with the configuration after apply.
+func PinPlannedAttributes(ctx context.Context, plan *tfsdk.Plan, stateResult types.Object, names []string) (types.Object, error) {
+	if len(names) == 0 {
+		return stateResult, nil
+	}
+	var planObj types.Object
+	if diags := plan.Get(ctx, &planObj); diags.HasError() {
+		return stateResult, fmt.Errorf("failed to get plan object: %v", diags)
+	}
+	planned := planObj.Attributes()
+	attributes := make(map[string]attr.Value, len(stateResult.Attributes()))
+	for name, value := range stateResult.Attributes() {
+		attributes[name] = value
+		if plannedValue, ok := planned[name]; ok && slices.Contains(names, name) {
+			attributes[name] = plannedValue
+		}
+	}
+	pinned, diags := types.ObjectValue(stateResult.AttributeTypes(ctx), attributes)
+	if diags.HasError() {
+		return stateResult, fmt.Errorf("failed to pin planned attributes: %v", diags)
+	}
+	return pinned, nil
+}
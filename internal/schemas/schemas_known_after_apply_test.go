@@ -0,0 +1,78 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type knownAfterApplyTestModel struct {
+	Name        string   `json:"name" mapstructure:"name" validate:"required"`
+	Description string   `json:"description,omitempty" mapstructure:"description,omitempty"`
+	Retention   int      `json:"retention,omitempty" mapstructure:"retention,omitempty"`
+	Mode        string   `json:"mode,omitempty" mapstructure:"mode,omitempty" default:"strict"`
+	Tags        []string `json:"tags,omitempty" mapstructure:"tags,omitempty"`
+	CreatedAt   string   `json:"created_at,omitempty" mapstructure:"created_at,omitempty"`
+}
+
+func TestRestrictKnownAfterApply(t *testing.T) {
+	t.Parallel()
+
+	generated := GenerateResourceSchemaFromStruct(&knownAfterApplyTestModel{}, nil, &knownAfterApplyTestModel{}, ResourceSchemaOptions{ComputedAttrs: []string{"created_at"}})
+	restricted := RestrictKnownAfterApply(&generated, []string{"retention"})
+	if expected := []string{"description", "tags"}; !reflect.DeepEqual(restricted, expected) {
+		t.Fatalf("expected restricted attributes %v, got %v", expected, restricted)
+	}
+	for name, computed := range map[string]bool{"name": false, "description": false, "tags": false, "retention": true, "mode": true, "created_at": true} {
+		if generated.Attributes[name].IsComputed() != computed {
+			t.Errorf("expected %s computed=%v", name, computed)
+		}
+	}
+	if !generated.Attributes["description"].IsOptional() {
+		t.Error("expected description to stay optional")
+	}
+	if expected := []string{"description", "name", "tags"}; !reflect.DeepEqual(ConfiguredOnlyAttributeNames(generated), expected) {
+		t.Errorf("expected configured-only attributes %v, got %v", expected, ConfiguredOnlyAttributeNames(generated))
+	}
+}
+
+func TestPinPlannedAttributes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	attrTypes := map[string]attr.Type{"description": types.StringType, "retention": types.Int64Type}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"description": tftypes.String, "retention": tftypes.Number}}
+	generated := GenerateResourceSchemaFromStruct(&struct {
+		Description string `json:"description,omitempty" mapstructure:"description,omitempty"`
+		Retention   int    `json:"retention,omitempty" mapstructure:"retention,omitempty"`
+	}{}, nil, nil, ResourceSchemaOptions{})
+	plan := &tfsdk.Plan{
+		Schema: generated,
+		Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"description": tftypes.NewValue(tftypes.String, nil),
+			"retention":   tftypes.NewValue(tftypes.Number, tftypes.UnknownValue),
+		}),
+	}
+	stateResult, _ := types.ObjectValue(attrTypes, map[string]attr.Value{
+		"description": types.StringValue("set by the API"),
+		"retention":   types.Int64Value(30),
+	})
+	pinned, err := PinPlannedAttributes(ctx, plan, stateResult, []string{"description"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !pinned.Attributes()["description"].IsNull() {
+		t.Errorf("expected description pinned to its null planned value, got %v", pinned.Attributes()["description"])
+	}
+	if !pinned.Attributes()["retention"].Equal(types.Int64Value(30)) {
+		t.Errorf("expected retention kept from the API, got %v", pinned.Attributes()["retention"])
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+const (
+	// CreatedAtAttributeName is the name of the computed attribute holding the creation time of a resource.
+	CreatedAtAttributeName = "created_at"
+	// LastModifiedAtAttributeName is the name of the computed attribute holding the last modification time of a resource.
+	LastModifiedAtAttributeName = "last_modified_at"
+)
+
+// timestampLayouts are the string layouts accepted for API timestamps, tried in order.
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+	time.RFC1123Z,
+	time.RFC1123,
+}
+
+// CreatedAtAttribute returns the computed created_at attribute, which keeps its prior value in plans
+// since the creation time of an object does not change.
+func CreatedAtAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description:         "Time the object was created, as reported by the API, in RFC 3339 format (UTC)",
+		MarkdownDescription: "Time the object was created, as reported by the API, in RFC 3339 format (UTC)",
+		Computed:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+// LastModifiedAtAttribute returns the computed last_modified_at attribute.
+func LastModifiedAtAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description:         "Time the object was last modified, as reported by the API, in RFC 3339 format (UTC)",
+		MarkdownDescription: "Time the object was last modified, as reported by the API, in RFC 3339 format (UTC)",
+		Computed:            true,
+	}
+}
+
+// LifecycleMetaSources maps the created_at and last_modified_at attributes to the state attributes they
+// are populated from. Attributes one of the models already declares map to an empty path, since they
+// are not added.
+func LifecycleMetaSources(createdAtPath string, lastModifiedAtPath string, models ...interface{}) map[string]string {
+	sources := map[string]string{
+		CreatedAtAttributeName:      createdAtPath,
+		LastModifiedAtAttributeName: lastModifiedAtPath,
+	}
+	for _, model := range models {
+		names := topLevelAttributeNames(model)
+		for name := range sources {
+			if names[name] {
+				sources[name] = ""
+			}
+		}
+	}
+	return sources
+}
+
+// NormalizeTimestamp renders an API timestamp as RFC 3339 in UTC. Integers are Unix times, whose unit
+// is inferred from their magnitude since APIs return seconds, milliseconds or microseconds. The second
+// return value is false for null, unknown, zero and unparsable values.
+func NormalizeTimestamp(value attr.Value) (string, bool) {
+	if value == nil || value.IsNull() || value.IsUnknown() {
+		return "", false
+	}
+	switch v := value.(type) {
+	case types.Int64:
+		return unixTimestamp(v.ValueInt64())
+	case types.Number:
+		unix, _ := v.ValueBigFloat().Int64()
+		return unixTimestamp(unix)
+	case types.String:
+		raw := strings.TrimSpace(v.ValueString())
+		if raw == "" {
+			return "", false
+		}
+		for _, layout := range timestampLayouts {
+			if parsed, err := time.Parse(layout, raw); err == nil {
+				return parsed.UTC().Format(time.RFC3339), true
+			}
+		}
+		return "", false
+	default:
+		return "", false
+	}
+}
+
+func unixTimestamp(unix int64) (string, bool) {
+	var parsed time.Time
+	switch {
+	case unix <= 0:
+		return "", false
+	case unix > 1e15:
+		parsed = time.UnixMicro(unix)
+	case unix > 1e12:
+		parsed = time.UnixMilli(unix)
+	default:
+		parsed = time.Unix(unix, 0)
+	}
+	return parsed.UTC().Format(time.RFC3339), true
+}
+
+// SetLifecycleMeta sets the created_at and last_modified_at attributes of a state object from the
+// attributes addressed by createdAtPath and lastModifiedAtPath, or to null when the API did not
+// return them. Attributes missing from the object, or whose source path is empty, are left as is.
+func SetLifecycleMeta(obj types.Object, createdAtPath string, lastModifiedAtPath string) (types.Object, error) {
+	if obj.IsNull() || obj.IsUnknown() {
+		return obj, nil
+	}
+	attrTypes := obj.AttributeTypes(context.Background())
+	attributes := obj.Attributes()
+	for name, sourcePath := range map[string]string{
+		CreatedAtAttributeName:      createdAtPath,
+		LastModifiedAtAttributeName: lastModifiedAtPath,
+	} {
+		if sourcePath == "" || attrTypes[name] != types.StringType {
+			continue
+		}
+		attributes[name] = types.StringNull()
+		if value, ok := objectAttributeByPath(obj, sourcePath); ok {
+			if timestamp, ok := NormalizeTimestamp(value); ok {
+				attributes[name] = types.StringValue(timestamp)
+			}
+		}
+	}
+	result, diags := types.ObjectValue(attrTypes, attributes)
+	if diags.HasError() {
+		return obj, fmt.Errorf("failed to set lifecycle timestamps: %v", diags)
+	}
+	return result, nil
+}
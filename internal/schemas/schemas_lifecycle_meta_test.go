@@ -0,0 +1,87 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestNormalizeTimestamp(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		value    attr.Value
+		expected string
+		ok       bool
+	}{
+		{name: "unix_seconds", value: types.Int64Value(1700000000), expected: "2023-11-14T22:13:20Z", ok: true},
+		{name: "unix_milliseconds", value: types.Int64Value(1700000000123), expected: "2023-11-14T22:13:20Z", ok: true},
+		{name: "unix_microseconds", value: types.Int64Value(1700000000123456), expected: "2023-11-14T22:13:20Z", ok: true},
+		{name: "number", value: types.NumberValue(big.NewFloat(1700000000)), expected: "2023-11-14T22:13:20Z", ok: true},
+		{name: "rfc3339_with_offset", value: types.StringValue("2023-11-15T00:13:20.5+02:00"), expected: "2023-11-14T22:13:20Z", ok: true},
+		{name: "without_zone", value: types.StringValue("2023-11-14 22:13:20"), expected: "2023-11-14T22:13:20Z", ok: true},
+		{name: "zero", value: types.Int64Value(0)},
+		{name: "empty_string", value: types.StringValue("")},
+		{name: "unparsable", value: types.StringValue("yesterday")},
+		{name: "null", value: types.Int64Null()},
+		{name: "unknown", value: types.StringUnknown()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, ok := NormalizeTimestamp(tt.value)
+			if ok != tt.ok || got != tt.expected {
+				t.Errorf("NormalizeTimestamp(%v) = (%q, %v), want (%q, %v)", tt.value, got, ok, tt.expected, tt.ok)
+			}
+		})
+	}
+}
+
+func TestSetLifecycleMeta(t *testing.T) {
+	t.Parallel()
+
+	attrTypes := map[string]attr.Type{
+		"creation_time":             types.Int64Type,
+		"last_modification_time":    types.Int64Type,
+		CreatedAtAttributeName:      types.StringType,
+		LastModifiedAtAttributeName: types.StringType,
+	}
+	obj, _ := types.ObjectValue(attrTypes, map[string]attr.Value{
+		"creation_time":             types.Int64Value(1700000000),
+		"last_modification_time":    types.Int64Null(),
+		CreatedAtAttributeName:      types.StringUnknown(),
+		LastModifiedAtAttributeName: types.StringUnknown(),
+	})
+	result, err := SetLifecycleMeta(obj, "creation_time", "last_modification_time")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := result.Attributes()[CreatedAtAttributeName]; !got.Equal(types.StringValue("2023-11-14T22:13:20Z")) {
+		t.Errorf("expected created_at to be set, got %v", got)
+	}
+	if got := result.Attributes()[LastModifiedAtAttributeName]; !got.IsNull() {
+		t.Errorf("expected last_modified_at to be null when the API returned no time, got %v", got)
+	}
+}
+
+func TestLifecycleMetaSources(t *testing.T) {
+	t.Parallel()
+
+	type modelWithCreatedAt struct {
+		CreatedAt string `mapstructure:"created_at"`
+		UpdatedAt string `mapstructure:"updated_at"`
+	}
+	sources := LifecycleMetaSources("created_at", "updated_at", &modelWithCreatedAt{})
+	if sources[CreatedAtAttributeName] != "" {
+		t.Errorf("expected created_at declared by the model to be skipped, got %q", sources[CreatedAtAttributeName])
+	}
+	if sources[LastModifiedAtAttributeName] != "updated_at" {
+		t.Errorf("expected last_modified_at to map to updated_at, got %q", sources[LastModifiedAtAttributeName])
+	}
+}
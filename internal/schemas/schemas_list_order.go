@@ -0,0 +1,164 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// SortListItems returns a copy of input whose top-level lists of objects are sorted by the id of their
+// items and then their name, so lists read from APIs returning items in an order varying between pages and
+// requests do not reshuffle the resources created with count or for_each over them. The top-level lists
+// are input itself when it is a slice, such as the items of a list resource, and otherwise the list
+// fields of an object wrapping a collection, such as the page of a list data source. Objects with an id
+// or a name are single objects rather than collections and are returned unsorted, as are lists nested in
+// items, whose order may be meaningful. The id is the field named id or, without one, the first field
+// ending in _id, and the name the field named name or, without one, the first field ending in _name.
+// Lists of items with neither keep their order, as do items with the same id and name.
+func SortListItems(input interface{}) interface{} {
+	if input == nil {
+		return nil
+	}
+	sorted := DeepCopy(input)
+	sortTopLevelListItems(reflect.ValueOf(sorted))
+	return sorted
+}
+
+// sortTopLevelListItems sorts in place the top-level lists of v. Elements are moved within the backing
+// arrays of the slices, which does not require v to be addressable.
+func sortTopLevelListItems(v reflect.Value) {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return
+		}
+		v = v.Elem()
+	}
+	switch v.Kind() {
+	case reflect.Slice:
+		sortSliceByItemKey(v)
+	case reflect.Struct:
+		if _, single := listItemKeyOf(v); single {
+			return
+		}
+		for _, field := range resolveFieldsValueSquashed(v) {
+			for field.Kind() == reflect.Pointer && !field.IsNil() {
+				field = field.Elem()
+			}
+			if field.Kind() == reflect.Slice {
+				sortSliceByItemKey(field)
+			}
+		}
+	default:
+	}
+}
+
+// listItemKey holds the id and name of a list item, either of which may be invalid when the item has no
+// such field.
+type listItemKey struct {
+	id   reflect.Value
+	name reflect.Value
+}
+
+// listItemKeyOf returns the id and name of a list item, and false for items that are not objects or have
+// neither field.
+func listItemKeyOf(item reflect.Value) (listItemKey, bool) {
+	for item.Kind() == reflect.Pointer || item.Kind() == reflect.Interface {
+		if item.IsNil() {
+			return listItemKey{}, false
+		}
+		item = item.Elem()
+	}
+	if item.Kind() != reflect.Struct {
+		return listItemKey{}, false
+	}
+	fields := resolveFieldsSquashed(item.Type())
+	values := resolveFieldsValueSquashed(item)
+	var key listItemKey
+	var suffixID, suffixName reflect.Value
+	for i := range fields {
+		switch name := resolveFieldName(fields[i]); {
+		case name == "id":
+			key.id = values[i]
+		case name == "name":
+			key.name = values[i]
+		case strings.HasSuffix(name, "_id") && !suffixID.IsValid():
+			suffixID = values[i]
+		case strings.HasSuffix(name, "_name") && !suffixName.IsValid():
+			suffixName = values[i]
+		}
+	}
+	if !key.id.IsValid() {
+		key.id = suffixID
+	}
+	if !key.name.IsValid() {
+		key.name = suffixName
+	}
+	return key, key.id.IsValid() || key.name.IsValid()
+}
+
+// compareKeyValues compares two id or name values, numerically for numbers and by their printed value
+// otherwise. Nil pointers come first.
+func compareKeyValues(a reflect.Value, b reflect.Value) int {
+	for a.Kind() == reflect.Pointer && b.Kind() == reflect.Pointer {
+		if a.IsNil() || b.IsNil() {
+			return cmp.Compare(boolRank(!a.IsNil()), boolRank(!b.IsNil()))
+		}
+		a, b = a.Elem(), b.Elem()
+	}
+	switch {
+	case !a.IsValid() || !b.IsValid():
+		return cmp.Compare(boolRank(a.IsValid()), boolRank(b.IsValid()))
+	case a.CanInt() && b.CanInt():
+		return cmp.Compare(a.Int(), b.Int())
+	case a.CanUint() && b.CanUint():
+		return cmp.Compare(a.Uint(), b.Uint())
+	case a.CanFloat() && b.CanFloat():
+		return cmp.Compare(a.Float(), b.Float())
+	case a.Kind() == reflect.String && b.Kind() == reflect.String:
+		return cmp.Compare(a.String(), b.String())
+	}
+	return cmp.Compare(fmt.Sprintf("%v", a.Interface()), fmt.Sprintf("%v", b.Interface()))
+}
+
+// boolRank orders false before true.
+func boolRank(value bool) int {
+	if value {
+		return 1
+	}
+	return 0
+}
+
+// sortSliceByItemKey sorts the items of a slice by their id and name when every item has one of them.
+func sortSliceByItemKey(slice reflect.Value) {
+	if slice.Len() < 2 {
+		return
+	}
+	keys := make([]listItemKey, slice.Len())
+	for i := range slice.Len() {
+		key, ok := listItemKeyOf(slice.Index(i))
+		if !ok {
+			return
+		}
+		keys[i] = key
+	}
+	order := make([]int, slice.Len())
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return cmp.Or(compareKeyValues(keys[a].id, keys[b].id), compareKeyValues(keys[a].name, keys[b].name))
+	})
+	elements := make([]reflect.Value, slice.Len())
+	for i, index := range order {
+		elements[i] = reflect.New(slice.Type().Elem()).Elem()
+		elements[i].Set(slice.Index(index))
+	}
+	for i := range elements {
+		slice.Index(i).Set(elements[i])
+	}
+}
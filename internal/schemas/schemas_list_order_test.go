@@ -0,0 +1,115 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"reflect"
+	"testing"
+)
+
+type testListOrderItem struct {
+	ID   string `json:"id" mapstructure:"id"`
+	Name string `json:"name" mapstructure:"name"`
+}
+
+type testListOrderNumbered struct {
+	PolicyID int    `json:"policy_id" mapstructure:"policy_id"`
+	Label    string `json:"label" mapstructure:"label"`
+}
+
+type testListOrderNamed struct {
+	Name string `json:"name" mapstructure:"name"`
+}
+
+type testListOrderUnkeyed struct {
+	Label string `json:"label" mapstructure:"label"`
+}
+
+type testListOrderGroup struct {
+	ID      string              `json:"id" mapstructure:"id"`
+	Members []testListOrderItem `json:"members" mapstructure:"members"`
+}
+
+type testListOrderResult struct {
+	Items    []testListOrderItem     `json:"items" mapstructure:"items"`
+	Numbered []testListOrderNumbered `json:"numbered" mapstructure:"numbered"`
+	Named    []*testListOrderNamed   `json:"named" mapstructure:"named"`
+	Unkeyed  []testListOrderUnkeyed  `json:"unkeyed" mapstructure:"unkeyed"`
+	Groups   []testListOrderGroup    `json:"groups" mapstructure:"groups"`
+}
+
+func TestSortListItems(t *testing.T) {
+	t.Parallel()
+
+	input := &testListOrderResult{
+		Items: []testListOrderItem{{ID: "b", Name: "y"}, {ID: "a", Name: "z"}, {ID: "b", Name: "x"}},
+		Numbered: []testListOrderNumbered{
+			{PolicyID: 10, Label: "ten"}, {PolicyID: 9, Label: "nine"}, {PolicyID: 100, Label: "hundred"},
+		},
+		Named:   []*testListOrderNamed{{Name: "web"}, {Name: "db"}},
+		Unkeyed: []testListOrderUnkeyed{{Label: "second"}, {Label: "first"}},
+		Groups: []testListOrderGroup{
+			{ID: "g2", Members: []testListOrderItem{{ID: "2"}, {ID: "1"}}},
+			{ID: "g1"},
+		},
+	}
+	sorted, ok := SortListItems(input).(*testListOrderResult)
+	if !ok {
+		t.Fatalf("expected a %T, got %T", input, sorted)
+	}
+
+	expected := &testListOrderResult{
+		Items: []testListOrderItem{{ID: "a", Name: "z"}, {ID: "b", Name: "x"}, {ID: "b", Name: "y"}},
+		Numbered: []testListOrderNumbered{
+			{PolicyID: 9, Label: "nine"}, {PolicyID: 10, Label: "ten"}, {PolicyID: 100, Label: "hundred"},
+		},
+		Named:   []*testListOrderNamed{{Name: "db"}, {Name: "web"}},
+		Unkeyed: []testListOrderUnkeyed{{Label: "second"}, {Label: "first"}},
+		Groups: []testListOrderGroup{
+			{ID: "g1"},
+			{ID: "g2", Members: []testListOrderItem{{ID: "2"}, {ID: "1"}}},
+		},
+	}
+	if !reflect.DeepEqual(sorted, expected) {
+		t.Errorf("expected %+v, got %+v", expected, sorted)
+	}
+	if input.Items[0].ID != "b" || input.Groups[0].Members[0].ID != "2" {
+		t.Error("expected the input to be left unsorted")
+	}
+}
+
+func TestSortListItemsSingleObject(t *testing.T) {
+	t.Parallel()
+
+	input := &testListOrderGroup{ID: "g1", Members: []testListOrderItem{{ID: "2"}, {ID: "1"}}}
+	sorted, ok := SortListItems(input).(*testListOrderGroup)
+	if !ok {
+		t.Fatalf("expected a %T, got %T", input, sorted)
+	}
+	if sorted.Members[0].ID != "2" {
+		t.Errorf("expected the lists of a single object to keep their order, got %+v", sorted.Members)
+	}
+}
+
+func TestSortListItemsTopLevel(t *testing.T) {
+	t.Parallel()
+
+	input := []interface{}{
+		&testListOrderItem{ID: "3"},
+		&testListOrderItem{ID: "1"},
+		&testListOrderItem{ID: "2"},
+	}
+	sorted, ok := SortListItems(input).([]interface{})
+	if !ok {
+		t.Fatalf("expected a list, got %T", sorted)
+	}
+	for i, id := range []string{"1", "2", "3"} {
+		if item := sorted[i].(*testListOrderItem); item.ID != id {
+			t.Errorf("expected item %d to be %s, got %s", i, id, item.ID)
+		}
+	}
+	if SortListItems(nil) != nil {
+		t.Error("expected nil to be returned as is")
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// listPrimitiveAttrType returns the Terraform type of a primitive Go kind, or nil for non-primitive kinds.
+func listPrimitiveAttrType(kind reflect.Kind) attr.Type {
+	switch kind {
+	case reflect.String:
+		return types.StringType
+	case reflect.Bool:
+		return types.BoolType
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return types.Int64Type
+	case reflect.Float32, reflect.Float64:
+		return types.Float64Type
+	default:
+		return nil
+	}
+}
+
+// flatAttribute describes a primitive, or list or map of primitives, field of a flat input model.
+type flatAttribute struct {
+	name        string
+	description string
+	required    bool
+	attrType    attr.Type
+}
+
+// flatModelAttributes resolves the attributes of flat input models, such as list filters and action
+// inputs. Only primitive fields and lists or maps of primitives are resolved; other fields are skipped.
+func flatModelAttributes(model interface{}) []flatAttribute {
+	if model == nil {
+		return nil
+	}
+	modelType := reflect.TypeOf(model)
+	if modelType.Kind() == reflect.Pointer {
+		modelType = modelType.Elem()
+	}
+	if modelType.Kind() != reflect.Struct {
+		return nil
+	}
+	var attributes []flatAttribute
+	for _, field := range resolveFieldsSquashed(modelType) {
+		fieldType := field.Type
+		if fieldType.Kind() == reflect.Pointer {
+			fieldType = fieldType.Elem()
+		}
+		attrType := listPrimitiveAttrType(fieldType.Kind())
+		switch fieldType.Kind() {
+		case reflect.Slice, reflect.Array:
+			if elemType := listPrimitiveAttrType(fieldType.Elem().Kind()); elemType != nil {
+				attrType = types.ListType{ElemType: elemType}
+			}
+		case reflect.Map:
+			if elemType := listPrimitiveAttrType(fieldType.Elem().Kind()); elemType != nil && isSupportedMapKeyKind(fieldType.Key().Kind()) {
+				attrType = types.MapType{ElemType: elemType}
+			}
+		}
+		if attrType == nil {
+			continue
+		}
+		attributes = append(attributes, flatAttribute{
+			name:        resolveFieldName(field),
+			description: field.Tag.Get("desc"),
+			required:    strings.Contains(field.Tag.Get("required"), "true") || strings.Contains(field.Tag.Get("validate"), "required"),
+			attrType:    attrType,
+		})
+	}
+	return attributes
+}
+
+// GenerateListResourceConfigSchemaFromStruct generates the configuration schema of a list resource
+// (the block used by `terraform query`) from the filters model of an SDK list action.
+// List filters are flat by nature, so only primitive fields and lists or maps of primitives are
+// exposed. A nil model yields an empty schema, for list actions that take no input.
+func GenerateListResourceConfigSchemaFromStruct(filtersModel interface{}) listschema.Schema {
+	attributes := map[string]listschema.Attribute{}
+	for _, flatAttr := range flatModelAttributes(filtersModel) {
+		desc, isRequired := flatAttr.description, flatAttr.required
+		switch attrType := flatAttr.attrType.(type) {
+		case types.ListType:
+			attributes[flatAttr.name] = listschema.ListAttribute{ElementType: attrType.ElemType, Description: desc, Required: isRequired, Optional: !isRequired}
+		case types.MapType:
+			attributes[flatAttr.name] = listschema.MapAttribute{ElementType: attrType.ElemType, Description: desc, Required: isRequired, Optional: !isRequired}
+		case basetypes.StringType:
+			attributes[flatAttr.name] = listschema.StringAttribute{Description: desc, Required: isRequired, Optional: !isRequired}
+		case basetypes.BoolType:
+			attributes[flatAttr.name] = listschema.BoolAttribute{Description: desc, Required: isRequired, Optional: !isRequired}
+		case basetypes.Int64Type:
+			attributes[flatAttr.name] = listschema.Int64Attribute{Description: desc, Required: isRequired, Optional: !isRequired}
+		case basetypes.Float64Type:
+			attributes[flatAttr.name] = listschema.Float64Attribute{Description: desc, Required: isRequired, Optional: !isRequired}
+		}
+	}
+	return listschema.Schema{Attributes: attributes}
+}
+
+// FlattenListResult flattens the result of an SDK list action into its items. SDK list actions
+// return either a slice of items, a channel of pages, or a wrapper struct holding the items slice
+// (for example IdsecPage.Items). Channels are drained until closed, or, when limit is positive, until
+// limit items were read. The pages left unread are then drained in the background, so the SDK
+// goroutine sending them is not left blocked.
+func FlattenListResult(result interface{}, limit int) []interface{} {
+	if result == nil {
+		return nil
+	}
+	var items []interface{}
+	flattenListValue(reflect.ValueOf(result), &items, true, limit)
+	return items
+}
+
+func flattenListValue(value reflect.Value, items *[]interface{}, unwrapStruct bool, limit int) {
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return
+		}
+		value = value.Elem()
+	}
+	switch value.Kind() {
+	case reflect.Chan:
+		for limit <= 0 || len(*items) < limit {
+			page, ok := value.Recv()
+			if !ok {
+				return
+			}
+			flattenListValue(page, items, true, limit)
+		}
+		go drainChannel(value)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len() && (limit <= 0 || len(*items) < limit); i++ {
+			flattenListValue(value.Index(i), items, false, limit)
+		}
+	case reflect.Struct:
+		if unwrapStruct {
+			if itemsField, ok := listItemsField(value); ok {
+				flattenListValue(itemsField, items, false, limit)
+				return
+			}
+		}
+		*items = append(*items, value.Interface())
+	}
+}
+
+// drainChannel receives the values left in a channel until it is closed.
+func drainChannel(value reflect.Value) {
+	for {
+		if _, ok := value.Recv(); !ok {
+			return
+		}
+	}
+}
+
+// listItemsField returns the slice field holding the items of a list wrapper struct: a field
+// named "items", or otherwise the only slice field of the struct.
+func listItemsField(value reflect.Value) (reflect.Value, bool) {
+	fields := resolveFieldsSquashed(value.Type())
+	fieldValues := resolveFieldsValueSquashed(value)
+	var sliceFields []reflect.Value
+	for i := range fields {
+		fieldValue := fieldValues[i]
+		if fieldValue.Kind() != reflect.Slice {
+			continue
+		}
+		if resolveFieldName(fields[i]) == "items" {
+			return fieldValue, true
+		}
+		sliceFields = append(sliceFields, fieldValue)
+	}
+	if len(sliceFields) == 1 {
+		return sliceFields[0], true
+	}
+	return reflect.Value{}, false
+}
@@ -0,0 +1,147 @@
+// Copyright CyberArk 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type listTestFilters struct {
+	Search string            `mapstructure:"search" desc:"Search term"`
+	Scope  string            `mapstructure:"scope" validate:"required"`
+	Limit  int               `mapstructure:"limit"`
+	Exact  *bool             `mapstructure:"exact"`
+	Tags   []string          `mapstructure:"tags"`
+	Labels map[string]string `mapstructure:"labels"`
+	Nested struct {
+		Inner string `mapstructure:"inner"`
+	} `mapstructure:"nested"`
+}
+
+type listTestItem struct {
+	ID string `mapstructure:"id"`
+}
+
+type listTestPage struct {
+	Items []*listTestItem `mapstructure:"items"`
+}
+
+type listTestWrapper struct {
+	Total   int            `mapstructure:"total"`
+	Entries []listTestItem `mapstructure:"entries"`
+}
+
+func TestGenerateListResourceConfigSchemaFromStruct(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success_nil_model", func(t *testing.T) {
+		t.Parallel()
+		schema := GenerateListResourceConfigSchemaFromStruct(nil)
+		if len(schema.Attributes) != 0 {
+			t.Errorf("expected no attributes, got %d", len(schema.Attributes))
+		}
+	})
+
+	t.Run("success_filters_model", func(t *testing.T) {
+		t.Parallel()
+		schema := GenerateListResourceConfigSchemaFromStruct(&listTestFilters{})
+		search, ok := schema.Attributes["search"].(listschema.StringAttribute)
+		if !ok || !search.Optional || search.Description != "Search term" {
+			t.Errorf("unexpected search attribute: %#v", schema.Attributes["search"])
+		}
+		if scope, ok := schema.Attributes["scope"].(listschema.StringAttribute); !ok || !scope.Required {
+			t.Errorf("expected scope to be a required string, got %#v", schema.Attributes["scope"])
+		}
+		if _, ok := schema.Attributes["limit"].(listschema.Int64Attribute); !ok {
+			t.Errorf("expected limit to be an int64, got %#v", schema.Attributes["limit"])
+		}
+		if _, ok := schema.Attributes["exact"].(listschema.BoolAttribute); !ok {
+			t.Errorf("expected exact to be a bool, got %#v", schema.Attributes["exact"])
+		}
+		if tags, ok := schema.Attributes["tags"].(listschema.ListAttribute); !ok || tags.ElementType != types.StringType {
+			t.Errorf("expected tags to be a list of strings, got %#v", schema.Attributes["tags"])
+		}
+		if _, ok := schema.Attributes["labels"].(listschema.MapAttribute); !ok {
+			t.Errorf("expected labels to be a map, got %#v", schema.Attributes["labels"])
+		}
+		if _, ok := schema.Attributes["nested"]; ok {
+			t.Errorf("expected nested struct to be skipped")
+		}
+	})
+}
+
+func TestFlattenListResult(t *testing.T) {
+	t.Parallel()
+
+	pages := make(chan *listTestPage, 2)
+	pages <- &listTestPage{Items: []*listTestItem{{ID: "a"}, {ID: "b"}}}
+	pages <- &listTestPage{Items: []*listTestItem{{ID: "c"}}}
+	close(pages)
+	limitedPages := make(chan *listTestPage)
+	sent := make(chan struct{})
+	go func() {
+		defer close(sent)
+		defer close(limitedPages)
+		limitedPages <- &listTestPage{Items: []*listTestItem{{ID: "a"}, {ID: "b"}}}
+		limitedPages <- &listTestPage{Items: []*listTestItem{{ID: "c"}}}
+	}()
+
+	tests := []struct {
+		name     string
+		input    interface{}
+		limit    int
+		expected []interface{}
+	}{
+		{
+			name:     "success_nil",
+			input:    nil,
+			expected: nil,
+		},
+		{
+			name:     "success_slice_of_pointers",
+			input:    []*listTestItem{{ID: "a"}},
+			expected: []interface{}{listTestItem{ID: "a"}},
+		},
+		{
+			name:     "success_channel_of_pages",
+			input:    (<-chan *listTestPage)(pages),
+			expected: []interface{}{listTestItem{ID: "a"}, listTestItem{ID: "b"}, listTestItem{ID: "c"}},
+		},
+		{
+			name:     "success_channel_of_pages_limited",
+			input:    (<-chan *listTestPage)(limitedPages),
+			limit:    1,
+			expected: []interface{}{listTestItem{ID: "a"}},
+		},
+		{
+			name:     "success_wrapper_single_slice",
+			input:    &listTestWrapper{Total: 1, Entries: []listTestItem{{ID: "x"}}},
+			expected: []interface{}{listTestItem{ID: "x"}},
+		},
+		{
+			name:     "success_single_item",
+			input:    &listTestItem{ID: "y"},
+			expected: []interface{}{listTestItem{ID: "y"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := FlattenListResult(tt.input, tt.limit)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %#v, got %#v", tt.expected, result)
+			}
+		})
+	}
+	select {
+	case <-sent:
+	case <-time.After(5 * time.Second):
+		t.Error("expected the pages past the limit to be drained")
+	}
+}
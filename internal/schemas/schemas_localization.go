@@ -0,0 +1,113 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"sync"
+)
+
+// DescriptionLocalizer translates the descriptions of generated schemas, so documentation can be
+// rendered in other locales. Localize returns false when it has no translation for a description,
+// in which case the original description is kept.
+type DescriptionLocalizer interface {
+	Localize(description string) (string, bool)
+}
+
+// DescriptionTable is a DescriptionLocalizer backed by a translation table keyed by the original
+// English description.
+type DescriptionTable map[string]string
+
+// Localize returns the translation of description from the table.
+func (t DescriptionTable) Localize(description string) (string, bool) {
+	translated, ok := t[description]
+	return translated, ok && translated != ""
+}
+
+var (
+	descriptionLocalizerMu sync.RWMutex
+	descriptionLocalizer   DescriptionLocalizer
+)
+
+// SetDescriptionLocalizer plugs a localizer into schema generation. A nil localizer restores the
+// original descriptions for schemas localized afterwards.
+func SetDescriptionLocalizer(localizer DescriptionLocalizer) {
+	descriptionLocalizerMu.Lock()
+	defer descriptionLocalizerMu.Unlock()
+	descriptionLocalizer = localizer
+}
+
+// LoadDescriptionTable reads a translation table from a JSON file mapping original descriptions to
+// their translations.
+func LoadDescriptionTable(path string) (DescriptionTable, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read description translations: %w", err)
+	}
+	table := DescriptionTable{}
+	if err := json.Unmarshal(content, &table); err != nil {
+		return nil, fmt.Errorf("failed to parse description translations %s: %w", path, err)
+	}
+	return table, nil
+}
+
+// LocalizeSchemaDescriptions translates, in place, the Description and MarkdownDescription of a schema
+// and of all its attributes, blocks and nested objects using the configured localizer. schema must be a
+// pointer to a resource, data source, list resource, action or provider schema.
+func LocalizeSchemaDescriptions(schema interface{}) {
+	descriptionLocalizerMu.RLock()
+	localizer := descriptionLocalizer
+	descriptionLocalizerMu.RUnlock()
+	if localizer == nil {
+		return
+	}
+	value := reflect.ValueOf(schema)
+	if value.Kind() != reflect.Pointer || value.IsNil() {
+		return
+	}
+	localizeValue(value.Elem(), localizer)
+}
+
+// localizeValue translates the descriptions of an addressable schema struct and recurses into the
+// Attributes and Blocks maps and the NestedObject struct holding its children.
+func localizeValue(value reflect.Value, localizer DescriptionLocalizer) {
+	if value.Kind() != reflect.Struct {
+		return
+	}
+	for _, fieldName := range []string{"Description", "MarkdownDescription"} {
+		field := value.FieldByName(fieldName)
+		if !field.IsValid() || !field.CanSet() || field.Kind() != reflect.String || field.String() == "" {
+			continue
+		}
+		if translated, ok := localizer.Localize(field.String()); ok {
+			field.SetString(translated)
+		}
+	}
+	for _, fieldName := range []string{"Attributes", "Blocks"} {
+		field := value.FieldByName(fieldName)
+		if !field.IsValid() || field.Kind() != reflect.Map || field.IsNil() {
+			continue
+		}
+		iter := field.MapRange()
+		for iter.Next() {
+			child := iter.Value()
+			for child.Kind() == reflect.Interface {
+				child = child.Elem()
+			}
+			if child.Kind() != reflect.Struct {
+				continue
+			}
+			copied := reflect.New(child.Type()).Elem()
+			copied.Set(child)
+			localizeValue(copied, localizer)
+			field.SetMapIndex(iter.Key(), copied)
+		}
+	}
+	if nested := value.FieldByName("NestedObject"); nested.IsValid() && nested.CanSet() {
+		localizeValue(nested, localizer)
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+func testLocalizedSchema() schema.Schema {
+	return schema.Schema{
+		Description: "Safe resource",
+		Attributes: map[string]schema.Attribute{
+			"safe_name": schema.StringAttribute{Description: "The name of the Safe", MarkdownDescription: "The name of the Safe"},
+			"creator": schema.SingleNestedAttribute{
+				Description: "The creator",
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{Description: "The creator name"},
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"members": schema.ListNestedBlock{
+				Description: "The members",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"member_name": schema.StringAttribute{Description: "The member name"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestLocalizeSchemaDescriptions(t *testing.T) {
+	SetDescriptionLocalizer(DescriptionTable{
+		"Safe resource":        "Ressource de coffre",
+		"The name of the Safe": "Le nom du coffre",
+		"The creator name":     "Le nom du créateur",
+		"The members":          "Les membres",
+		"The member name":      "Le nom du membre",
+	})
+	defer SetDescriptionLocalizer(nil)
+
+	localized := testLocalizedSchema()
+	LocalizeSchemaDescriptions(&localized)
+
+	if localized.Description != "Ressource de coffre" {
+		t.Errorf("expected schema description to be translated, got %q", localized.Description)
+	}
+	safeName := localized.Attributes["safe_name"].(schema.StringAttribute)
+	if safeName.Description != "Le nom du coffre" || safeName.MarkdownDescription != "Le nom du coffre" {
+		t.Errorf("expected attribute descriptions to be translated, got %+v", safeName)
+	}
+	creator := localized.Attributes["creator"].(schema.SingleNestedAttribute)
+	if creator.Description != "The creator" {
+		t.Errorf("expected untranslated description to be kept, got %q", creator.Description)
+	}
+	if creator.Attributes["name"].(schema.StringAttribute).Description != "Le nom du créateur" {
+		t.Error("expected nested attribute description to be translated")
+	}
+	members := localized.Blocks["members"].(schema.ListNestedBlock)
+	if members.Description != "Les membres" || members.NestedObject.Attributes["member_name"].(schema.StringAttribute).Description != "Le nom du membre" {
+		t.Errorf("expected block descriptions to be translated, got %+v", members)
+	}
+}
+
+func TestLocalizeSchemaDescriptionsWithoutLocalizer(t *testing.T) {
+	SetDescriptionLocalizer(nil)
+	localized := testLocalizedSchema()
+	LocalizeSchemaDescriptions(&localized)
+	if localized.Attributes["safe_name"].(schema.StringAttribute).Description != "The name of the Safe" {
+		t.Error("expected descriptions to be kept without a localizer")
+	}
+}
+
+func TestLoadDescriptionTable(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	valid := filepath.Join(dir, "fr.json")
+	if err := os.WriteFile(valid, []byte(`{"The name of the Safe": "Le nom du coffre"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	table, err := LoadDescriptionTable(valid)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if translated, ok := table.Localize("The name of the Safe"); !ok || translated != "Le nom du coffre" {
+		t.Errorf("expected translation, got %q (%v)", translated, ok)
+	}
+
+	invalid := filepath.Join(dir, "broken.json")
+	if err := os.WriteFile(invalid, []byte(`not json`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadDescriptionTable(invalid); err == nil {
+		t.Error("expected an error for an invalid table")
+	}
+	if _, err := LoadDescriptionTable(filepath.Join(dir, "missing.json")); err == nil {
+		t.Error("expected an error for a missing table")
+	}
+}
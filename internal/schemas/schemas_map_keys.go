@@ -0,0 +1,62 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// isSupportedMapKeyKind reports whether map keys of the given kind can be represented in Terraform,
+// whose maps are always keyed by strings. Integer keys are stringified in state and parsed back
+// when converting to SDK models.
+func isSupportedMapKeyKind(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	default:
+		return false
+	}
+}
+
+// mapKeyToString renders a map key as the string key of a Terraform map.
+func mapKeyToString(key reflect.Value) (string, error) {
+	switch key.Kind() {
+	case reflect.String:
+		return key.String(), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(key.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(key.Uint(), 10), nil
+	default:
+		return "", fmt.Errorf("unsupported map key type: %s", key.Kind())
+	}
+}
+
+// stringToMapKey parses the string key of a Terraform map into a key of the given type.
+func stringToMapKey(key string, keyType reflect.Type) (reflect.Value, error) {
+	value := reflect.New(keyType).Elem()
+	switch keyType.Kind() {
+	case reflect.String:
+		value.SetString(key)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		parsed, err := strconv.ParseInt(key, 10, keyType.Bits())
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("map key %q is not a valid %s: %w", key, keyType.Kind(), err)
+		}
+		value.SetInt(parsed)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		parsed, err := strconv.ParseUint(key, 10, keyType.Bits())
+		if err != nil {
+			return reflect.Value{}, fmt.Errorf("map key %q is not a valid %s: %w", key, keyType.Kind(), err)
+		}
+		value.SetUint(parsed)
+	default:
+		return reflect.Value{}, fmt.Errorf("unsupported map key type: %s", keyType.Kind())
+	}
+	return value, nil
+}
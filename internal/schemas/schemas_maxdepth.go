@@ -0,0 +1,44 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// MaxDepthTag is the model field tag bounding the expansion of a recursive nested field, e.g.
+// `maxdepth:"3"` on the subfolders of a folder. The field is generated as nested attributes down to that
+// many levels, and below them as a dynamic attribute holding the rest of the tree, which takes an HCL
+// value or a JSON string of the same shape. Recursive fields without the tag cannot be generated.
+const MaxDepthTag = "maxdepth"
+
+// exceedsMaxDepth reports whether a field tagged with MaxDepthTag is nested deeper than its bound at
+// fieldPath, its depth being the number of times its attribute name appears in the path, and returns
+// the bound.
+func exceedsMaxDepth(field reflect.StructField, fieldPath string) (int, bool) {
+	maxDepth, err := strconv.Atoi(field.Tag.Get(MaxDepthTag))
+	if err != nil || maxDepth < 1 {
+		return 0, false
+	}
+	depth := 0
+	for _, segment := range strings.Split(fieldPath, ".") {
+		if segment == resolveFieldName(field) {
+			depth++
+		}
+	}
+	return maxDepth, depth > maxDepth
+}
+
+// maxDepthDescription returns the description of the dynamic attribute replacing a recursive field
+// below its maximum depth.
+func maxDepthDescription(desc string, maxDepth int) string {
+	note := fmt.Sprintf("Nested %d levels deep, the rest of the tree is given as a value or JSON string of the same shape.", maxDepth)
+	if desc == "" {
+		return note
+	}
+	return strings.TrimSuffix(strings.TrimSpace(desc), ".") + ". " + note
+}
@@ -0,0 +1,80 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+type testFolder struct {
+	Name       string       `json:"name" mapstructure:"name" validate:"required"`
+	Subfolders []testFolder `json:"subfolders,omitempty" mapstructure:"subfolders" maxdepth:"2" desc:"The subfolders of the folder."`
+}
+
+func TestGenerateResourceSchemaMaxDepth(t *testing.T) {
+	t.Parallel()
+
+	generated := GenerateResourceSchemaFromStruct(&testFolder{}, nil, nil, ResourceSchemaOptions{})
+	first, ok := generated.Attributes["subfolders"].(schema.ListNestedAttribute)
+	if !ok {
+		t.Fatalf("expected the first level to be nested attributes, got %T", generated.Attributes["subfolders"])
+	}
+	second, ok := first.NestedObject.Attributes["subfolders"].(schema.ListNestedAttribute)
+	if !ok {
+		t.Fatalf("expected the second level to be nested attributes, got %T", first.NestedObject.Attributes["subfolders"])
+	}
+	rest, ok := second.NestedObject.Attributes["subfolders"].(schema.DynamicAttribute)
+	if !ok {
+		t.Fatalf("expected the levels below the maximum depth to be dynamic, got %T", second.NestedObject.Attributes["subfolders"])
+	}
+	if !rest.Optional || !strings.HasPrefix(rest.Description, "The subfolders of the folder. Nested 2 levels deep") {
+		t.Errorf("unexpected dynamic attribute %+v", rest)
+	}
+
+	dataSource := GenerateDataSourceSchemaFromStruct(nil, &testFolder{}, nil, nil, nil)
+	dataSourceFirst := dataSource.Attributes["subfolders"].(datasourceschema.ListNestedAttribute)
+	dataSourceSecond := dataSourceFirst.NestedObject.Attributes["subfolders"].(datasourceschema.ListNestedAttribute)
+	if _, ok := dataSourceSecond.NestedObject.Attributes["subfolders"].(datasourceschema.DynamicAttribute); !ok {
+		t.Errorf("expected the data source to bound the depth too, got %T", dataSourceSecond.NestedObject.Attributes["subfolders"])
+	}
+}
+
+func TestStructToStateObjectMaxDepth(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	generated := GenerateResourceSchemaFromStruct(&testFolder{}, nil, nil, ResourceSchemaOptions{})
+	tree := &testFolder{Name: "root", Subfolders: []testFolder{{Name: "a", Subfolders: []testFolder{{Name: "b", Subfolders: []testFolder{{Name: "c"}}}}}}}
+	stateObj, err := StructToStateObject(ctx, tree, nil, nil, ResourceSchemaToSchemaAttrTypes(generated), nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	first := stateObj.Attributes()["subfolders"].(types.List).Elements()[0].(types.Object)
+	second := first.Attributes()["subfolders"].(types.List).Elements()[0].(types.Object)
+	rest := second.Attributes()["subfolders"].(types.Dynamic)
+	deepest := rest.UnderlyingValue().(basetypes.TupleValue).Elements()[0].(types.Object)
+	if name := deepest.Attributes()["name"]; !name.Equal(types.StringValue("c")) {
+		t.Errorf("expected the folders below the maximum depth in the dynamic attribute, got %v", rest)
+	}
+
+	raw, err := stateObj.ToTerraformValue(ctx)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	decoded, err := StructFromPlanObject(ctx, &tfsdk.Plan{Schema: generated, Raw: raw}, &testFolder{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if folder := decoded.(*testFolder); folder.Subfolders[0].Subfolders[0].Subfolders[0].Name != "c" {
+		t.Errorf("expected the folders below the maximum depth to be decoded, got %+v", folder)
+	}
+}
@@ -0,0 +1,62 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+)
+
+// NamePrefixSuffixLength is the length of the unique suffix appended to a name prefix: a UTC timestamp
+// to the second followed by 8 random hexadecimal characters.
+const NamePrefixSuffixLength = 22
+
+// NamePrefixAttributeName returns the name of the prefix attribute of a name attribute, e.g.
+// "safe_name_prefix" for "safe_name".
+func NamePrefixAttributeName(nameAttribute string) string {
+	return nameAttribute + "_prefix"
+}
+
+// AddNamePrefixAttribute adds the <name>_prefix attribute to a resource schema, from which the name is
+// generated when it is not configured. The name attribute becomes Optional and Computed, and keeps its
+// generated value in later plans. Changing the prefix replaces the resource, as the name it generated
+// is no longer derived from it. Returns false, leaving the schema unchanged, when the name attribute is
+// not a top-level string attribute or the prefix attribute already exists.
+func AddNamePrefixAttribute(resourceSchema *schema.Schema, nameAttribute string) bool {
+	nameAttr, ok := resourceSchema.Attributes[nameAttribute].(schema.StringAttribute)
+	prefixAttribute := NamePrefixAttributeName(nameAttribute)
+	if !ok {
+		return false
+	}
+	if _, exists := resourceSchema.Attributes[prefixAttribute]; exists {
+		return false
+	}
+	nameAttr.Required = false
+	nameAttr.Optional = true
+	nameAttr.Computed = true
+	nameAttr.PlanModifiers = append(nameAttr.PlanModifiers, stringplanmodifier.UseStateForUnknown())
+	resourceSchema.Attributes[nameAttribute] = nameAttr
+
+	description := "Creates a unique " + nameAttribute + " beginning with the specified prefix. Conflicts with " + nameAttribute + "."
+	resourceSchema.Attributes[prefixAttribute] = schema.StringAttribute{
+		Optional:            true,
+		Description:         description,
+		MarkdownDescription: "Creates a unique `" + nameAttribute + "` beginning with the specified prefix. Conflicts with `" + nameAttribute + "`.",
+		PlanModifiers:       []planmodifier.String{stringplanmodifier.RequiresReplace()},
+	}
+	return true
+}
+
+// PrefixedUniqueName returns prefix followed by a unique suffix of NamePrefixSuffixLength characters.
+// Names generated from the same prefix sort in creation order.
+func PrefixedUniqueName(prefix string) string {
+	random := make([]byte, 4)
+	_, _ = rand.Read(random)
+	return prefix + time.Now().UTC().Format("20060102150405") + hex.EncodeToString(random)
+}
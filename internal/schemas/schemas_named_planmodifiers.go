@@ -0,0 +1,217 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"context"
+	"net/netip"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+)
+
+// Named plan modifiers are attached to attributes by action definitions, through their PlanModifiers
+// map, so services customize how attributes are planned without provider code changes. A modifier is
+// registered once for all the attribute types it implements the plan modifier interface of, e.g.
+// planmodifier.String for string attributes.
+var (
+	namedPlanModifiersMu sync.RWMutex
+	namedPlanModifiers   = map[string]interface{}{
+		"lowercase":      NormalizingString("lowercase", "letter case", strings.ToLower),
+		"trim_space":     NormalizingString("trim_space", "leading and trailing white space", strings.TrimSpace),
+		"normalize_cidr": NormalizingString("normalize_cidr", "the notation of the IP address or CIDR block", normalizeCIDR),
+	}
+)
+
+// RegisterPlanModifier registers modifier under name, replacing any modifier of the same name. The
+// modifier must implement the plan modifier interface of at least one attribute type, such as
+// planmodifier.String or planmodifier.List, and is only attached to attributes of those types.
+func RegisterPlanModifier(name string, modifier interface{}) {
+	namedPlanModifiersMu.Lock()
+	defer namedPlanModifiersMu.Unlock()
+	namedPlanModifiers[name] = modifier
+}
+
+// NamedPlanModifier returns the modifier registered under name.
+func NamedPlanModifier(name string) (interface{}, bool) {
+	namedPlanModifiersMu.RLock()
+	defer namedPlanModifiersMu.RUnlock()
+	modifier, ok := namedPlanModifiers[name]
+	return modifier, ok
+}
+
+// UnknownPlanModifiers returns the sorted names of planModifiers, keyed by attribute path, that have no
+// registered modifier.
+func UnknownPlanModifiers(planModifiers map[string][]string) []string {
+	unknown := map[string]bool{}
+	for _, names := range planModifiers {
+		for _, name := range names {
+			if _, ok := NamedPlanModifier(name); !ok {
+				unknown[name] = true
+			}
+		}
+	}
+	names := make([]string, 0, len(unknown))
+	for name := range unknown {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// applyNamedPlanModifiers appends the modifiers named in planModifiers to the attributes at their paths.
+// Dotted paths (e.g. "rules.cidr") address the attributes of nested attributes. Paths matching no
+// attribute, unknown names and modifiers not supporting the type of the attribute are skipped.
+func applyNamedPlanModifiers(attributes map[string]schema.Attribute, planModifiers map[string][]string) {
+	for attributePath, names := range planModifiers {
+		for _, name := range names {
+			if modifier, ok := NamedPlanModifier(name); ok {
+				applyNamedPlanModifier(attributes, strings.Split(attributePath, "."), modifier)
+			}
+		}
+	}
+}
+
+// applyNamedPlanModifier appends modifier to the attribute at path, copying the nested attributes it
+// goes through so schemas shared with other callers are not altered.
+func applyNamedPlanModifier(attributes map[string]schema.Attribute, path []string, modifier interface{}) {
+	attribute, ok := attributes[path[0]]
+	if !ok {
+		return
+	}
+	if len(path) > 1 {
+		switch attr := attribute.(type) {
+		case schema.SingleNestedAttribute:
+			attr.Attributes = copyAttributes(attr.Attributes)
+			applyNamedPlanModifier(attr.Attributes, path[1:], modifier)
+			attributes[path[0]] = attr
+		case schema.ListNestedAttribute:
+			attr.NestedObject.Attributes = copyAttributes(attr.NestedObject.Attributes)
+			applyNamedPlanModifier(attr.NestedObject.Attributes, path[1:], modifier)
+			attributes[path[0]] = attr
+		case schema.SetNestedAttribute:
+			attr.NestedObject.Attributes = copyAttributes(attr.NestedObject.Attributes)
+			applyNamedPlanModifier(attr.NestedObject.Attributes, path[1:], modifier)
+			attributes[path[0]] = attr
+		case schema.MapNestedAttribute:
+			attr.NestedObject.Attributes = copyAttributes(attr.NestedObject.Attributes)
+			applyNamedPlanModifier(attr.NestedObject.Attributes, path[1:], modifier)
+			attributes[path[0]] = attr
+		}
+		return
+	}
+	switch attr := attribute.(type) {
+	case schema.StringAttribute:
+		if typed, ok := modifier.(planmodifier.String); ok {
+			attr.PlanModifiers = append(slices.Clone(attr.PlanModifiers), typed)
+			attributes[path[0]] = attr
+		}
+	case schema.BoolAttribute:
+		if typed, ok := modifier.(planmodifier.Bool); ok {
+			attr.PlanModifiers = append(slices.Clone(attr.PlanModifiers), typed)
+			attributes[path[0]] = attr
+		}
+	case schema.Int64Attribute:
+		if typed, ok := modifier.(planmodifier.Int64); ok {
+			attr.PlanModifiers = append(slices.Clone(attr.PlanModifiers), typed)
+			attributes[path[0]] = attr
+		}
+	case schema.Float64Attribute:
+		if typed, ok := modifier.(planmodifier.Float64); ok {
+			attr.PlanModifiers = append(slices.Clone(attr.PlanModifiers), typed)
+			attributes[path[0]] = attr
+		}
+	case schema.ListAttribute:
+		if typed, ok := modifier.(planmodifier.List); ok {
+			attr.PlanModifiers = append(slices.Clone(attr.PlanModifiers), typed)
+			attributes[path[0]] = attr
+		}
+	case schema.ListNestedAttribute:
+		if typed, ok := modifier.(planmodifier.List); ok {
+			attr.PlanModifiers = append(slices.Clone(attr.PlanModifiers), typed)
+			attributes[path[0]] = attr
+		}
+	case schema.SetAttribute:
+		if typed, ok := modifier.(planmodifier.Set); ok {
+			attr.PlanModifiers = append(slices.Clone(attr.PlanModifiers), typed)
+			attributes[path[0]] = attr
+		}
+	case schema.SetNestedAttribute:
+		if typed, ok := modifier.(planmodifier.Set); ok {
+			attr.PlanModifiers = append(slices.Clone(attr.PlanModifiers), typed)
+			attributes[path[0]] = attr
+		}
+	case schema.MapAttribute:
+		if typed, ok := modifier.(planmodifier.Map); ok {
+			attr.PlanModifiers = append(slices.Clone(attr.PlanModifiers), typed)
+			attributes[path[0]] = attr
+		}
+	case schema.MapNestedAttribute:
+		if typed, ok := modifier.(planmodifier.Map); ok {
+			attr.PlanModifiers = append(slices.Clone(attr.PlanModifiers), typed)
+			attributes[path[0]] = attr
+		}
+	case schema.SingleNestedAttribute:
+		if typed, ok := modifier.(planmodifier.Object); ok {
+			attr.PlanModifiers = append(slices.Clone(attr.PlanModifiers), typed)
+			attributes[path[0]] = attr
+		}
+	}
+}
+
+// NormalizingStringModifier keeps the prior string value in the plan when it equals the planned value
+// once both are normalized, so values the API stores in another notation, e.g. lowercased, do not show
+// as a diff. Other changes are left unchanged. Terraform accepts the prior value in place of a configured
+// one, so it also applies to attributes that are not computed.
+type NormalizingStringModifier struct {
+	name       string
+	difference string
+	normalize  func(string) string
+}
+
+// NormalizingString returns a plan modifier ignoring the differences removed by normalize, described
+// as difference, e.g. "letter case".
+func NormalizingString(name string, difference string, normalize func(string) string) planmodifier.String {
+	return NormalizingStringModifier{name: name, difference: difference, normalize: normalize}
+}
+
+// Description returns a human-readable description of the plan modifier.
+func (m NormalizingStringModifier) Description(_ context.Context) string {
+	return "When the planned value equals the state value ignoring " + m.difference + ", the plan uses the state's value (" + m.name + ")."
+}
+
+// MarkdownDescription returns a markdown-formatted description of the plan modifier.
+func (m NormalizingStringModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+// PlanModifyString uses the state value when state and plan are equal once normalized.
+func (m NormalizingStringModifier) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if req.PlanValue.IsUnknown() || req.PlanValue.IsNull() || req.StateValue.IsNull() || req.PlanValue.Equal(req.StateValue) {
+		return
+	}
+	if m.normalize(req.PlanValue.ValueString()) == m.normalize(req.StateValue.ValueString()) {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+// normalizeCIDR returns the canonical notation of an IP address or CIDR block, e.g. "2001:db8::/32" for
+// "2001:DB8:0::0/32". Values that are neither are returned unchanged.
+func normalizeCIDR(value string) string {
+	trimmed := strings.TrimSpace(value)
+	if prefix, err := netip.ParsePrefix(trimmed); err == nil {
+		return prefix.String()
+	}
+	if addr, err := netip.ParseAddr(trimmed); err == nil {
+		return addr.String()
+	}
+	return value
+}
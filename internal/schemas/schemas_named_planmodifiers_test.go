@@ -0,0 +1,123 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type namedPlanModifiersTestRule struct {
+	Host string `mapstructure:"host"`
+	Port int    `mapstructure:"port"`
+}
+
+type namedPlanModifiersTestModel struct {
+	CIDR  string                       `mapstructure:"cidr"`
+	Name  string                       `mapstructure:"name" validate:"required"`
+	Rules []namedPlanModifiersTestRule `mapstructure:"rules"`
+}
+
+func TestGenerateResourceSchemaFromStruct_PlanModifiers(t *testing.T) {
+	t.Parallel()
+
+	planModifiers := map[string][]string{
+		"cidr":       {"normalize_cidr", "trim_space"},
+		"name":       {"lowercase"},
+		"rules.host": {"lowercase"},
+		"rules.port": {"lowercase"},
+		"missing":    {"lowercase"},
+		"rules.none": {"lowercase"},
+	}
+	s := GenerateResourceSchemaFromStruct(&namedPlanModifiersTestModel{}, nil, nil, ResourceSchemaOptions{PlanModifiers: planModifiers})
+
+	cidr := s.Attributes["cidr"].(schema.StringAttribute)
+	if len(cidr.PlanModifiers) < 2 {
+		t.Fatalf("expected the named modifiers on cidr, got %d modifiers", len(cidr.PlanModifiers))
+	}
+	rules := s.Attributes["rules"].(schema.ListNestedAttribute)
+	host := rules.NestedObject.Attributes["host"].(schema.StringAttribute)
+	if len(host.PlanModifiers) == 0 {
+		t.Error("expected the lowercase modifier on rules.host")
+	}
+	unmodified := GenerateResourceSchemaFromStruct(&namedPlanModifiersTestModel{}, nil, nil, ResourceSchemaOptions{})
+	port := rules.NestedObject.Attributes["port"].(schema.Int64Attribute)
+	unmodifiedPort := unmodified.Attributes["rules"].(schema.ListNestedAttribute).NestedObject.Attributes["port"].(schema.Int64Attribute)
+	if len(port.PlanModifiers) != len(unmodifiedPort.PlanModifiers) {
+		t.Error("expected a string modifier not to be attached to an integer attribute")
+	}
+	if len(unmodified.Attributes["cidr"].(schema.StringAttribute).PlanModifiers) != len(cidr.PlanModifiers)-2 {
+		t.Error("expected the named modifiers to be appended to the generated ones")
+	}
+	if name := s.Attributes["name"].(schema.StringAttribute); len(name.PlanModifiers) != len(unmodified.Attributes["name"].(schema.StringAttribute).PlanModifiers)+1 {
+		t.Error("expected a normalizing modifier to be attached to an attribute that is not computed")
+	}
+
+	if unknown := UnknownPlanModifiers(map[string][]string{"cidr": {"lowercase", "uppercase"}, "name": {"reverse"}}); !reflect.DeepEqual(unknown, []string{"reverse", "uppercase"}) {
+		t.Errorf("unexpected unknown modifiers %v", unknown)
+	}
+}
+
+func TestRegisterPlanModifier(t *testing.T) {
+	t.Parallel()
+
+	RegisterPlanModifier("test_trim_zeros", NormalizingString("test_trim_zeros", "leading zeros", func(value string) string {
+		for len(value) > 1 && value[0] == '0' {
+			value = value[1:]
+		}
+		return value
+	}))
+	if _, ok := NamedPlanModifier("test_trim_zeros"); !ok {
+		t.Fatal("expected the registered modifier to be found")
+	}
+	if unknown := UnknownPlanModifiers(map[string][]string{"code": {"test_trim_zeros"}}); len(unknown) != 0 {
+		t.Errorf("expected no unknown modifiers, got %v", unknown)
+	}
+}
+
+func TestNormalizingStringModifier(t *testing.T) {
+	t.Parallel()
+
+	existing := tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{})}
+	plan := tfsdk.Plan{Raw: tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{})}
+	modifier, _ := NamedPlanModifier("normalize_cidr")
+	tests := []struct {
+		name     string
+		state    tfsdk.State
+		prior    types.String
+		planned  types.String
+		expected types.String
+	}{
+		{name: "same_block", state: existing, prior: types.StringValue("2001:db8::/32"), planned: types.StringValue("2001:DB8:0::0/32"), expected: types.StringValue("2001:db8::/32")},
+		{name: "same_address", state: existing, prior: types.StringValue("10.0.0.1"), planned: types.StringValue(" 10.0.0.1 "), expected: types.StringValue("10.0.0.1")},
+		{name: "other_block", state: existing, prior: types.StringValue("10.0.0.0/8"), planned: types.StringValue("10.0.0.0/16"), expected: types.StringValue("10.0.0.0/16")},
+		{name: "create", state: tfsdk.State{Raw: tftypes.NewValue(tftypes.Object{}, nil)}, prior: types.StringNull(), planned: types.StringValue("10.0.0.0/8"), expected: types.StringValue("10.0.0.0/8")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			req := planmodifier.StringRequest{
+				Path:        path.Root("cidr"),
+				State:       tt.state,
+				Plan:        plan,
+				StateValue:  tt.prior,
+				PlanValue:   tt.planned,
+				ConfigValue: tt.planned,
+			}
+			resp := &planmodifier.StringResponse{PlanValue: tt.planned}
+			modifier.(planmodifier.String).PlanModifyString(context.Background(), req, resp)
+			if !resp.PlanValue.Equal(tt.expected) {
+				t.Errorf("expected %s, got %s", tt.expected, resp.PlanValue)
+			}
+		})
+	}
+}
@@ -377,6 +377,75 @@ func (m ImmutableInt64Modifier) PlanModifyInt64(ctx context.Context, req planmod
 	)
 }
 
+// ImmutableFloat64Modifier prevents changes to float64 attributes after resource creation.
+//
+// This plan modifier implements the planmodifier.Float64 interface with the same
+// behavior as ImmutableStringModifier but for floating point attributes.
+type ImmutableFloat64Modifier struct{}
+
+// ImmutableFloat64 returns a plan modifier that prevents changes to float64 attributes
+// after resource creation.
+//
+// Returns a plan modifier implementing planmodifier.Float64 interface.
+func ImmutableFloat64() planmodifier.Float64 {
+	return ImmutableFloat64Modifier{}
+}
+
+// Description returns a human-readable description of the plan modifier.
+//
+// Parameters:
+//   - ctx: Context for the operation (unused but required by interface)
+//
+// Returns a description string for use in Terraform documentation.
+func (m ImmutableFloat64Modifier) Description(_ context.Context) string {
+	return "Prevents changes to this attribute after initial creation. Any attempt to modify will result in an error."
+}
+
+// MarkdownDescription returns a markdown-formatted description of the plan modifier.
+//
+// Parameters:
+//   - ctx: Context for the operation (unused but required by interface)
+//
+// Returns a markdown description string for use in Terraform documentation.
+func (m ImmutableFloat64Modifier) MarkdownDescription(_ context.Context) string {
+	return "**Immutable attribute** - Cannot be changed after initial creation. Any modification attempt will result in an error."
+}
+
+// PlanModifyFloat64 implements the plan modification logic for float64 attributes.
+//
+// Parameters:
+//   - ctx: Context for the operation
+//   - req: The plan modification request containing state, plan, and config values
+//   - resp: The response where diagnostics or plan modifications are written
+func (m ImmutableFloat64Modifier) PlanModifyFloat64(ctx context.Context, req planmodifier.Float64Request, resp *planmodifier.Float64Response) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+	if req.PlanValue.IsUnknown() {
+		return
+	}
+	if req.ConfigValue.IsUnknown() {
+		return
+	}
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	if req.PlanValue.Equal(req.StateValue) {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		req.Path,
+		errImmutableAttributeSummary,
+		fmt.Sprintf(
+			errImmutableAttributeDetailWithValues,
+			req.Path.String(),
+			req.StateValue.ValueFloat64(),
+			req.PlanValue.ValueFloat64(),
+		),
+	)
+}
+
 // ImmutableBoolModifier prevents changes to bool attributes after resource creation.
 //
 // This plan modifier implements the planmodifier.Bool interface with the same
@@ -0,0 +1,55 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// AttributeProjectionAttributeName is the name of the data source argument selecting the attributes
+// stored in state.
+const AttributeProjectionAttributeName = "attribute_projection"
+
+// AttributeProjectionAttribute returns the attribute_projection argument added to data sources.
+func AttributeProjectionAttribute() schema.ListAttribute {
+	return schema.ListAttribute{
+		Description: "Top-level attributes of the result to store in state, to keep large objects out of state. " +
+			"Other computed attributes are set to null. Defaults to all attributes",
+		MarkdownDescription: "Top-level attributes of the result to store in state, to keep large objects out of state. " +
+			"Other computed attributes are set to `null`. Defaults to all attributes",
+		Optional:    true,
+		ElementType: types.StringType,
+	}
+}
+
+// ProjectObjectAttributes returns obj with every top-level attribute not in keep set to null.
+func ProjectObjectAttributes(ctx context.Context, obj types.Object, keep []string) (types.Object, error) {
+	if obj.IsNull() || obj.IsUnknown() {
+		return obj, nil
+	}
+	attrTypes := obj.AttributeTypes(ctx)
+	attributes := make(map[string]attr.Value, len(attrTypes))
+	for name, value := range obj.Attributes() {
+		if slices.Contains(keep, name) {
+			attributes[name] = value
+			continue
+		}
+		nullValue, err := getNullValue(attrTypes[name])
+		if err != nil {
+			return obj, fmt.Errorf("failed to create null value for attribute %q: %w", name, err)
+		}
+		attributes[name] = nullValue
+	}
+	projected, diags := types.ObjectValue(attrTypes, attributes)
+	if diags.HasError() {
+		return obj, fmt.Errorf("failed to project attributes: %v", diags)
+	}
+	return projected, nil
+}
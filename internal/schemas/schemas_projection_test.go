@@ -0,0 +1,39 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestProjectObjectAttributes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	attrTypes := map[string]attr.Type{
+		"policy_id": types.StringType,
+		"name":      types.StringType,
+		"rules":     types.ListType{ElemType: types.StringType},
+	}
+	rules, _ := types.ListValueFrom(ctx, types.StringType, []string{"allow", "deny"})
+	obj, _ := types.ObjectValue(attrTypes, map[string]attr.Value{
+		"policy_id": types.StringValue("policy-1"),
+		"name":      types.StringValue("Admins"),
+		"rules":     rules,
+	})
+	projected, err := ProjectObjectAttributes(ctx, obj, []string{"policy_id", "name"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !projected.Attributes()["rules"].IsNull() {
+		t.Errorf("expected rules to be projected out, got %v", projected.Attributes()["rules"])
+	}
+	if !projected.Attributes()["name"].Equal(types.StringValue("Admins")) || !projected.Attributes()["policy_id"].Equal(types.StringValue("policy-1")) {
+		t.Errorf("expected kept attributes to be unchanged, got %v", projected.Attributes())
+	}
+}
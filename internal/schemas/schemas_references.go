@@ -0,0 +1,84 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// AttributeReference describes an attribute whose value identifies an object owned by another service,
+// declared on SDK models with a `ref:"service.action"` or `ref:"service.action.input_field"` struct tag.
+type AttributeReference struct {
+	// Attribute is the Terraform name of the referencing attribute.
+	Attribute string
+	// Service is the SDK service owning the referenced object, e.g. "pcloud-safes".
+	Service string
+	// Action is the SDK action looking the referenced object up, e.g. "get".
+	Action string
+	// InputField is the attribute of the action input receiving the referenced value.
+	// Defaults to the name of the referencing attribute.
+	InputField string
+}
+
+// ParseAttributeReference parses a reference spec of the form "service.action" or
+// "service.action.input_field" declared for the given attribute.
+// The second return value is false when the spec is malformed.
+func ParseAttributeReference(attribute string, spec string) (AttributeReference, bool) {
+	parts := strings.Split(strings.TrimSpace(spec), ".")
+	if len(parts) < 2 || len(parts) > 3 {
+		return AttributeReference{}, false
+	}
+	for _, part := range parts {
+		if part == "" {
+			return AttributeReference{}, false
+		}
+	}
+	reference := AttributeReference{
+		Attribute:  attribute,
+		Service:    parts[0],
+		Action:     parts[1],
+		InputField: attribute,
+	}
+	if len(parts) == 3 {
+		reference.InputField = parts[2]
+	}
+	return reference, true
+}
+
+// AttributeReferences returns the extra references keyed by attribute name, followed by the references
+// declared through `ref` struct tags on the top level fields of the model. Extra references take
+// precedence over tags declared for the same attribute. Malformed specs are ignored.
+func AttributeReferences(model interface{}, extra map[string]string) []AttributeReference {
+	var references []AttributeReference
+	seen := map[string]bool{}
+	for _, attribute := range slices.Sorted(maps.Keys(extra)) {
+		if reference, ok := ParseAttributeReference(attribute, extra[attribute]); ok {
+			references = append(references, reference)
+			seen[attribute] = true
+		}
+	}
+	if model != nil {
+		modelType := reflect.TypeOf(model)
+		if modelType.Kind() == reflect.Pointer {
+			modelType = modelType.Elem()
+		}
+		if modelType.Kind() == reflect.Struct {
+			for _, field := range resolveFieldsSquashed(modelType) {
+				spec := field.Tag.Get("ref")
+				attribute := resolveFieldName(field)
+				if spec == "" || seen[attribute] {
+					continue
+				}
+				if reference, ok := ParseAttributeReference(attribute, spec); ok {
+					references = append(references, reference)
+					seen[attribute] = true
+				}
+			}
+		}
+	}
+	return references
+}
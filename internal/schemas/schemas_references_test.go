@@ -0,0 +1,72 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"reflect"
+	"testing"
+)
+
+type testReferencingModel struct {
+	SafeName  string `mapstructure:"safe_name" ref:"pcloud-safes.get.safe_id"`
+	PolicyID  string `mapstructure:"policy_id" ref:"policy-db.policy"`
+	TargetSet string `mapstructure:"target_set" ref:"malformed"`
+	Plain     string `mapstructure:"plain"`
+}
+
+func TestParseAttributeReference(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		spec     string
+		expected AttributeReference
+		ok       bool
+	}{
+		{
+			name:     "success_service_and_action",
+			spec:     "pcloud-safes.get",
+			expected: AttributeReference{Attribute: "safe_id", Service: "pcloud-safes", Action: "get", InputField: "safe_id"},
+			ok:       true,
+		},
+		{
+			name:     "success_with_input_field",
+			spec:     "pcloud-safes.get.safe_id",
+			expected: AttributeReference{Attribute: "safe_id", Service: "pcloud-safes", Action: "get", InputField: "safe_id"},
+			ok:       true,
+		},
+		{name: "error_single_segment", spec: "pcloud-safes"},
+		{name: "error_empty_segment", spec: "pcloud-safes..safe_id"},
+		{name: "error_too_many_segments", spec: "a.b.c.d"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			reference, ok := ParseAttributeReference("safe_id", tt.spec)
+			if ok != tt.ok {
+				t.Fatalf("expected ok=%v, got %v", tt.ok, ok)
+			}
+			if ok && reference != tt.expected {
+				t.Errorf("expected %+v, got %+v", tt.expected, reference)
+			}
+		})
+	}
+}
+
+func TestAttributeReferences(t *testing.T) {
+	t.Parallel()
+
+	references := AttributeReferences(&testReferencingModel{}, map[string]string{
+		"policy_id": "policy-db.get-policy.id",
+		"owner":     "identity-users.user",
+	})
+	expected := []AttributeReference{
+		{Attribute: "owner", Service: "identity-users", Action: "user", InputField: "owner"},
+		{Attribute: "policy_id", Service: "policy-db", Action: "get-policy", InputField: "id"},
+		{Attribute: "safe_name", Service: "pcloud-safes", Action: "get", InputField: "safe_id"},
+	}
+	if !reflect.DeepEqual(references, expected) {
+		t.Errorf("expected %+v, got %+v", expected, references)
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// ReferenceTypeTag is the model field tag declaring that a string field holds the ID of another object,
+// e.g. `reftype:"safe_id"`. The generated attribute gets the matching ReferenceIDType, which rejects
+// values not shaped like such an ID, such as the name of the object.
+const ReferenceTypeTag = "reftype"
+
+// referenceDocsURL is the base URL of the provider documentation linked from reference ID errors.
+const referenceDocsURL = "https://registry.terraform.io/providers/cyberark/idsec/latest/docs/"
+
+// referenceIDKind describes the IDs of a kind of object.
+type referenceIDKind struct {
+	// name names the ID in descriptions and errors, e.g. "Safe ID".
+	name string
+	// pattern matches the valid IDs.
+	pattern *regexp.Regexp
+	// hint tells what the ID is and how it differs from a name.
+	hint string
+	// docPath is the page documenting the object, relative to referenceDocsURL.
+	docPath string
+}
+
+// referenceIDKinds are the kinds of IDs a reftype tag can declare, keyed by tag value.
+var referenceIDKinds = map[string]referenceIDKind{
+	"safe_id": {
+		name:    "Safe ID",
+		pattern: regexp.MustCompile(`^(?:[^\s%/\\]|%[0-9A-Fa-f]{2})+$`),
+		hint:    "The Safe ID is the URL encoding of the Safe name, e.g. My%20Safe for the Safe named My Safe, as exported by the safe_id attribute of idsec_pcloud_safe.",
+		docPath: "resources/pcloud_safe",
+	},
+	"policy_id": {
+		name:    "policy ID",
+		pattern: regexp.MustCompile(`^[0-9A-Fa-f]{8}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{4}-[0-9A-Fa-f]{12}$`),
+		hint:    "The policy ID is the UUID assigned to an access policy when it is created, as exported by metadata.policy_id of the policy resources, not the policy name.",
+		docPath: "resources/policy_vm",
+	},
+}
+
+var (
+	// SafeIDType is the type of attributes holding the ID of a Privilege Cloud Safe.
+	SafeIDType = ReferenceIDType{Kind: "safe_id"}
+	// PolicyIDType is the type of attributes holding the ID of an access policy.
+	PolicyIDType = ReferenceIDType{Kind: "policy_id"}
+)
+
+// The values of ReferenceIDType are basetypes.StringValue, which the converters handle, so it validates
+// them as a type rather than through a custom value type.
+var (
+	_ basetypes.StringTypable = ReferenceIDType{}
+	_ xattr.TypeWithValidate  = ReferenceIDType{}
+)
+
+// ReferenceIDType is a string type for attributes holding the ID of another object. Its values are plain
+// strings, which it validates against the format of the ID so that passing the name of the object, a
+// common mistake, fails validation with an explanation instead of failing the API call.
+type ReferenceIDType struct {
+	basetypes.StringType
+	// Kind is the reftype tag value of the type, e.g. "safe_id".
+	Kind string
+}
+
+// ReferenceIDTypeOf returns the reference ID type declared by the reftype tag of a model field. The
+// second return value is false when the field has no reftype tag or its kind is unknown.
+func ReferenceIDTypeOf(field reflect.StructField) (ReferenceIDType, bool) {
+	kind := field.Tag.Get(ReferenceTypeTag)
+	if _, ok := referenceIDKinds[kind]; !ok {
+		return ReferenceIDType{}, false
+	}
+	return ReferenceIDType{Kind: kind}, true
+}
+
+// Equal reports whether o is a reference ID type of the same kind. As values of the type are plain
+// strings, it also equals the string type.
+func (t ReferenceIDType) Equal(o attr.Type) bool {
+	switch other := o.(type) {
+	case ReferenceIDType:
+		return other.Kind == t.Kind
+	case basetypes.StringType:
+		return true
+	default:
+		return false
+	}
+}
+
+// String returns a human-readable representation of the type.
+func (t ReferenceIDType) String() string {
+	return "schemas.ReferenceIDType[" + t.Kind + "]"
+}
+
+// ValueFromString returns the string value as is.
+func (t ReferenceIDType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return in, nil
+}
+
+// ValueFromTerraform returns the string value of a Terraform value.
+func (t ReferenceIDType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	return t.StringType.ValueFromTerraform(ctx, in)
+}
+
+// ValueType returns the value type of the type.
+func (t ReferenceIDType) ValueType(_ context.Context) attr.Value {
+	return basetypes.StringValue{}
+}
+
+// Validate rejects known values not shaped like an ID of the kind of the type.
+func (t ReferenceIDType) Validate(_ context.Context, in tftypes.Value, attributePath path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+	kind, ok := referenceIDKinds[t.Kind]
+	if !ok || in.IsNull() || !in.IsKnown() {
+		return diags
+	}
+	var value string
+	if err := in.As(&value); err != nil {
+		diags.AddAttributeError(attributePath, "Invalid "+kind.name, fmt.Sprintf("Expected a string value: %s", err.Error()))
+		return diags
+	}
+	if !kind.pattern.MatchString(value) {
+		diags.AddAttributeError(
+			attributePath,
+			"Invalid "+kind.name,
+			fmt.Sprintf("%q is not a valid %s. %s See %s%s.", value, kind.name, kind.hint, referenceDocsURL, kind.docPath),
+		)
+	}
+	return diags
+}
+
+// Describe appends what the attribute expects to its description.
+func (t ReferenceIDType) Describe(desc string) string {
+	kind, ok := referenceIDKinds[t.Kind]
+	if !ok {
+		return desc
+	}
+	expects := "Expects a " + kind.name + "."
+	if desc = strings.TrimSpace(desc); desc == "" {
+		return expects
+	}
+	if !strings.HasSuffix(desc, ".") {
+		desc += "."
+	}
+	return desc + " " + expects
+}
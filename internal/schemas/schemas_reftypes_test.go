@@ -0,0 +1,118 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testReferenceTypeModel struct {
+	SafeID   string `json:"safe_id" mapstructure:"safe_id" desc:"The Safe of the member" reftype:"safe_id"`
+	PolicyID string `json:"policy_id,omitempty" mapstructure:"policy_id,omitempty" reftype:"policy_id"`
+	Name     string `json:"name" mapstructure:"name" reftype:"unknown_kind"`
+}
+
+func TestReferenceIDTypeValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		refType   ReferenceIDType
+		value     tftypes.Value
+		wantError string
+	}{
+		{name: "url_encoded_safe_id", refType: SafeIDType, value: tftypes.NewValue(tftypes.String, "My%20Safe")},
+		{name: "safe_name_with_space", refType: SafeIDType, value: tftypes.NewValue(tftypes.String, "My Safe"), wantError: "Invalid Safe ID"},
+		{name: "malformed_escape", refType: SafeIDType, value: tftypes.NewValue(tftypes.String, "My%2"), wantError: "Invalid Safe ID"},
+		{name: "policy_uuid", refType: PolicyIDType, value: tftypes.NewValue(tftypes.String, "9b2b5d52-7c4e-4f0b-a0a3-0c7a1c2c6a51")},
+		{name: "policy_name", refType: PolicyIDType, value: tftypes.NewValue(tftypes.String, "prod-db-access"), wantError: "Invalid policy ID"},
+		{name: "null_skipped", refType: PolicyIDType, value: tftypes.NewValue(tftypes.String, nil)},
+		{name: "unknown_skipped", refType: PolicyIDType, value: tftypes.NewValue(tftypes.String, tftypes.UnknownValue)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			diags := tt.refType.Validate(context.Background(), tt.value, path.Root("attr"))
+			if tt.wantError == "" {
+				if diags.HasError() {
+					t.Errorf("unexpected diagnostics: %v", diags)
+				}
+				return
+			}
+			if !diags.HasError() || diags.Errors()[0].Summary() != tt.wantError {
+				t.Fatalf("expected %q, got %v", tt.wantError, diags)
+			}
+			if !strings.Contains(diags.Errors()[0].Detail(), referenceDocsURL) {
+				t.Errorf("expected the error to link the documentation, got %q", diags.Errors()[0].Detail())
+			}
+		})
+	}
+}
+
+func TestReferenceTypeTag(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	generated := GenerateResourceSchemaFromStruct(&testReferenceTypeModel{}, nil, nil, ResourceSchemaOptions{})
+	safeID := generated.Attributes["safe_id"].(schema.StringAttribute)
+	if !SafeIDType.Equal(safeID.CustomType) {
+		t.Fatalf("expected safe_id to be a Safe ID, got %v", safeID.CustomType)
+	}
+	if safeID.Description != "The Safe of the member. Expects a Safe ID." {
+		t.Errorf("unexpected description %q", safeID.Description)
+	}
+	if name := generated.Attributes["name"].(schema.StringAttribute); name.CustomType != nil {
+		t.Errorf("expected an unknown reftype to be ignored, got %v", name.CustomType)
+	}
+
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"safe_id": tftypes.String, "policy_id": tftypes.String, "name": tftypes.String}}
+	raw := func(safeID string) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"safe_id":   tftypes.NewValue(tftypes.String, safeID),
+			"policy_id": tftypes.NewValue(tftypes.String, nil),
+			"name":      tftypes.NewValue(tftypes.String, "member"),
+		})
+	}
+
+	config := tfsdk.Config{Schema: generated, Raw: raw("My Safe")}
+	var value types.String
+	if diags := config.GetAttribute(ctx, path.Root("safe_id"), &value); !diags.HasError() || diags.Errors()[0].Summary() != "Invalid Safe ID" {
+		t.Errorf("expected a Safe name to fail validation, got %v", diags)
+	}
+
+	plan := &tfsdk.Plan{Schema: generated, Raw: raw("My%20Safe")}
+	result, err := StructFromPlanObject(ctx, plan, &testReferenceTypeModel{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	model := result.(*testReferenceTypeModel)
+	if model.SafeID != "My%20Safe" {
+		t.Errorf("expected safe_id to decode as a string, got %+v", model)
+	}
+
+	attrTypes := map[string]attr.Type{}
+	for name, attribute := range generated.Attributes {
+		attrTypes[name] = attribute.GetType()
+	}
+	stateObj, err := StructToStateObject(ctx, model, nil, plan, attrTypes, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	state := tfsdk.State{Schema: generated, Raw: tftypes.NewValue(objectType, nil)}
+	if diags := state.Set(ctx, stateObj); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if diags := state.GetAttribute(ctx, path.Root("safe_id"), &value); diags.HasError() || value.ValueString() != "My%20Safe" {
+		t.Errorf("expected safe_id to round trip through state, got %v %v", value, diags)
+	}
+}
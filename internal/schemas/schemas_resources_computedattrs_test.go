@@ -66,13 +66,9 @@ func TestGenerateResourceSchema_BareComputedNameIsTopLevelOnly(t *testing.T) {
 		&computedAttrsModel{},
 		nil,
 		nil,
-		nil,
-		nil,
-		nil,
-		nil,
-		nil,
-		[]string{"id"},
-		nil,
+		ResourceSchemaOptions{
+			ComputedAttrs: []string{"id"},
+		},
 	)
 
 	if !attrIsReadOnly(s.Attributes["id"]) {
@@ -96,13 +92,9 @@ func TestGenerateResourceSchema_DottedComputedPathTargetsNestedOnly(t *testing.T
 		&computedAttrsModel{},
 		nil,
 		nil,
-		nil,
-		nil,
-		nil,
-		nil,
-		nil,
-		[]string{"source.id"},
-		nil,
+		ResourceSchemaOptions{
+			ComputedAttrs: []string{"source.id"},
+		},
 	)
 
 	if !attrIsSettable(s.Attributes["id"]) {
@@ -115,3 +107,75 @@ func TestGenerateResourceSchema_DottedComputedPathTargetsNestedOnly(t *testing.T
 		t.Errorf("expected target.id to remain settable, got %+v", got)
 	}
 }
+
+type computedOnlyCreateModel struct {
+	Name string `mapstructure:"name"`
+}
+
+type computedOnlyUpdateModel struct {
+	ID          string `mapstructure:"id"`
+	Description string `mapstructure:"description"`
+}
+
+type computedOnlyStateModel struct {
+	ID          string                 `mapstructure:"id"`
+	Name        string                 `mapstructure:"name"`
+	Description string                 `mapstructure:"description"`
+	CreatedAt   string                 `mapstructure:"created_at"`
+	Owner       computedAttrsNestedRef `mapstructure:"owner"`
+}
+
+// TestStateOnlyAttributeNames verifies that only attributes absent from both the create
+// and update models are reported, and that the result is sorted.
+func TestStateOnlyAttributeNames(t *testing.T) {
+	t.Parallel()
+
+	got := StateOnlyAttributeNames(computedOnlyCreateModel{}, &computedOnlyUpdateModel{}, computedOnlyStateModel{})
+	want := []string{"created_at", "owner"}
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("expected %v, got %v", want, got)
+		}
+	}
+
+	if got := StateOnlyAttributeNames(computedOnlyCreateModel{}, nil, nil); len(got) != 0 {
+		t.Errorf("expected no state-only attributes without a state model, got %v", got)
+	}
+}
+
+// TestGenerateResourceSchema_StateOnlyAttributesReadOnly verifies that feeding the
+// state-only names as computed attributes turns outputs, and the nested attributes of
+// those, read-only while create/update inputs stay settable.
+func TestGenerateResourceSchema_StateOnlyAttributesReadOnly(t *testing.T) {
+	t.Parallel()
+
+	stateOnly := StateOnlyAttributeNames(computedOnlyCreateModel{}, computedOnlyUpdateModel{}, computedOnlyStateModel{})
+	s := GenerateResourceSchemaFromStruct(
+		computedOnlyCreateModel{},
+		computedOnlyUpdateModel{},
+		computedOnlyStateModel{},
+		ResourceSchemaOptions{
+			ComputedAttrs: stateOnly,
+		},
+	)
+
+	for _, name := range []string{"created_at", "owner"} {
+		if !attrIsReadOnly(s.Attributes[name]) {
+			t.Errorf("expected %q to be read-only, got %+v", name, s.Attributes[name])
+		}
+	}
+	owner := s.Attributes["owner"].(schema.SingleNestedAttribute)
+	for name, nested := range owner.Attributes {
+		if !attrIsReadOnly(nested) {
+			t.Errorf("expected owner.%s to be read-only, got %+v", name, nested)
+		}
+	}
+	for _, name := range []string{"name", "description"} {
+		if !attrIsSettable(s.Attributes[name]) {
+			t.Errorf("expected %q to remain settable, got %+v", name, s.Attributes[name])
+		}
+	}
+}
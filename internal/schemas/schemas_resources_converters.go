@@ -4,6 +4,10 @@
 package schemas
 
 import (
+	"context"
+	"encoding/json"
+	"maps"
+	"math/big"
 	"reflect"
 	"slices"
 	"strconv"
@@ -13,6 +17,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/dynamicplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
@@ -20,6 +25,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -34,8 +40,15 @@ var intTypes = []reflect.Kind{
 	reflect.Uint16,
 	reflect.Uint32,
 	reflect.Uint64,
-	reflect.Float32,
-	reflect.Float64,
+}
+
+// jsonNumberType is the reflect type of json.Number, which is exposed as an arbitrary-precision number attribute.
+var jsonNumberType = reflect.TypeOf(json.Number(""))
+
+// isByteSliceType reports whether t is a byte slice, such as a certificate or a key, which is exposed as a
+// base64 encoded string attribute rather than a list of numbers.
+func isByteSliceType(t reflect.Type) bool {
+	return t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8
 }
 
 var simpleTypes = []reflect.Kind{
@@ -55,7 +68,14 @@ var simpleTypes = []reflect.Kind{
 	reflect.Float64,
 }
 
+// hasInterfaceInnerType reports whether a type holds an interface, directly or in its elements or fields.
 func hasInterfaceInnerType(fieldType reflect.Type) bool {
+	return hasInterfaceInnerTypeVisiting(fieldType, map[reflect.Type]bool{})
+}
+
+// hasInterfaceInnerTypeVisiting checks fieldType, visiting holding the structs already checked, which
+// recursive structs reach again.
+func hasInterfaceInnerTypeVisiting(fieldType reflect.Type, visiting map[reflect.Type]bool) bool {
 	if fieldType.Kind() == reflect.Interface {
 		return true
 	}
@@ -65,13 +85,20 @@ func hasInterfaceInnerType(fieldType reflect.Type) bool {
 			return true
 		}
 		if fieldType.Elem().Kind() == reflect.Struct || fieldType.Elem().Kind() == reflect.Map {
-			return hasInterfaceInnerType(fieldType.Elem())
+			return hasInterfaceInnerTypeVisiting(fieldType.Elem(), visiting)
 		}
 	}
 	if fieldType.Kind() == reflect.Struct {
+		if visiting[fieldType] {
+			return false
+		}
+		visiting[fieldType] = true
 		actualFields := resolveFieldsSquashed(fieldType)
 		for i := range actualFields {
-			hasType := hasInterfaceInnerType(actualFields[i].Type)
+			if _, ok := fieldUnion(actualFields[i]); ok {
+				continue
+			}
+			hasType := hasInterfaceInnerTypeVisiting(actualFields[i].Type, visiting)
 			if hasType {
 				return true
 			}
@@ -109,6 +136,77 @@ func parseMinMaxLengthFromFieldTags(minlength, maxlength string) (*int64, *int64
 	return minVal, maxVal
 }
 
+// parseMinMaxValueFromFieldTags parses standalone `minvalue` and `maxvalue` struct tags
+// and returns them as float64 pointers. A nil pointer means the tag was absent or could not be
+// parsed as a number. The bounds constrain the value of floating point attributes.
+//
+// Example: `minvalue:"0" maxvalue:"1"`.
+func parseMinMaxValueFromFieldTags(minvalue, maxvalue string) (*float64, *float64) {
+	var minVal, maxVal *float64
+	if minvalue != "" {
+		if v, err := strconv.ParseFloat(minvalue, 64); err == nil {
+			minVal = &v
+		}
+	}
+	if maxvalue != "" {
+		if v, err := strconv.ParseFloat(maxvalue, 64); err == nil {
+			maxVal = &v
+		}
+	}
+	return minVal, maxVal
+}
+
+// nestedCollectionDefault parses the `default` tag of a list, set or map of nested objects, a JSON
+// document decoded into the field type, e.g. `default:"[{\"name\":\"admin\"}]"`, and converts it to
+// a value of collectionType. The second return value is false when the tag cannot be decoded.
+func nestedCollectionDefault(fieldType reflect.Type, defaultValue string, collectionType attr.Type) (attr.Value, bool) {
+	decoded := reflect.New(fieldType)
+	if err := json.Unmarshal([]byte(defaultValue), decoded.Interface()); err != nil {
+		return nil, false
+	}
+	value, err := interfaceTypeToAttr(context.Background(), decoded.Elem().Interface(), collectionType)
+	if err != nil {
+		return nil, false
+	}
+	return value, true
+}
+
+// simpleMapDefault decodes the `default` tag of a map of bools or integers, given as comma separated
+// key=value pairs, e.g. `default:"read=true,write=false"`. Maps of other values, or pairs that do not
+// parse, have no default.
+func simpleMapDefault(elemType reflect.Type, defaultValue string) (types.Map, bool) {
+	values := map[string]attr.Value{}
+	for _, pair := range strings.Split(defaultValue, ",") {
+		if pair == "" {
+			continue
+		}
+		key, value, found := strings.Cut(pair, "=")
+		if !found || key == "" {
+			return types.Map{}, false
+		}
+		switch {
+		case elemType.Kind() == reflect.Bool:
+			boolValue, err := strconv.ParseBool(value)
+			if err != nil {
+				return types.Map{}, false
+			}
+			values[key] = types.BoolValue(boolValue)
+		case slices.Contains(intTypes, elemType.Kind()):
+			intValue, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return types.Map{}, false
+			}
+			values[key] = types.Int64Value(intValue)
+		default:
+			return types.Map{}, false
+		}
+	}
+	if elemType.Kind() == reflect.Bool {
+		return types.MapValueMust(types.BoolType, values), true
+	}
+	return types.MapValueMust(types.Int64Type, values), true
+}
+
 func resourceSchemaAttrsFromStruct(inputModel interface{}, setAsComputed bool, sensitiveAttrs []string, extraRequiredAttrs []string, computedAsSetAttrs []string, immutableAttrs []string, forceNewAttrs []string, computedAttrs []string, caseInsensitiveAttrs []string, pathPrefix string) map[string]schema.Attribute {
 	modelType := reflect.TypeOf(inputModel)
 	if modelType.Kind() == reflect.Pointer {
@@ -127,19 +225,81 @@ func resourceSchemaAttrsFromStruct(inputModel interface{}, setAsComputed bool, s
 		defaultValue := field.Tag.Get("default")
 		minVal, maxVal := parseMinMaxLengthFromFieldTags(field.Tag.Get("minlength"), field.Tag.Get("maxlength"))
 		hasMinMaxLength := minVal != nil || maxVal != nil
+		minValue, maxValue := parseMinMaxValueFromFieldTags(field.Tag.Get("minvalue"), field.Tag.Get("maxvalue"))
 		fieldName := resolveFieldName(field)
 		fieldPath := fieldName
 		if pathPrefix != "" {
 			fieldPath = pathPrefix + "." + fieldName
 		}
 		isRequired := strings.Contains(required, "true") || strings.Contains(validate, "required") || slices.Contains(extraRequiredAttrs, fieldName)
-		isSensitive := slices.Contains(sensitiveAttrs, fieldName)
+		isSensitive := slices.Contains(sensitiveAttrs, fieldName) || hasSensitiveTag(field)
 		isImmutable := slices.Contains(immutableAttrs, fieldName)
 		isForceNew := slices.Contains(forceNewAttrs, fieldName)
+		if isForceNew {
+			desc = withReplaceImpact(desc, field.Tag.Get(ReplaceImpactTag))
+		}
 		isComputedOnly := slices.Contains(computedAttrs, fieldPath)
+		if maxDepth, exceeded := exceedsMaxDepth(field, fieldPath); exceeded {
+			attributes[fieldName] = applyDeprecation(schema.DynamicAttribute{
+				Description: maxDepthDescription(desc, maxDepth),
+				Optional:    (!isRequired || setAsComputed) && !isComputedOnly,
+				Required:    isRequired && !setAsComputed && !isComputedOnly,
+				Computed:    !isRequired || setAsComputed || isComputedOnly,
+				Sensitive:   isSensitive,
+			}, depInfo)
+			continue
+		}
 		if fieldType.Kind() == reflect.Pointer {
 			fieldType = fieldType.Elem()
 		}
+		if fieldType == jsonNumberType {
+			numberAttr := schema.NumberAttribute{
+				Description: desc,
+				Optional:    !isRequired || setAsComputed,
+				Required:    isRequired && !setAsComputed,
+				Computed:    !isRequired || setAsComputed || isComputedOnly,
+				Sensitive:   isSensitive,
+			}
+			if isComputedOnly {
+				numberAttr.Optional = false
+				numberAttr.Required = false
+			}
+			if defaultValue != "" && !setAsComputed && !isComputedOnly {
+				if numberValue, _, err := big.ParseFloat(defaultValue, 10, 512, big.ToNearestEven); err == nil {
+					numberAttr.Default = NumberDefault{Value: numberValue}
+					numberAttr.Required = false
+					numberAttr.Optional = true
+					numberAttr.Computed = true
+				}
+			}
+			attributes[fieldName] = applyDeprecation(numberAttr, depInfo)
+			continue
+		}
+		if isByteSliceType(fieldType) {
+			bytesAttr := schema.StringAttribute{
+				Description: desc,
+				Optional:    !isRequired || setAsComputed,
+				Required:    isRequired && !setAsComputed,
+				Computed:    !isRequired || setAsComputed || isComputedOnly,
+				Sensitive:   isSensitive,
+				Validators:  []validator.String{Base64Validator{}},
+			}
+			if isComputedOnly {
+				bytesAttr.Optional = false
+				bytesAttr.Required = false
+			}
+			if isImmutable {
+				bytesAttr.PlanModifiers = []planmodifier.String{
+					ImmutableString(),
+				}
+			} else if isForceNew {
+				bytesAttr.PlanModifiers = []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				}
+			}
+			attributes[fieldName] = applyDeprecation(bytesAttr, depInfo)
+			continue
+		}
 		switch fieldType.Kind() {
 		case reflect.String:
 			if setAsComputed || isComputedOnly {
@@ -174,9 +334,16 @@ func resourceSchemaAttrsFromStruct(inputModel interface{}, setAsComputed bool, s
 			if choices != "" {
 				strAttr.Validators = append(strAttr.Validators, StringInChoicesValidator{Choices: strings.Split(choices, ",")})
 			}
+			if formatValidator, ok := FormatValidatorOf(field); ok {
+				strAttr.Validators = append(strAttr.Validators, formatValidator)
+			}
 			if hasMinMaxLength {
 				strAttr.Validators = append(strAttr.Validators, StringLengthValidator{Min: minVal, Max: maxVal})
 			}
+			if refType, ok := ReferenceIDTypeOf(field); ok {
+				strAttr.CustomType = refType
+				strAttr.Description = refType.Describe(strAttr.Description)
+			}
 			if isImmutable {
 				strAttr.PlanModifiers = []planmodifier.String{
 					ImmutableString(),
@@ -269,6 +436,44 @@ func resourceSchemaAttrsFromStruct(inputModel interface{}, setAsComputed bool, s
 				}
 			}
 			attributes[fieldName] = applyDeprecation(int64Attr, depInfo)
+		case reflect.Float32, reflect.Float64:
+			if setAsComputed || isComputedOnly {
+				floatAttr := schema.Float64Attribute{
+					Description: desc,
+					Optional:    !isComputedOnly,
+					Computed:    true,
+					Sensitive:   isSensitive,
+				}
+				attributes[fieldName] = applyDeprecation(floatAttr, depInfo)
+				continue
+			}
+			float64Attr := schema.Float64Attribute{
+				Description: desc,
+				Optional:    !isRequired,
+				Required:    isRequired,
+				Computed:    !isRequired,
+				Sensitive:   isSensitive,
+			}
+			if defaultValue != "" {
+				floatValue, _ := strconv.ParseFloat(defaultValue, 64)
+				float64Attr.Default = Float64Default{Value: floatValue}
+				float64Attr.Required = false
+				float64Attr.Optional = true
+				float64Attr.Computed = true
+			}
+			if minValue != nil || maxValue != nil {
+				float64Attr.Validators = append(float64Attr.Validators, Float64RangeValidator{Min: minValue, Max: maxValue})
+			}
+			if isImmutable {
+				float64Attr.PlanModifiers = []planmodifier.Float64{
+					ImmutableFloat64(),
+				}
+			} else if isForceNew {
+				float64Attr.PlanModifiers = []planmodifier.Float64{
+					float64planmodifier.RequiresReplace(),
+				}
+			}
+			attributes[fieldName] = applyDeprecation(float64Attr, depInfo)
 		case reflect.Slice, reflect.Array:
 			// Inner dynamic types are not supported in terraform
 			if hasInterfaceInnerType(fieldType) {
@@ -290,12 +495,12 @@ func resourceSchemaAttrsFromStruct(inputModel interface{}, setAsComputed bool, s
 				}, depInfo)
 				continue
 			}
-			if slices.Contains(simpleTypes, fieldType.Elem().Kind()) {
+			if slices.Contains(simpleTypes, fieldType.Elem().Kind()) || isByteSliceType(fieldType.Elem()) {
 				terraType, err := reflectTypeToTerraformType(fieldType.Elem())
 				if err != nil {
 					continue
 				}
-				if slices.Contains(computedAsSetAttrs, fieldName) {
+				if slices.Contains(computedAsSetAttrs, fieldName) || isUnordered(field) {
 					if setAsComputed || isComputedOnly {
 						sliceAttr := schema.SetAttribute{
 							ElementType: terraType,
@@ -355,6 +560,9 @@ func resourceSchemaAttrsFromStruct(inputModel interface{}, setAsComputed bool, s
 					if choices != "" {
 						sliceAttr.Validators = append(sliceAttr.Validators, SliceInSetValidator{Choices: strings.Split(choices, ",")})
 					}
+					if formatValidator, ok := FormatValidatorOf(field); ok {
+						sliceAttr.Validators = append(sliceAttr.Validators, formatValidator)
+					}
 					if hasMinMaxLength {
 						sliceAttr.Validators = append(sliceAttr.Validators, SetSizeValidator{Min: minVal, Max: maxVal})
 					}
@@ -428,6 +636,9 @@ func resourceSchemaAttrsFromStruct(inputModel interface{}, setAsComputed bool, s
 					if choices != "" {
 						sliceAttr.Validators = append(sliceAttr.Validators, SliceInChoicesValidator{Choices: strings.Split(choices, ",")})
 					}
+					if formatValidator, ok := FormatValidatorOf(field); ok {
+						sliceAttr.Validators = append(sliceAttr.Validators, formatValidator)
+					}
 					if hasMinMaxLength {
 						sliceAttr.Validators = append(sliceAttr.Validators, ListSizeValidator{Min: minVal, Max: maxVal})
 					}
@@ -483,34 +694,96 @@ func resourceSchemaAttrsFromStruct(inputModel interface{}, setAsComputed bool, s
 			if fieldType.Elem().Kind() == reflect.Struct {
 				// Handle nested structs by recursively generating their schema
 				nestedSchemaAttrs := resourceSchemaAttrsFromStruct(reflect.New(fieldType.Elem()).Elem().Interface(), setAsComputed, sensitiveAttrs, extraRequiredAttrs, computedAsSetAttrs, immutableAttrs, forceNewAttrs, computedAttrs, caseInsensitiveAttrs, fieldPath)
-				if setAsComputed {
+				nestedObject := schema.NestedAttributeObject{
+					Attributes: nestedSchemaAttrs,
+				}
+				if slices.Contains(computedAsSetAttrs, fieldName) || isUnordered(field) {
+					if setAsComputed || isComputedOnly {
+						attributes[fieldName] = applyDeprecation(schema.SetNestedAttribute{
+							NestedObject: nestedObject,
+							Description:  desc,
+							Optional:     !isComputedOnly,
+							Computed:     true,
+							Sensitive:    isSensitive,
+						}, depInfo)
+						continue
+					}
+					setNested := schema.SetNestedAttribute{
+						NestedObject: nestedObject,
+						Description:  desc,
+						Optional:     !isRequired,
+						Required:     isRequired,
+						Computed:     !isRequired,
+						Sensitive:    isSensitive,
+					}
+					if defaultValue != "" {
+						if value, ok := nestedCollectionDefault(fieldType, defaultValue, types.SetType{ElemType: nestedObject.Type()}); ok {
+							setNested.Default = SetNestedDefault{Value: value.(types.Set)}
+							setNested.Required = false
+							setNested.Optional = true
+							setNested.Computed = true
+						}
+					}
+					if hasMinMaxLength {
+						setNested.Validators = append(setNested.Validators, SetSizeValidator{Min: minVal, Max: maxVal})
+					}
+					if isImmutable {
+						setNested.PlanModifiers = []planmodifier.Set{
+							ImmutableSet(),
+						}
+					} else if isForceNew {
+						setNested.PlanModifiers = []planmodifier.Set{
+							setplanmodifier.RequiresReplace(),
+						}
+					}
+					attributes[fieldName] = applyDeprecation(setNested, depInfo)
+					continue
+				}
+				if setAsComputed || isComputedOnly {
 					attributes[fieldName] = applyDeprecation(schema.ListNestedAttribute{
-						NestedObject: schema.NestedAttributeObject{
-							Attributes: nestedSchemaAttrs,
-						},
-						Description: desc,
-						Optional:    true,
-						Computed:    true,
-						Sensitive:   isSensitive,
+						NestedObject: nestedObject,
+						Description:  desc,
+						Optional:     !isComputedOnly,
+						Computed:     true,
+						Sensitive:    isSensitive,
 					}, depInfo)
 					continue
 				}
 				listNested := schema.ListNestedAttribute{
-					NestedObject: schema.NestedAttributeObject{
-						Attributes: nestedSchemaAttrs,
-					},
-					Description: desc,
-					Optional:    !isRequired,
-					Required:    isRequired,
-					Computed:    !isRequired,
-					Sensitive:   isSensitive,
+					NestedObject: nestedObject,
+					Description:  desc,
+					Optional:     !isRequired,
+					Required:     isRequired,
+					Computed:     !isRequired,
+					Sensitive:    isSensitive,
+				}
+				if defaultValue != "" {
+					if value, ok := nestedCollectionDefault(fieldType, defaultValue, types.ListType{ElemType: nestedObject.Type()}); ok {
+						listNested.Default = ListNestedDefault{Value: value.(types.List)}
+						listNested.Required = false
+						listNested.Optional = true
+						listNested.Computed = true
+					}
 				}
 				if hasMinMaxLength {
 					listNested.Validators = append(listNested.Validators, ListSizeValidator{Min: minVal, Max: maxVal})
 				}
+				if isImmutable {
+					listNested.PlanModifiers = []planmodifier.List{
+						ImmutableList(),
+					}
+				} else if isForceNew {
+					listNested.PlanModifiers = []planmodifier.List{
+						listplanmodifier.RequiresReplace(),
+					}
+				}
 				attributes[fieldName] = applyDeprecation(listNested, depInfo)
 			}
 		case reflect.Map:
+			// Terraform maps are keyed by strings, integer keys are stringified
+			if !isSupportedMapKeyKind(fieldType.Key().Kind()) {
+				continue
+			}
 			// Inner dynamic types are not supported in terraform
 			if hasInterfaceInnerType(fieldType) {
 				if setAsComputed {
@@ -531,7 +804,7 @@ func resourceSchemaAttrsFromStruct(inputModel interface{}, setAsComputed bool, s
 				}, depInfo)
 				continue
 			}
-			if slices.Contains(simpleTypes, fieldType.Elem().Kind()) {
+			if slices.Contains(simpleTypes, fieldType.Elem().Kind()) || isByteSliceType(fieldType.Elem()) {
 				terraType, err := reflectTypeToTerraformType(fieldType.Elem())
 				if err != nil {
 					continue
@@ -555,6 +828,14 @@ func resourceSchemaAttrsFromStruct(inputModel interface{}, setAsComputed bool, s
 					Computed:    !isRequired,
 					Sensitive:   isSensitive,
 				}
+				if defaultValue != "" {
+					if value, ok := simpleMapDefault(fieldType.Elem(), defaultValue); ok {
+						mapAttr.Default = MapDefault{Value: value}
+						mapAttr.Required = false
+						mapAttr.Optional = true
+						mapAttr.Computed = true
+					}
+				}
 				if hasMinMaxLength {
 					mapAttr.Validators = append(mapAttr.Validators, MapSizeValidator{Min: minVal, Max: maxVal})
 				}
@@ -587,32 +868,48 @@ func resourceSchemaAttrsFromStruct(inputModel interface{}, setAsComputed bool, s
 				}, depInfo)
 			} else if fieldType.Elem().Kind() == reflect.Struct {
 				nestedAttrs := resourceSchemaAttrsFromStruct(reflect.New(fieldType.Elem()).Elem().Interface(), setAsComputed, sensitiveAttrs, extraRequiredAttrs, computedAsSetAttrs, immutableAttrs, forceNewAttrs, computedAttrs, caseInsensitiveAttrs, fieldPath)
-				if setAsComputed {
+				nestedObject := schema.NestedAttributeObject{
+					Attributes: nestedAttrs,
+				}
+				if setAsComputed || isComputedOnly {
 					complexMapAttr := schema.MapNestedAttribute{
-						NestedObject: schema.NestedAttributeObject{
-							Attributes: nestedAttrs,
-						},
-						Description: desc,
-						Optional:    true,
-						Computed:    true,
-						Sensitive:   isSensitive,
+						NestedObject: nestedObject,
+						Description:  desc,
+						Optional:     !isComputedOnly,
+						Computed:     true,
+						Sensitive:    isSensitive,
 					}
 					attributes[fieldName] = applyDeprecation(complexMapAttr, depInfo)
 					continue
 				}
 				complexMapAttr := schema.MapNestedAttribute{
-					NestedObject: schema.NestedAttributeObject{
-						Attributes: nestedAttrs,
-					},
-					Description: desc,
-					Optional:    !isRequired,
-					Required:    isRequired,
-					Computed:    !isRequired,
-					Sensitive:   isSensitive,
+					NestedObject: nestedObject,
+					Description:  desc,
+					Optional:     !isRequired,
+					Required:     isRequired,
+					Computed:     !isRequired,
+					Sensitive:    isSensitive,
+				}
+				if defaultValue != "" {
+					if value, ok := nestedCollectionDefault(fieldType, defaultValue, types.MapType{ElemType: nestedObject.Type()}); ok {
+						complexMapAttr.Default = MapNestedDefault{Value: value.(types.Map)}
+						complexMapAttr.Required = false
+						complexMapAttr.Optional = true
+						complexMapAttr.Computed = true
+					}
 				}
 				if hasMinMaxLength {
 					complexMapAttr.Validators = append(complexMapAttr.Validators, MapSizeValidator{Min: minVal, Max: maxVal})
 				}
+				if isImmutable {
+					complexMapAttr.PlanModifiers = []planmodifier.Map{
+						ImmutableMap(),
+					}
+				} else if isForceNew {
+					complexMapAttr.PlanModifiers = []planmodifier.Map{
+						mapplanmodifier.RequiresReplace(),
+					}
+				}
 				attributes[fieldName] = applyDeprecation(complexMapAttr, depInfo)
 			}
 		case reflect.Struct:
@@ -645,6 +942,27 @@ func resourceSchemaAttrsFromStruct(inputModel interface{}, setAsComputed bool, s
 				}
 			}
 		case reflect.Interface:
+			if union, ok := fieldUnion(field); ok {
+				variantAttrs := map[string]schema.Attribute{}
+				for _, name := range union.variantNames() {
+					variantAttrs[name] = schema.SingleNestedAttribute{
+						Attributes:  resourceSchemaAttrsFromStruct(union.variantPrototype(name), setAsComputed, sensitiveAttrs, extraRequiredAttrs, computedAsSetAttrs, immutableAttrs, forceNewAttrs, computedAttrs, caseInsensitiveAttrs, fieldPath+"."+name),
+						Description: union.variantDescription(name),
+						Optional:    true,
+						Sensitive:   isSensitive,
+					}
+				}
+				attributes[fieldName] = applyDeprecation(schema.SingleNestedAttribute{
+					Attributes:  variantAttrs,
+					Description: desc,
+					Optional:    !isRequired || setAsComputed,
+					Required:    isRequired && !setAsComputed,
+					Computed:    !isRequired || setAsComputed,
+					Sensitive:   isSensitive,
+					Validators:  []validator.Object{union.validator()},
+				}, depInfo)
+				continue
+			}
 			if setAsComputed {
 				attributes[fieldName] = applyDeprecation(schema.DynamicAttribute{
 					Description: desc,
@@ -665,11 +983,13 @@ func resourceSchemaAttrsFromStruct(inputModel interface{}, setAsComputed bool, s
 			continue
 		}
 	}
+	applyConditionalValidators(attributes, actualFields)
+	applyJSONStringValidators(attributes)
 	return attributes
 }
 
 // forceComputedAttributesReadOnly marks computed-only attributes as read-only
-// (Optional=false, Required=false, Computed=true).
+// (Optional=false, Required=false, Computed=true), along with all the nested attributes of those.
 func forceComputedAttributesReadOnly(attributes map[string]schema.Attribute, computedAttrs []string) {
 	for _, computedAttrPath := range computedAttrs {
 		// Check if this is a path (contains a dot)
@@ -694,6 +1014,12 @@ func forceComputedAttributesReadOnly(attributes map[string]schema.Attribute, com
 						forceComputedAttributesReadOnly(a.NestedObject.Attributes, []string{remainingPath})
 						attributes[nestedAttrName] = a
 					}
+				case schema.SetNestedAttribute:
+					if a.NestedObject.Attributes != nil {
+						// Recursively process with the remaining path
+						forceComputedAttributesReadOnly(a.NestedObject.Attributes, []string{remainingPath})
+						attributes[nestedAttrName] = a
+					}
 				case schema.MapNestedAttribute:
 					if a.NestedObject.Attributes != nil {
 						// Recursively process with the remaining path
@@ -752,9 +1078,9 @@ func forceComputedAttributesReadOnly(attributes map[string]schema.Attribute, com
 				a.PlanModifiers = append(a.PlanModifiers, dynamicplanmodifier.UseStateForUnknown())
 				attributes[computedAttrPath] = a
 			case schema.SingleNestedAttribute:
-				// Recursively process nested attributes
+				// Nested attributes of a read-only attribute cannot be configured either
 				if a.Attributes != nil {
-					forceComputedAttributesReadOnly(a.Attributes, computedAttrs)
+					forceComputedAttributesReadOnly(a.Attributes, slices.Collect(maps.Keys(a.Attributes)))
 				}
 				a.Optional = false
 				a.Required = false
@@ -762,19 +1088,29 @@ func forceComputedAttributesReadOnly(attributes map[string]schema.Attribute, com
 				a.PlanModifiers = append(a.PlanModifiers, objectplanmodifier.UseStateForUnknown())
 				attributes[computedAttrPath] = a
 			case schema.ListNestedAttribute:
-				// Recursively process nested attributes
+				// Nested attributes of a read-only attribute cannot be configured either
 				if a.NestedObject.Attributes != nil {
-					forceComputedAttributesReadOnly(a.NestedObject.Attributes, computedAttrs)
+					forceComputedAttributesReadOnly(a.NestedObject.Attributes, slices.Collect(maps.Keys(a.NestedObject.Attributes)))
 				}
 				a.Optional = false
 				a.Required = false
 				a.Computed = true
 				a.PlanModifiers = append(a.PlanModifiers, listplanmodifier.UseStateForUnknown())
 				attributes[computedAttrPath] = a
+			case schema.SetNestedAttribute:
+				// Nested attributes of a read-only attribute cannot be configured either
+				if a.NestedObject.Attributes != nil {
+					forceComputedAttributesReadOnly(a.NestedObject.Attributes, slices.Collect(maps.Keys(a.NestedObject.Attributes)))
+				}
+				a.Optional = false
+				a.Required = false
+				a.Computed = true
+				a.PlanModifiers = append(a.PlanModifiers, setplanmodifier.UseStateForUnknown())
+				attributes[computedAttrPath] = a
 			case schema.MapNestedAttribute:
-				// Recursively process nested attributes
+				// Nested attributes of a read-only attribute cannot be configured either
 				if a.NestedObject.Attributes != nil {
-					forceComputedAttributesReadOnly(a.NestedObject.Attributes, computedAttrs)
+					forceComputedAttributesReadOnly(a.NestedObject.Attributes, slices.Collect(maps.Keys(a.NestedObject.Attributes)))
 				}
 				a.Optional = false
 				a.Required = false
@@ -811,8 +1147,8 @@ func getNestedStructFieldNames(stateModel interface{}) map[string]bool {
 		if mapstructureTag == "-" { // skip ignored fields
 			continue
 		}
-		// Skip squashed fields - they're already flattened
-		if mapstructureTag == ",squash" {
+		// Skip squashed and flattened fields - they're already flattened
+		if _, flattened := flattenedField(field); mapstructureTag == ",squash" || flattened {
 			continue
 		}
 		fieldType := field.Type
@@ -833,9 +1169,117 @@ func getNestedStructFieldNames(stateModel interface{}) map[string]bool {
 	return fieldNames
 }
 
+// topLevelAttributeNames returns the snake_case attribute names a model contributes at the
+// top level of a schema, resolving squashed fields the same way schema generation does.
+func topLevelAttributeNames(model interface{}) map[string]bool {
+	names := make(map[string]bool)
+	if model == nil {
+		return names
+	}
+	modelType := reflect.TypeOf(model)
+	if modelType.Kind() == reflect.Pointer {
+		modelType = modelType.Elem()
+	}
+	if modelType.Kind() != reflect.Struct {
+		return names
+	}
+	for _, field := range resolveFieldsSquashed(modelType) {
+		names[resolveFieldName(field)] = true
+	}
+	return names
+}
+
+// ReplaceImpactTag is the model field tag describing what the API destroys when a ForceNew attribute
+// changes, e.g. `replace_impact:"deletes and recreates the safe and all its memberships"`.
+const ReplaceImpactTag = "replace_impact"
+
+// withReplaceImpact appends what the API destroys when a ForceNew attribute changes to its description.
+func withReplaceImpact(desc string, impact string) string {
+	impact = strings.TrimSuffix(strings.TrimSpace(impact), ".")
+	if impact == "" {
+		return desc
+	}
+	sentence := "Changing this attribute " + impact + "."
+	if desc = strings.TrimSpace(desc); desc == "" {
+		return sentence
+	}
+	if !strings.HasSuffix(desc, ".") {
+		desc += "."
+	}
+	return desc + " " + sentence
+}
+
+// ReplaceImpacts maps the top-level attributes of the models to their replace_impact tag, which tells
+// what the API destroys when the attribute changes and the resource is replaced. When several models
+// declare an attribute, the first tag set wins.
+func ReplaceImpacts(models ...interface{}) map[string]string {
+	impacts := make(map[string]string)
+	for _, model := range models {
+		if model == nil {
+			continue
+		}
+		modelType := reflect.TypeOf(model)
+		if modelType.Kind() == reflect.Pointer {
+			modelType = modelType.Elem()
+		}
+		if modelType.Kind() != reflect.Struct {
+			continue
+		}
+		for _, field := range resolveFieldsSquashed(modelType) {
+			impact := strings.TrimSuffix(strings.TrimSpace(field.Tag.Get(ReplaceImpactTag)), ".")
+			name := resolveFieldName(field)
+			if _, exists := impacts[name]; impact != "" && !exists {
+				impacts[name] = impact
+			}
+		}
+	}
+	return impacts
+}
+
+// StateOnlyAttributeNames returns the top-level attribute names that only exist on the state model,
+// i.e. outputs the user can never send through create or update. The result is sorted.
+func StateOnlyAttributeNames(createModel interface{}, updateModel interface{}, stateModel interface{}) []string {
+	inputNames := topLevelAttributeNames(createModel)
+	for name := range topLevelAttributeNames(updateModel) {
+		inputNames[name] = true
+	}
+	var stateOnly []string
+	for name := range topLevelAttributeNames(stateModel) {
+		if !inputNames[name] {
+			stateOnly = append(stateOnly, name)
+		}
+	}
+	slices.Sort(stateOnly)
+	return stateOnly
+}
+
+// ResourceSchemaOptions holds the attribute names tuning the schema GenerateResourceSchemaFromStruct
+// generates. Nested attributes are named by their dotted path where supported.
+type ResourceSchemaOptions struct {
+	// SensitiveAttrs lists the attributes marked sensitive.
+	SensitiveAttrs []string
+	// ExtraRequiredAttrs lists the attributes required even though the models do not require them.
+	ExtraRequiredAttrs []string
+	// ComputedAsSetAttrs lists the list attributes generated as sets.
+	ComputedAsSetAttrs []string
+	// ImmutableAttrs lists the attributes that cannot change once set.
+	ImmutableAttrs []string
+	// ForceNewAttrs lists the attributes whose change replaces the resource.
+	ForceNewAttrs []string
+	// ComputedAttrs lists the attributes forced read-only.
+	ComputedAttrs []string
+	// CaseInsensitiveAttrs lists top-level string attributes that get CaseInsensitiveString plan modifiers.
+	CaseInsensitiveAttrs []string
+	// BlockAttrs lists nested attributes emitted as HCL blocks instead of nested attributes.
+	BlockAttrs []string
+	// PlanModifiers maps attributes to the names of the plan modifiers applied to them.
+	PlanModifiers map[string][]string
+}
+
 // GenerateResourceSchemaFromStruct generates a Terraform schema from a Go struct.
-// caseInsensitiveAttrs lists top-level string attribute names that get CaseInsensitiveString plan modifiers.
-func GenerateResourceSchemaFromStruct(createModel interface{}, updateModel interface{}, stateModel interface{}, sensitiveAttrs []string, extraRequiredAttrs []string, computedAsSetAttrs []string, immutableAttrs []string, forceNewAttrs []string, computedAttrs []string, caseInsensitiveAttrs []string) schema.Schema {
+func GenerateResourceSchemaFromStruct(createModel interface{}, updateModel interface{}, stateModel interface{}, options ResourceSchemaOptions) schema.Schema {
+	sensitiveAttrs, extraRequiredAttrs, computedAsSetAttrs := options.SensitiveAttrs, options.ExtraRequiredAttrs, options.ComputedAsSetAttrs
+	immutableAttrs, forceNewAttrs, computedAttrs, caseInsensitiveAttrs := options.ImmutableAttrs, options.ForceNewAttrs, options.ComputedAttrs, options.CaseInsensitiveAttrs
 	schemaAttrs := resourceSchemaAttrsFromStruct(createModel, false, sensitiveAttrs, extraRequiredAttrs, computedAsSetAttrs, immutableAttrs, forceNewAttrs, computedAttrs, caseInsensitiveAttrs, "")
 
 	// Get field names that belong to nested structs in the state model
@@ -874,9 +1318,11 @@ func GenerateResourceSchemaFromStruct(createModel interface{}, updateModel inter
 	// Force computed-only attributes to be read-only (Optional=false, Required=false, Computed=true)
 	// This processes both top-level and nested attributes recursively
 	forceComputedAttributesReadOnly(schemaAttrs, computedAttrs)
+	applyNamedPlanModifiers(schemaAttrs, options.PlanModifiers)
 
 	return schema.Schema{
 		Attributes: schemaAttrs,
+		Blocks:     convertNestedAttributesToBlocks(schemaAttrs, options.BlockAttrs),
 	}
 }
 
@@ -886,5 +1332,8 @@ func ResourceSchemaToSchemaAttrTypes(schemaInput schema.Schema) map[string]attr.
 	for key, schemaAttr := range schemaInput.Attributes {
 		attributes[key] = schemaAttr.GetType()
 	}
+	for key, schemaBlock := range schemaInput.Blocks {
+		attributes[key] = schemaBlock.Type()
+	}
 	return attributes
 }
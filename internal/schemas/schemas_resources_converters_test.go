@@ -5,10 +5,18 @@ package schemas
 
 import (
 	"context"
+	"encoding/json"
+	"math/big"
+	"reflect"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/mitchellh/mapstructure"
 )
 
 // Test helper structs for testing nested struct scenarios
@@ -169,6 +177,12 @@ func TestGetNestedStructFieldNames(t *testing.T) {
 	}
 }
 
+type testSensitiveTagModel struct {
+	Address  string `mapstructure:"address"`
+	Username string `mapstructure:"username"`
+	Password string `mapstructure:"password" sensitive:"true"`
+}
+
 // TestGenerateResourceSchemaFromStruct tests the GenerateResourceSchemaFromStruct function.
 func TestGenerateResourceSchemaFromStruct(t *testing.T) {
 	t.Parallel()
@@ -259,6 +273,22 @@ func TestGenerateResourceSchemaFromStruct(t *testing.T) {
 				}
 			},
 		},
+		{
+			name:           "success_with_sensitive_tag_unioned_with_list",
+			createModel:    &testSensitiveTagModel{},
+			stateModel:     &testSensitiveTagModel{},
+			sensitiveAttrs: []string{"username"},
+			validateFunc: func(t *testing.T, result schema.Schema) {
+				for _, name := range []string{"password", "username"} {
+					if !result.Attributes[name].IsSensitive() {
+						t.Errorf("Expected %s to be sensitive", name)
+					}
+				}
+				if result.Attributes["address"].IsSensitive() {
+					t.Error("Expected address to not be sensitive")
+				}
+			},
+		},
 		{
 			name:               "success_with_extra_required_attributes",
 			createModel:        &testCreateModel{},
@@ -416,13 +446,15 @@ func TestGenerateResourceSchemaFromStruct(t *testing.T) {
 				tt.createModel,
 				tt.updateModel,
 				tt.stateModel,
-				tt.sensitiveAttrs,
-				tt.extraRequiredAttrs,
-				tt.computedAsSetAttrs,
-				tt.immutableAttrs,
-				tt.forceNewAttrs,
-				tt.computedAttrs,
-				tt.caseInsensitiveAttrs,
+				ResourceSchemaOptions{
+					SensitiveAttrs:       tt.sensitiveAttrs,
+					ExtraRequiredAttrs:   tt.extraRequiredAttrs,
+					ComputedAsSetAttrs:   tt.computedAsSetAttrs,
+					ImmutableAttrs:       tt.immutableAttrs,
+					ForceNewAttrs:        tt.forceNewAttrs,
+					ComputedAttrs:        tt.computedAttrs,
+					CaseInsensitiveAttrs: tt.caseInsensitiveAttrs,
+				},
 			)
 
 			// Validate result
@@ -490,13 +522,7 @@ func TestGenerateResourceSchemaFromStructNestedStructRemoval(t *testing.T) {
 		createModel,
 		updateModel,
 		stateModel,
-		nil, // sensitiveAttrs
-		nil, // extraRequiredAttrs
-		nil, // computedAsSetAttrs
-		nil, // immutableAttrs
-		nil, // forceNewAttrs
-		nil, // computedAttrs
-		nil,
+		ResourceSchemaOptions{},
 	)
 
 	// Verify nested structs exist
@@ -560,13 +586,7 @@ func TestGenerateResourceSchemaFromStructWithSquashedStateModel(t *testing.T) {
 		createModel,
 		nil,
 		stateModel,
-		nil,
-		nil,
-		nil,
-		nil,
-		nil, // forceNewAttrs
-		nil, // computedAttrs
-		nil,
+		ResourceSchemaOptions{},
 	)
 
 	// When state model has squashed fields, they should appear at root level
@@ -636,13 +656,7 @@ func TestGenerateResourceSchemaFromStructWithAttributeConflict(t *testing.T) {
 		createModel,
 		updateModel,
 		stateModel,
-		nil, // sensitiveAttrs
-		nil, // extraRequiredAttrs
-		nil, // computedAsSetAttrs
-		nil, // immutableAttrs
-		nil, // forceNewAttrs
-		nil, // computedAttrs
-		nil,
+		ResourceSchemaOptions{},
 	)
 
 	// Verify that nested_struct exists
@@ -793,13 +807,9 @@ func TestGenerateResourceSchemaFromStructMinMaxLengthTags(t *testing.T) {
 		&testMinMaxCreateModel{},
 		nil,
 		nil,
-		nil,
-		nil,
-		[]string{"set_items"},
-		nil,
-		nil,
-		nil,
-		nil,
+		ResourceSchemaOptions{
+			ComputedAsSetAttrs: []string{"set_items"},
+		},
 	)
 
 	tests := []struct {
@@ -1010,13 +1020,9 @@ func TestMinMaxLengthValidatorsAttachedHaveCorrectDescriptions(t *testing.T) {
 		&testMinMaxBoundsModel{},
 		nil,
 		nil,
-		nil,
-		nil,
-		[]string{"set_items"},
-		nil,
-		nil,
-		nil,
-		nil,
+		ResourceSchemaOptions{
+			ComputedAsSetAttrs: []string{"set_items"},
+		},
 	)
 
 	ctx := context.Background()
@@ -1093,3 +1099,459 @@ func TestMinMaxLengthValidatorsAttachedHaveCorrectDescriptions(t *testing.T) {
 		}
 	})
 }
+
+// testNumericTuningModel exercises floating point and arbitrary-precision number fields.
+type testNumericTuningModel struct {
+	Threshold float64     `mapstructure:"threshold" desc:"Threshold" default:"0.75" minvalue:"0" maxvalue:"1"`
+	Ratio     *float32    `mapstructure:"ratio" desc:"Ratio" validate:"required" minvalue:"0.5"`
+	Weight    float64     `mapstructure:"weight" desc:"Weight"`
+	Quota     json.Number `mapstructure:"quota" desc:"Quota" default:"12.5"`
+}
+
+// TestGenerateResourceSchemaFromStructNumericTuningFields verifies that float fields become
+// Float64 attributes with defaults and range validators, and json.Number fields become Number attributes.
+func TestGenerateResourceSchemaFromStructNumericTuningFields(t *testing.T) {
+	t.Parallel()
+
+	result := GenerateResourceSchemaFromStruct(
+		&testNumericTuningModel{},
+		nil,
+		nil,
+		ResourceSchemaOptions{
+			ImmutableAttrs: []string{"weight"},
+		},
+	)
+	ctx := context.Background()
+
+	threshold, ok := result.Attributes["threshold"].(schema.Float64Attribute)
+	if !ok {
+		t.Fatalf("expected threshold to be Float64Attribute, got %T", result.Attributes["threshold"])
+	}
+	if !threshold.Optional || !threshold.Computed || threshold.Required {
+		t.Errorf("expected threshold to be optional+computed, got %+v", threshold)
+	}
+	defaultResp := &defaults.Float64Response{}
+	threshold.Default.DefaultFloat64(ctx, defaults.Float64Request{}, defaultResp)
+	if defaultResp.PlanValue.ValueFloat64() != 0.75 {
+		t.Errorf("expected threshold default 0.75, got %v", defaultResp.PlanValue)
+	}
+	if len(threshold.Validators) != 1 {
+		t.Fatalf("expected one validator on threshold, got %d", len(threshold.Validators))
+	}
+	rangeValidator, ok := threshold.Validators[0].(Float64RangeValidator)
+	if !ok || rangeValidator.Min == nil || *rangeValidator.Min != 0 || rangeValidator.Max == nil || *rangeValidator.Max != 1 {
+		t.Errorf("expected Float64RangeValidator [0, 1], got %+v", threshold.Validators[0])
+	}
+
+	ratio, ok := result.Attributes["ratio"].(schema.Float64Attribute)
+	if !ok {
+		t.Fatalf("expected ratio to be Float64Attribute, got %T", result.Attributes["ratio"])
+	}
+	if !ratio.Required {
+		t.Error("expected ratio to be required")
+	}
+
+	weight, ok := result.Attributes["weight"].(schema.Float64Attribute)
+	if !ok {
+		t.Fatalf("expected weight to be Float64Attribute, got %T", result.Attributes["weight"])
+	}
+	if len(weight.PlanModifiers) != 1 {
+		t.Errorf("expected immutable plan modifier on weight, got %d", len(weight.PlanModifiers))
+	}
+
+	quota, ok := result.Attributes["quota"].(schema.NumberAttribute)
+	if !ok {
+		t.Fatalf("expected quota to be NumberAttribute, got %T", result.Attributes["quota"])
+	}
+	numberResp := &defaults.NumberResponse{}
+	quota.Default.DefaultNumber(ctx, defaults.NumberRequest{}, numberResp)
+	if numberResp.PlanValue.ValueBigFloat().Cmp(big.NewFloat(12.5)) != 0 {
+		t.Errorf("expected quota default 12.5, got %v", numberResp.PlanValue)
+	}
+}
+
+// TestFloat64RangeValidator verifies inclusive bounds, half-open ranges and null handling.
+func TestFloat64RangeValidator(t *testing.T) {
+	t.Parallel()
+
+	minVal, maxVal := 0.0, 1.0
+	tests := []struct {
+		name      string
+		validator Float64RangeValidator
+		value     types.Float64
+		wantError bool
+	}{
+		{name: "success_within_bounds", validator: Float64RangeValidator{Min: &minVal, Max: &maxVal}, value: types.Float64Value(0.5)},
+		{name: "success_inclusive_upper_bound", validator: Float64RangeValidator{Min: &minVal, Max: &maxVal}, value: types.Float64Value(1)},
+		{name: "success_null_skipped", validator: Float64RangeValidator{Min: &minVal, Max: &maxVal}, value: types.Float64Null()},
+		{name: "success_unbounded_max", validator: Float64RangeValidator{Min: &minVal}, value: types.Float64Value(1e9)},
+		{name: "error_below_min", validator: Float64RangeValidator{Min: &minVal, Max: &maxVal}, value: types.Float64Value(-0.1), wantError: true},
+		{name: "error_above_max", validator: Float64RangeValidator{Max: &maxVal}, value: types.Float64Value(1.01), wantError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			resp := &validator.Float64Response{}
+			tt.validator.ValidateFloat64(context.Background(), validator.Float64Request{
+				Path:        path.Root("threshold"),
+				ConfigValue: tt.value,
+			}, resp)
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("expected error=%v, got diagnostics %v", tt.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+// TestNumericTuningFieldsRoundTrip verifies float and json.Number values convert to state and back.
+func TestNumericTuningFieldsRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	floatAttr, err := interfaceTypeToAttr(ctx, float32(0.5), types.Float64Type)
+	if err != nil {
+		t.Fatalf("unexpected error converting float: %v", err)
+	}
+	if floatAttr.(types.Float64).ValueFloat64() != 0.5 {
+		t.Errorf("expected 0.5, got %v", floatAttr)
+	}
+
+	numberAttr, err := interfaceTypeToAttr(ctx, json.Number("12.25"), types.NumberType)
+	if err != nil {
+		t.Fatalf("unexpected error converting number: %v", err)
+	}
+	back, err := attrToInterface("quota", numberAttr, &testNumericTuningModel{})
+	if err != nil {
+		t.Fatalf("unexpected error converting back: %v", err)
+	}
+	if back != json.Number("12.25") {
+		t.Errorf("expected json.Number 12.25, got %#v", back)
+	}
+
+	terraType, err := reflectTypeToTerraformType(reflect.TypeOf([]float64{}))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !terraType.Equal(types.ListType{ElemType: types.Float64Type}) {
+		t.Errorf("expected list of float64, got %v", terraType)
+	}
+}
+
+type testIntegerKeyedModel struct {
+	Priorities map[int]string     `json:"priorities,omitempty" mapstructure:"priorities,omitempty" desc:"Priorities by level"`
+	Limits     map[uint16]int     `json:"limits,omitempty" mapstructure:"limits,omitempty" desc:"Limits by port"`
+	Weights    map[float64]string `json:"weights,omitempty" mapstructure:"weights,omitempty" desc:"Unsupported float keys"`
+}
+
+func TestGenerateResourceSchemaFromStructIntegerMapKeys(t *testing.T) {
+	t.Parallel()
+
+	attrs := GenerateResourceSchemaFromStruct(&testIntegerKeyedModel{}, nil, nil, ResourceSchemaOptions{}).Attributes
+	for _, name := range []string{"priorities", "limits"} {
+		if _, ok := attrs[name].(schema.MapAttribute); !ok {
+			t.Errorf("expected %s to be a map attribute, got %T", name, attrs[name])
+		}
+	}
+	if _, ok := attrs["weights"]; ok {
+		t.Errorf("expected weights with float keys to be skipped")
+	}
+}
+
+func TestIntegerMapKeysRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	mapType := types.MapType{ElemType: types.StringType}
+	mapAttr, err := interfaceTypeToAttr(ctx, map[int]string{1: "low", 10: "high"}, mapType)
+	if err != nil {
+		t.Fatalf("unexpected error converting map: %v", err)
+	}
+	elements := mapAttr.(types.Map).Elements()
+	if elements["1"].(types.String).ValueString() != "low" || elements["10"].(types.String).ValueString() != "high" {
+		t.Errorf("expected stringified integer keys, got %v", elements)
+	}
+
+	back, err := attrToInterface("priorities", mapAttr, &testIntegerKeyedModel{})
+	if err != nil {
+		t.Fatalf("unexpected error converting back: %v", err)
+	}
+	var model testIntegerKeyedModel
+	if err := mapstructure.Decode(map[string]interface{}{"priorities": back}, &model); err != nil {
+		t.Fatalf("unexpected error decoding: %v", err)
+	}
+	if !reflect.DeepEqual(model.Priorities, map[int]string{1: "low", 10: "high"}) {
+		t.Errorf("expected integer keyed map, got %#v", model.Priorities)
+	}
+
+	invalid, _ := types.MapValue(types.StringType, map[string]attr.Value{"not-a-number": types.StringValue("x")})
+	if _, err := attrToInterface("priorities", invalid, &testIntegerKeyedModel{}); err == nil {
+		t.Errorf("expected error for non integer key")
+	}
+
+	converted, err := convertGoValueToAttr(ctx, map[uint16]int{8080: 5})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := converted.(types.Object).Attributes()["8080"]; !ok {
+		t.Errorf("expected key 8080, got %v", converted)
+	}
+}
+
+type testBinaryModel struct {
+	Certificate []byte   `json:"certificate,omitempty" mapstructure:"certificate,omitempty" desc:"PEM certificate"`
+	PrivateKey  *[]byte  `json:"private_key,omitempty" mapstructure:"private_key,omitempty" desc:"Private key"`
+	Chain       [][]byte `json:"chain,omitempty" mapstructure:"chain,omitempty" desc:"Certificate chain"`
+}
+
+func TestGenerateResourceSchemaFromStructByteSlices(t *testing.T) {
+	t.Parallel()
+
+	attrs := GenerateResourceSchemaFromStruct(&testBinaryModel{}, nil, nil, ResourceSchemaOptions{SensitiveAttrs: []string{"private_key"}}).Attributes
+	certificate, ok := attrs["certificate"].(schema.StringAttribute)
+	if !ok {
+		t.Fatalf("expected certificate to be a string attribute, got %T", attrs["certificate"])
+	}
+	if len(certificate.Validators) != 1 || certificate.Sensitive {
+		t.Errorf("expected a non sensitive attribute with a base64 validator, got %+v", certificate)
+	}
+	privateKey, ok := attrs["private_key"].(schema.StringAttribute)
+	if !ok || !privateKey.Sensitive {
+		t.Errorf("expected private_key to be a sensitive string attribute, got %+v", attrs["private_key"])
+	}
+	chain, ok := attrs["chain"].(schema.ListAttribute)
+	if !ok || !chain.ElementType.Equal(types.StringType) {
+		t.Errorf("expected chain to be a list of strings, got %+v", attrs["chain"])
+	}
+}
+
+func TestByteSlicesRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	raw := []byte{0x30, 0x82, 0x00, 0xff}
+	encoded := "MIIA/w=="
+
+	certificate, err := interfaceTypeToAttr(ctx, raw, types.StringType)
+	if err != nil {
+		t.Fatalf("unexpected error converting bytes: %v", err)
+	}
+	if certificate.(types.String).ValueString() != encoded {
+		t.Errorf("expected %s, got %v", encoded, certificate)
+	}
+	nullCertificate, err := interfaceTypeToAttr(ctx, []byte(nil), types.StringType)
+	if err != nil || !nullCertificate.IsNull() {
+		t.Errorf("expected null for nil bytes, got %v (%v)", nullCertificate, err)
+	}
+
+	back, err := attrToInterface("certificate", types.StringValue(encoded), &testBinaryModel{})
+	if err != nil {
+		t.Fatalf("unexpected error converting back: %v", err)
+	}
+	if !reflect.DeepEqual(back, raw) {
+		t.Errorf("expected %v, got %#v", raw, back)
+	}
+	chain, _ := types.ListValue(types.StringType, []attr.Value{types.StringValue(encoded)})
+	backChain, err := attrToInterface("chain", chain, &testBinaryModel{})
+	if err != nil {
+		t.Fatalf("unexpected error converting chain: %v", err)
+	}
+	if !reflect.DeepEqual(backChain, []interface{}{raw}) {
+		t.Errorf("expected decoded chain, got %#v", backChain)
+	}
+	if _, err := attrToInterface("certificate", types.StringValue("not base64!"), &testBinaryModel{}); err == nil {
+		t.Errorf("expected error for invalid base64")
+	}
+
+	dynamic, err := convertGoValueToAttr(ctx, raw)
+	if err != nil || dynamic.(types.String).ValueString() != encoded {
+		t.Errorf("expected base64 dynamic value, got %v (%v)", dynamic, err)
+	}
+}
+
+func TestBase64Validator(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		value     types.String
+		wantError bool
+	}{
+		{name: "success_valid", value: types.StringValue("aGVsbG8=")},
+		{name: "success_null_skipped", value: types.StringNull()},
+		{name: "error_invalid", value: types.StringValue("hello!"), wantError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			resp := &validator.StringResponse{}
+			Base64Validator{}.ValidateString(context.Background(), validator.StringRequest{
+				Path:        path.Root("certificate"),
+				ConfigValue: tt.value,
+			}, resp)
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("expected error=%v, got diagnostics %v", tt.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+type testNestedCollectionRule struct {
+	Name     string `json:"name" mapstructure:"name" desc:"Rule name"`
+	Priority int    `json:"priority,omitempty" mapstructure:"priority,omitempty" desc:"Rule priority"`
+}
+
+type testNestedCollectionsModel struct {
+	Rules    []testNestedCollectionRule          `json:"rules,omitempty" mapstructure:"rules,omitempty" desc:"Rules" default:"[{\"name\":\"deny-all\",\"priority\":100}]" maxlength:"5"`
+	Targets  []testNestedCollectionRule          `json:"targets,omitempty" mapstructure:"targets,omitempty" desc:"Targets" default:"[{\"name\":\"all\"}]"`
+	Profiles map[string]testNestedCollectionRule `json:"profiles,omitempty" mapstructure:"profiles,omitempty" desc:"Profiles" default:"{\"default\":{\"name\":\"basic\"}}"`
+	Invalid  []testNestedCollectionRule          `json:"invalid,omitempty" mapstructure:"invalid,omitempty" desc:"Invalid default" default:"not json"`
+	Audits   []testNestedCollectionRule          `json:"audits,omitempty" mapstructure:"audits,omitempty" desc:"Server managed audits"`
+}
+
+func TestGenerateResourceSchemaFromStructNestedCollections(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	attrs := GenerateResourceSchemaFromStruct(&testNestedCollectionsModel{}, nil, nil, ResourceSchemaOptions{ComputedAsSetAttrs: []string{"targets"}, ImmutableAttrs: []string{"rules"}, ForceNewAttrs: []string{"targets", "profiles"}, ComputedAttrs: []string{"audits"}}).Attributes
+
+	rules, ok := attrs["rules"].(schema.ListNestedAttribute)
+	if !ok {
+		t.Fatalf("expected rules to be a list nested attribute, got %T", attrs["rules"])
+	}
+	rulesDefault, ok := rules.Default.(ListNestedDefault)
+	if !ok {
+		t.Fatalf("expected rules to have a list nested default, got %T", rules.Default)
+	}
+	if len(rulesDefault.Value.Elements()) != 1 {
+		t.Fatalf("expected one default rule, got %v", rulesDefault.Value)
+	}
+	rule := rulesDefault.Value.Elements()[0].(types.Object).Attributes()
+	if !rule["name"].Equal(types.StringValue("deny-all")) || !rule["priority"].Equal(types.Int64Value(100)) {
+		t.Errorf("expected the default rule to be decoded from the tag, got %v", rule)
+	}
+	if !rules.Optional || !rules.Computed || rules.Required {
+		t.Errorf("expected rules with a default to be optional and computed")
+	}
+	if len(rules.Validators) != 1 || len(rules.PlanModifiers) != 1 {
+		t.Errorf("expected rules to have a size validator and an immutable plan modifier, got %d validators and %d plan modifiers", len(rules.Validators), len(rules.PlanModifiers))
+	}
+
+	targets, ok := attrs["targets"].(schema.SetNestedAttribute)
+	if !ok {
+		t.Fatalf("expected targets to be a set nested attribute, got %T", attrs["targets"])
+	}
+	if _, ok := targets.Default.(SetNestedDefault); !ok {
+		t.Errorf("expected targets to have a set nested default, got %T", targets.Default)
+	}
+	if len(targets.PlanModifiers) != 1 {
+		t.Errorf("expected targets to require replacement, got %d plan modifiers", len(targets.PlanModifiers))
+	}
+
+	profiles, ok := attrs["profiles"].(schema.MapNestedAttribute)
+	if !ok {
+		t.Fatalf("expected profiles to be a map nested attribute, got %T", attrs["profiles"])
+	}
+	profilesDefault, ok := profiles.Default.(MapNestedDefault)
+	if !ok {
+		t.Fatalf("expected profiles to have a map nested default, got %T", profiles.Default)
+	}
+	if _, ok := profilesDefault.Value.Elements()["default"]; !ok {
+		t.Errorf("expected the default profile to be keyed by default, got %v", profilesDefault.Value)
+	}
+	if len(profiles.PlanModifiers) != 1 {
+		t.Errorf("expected profiles to require replacement, got %d plan modifiers", len(profiles.PlanModifiers))
+	}
+	resp := &defaults.MapResponse{}
+	profiles.Default.DefaultMap(ctx, defaults.MapRequest{}, resp)
+	if !resp.PlanValue.Equal(profilesDefault.Value) {
+		t.Errorf("expected the default map to be planned, got %v", resp.PlanValue)
+	}
+
+	invalid, ok := attrs["invalid"].(schema.ListNestedAttribute)
+	if !ok {
+		t.Fatalf("expected invalid to be a list nested attribute, got %T", attrs["invalid"])
+	}
+	if invalid.Default != nil {
+		t.Errorf("expected an undecodable default to be ignored, got %v", invalid.Default)
+	}
+
+	audits, ok := attrs["audits"].(schema.ListNestedAttribute)
+	if !ok {
+		t.Fatalf("expected audits to be a list nested attribute, got %T", attrs["audits"])
+	}
+	if audits.Optional || audits.Required || !audits.Computed {
+		t.Errorf("expected computed-only audits to be read-only")
+	}
+}
+
+type testSimpleMapDefaultsModel struct {
+	Permissions map[string]bool  `json:"permissions,omitempty" mapstructure:"permissions,omitempty" desc:"Permissions" default:"read=true,write=false"`
+	Quotas      map[string]int64 `json:"quotas,omitempty" mapstructure:"quotas,omitempty" desc:"Quotas" default:"sessions=5,vaults=1"`
+	Labels      map[string]bool  `json:"labels,omitempty" mapstructure:"labels,omitempty" desc:"Invalid default" default:"read=maybe"`
+}
+
+func TestGenerateResourceSchemaFromStructSimpleMapDefaults(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	attrs := GenerateResourceSchemaFromStruct(&testSimpleMapDefaultsModel{}, nil, nil, ResourceSchemaOptions{}).Attributes
+
+	permissions := attrs["permissions"].(schema.MapAttribute)
+	resp := &defaults.MapResponse{}
+	permissions.Default.DefaultMap(ctx, defaults.MapRequest{}, resp)
+	want := types.MapValueMust(types.BoolType, map[string]attr.Value{"read": types.BoolValue(true), "write": types.BoolValue(false)})
+	if !resp.PlanValue.Equal(want) {
+		t.Errorf("expected the permissions default %v, got %v", want, resp.PlanValue)
+	}
+	if !permissions.Optional || !permissions.Computed || permissions.Required {
+		t.Errorf("expected permissions with a default to be optional and computed")
+	}
+
+	quotas := attrs["quotas"].(schema.MapAttribute)
+	resp = &defaults.MapResponse{}
+	quotas.Default.DefaultMap(ctx, defaults.MapRequest{}, resp)
+	want = types.MapValueMust(types.Int64Type, map[string]attr.Value{"sessions": types.Int64Value(5), "vaults": types.Int64Value(1)})
+	if !resp.PlanValue.Equal(want) {
+		t.Errorf("expected the quotas default %v, got %v", want, resp.PlanValue)
+	}
+
+	if labels := attrs["labels"].(schema.MapAttribute); labels.Default != nil {
+		t.Errorf("expected an undecodable default to be ignored, got %v", labels.Default)
+	}
+}
+
+type testReplaceImpactModel struct {
+	SafeName    string `mapstructure:"safe_name" desc:"Name of the safe" replace_impact:"deletes and recreates the safe and all memberships"`
+	Description string `mapstructure:"description" desc:"Description of the safe" replace_impact:"deletes the safe"`
+	Location    string `mapstructure:"location" desc:"Location of the safe"`
+}
+
+func TestGenerateResourceSchemaFromStructReplaceImpact(t *testing.T) {
+	result := GenerateResourceSchemaFromStruct(&testReplaceImpactModel{}, nil, nil, ResourceSchemaOptions{ForceNewAttrs: []string{"safe_name", "location"}})
+	want := map[string]string{
+		"safe_name":   "Name of the safe. Changing this attribute deletes and recreates the safe and all memberships.",
+		"description": "Description of the safe",
+		"location":    "Location of the safe",
+	}
+	for name, description := range want {
+		if got := result.Attributes[name].GetDescription(); got != description {
+			t.Errorf("expected description of %s %q, got %q", name, description, got)
+		}
+	}
+}
+
+func TestReplaceImpacts(t *testing.T) {
+	type updateModel struct {
+		SafeName string `mapstructure:"safe_name" replace_impact:"renames the safe."`
+		Owner    string `mapstructure:"owner" replace_impact:"transfers the safe"`
+	}
+	got := ReplaceImpacts(nil, &testReplaceImpactModel{}, updateModel{})
+	want := map[string]string{
+		"safe_name":   "deletes and recreates the safe and all memberships",
+		"description": "deletes the safe",
+		"owner":       "transfers the safe",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
@@ -0,0 +1,104 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ExposeSDKResponseAttributeName is the name of the attribute opting a resource in to sdk_response_json.
+const ExposeSDKResponseAttributeName = "expose_sdk_response"
+
+// SDKResponseAttributeName is the name of the attribute holding the last response of a resource, as decoded
+// by the SDK, encoded as JSON.
+const SDKResponseAttributeName = "sdk_response_json"
+
+// ExposeSDKResponseAttribute returns the optional expose_sdk_response attribute.
+func ExposeSDKResponseAttribute() schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Optional:            true,
+		Description:         "Whether to store the response of the resource, as decoded by the SDK, in sdk_response_json.",
+		MarkdownDescription: "Whether to store the response of the resource, as decoded by the SDK, in `sdk_response_json`.",
+	}
+}
+
+// SDKResponseAttribute returns the computed sdk_response_json attribute. The SDK does not expose the HTTP
+// body, so the attribute holds the SDK model of the response encoded back to JSON: fields the SDK model does
+// not declare are missing. The model may hold fields the schema does not, such as secrets, so the attribute
+// is sensitive.
+func SDKResponseAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Computed:  true,
+		Sensitive: true,
+		Description: "Response of the last create, update or read of the resource as decoded by the SDK, encoded as JSON, when expose_sdk_response is true. " +
+			"It is not the raw HTTP body: fields the SDK does not decode are missing. It helps debugging values missing from the attributes.",
+		MarkdownDescription: "Response of the last create, update or read of the resource as decoded by the SDK, encoded as JSON, when `expose_sdk_response` is true. " +
+			"It is not the raw HTTP body: fields the SDK does not decode are missing. It helps debugging values missing from the attributes.",
+		PlanModifiers: []planmodifier.String{sdkResponseModifier{}},
+	}
+}
+
+// sdkResponseModifier plans a null sdk_response_json for resources not opted in, so it does not show as
+// "known after apply" on every change.
+type sdkResponseModifier struct{}
+
+func (m sdkResponseModifier) Description(_ context.Context) string {
+	return "Null unless expose_sdk_response is true."
+}
+
+func (m sdkResponseModifier) MarkdownDescription(_ context.Context) string {
+	return "Null unless `expose_sdk_response` is true."
+}
+
+func (m sdkResponseModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var expose types.Bool
+	if diags := req.Plan.GetAttribute(ctx, path.Root(ExposeSDKResponseAttributeName), &expose); diags.HasError() {
+		return
+	}
+	if expose.IsUnknown() {
+		resp.PlanValue = types.StringUnknown()
+		return
+	}
+	if !expose.ValueBool() {
+		resp.PlanValue = types.StringNull()
+	}
+}
+
+// SetSDKResponse sets the sdk_response_json attribute of a state object to the SDK response encoded as JSON when
+// expose_sdk_response is true in the object, and to null otherwise. Objects without the attribute are left
+// as is.
+func SetSDKResponse(obj types.Object, response interface{}) (types.Object, error) {
+	if obj.IsNull() || obj.IsUnknown() {
+		return obj, nil
+	}
+	attrTypes := obj.AttributeTypes(context.Background())
+	if attrTypes[SDKResponseAttributeName] != types.StringType {
+		return obj, nil
+	}
+	attributes := obj.Attributes()
+	sdkResponse := types.StringNull()
+	if expose, ok := attributes[ExposeSDKResponseAttributeName].(types.Bool); ok && expose.ValueBool() {
+		encoded, err := json.Marshal(response)
+		if err != nil {
+			return obj, fmt.Errorf("failed to encode the SDK response: %w", err)
+		}
+		sdkResponse = types.StringValue(string(encoded))
+	}
+	attributes[SDKResponseAttributeName] = sdkResponse
+	result, diags := types.ObjectValue(attrTypes, attributes)
+	if diags.HasError() {
+		return obj, fmt.Errorf("failed to set the SDK response: %v", diags)
+	}
+	return result, nil
+}
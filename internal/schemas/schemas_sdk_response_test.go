@@ -0,0 +1,102 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testSDKResponse struct {
+	SafeName string            `json:"safe_name"`
+	Extra    map[string]string `json:"extra"`
+}
+
+func TestSDKResponseModifier(t *testing.T) {
+	t.Parallel()
+
+	resourceSchema := schema.Schema{Attributes: map[string]schema.Attribute{
+		ExposeSDKResponseAttributeName: ExposeSDKResponseAttribute(),
+		SDKResponseAttributeName:       SDKResponseAttribute(),
+	}}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		ExposeSDKResponseAttributeName: tftypes.Bool,
+		SDKResponseAttributeName:       tftypes.String,
+	}}
+	tests := []struct {
+		name     string
+		expose   tftypes.Value
+		expected types.String
+	}{
+		{name: "exposed", expose: tftypes.NewValue(tftypes.Bool, true), expected: types.StringUnknown()},
+		{name: "not_exposed", expose: tftypes.NewValue(tftypes.Bool, false), expected: types.StringNull()},
+		{name: "not_configured", expose: tftypes.NewValue(tftypes.Bool, nil), expected: types.StringNull()},
+		{name: "unknown", expose: tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue), expected: types.StringUnknown()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			plan := tfsdk.Plan{Schema: resourceSchema, Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				ExposeSDKResponseAttributeName: tt.expose,
+				SDKResponseAttributeName:       tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+			})}
+			req := planmodifier.StringRequest{Plan: plan, PlanValue: types.StringUnknown()}
+			resp := &planmodifier.StringResponse{PlanValue: req.PlanValue}
+			sdkResponseModifier{}.PlanModifyString(context.Background(), req, resp)
+			if !resp.PlanValue.Equal(tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, resp.PlanValue)
+			}
+		})
+	}
+}
+
+func TestSetSDKResponse(t *testing.T) {
+	t.Parallel()
+
+	attrTypes := map[string]attr.Type{
+		ExposeSDKResponseAttributeName: types.BoolType,
+		SDKResponseAttributeName:       types.StringType,
+	}
+	response := &testSDKResponse{SafeName: "Finance", Extra: map[string]string{"location": "\\"}}
+
+	exposed, _ := types.ObjectValue(attrTypes, map[string]attr.Value{
+		ExposeSDKResponseAttributeName: types.BoolValue(true),
+		SDKResponseAttributeName:       types.StringUnknown(),
+	})
+	result, err := SetSDKResponse(exposed, response)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := types.StringValue(`{"safe_name":"Finance","extra":{"location":"\\"}}`)
+	if got := result.Attributes()[SDKResponseAttributeName]; !got.Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	notExposed, _ := types.ObjectValue(attrTypes, map[string]attr.Value{
+		ExposeSDKResponseAttributeName: types.BoolNull(),
+		SDKResponseAttributeName:       types.StringValue(`{"safe_name":"Finance"}`),
+	})
+	result, err = SetSDKResponse(notExposed, response)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if got := result.Attributes()[SDKResponseAttributeName]; !got.IsNull() {
+		t.Errorf("expected a null SDK response once no longer exposed, got %v", got)
+	}
+
+	withoutAttribute, _ := types.ObjectValue(map[string]attr.Type{"safe_name": types.StringType}, map[string]attr.Value{
+		"safe_name": types.StringValue("Finance"),
+	})
+	result, err = SetSDKResponse(withoutAttribute, response)
+	if err != nil || !result.Equal(withoutAttribute) {
+		t.Errorf("expected the object to be left as is, got %v, %v", result, err)
+	}
+}
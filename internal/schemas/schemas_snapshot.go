@@ -0,0 +1,180 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+)
+
+// snapshotFlags are the boolean fields of attributes and blocks listed in a snapshot, in order.
+var snapshotFlags = []string{"Required", "Optional", "Computed", "Sensitive", "WriteOnly"}
+
+// describer is implemented by validators, plan modifiers and defaults.
+type describer interface {
+	Description(ctx context.Context) string
+}
+
+// SnapshotSchema renders a deterministic textual snapshot of a schema for golden-file tests: one entry
+// per attribute and block, by path in name order, with its type, flags, element type, descriptions,
+// deprecation message, default, validators and plan modifiers. A change of an SDK model then shows in
+// the golden file of the schema generated from it as a readable diff. schema must be a resource, data
+// source, list resource, action or provider schema, or a pointer to one.
+func SnapshotSchema(schema interface{}) string {
+	value := reflect.ValueOf(schema)
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return ""
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Struct {
+		return ""
+	}
+	var snapshot strings.Builder
+	fmt.Fprintf(&snapshot, "%s schema\n", path.Base(path.Dir(value.Type().PkgPath())))
+	snapshotProperties(&snapshot, value)
+	snapshotChildren(&snapshot, value, "")
+	return snapshot.String()
+}
+
+// snapshotChildren renders the attributes and blocks of a schema, attribute or block, and the children
+// of its nested object, under the path prefix.
+func snapshotChildren(snapshot *strings.Builder, value reflect.Value, prefix string) {
+	if nested := value.FieldByName("NestedObject"); nested.IsValid() && nested.Kind() == reflect.Struct {
+		snapshotChildren(snapshot, nested, prefix)
+	}
+	for _, fieldName := range []string{"Attributes", "Blocks"} {
+		field := value.FieldByName(fieldName)
+		if !field.IsValid() || field.Kind() != reflect.Map || field.Len() == 0 {
+			continue
+		}
+		names := make([]string, 0, field.Len())
+		for _, key := range field.MapKeys() {
+			names = append(names, key.String())
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			child := field.MapIndex(reflect.ValueOf(name))
+			for child.Kind() == reflect.Interface || child.Kind() == reflect.Pointer {
+				if child.IsNil() {
+					break
+				}
+				child = child.Elem()
+			}
+			if child.Kind() != reflect.Struct {
+				continue
+			}
+			fmt.Fprintf(snapshot, "\n%s %s%s (%s)\n", strings.ToLower(strings.TrimSuffix(fieldName, "s")), prefix, name, child.Type().Name())
+			snapshotProperties(snapshot, child)
+			snapshotChildren(snapshot, child, prefix+name+".")
+		}
+	}
+}
+
+// snapshotProperties renders the properties of a schema, attribute or block that are set.
+func snapshotProperties(snapshot *strings.Builder, value reflect.Value) {
+	var flags []string
+	for _, flag := range snapshotFlags {
+		if field := value.FieldByName(flag); field.IsValid() && field.Kind() == reflect.Bool && field.Bool() {
+			flags = append(flags, strings.ToLower(flag))
+		}
+	}
+	if len(flags) > 0 {
+		fmt.Fprintf(snapshot, "  flags: %s\n", strings.Join(flags, ", "))
+	}
+	if field := value.FieldByName("ElementType"); field.IsValid() && field.Kind() == reflect.Interface && !field.IsNil() {
+		if elementType, ok := field.Interface().(fmt.Stringer); ok {
+			fmt.Fprintf(snapshot, "  element_type: %s\n", elementType.String())
+		}
+	}
+	for _, property := range []struct{ field, label string }{
+		{"Description", "description"},
+		{"MarkdownDescription", "markdown_description"},
+		{"DeprecationMessage", "deprecation_message"},
+	} {
+		if field := value.FieldByName(property.field); field.IsValid() && field.Kind() == reflect.String && field.String() != "" {
+			fmt.Fprintf(snapshot, "  %s: %q\n", property.label, field.String())
+		}
+	}
+	if field := value.FieldByName("Default"); field.IsValid() && field.Kind() == reflect.Interface && !field.IsNil() {
+		fmt.Fprintf(snapshot, "  default: %s\n", describeDefault(field.Interface()))
+	}
+	for _, property := range []struct{ field, label string }{
+		{"Validators", "validators"},
+		{"PlanModifiers", "plan_modifiers"},
+	} {
+		field := value.FieldByName(property.field)
+		if !field.IsValid() || field.Kind() != reflect.Slice || field.Len() == 0 {
+			continue
+		}
+		fmt.Fprintf(snapshot, "  %s:\n", property.label)
+		for i := 0; i < field.Len(); i++ {
+			fmt.Fprintf(snapshot, "    - %s\n", describe(field.Index(i).Interface()))
+		}
+	}
+}
+
+// describe returns the description of a validator, plan modifier or default, or its type name when it
+// has none, so the snapshot changes when either does.
+func describe(value interface{}) string {
+	typeName := reflect.TypeOf(value).String()
+	if d, ok := value.(describer); ok {
+		if description := d.Description(context.Background()); description != "" {
+			return fmt.Sprintf("%s: %q", typeName, description)
+		}
+	}
+	return typeName
+}
+
+// describeDefault returns the value a default sets, followed by the type of the default.
+func describeDefault(value interface{}) string {
+	ctx := context.Background()
+	var planValue attr.Value
+	switch d := value.(type) {
+	case defaults.String:
+		resp := &defaults.StringResponse{}
+		d.DefaultString(ctx, defaults.StringRequest{}, resp)
+		planValue = resp.PlanValue
+	case defaults.Bool:
+		resp := &defaults.BoolResponse{}
+		d.DefaultBool(ctx, defaults.BoolRequest{}, resp)
+		planValue = resp.PlanValue
+	case defaults.Int64:
+		resp := &defaults.Int64Response{}
+		d.DefaultInt64(ctx, defaults.Int64Request{}, resp)
+		planValue = resp.PlanValue
+	case defaults.Float64:
+		resp := &defaults.Float64Response{}
+		d.DefaultFloat64(ctx, defaults.Float64Request{}, resp)
+		planValue = resp.PlanValue
+	case defaults.List:
+		resp := &defaults.ListResponse{}
+		d.DefaultList(ctx, defaults.ListRequest{}, resp)
+		planValue = resp.PlanValue
+	case defaults.Set:
+		resp := &defaults.SetResponse{}
+		d.DefaultSet(ctx, defaults.SetRequest{}, resp)
+		planValue = resp.PlanValue
+	case defaults.Map:
+		resp := &defaults.MapResponse{}
+		d.DefaultMap(ctx, defaults.MapRequest{}, resp)
+		planValue = resp.PlanValue
+	case defaults.Object:
+		resp := &defaults.ObjectResponse{}
+		d.DefaultObject(ctx, defaults.ObjectRequest{}, resp)
+		planValue = resp.PlanValue
+	}
+	if planValue == nil {
+		return describe(value)
+	}
+	return fmt.Sprintf("%s (%s)", planValue.String(), reflect.TypeOf(value).String())
+}
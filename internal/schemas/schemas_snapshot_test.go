@@ -0,0 +1,76 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var updateGolden = flag.Bool("update", false, "update the golden files of schema snapshots")
+
+type snapshotTestMember struct {
+	MemberName string `mapstructure:"member_name" desc:"Name of the member" validate:"required"`
+	Role       string `mapstructure:"role" desc:"Role of the member" choices:"owner,viewer" default:"viewer"`
+}
+
+type snapshotTestModel struct {
+	SafeID      string               `mapstructure:"safe_id" desc:"ID of the safe"`
+	SafeName    string               `mapstructure:"safe_name" desc:"Name of the safe" validate:"required,max=28"`
+	Description string               `mapstructure:"description" desc:"Description of the safe"`
+	Password    string               `mapstructure:"password" desc:"Password of the safe"`
+	Tags        []string             `mapstructure:"tags" desc:"Tags of the safe"`
+	Members     []snapshotTestMember `mapstructure:"members" desc:"Members of the safe"`
+}
+
+// checkGolden compares a snapshot with the golden file testdata/<name>.golden, rewriting it with -update.
+func checkGolden(t *testing.T, name string, snapshot string) {
+	t.Helper()
+	golden := filepath.Join("testdata", name+".golden")
+	if *updateGolden {
+		if err := os.MkdirAll("testdata", 0o755); err != nil {
+			t.Fatalf("failed to create testdata: %v", err)
+		}
+		if err := os.WriteFile(golden, []byte(snapshot), 0o600); err != nil {
+			t.Fatalf("failed to update %s: %v", golden, err)
+		}
+	}
+	expected, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("failed to read %s, run the test with -update to create it: %v", golden, err)
+	}
+	if string(expected) != snapshot {
+		t.Errorf("schema snapshot differs from %s, run the test with -update to accept it:\n%s", golden, snapshot)
+	}
+}
+
+func TestSnapshotSchemaResource(t *testing.T) {
+	generated := GenerateResourceSchemaFromStruct(&snapshotTestModel{}, nil, &snapshotTestModel{}, ResourceSchemaOptions{SensitiveAttrs: []string{"password"}, ImmutableAttrs: []string{"safe_name"}, ComputedAttrs: []string{"safe_id"}})
+	snapshot := SnapshotSchema(generated)
+	if snapshot != SnapshotSchema(&generated) {
+		t.Error("expected the snapshot of a schema and of a pointer to it to be equal")
+	}
+	checkGolden(t, "snapshot_resource", snapshot)
+}
+
+func TestSnapshotSchemaDataSource(t *testing.T) {
+	generated := GenerateDataSourceSchemaFromStruct(&snapshotTestModel{}, &snapshotTestModel{}, []string{"password"}, nil, []string{"members"})
+	checkGolden(t, "snapshot_data_source", SnapshotSchema(generated))
+}
+
+func TestSnapshotSchemaDeterministic(t *testing.T) {
+	t.Parallel()
+
+	first := SnapshotSchema(GenerateResourceSchemaFromStruct(&snapshotTestModel{}, nil, nil, ResourceSchemaOptions{}))
+	for i := 0; i < 10; i++ {
+		if snapshot := SnapshotSchema(GenerateResourceSchemaFromStruct(&snapshotTestModel{}, nil, nil, ResourceSchemaOptions{})); snapshot != first {
+			t.Fatalf("expected snapshots of the same schema to be equal, got:\n%s\nand:\n%s", first, snapshot)
+		}
+	}
+	if SnapshotSchema(nil) != "" {
+		t.Error("expected an empty snapshot of a nil schema")
+	}
+}
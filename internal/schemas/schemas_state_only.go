@@ -0,0 +1,43 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// StateOnlyTag is the model field tag of a top-level field whose state value is not taken from the API,
+// e.g. `state_only:"config"` on an input the API accepts but never returns. The API would otherwise leave
+// the field empty, and every read would null the configured value and show a change.
+const StateOnlyTag = "state_only"
+
+// stateOnlyConfig is the StateOnlyTag value keeping the configured value of a field: the planned value after
+// a create or update, and the value in state after a read.
+const stateOnlyConfig = "config"
+
+// isStateOnlyConfig reports whether a field is tagged with StateOnlyTag to keep its configured value.
+func isStateOnlyConfig(field reflect.StructField) bool {
+	return field.Tag.Get(StateOnlyTag) == stateOnlyConfig
+}
+
+// stateOnlyValue returns the value of an attribute kept from the plan, or from the state without a plan,
+// for a field tagged with StateOnlyTag. The second return value is false when neither holds a known value
+// of the attribute, e.g. for a computed attribute planned as unknown, so the API value is used instead.
+func stateOnlyValue(name string, planObj types.Object, hasPlan bool, stateObj types.Object, hasState bool) (attr.Value, bool) {
+	source, ok := stateObj, hasState
+	if hasPlan {
+		source, ok = planObj, true
+	}
+	if !ok || source.IsNull() || source.IsUnknown() {
+		return nil, false
+	}
+	value, exists := source.Attributes()[name]
+	if !exists || value.IsUnknown() {
+		return nil, false
+	}
+	return value, true
+}
@@ -0,0 +1,64 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testStateOnlyModel struct {
+	Name           string `json:"name" mapstructure:"name"`
+	InitialSecret  string `json:"initial_secret" mapstructure:"initial_secret" state_only:"config"`
+	ActivationCode string `json:"activation_code" mapstructure:"activation_code"`
+}
+
+func TestStructToStateObjectStateOnly(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	generated := GenerateResourceSchemaFromStruct(&testStateOnlyModel{}, nil, nil, ResourceSchemaOptions{})
+	attrTypes := ResourceSchemaToSchemaAttrTypes(generated)
+	objectType := generated.Type().TerraformType(ctx)
+	configured := func(secret interface{}) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"name":            tftypes.NewValue(tftypes.String, "web"),
+			"initial_secret":  tftypes.NewValue(tftypes.String, secret),
+			"activation_code": tftypes.NewValue(tftypes.String, "configured"),
+		})
+	}
+	apiModel := &testStateOnlyModel{Name: "web", ActivationCode: "returned"}
+
+	tests := []struct {
+		name  string
+		state *tfsdk.State
+		plan  *tfsdk.Plan
+		want  types.String
+	}{
+		{name: "apply", plan: &tfsdk.Plan{Schema: generated, Raw: configured("s3cret")}, want: types.StringValue("s3cret")},
+		{name: "read", state: &tfsdk.State{Schema: generated, Raw: configured("s3cret")}, want: types.StringValue("s3cret")},
+		{name: "read_of_null", state: &tfsdk.State{Schema: generated, Raw: configured(nil)}, want: types.StringNull()},
+		{name: "planned_unknown", plan: &tfsdk.Plan{Schema: generated, Raw: configured(tftypes.UnknownValue)}, want: types.StringValue("")},
+		{name: "without_plan_or_state", want: types.StringValue("")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			stateObj, err := StructToStateObject(ctx, apiModel, tt.state, tt.plan, attrTypes, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := stateObj.Attributes()["initial_secret"]; !got.Equal(tt.want) {
+				t.Errorf("expected initial_secret %v, got %v", tt.want, got)
+			}
+			if got := stateObj.Attributes()["activation_code"]; !got.Equal(types.StringValue("returned")) {
+				t.Errorf("expected the untagged activation_code to come from the API, got %v", got)
+			}
+		})
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SyntheticIDAttributeName is the name of the computed attribute injected into resources whose models
+// have no top-level id.
+const SyntheticIDAttributeName = "id"
+
+// IDTemplateAttributes returns the attribute paths referenced by the placeholders of an id template,
+// e.g. ["safe_name", "account_name"] for "{safe_name}/{account_name}". Dotted paths address nested
+// attributes.
+func IDTemplateAttributes(template string) ([]string, error) {
+	var attributes []string
+	rest := template
+	for {
+		start := strings.Index(rest, "{")
+		if start < 0 {
+			if strings.Contains(rest, "}") {
+				return nil, fmt.Errorf("id template %q has an unmatched '}'", template)
+			}
+			break
+		}
+		end := strings.Index(rest[start:], "}")
+		if end < 0 {
+			return nil, fmt.Errorf("id template %q has an unmatched '{'", template)
+		}
+		attribute := strings.TrimSpace(rest[start+1 : start+end])
+		if _, err := ParseImportAttributePath(attribute); err != nil {
+			return nil, fmt.Errorf("id template %q: %w", template, err)
+		}
+		attributes = append(attributes, attribute)
+		rest = rest[start+end+1:]
+	}
+	if len(attributes) == 0 {
+		return nil, fmt.Errorf("id template %q references no attribute", template)
+	}
+	return attributes, nil
+}
+
+// ExpandIDTemplate replaces the placeholders of an id template with the values returned by lookup.
+// The second return value is false when the template is malformed or an attribute has no value yet.
+func ExpandIDTemplate(template string, lookup func(attribute string) (string, bool)) (string, bool) {
+	attributes, err := IDTemplateAttributes(template)
+	if err != nil {
+		return "", false
+	}
+	id := template
+	for _, attribute := range attributes {
+		value, ok := lookup(attribute)
+		if !ok {
+			return "", false
+		}
+		id = strings.Replace(id, "{"+attribute+"}", value, 1)
+	}
+	return id, true
+}
+
+// idValueString renders a known string, integer or number attribute as part of a synthetic id.
+func idValueString(value attr.Value) (string, bool) {
+	if value == nil || value.IsNull() || value.IsUnknown() {
+		return "", false
+	}
+	switch v := value.(type) {
+	case types.String:
+		return v.ValueString(), v.ValueString() != ""
+	case types.Int64:
+		return strconv.FormatInt(v.ValueInt64(), 10), true
+	case types.Number:
+		return v.ValueBigFloat().Text('f', -1), true
+	default:
+		return "", false
+	}
+}
+
+// objectAttributeByPath returns the attribute of obj addressed by a dotted path.
+func objectAttributeByPath(obj types.Object, attributePath string) (attr.Value, bool) {
+	segments := strings.Split(attributePath, ".")
+	current := obj
+	for i, segment := range segments {
+		value, ok := current.Attributes()[segment]
+		if !ok {
+			return nil, false
+		}
+		if i == len(segments)-1 {
+			return value, true
+		}
+		nested, ok := value.(types.Object)
+		if !ok || nested.IsNull() || nested.IsUnknown() {
+			return nil, false
+		}
+		current = nested
+	}
+	return nil, false
+}
+
+// SyntheticIDAttribute returns the computed id attribute of a resource identified by the template.
+func SyntheticIDAttribute(template string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: fmt.Sprintf("Identifier of the resource, built from the template %q", template),
+		Computed:    true,
+		PlanModifiers: []planmodifier.String{
+			SyntheticIDModifier{Template: template},
+		},
+	}
+}
+
+// SetSyntheticID sets the id attribute of a state object from the template, or to null when an
+// attribute of the template has no value.
+func SetSyntheticID(obj types.Object, template string) (types.Object, error) {
+	if _, ok := obj.AttributeTypes(context.Background())[SyntheticIDAttributeName]; !ok {
+		return obj, nil
+	}
+	attributes := obj.Attributes()
+	id, ok := ExpandIDTemplate(template, func(attribute string) (string, bool) {
+		value, found := objectAttributeByPath(obj, attribute)
+		if !found {
+			return "", false
+		}
+		return idValueString(value)
+	})
+	if ok {
+		attributes[SyntheticIDAttributeName] = types.StringValue(id)
+	} else {
+		attributes[SyntheticIDAttributeName] = types.StringNull()
+	}
+	result, diags := types.ObjectValue(obj.AttributeTypes(context.Background()), attributes)
+	if diags.HasError() {
+		return obj, fmt.Errorf("failed to set synthetic id: %v", diags)
+	}
+	return result, nil
+}
+
+// SyntheticIDModifier plans the synthetic id of a resource from the planned values of the attributes
+// of its template, so the id is known at plan time whenever these attributes are.
+type SyntheticIDModifier struct {
+	Template string
+}
+
+// Description returns a human-readable description of the plan modifier.
+func (m SyntheticIDModifier) Description(_ context.Context) string {
+	return fmt.Sprintf("The value is built from the template %q.", m.Template)
+}
+
+// MarkdownDescription returns a markdown-formatted description of the plan modifier.
+func (m SyntheticIDModifier) MarkdownDescription(_ context.Context) string {
+	return fmt.Sprintf("The value is built from the template `%s`.", m.Template)
+}
+
+// PlanModifyString sets the planned id when every attribute of the template is known.
+func (m SyntheticIDModifier) PlanModifyString(ctx context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	if req.Plan.Raw.IsNull() || !req.PlanValue.IsUnknown() {
+		return
+	}
+	id, ok := ExpandIDTemplate(m.Template, func(attribute string) (string, bool) {
+		attributePath, err := ParseImportAttributePath(attribute)
+		if err != nil {
+			return "", false
+		}
+		var value attr.Value
+		if diags := req.Plan.GetAttribute(ctx, attributePath, &value); diags.HasError() {
+			return "", false
+		}
+		return idValueString(value)
+	})
+	if ok {
+		resp.PlanValue = types.StringValue(id)
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestIDTemplateAttributes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		template  string
+		expected  []string
+		wantError bool
+	}{
+		{name: "success_composite", template: "{safe_name}/{account_name}", expected: []string{"safe_name", "account_name"}},
+		{name: "success_nested_path", template: "policy-{metadata.policy_id}", expected: []string{"metadata.policy_id"}},
+		{name: "error_no_placeholder", template: "static", wantError: true},
+		{name: "error_unmatched_open", template: "{safe_name", wantError: true},
+		{name: "error_unmatched_close", template: "safe_name}", wantError: true},
+		{name: "error_empty_placeholder", template: "{}", wantError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			result, err := IDTemplateAttributes(tt.template)
+			if (err != nil) != tt.wantError {
+				t.Fatalf("expected error=%v, got %v", tt.wantError, err)
+			}
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestSetSyntheticID(t *testing.T) {
+	t.Parallel()
+
+	attrTypes := map[string]attr.Type{
+		"id":        types.StringType,
+		"safe_name": types.StringType,
+		"metadata":  types.ObjectType{AttrTypes: map[string]attr.Type{"version": types.Int64Type}},
+	}
+	metadata, _ := types.ObjectValue(map[string]attr.Type{"version": types.Int64Type}, map[string]attr.Value{"version": types.Int64Value(3)})
+	obj, _ := types.ObjectValue(attrTypes, map[string]attr.Value{
+		"id":        types.StringNull(),
+		"safe_name": types.StringValue("finance"),
+		"metadata":  metadata,
+	})
+
+	result, err := SetSyntheticID(obj, "{safe_name}/{metadata.version}")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if id := result.Attributes()["id"].(types.String).ValueString(); id != "finance/3" {
+		t.Errorf("expected id finance/3, got %s", id)
+	}
+
+	result, err = SetSyntheticID(obj, "{safe_name}/{missing}")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !result.Attributes()["id"].IsNull() {
+		t.Errorf("expected null id when an attribute is missing, got %v", result.Attributes()["id"])
+	}
+}
+
+func TestSyntheticIDModifier(t *testing.T) {
+	t.Parallel()
+
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id":          SyntheticIDAttribute("{safe_id}:{member_name}"),
+			"safe_id":     schema.StringAttribute{Required: true},
+			"member_name": schema.StringAttribute{Required: true},
+		},
+	}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"id":          tftypes.String,
+		"safe_id":     tftypes.String,
+		"member_name": tftypes.String,
+	}}
+	plan := func(memberName interface{}) tfsdk.Plan {
+		return tfsdk.Plan{
+			Schema: resourceSchema,
+			Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"id":          tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+				"safe_id":     tftypes.NewValue(tftypes.String, "12"),
+				"member_name": tftypes.NewValue(tftypes.String, memberName),
+			}),
+		}
+	}
+
+	tests := []struct {
+		name     string
+		plan     tfsdk.Plan
+		expected types.String
+	}{
+		{name: "success_known_attributes", plan: plan("alice"), expected: types.StringValue("12:alice")},
+		{name: "success_unknown_attribute_keeps_unknown", plan: plan(tftypes.UnknownValue), expected: types.StringUnknown()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			resp := &planmodifier.StringResponse{PlanValue: types.StringUnknown()}
+			SyntheticIDModifier{Template: "{safe_id}:{member_name}"}.PlanModifyString(context.Background(), planmodifier.StringRequest{
+				Plan:      tt.plan,
+				PlanValue: types.StringUnknown(),
+			}, resp)
+			if !resp.PlanValue.Equal(tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, resp.PlanValue)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// TimeoutsBlockName is the name of the block overriding the timeouts of the operations of a resource.
+const TimeoutsBlockName = "timeouts"
+
+// DefaultOperationTimeout bounds the operations of resources whose action definition declares no timeout
+// for them.
+const DefaultOperationTimeout = 20 * time.Minute
+
+// timeoutOperations are the operations a timeouts block can set, in the order of the block.
+var timeoutOperations = []string{"create", "read", "update", "delete"}
+
+// TimeoutsBlock returns the optional timeouts block of a resource, with an attribute per operation of
+// defaults, the default timeout of each operation the resource supports. Operations without a default
+// get no attribute.
+func TimeoutsBlock(defaults map[string]time.Duration) schema.SingleNestedBlock {
+	attributes := map[string]schema.Attribute{}
+	for _, operation := range timeoutOperations {
+		timeout, ok := defaults[operation]
+		if !ok {
+			continue
+		}
+		description := fmt.Sprintf("Timeout of the %s operation, as a duration such as 30m or 1h30m. Defaults to %s.", operation, FormatTimeout(timeout))
+		attributes[operation] = schema.StringAttribute{
+			Optional:            true,
+			Description:         description,
+			MarkdownDescription: description,
+			Validators:          []validator.String{DurationValidator{}},
+		}
+	}
+	return schema.SingleNestedBlock{
+		Description:         "Overrides the default timeouts of the operations of the resource. A timeout bounds the wait for the operation to start and its retries. The API calls themselves cannot be interrupted, so a call in flight when the timeout expires runs to completion, and no retry follows it.",
+		MarkdownDescription: "Overrides the default timeouts of the operations of the resource. A timeout bounds the wait for the operation to start and its retries. The API calls themselves cannot be interrupted, so a call in flight when the timeout expires runs to completion, and no retry follows it.",
+		Attributes:          attributes,
+	}
+}
+
+// FormatTimeout formats a timeout without its zero units, e.g. "20m" rather than "20m0s".
+func FormatTimeout(timeout time.Duration) string {
+	formatted := timeout.String()
+	if strings.HasSuffix(formatted, "m0s") {
+		formatted = strings.TrimSuffix(formatted, "0s")
+	}
+	if strings.HasSuffix(formatted, "h0m") {
+		formatted = strings.TrimSuffix(formatted, "0m")
+	}
+	return formatted
+}
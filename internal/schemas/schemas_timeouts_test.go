@@ -0,0 +1,25 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatTimeout(t *testing.T) {
+	tests := map[time.Duration]string{
+		20 * time.Minute:             "20m",
+		time.Hour:                    "1h",
+		90 * time.Minute:             "1h30m",
+		90 * time.Second:             "1m30s",
+		45 * time.Second:             "45s",
+		2*time.Hour + 30*time.Second: "2h0m30s",
+	}
+	for timeout, expected := range tests {
+		if got := FormatTimeout(timeout); got != expected {
+			t.Errorf("FormatTimeout(%s) = %q, expected %q", timeout, got, expected)
+		}
+	}
+}
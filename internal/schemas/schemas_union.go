@@ -0,0 +1,279 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/iancoleman/strcase"
+	"github.com/mitchellh/mapstructure"
+)
+
+// OneOfTag is the model field tag naming the Union registered with RegisterUnion that an interface field
+// holds, e.g. `oneof:"target"`. Such fields generate an object with an optional nested attribute per
+// variant, of which exactly one is set, instead of a dynamic attribute.
+const OneOfTag = "oneof"
+
+// Union declares the concrete variants of a polymorphic model field tagged with OneOfTag.
+type Union struct {
+	// Variants maps the name of the nested attribute of each variant to a prototype of its model.
+	Variants map[string]interface{}
+	// Discriminator is the key naming the variant of the payloads read from the API, compared to the variant
+	// names in snake case. Payloads without it are read as the first variant they decode into without
+	// unused keys.
+	Discriminator string
+}
+
+var (
+	unionsMutex sync.RWMutex
+	unions      = map[string]Union{}
+)
+
+// RegisterUnion registers the variants of the fields tagged with OneOfTag and the given name.
+func RegisterUnion(name string, union Union) {
+	unionsMutex.Lock()
+	defer unionsMutex.Unlock()
+	unions[name] = union
+}
+
+// fieldUnion returns the registered Union of an interface field tagged with OneOfTag, and false for other
+// fields, which keep their dynamic attribute.
+func fieldUnion(field reflect.StructField) (Union, bool) {
+	name := field.Tag.Get(OneOfTag)
+	if name == "" {
+		return Union{}, false
+	}
+	fieldType := field.Type
+	for fieldType.Kind() == reflect.Pointer {
+		fieldType = fieldType.Elem()
+	}
+	if fieldType.Kind() != reflect.Interface {
+		return Union{}, false
+	}
+	unionsMutex.RLock()
+	defer unionsMutex.RUnlock()
+	union, ok := unions[name]
+	return union, ok && len(union.Variants) > 0
+}
+
+// variantNames returns the names of the variants, sorted.
+func (u Union) variantNames() []string {
+	names := make([]string, 0, len(u.Variants))
+	for name := range u.Variants {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// variantType returns the struct type of the model of a variant.
+func (u Union) variantType(name string) reflect.Type {
+	variantType := reflect.TypeOf(u.Variants[name])
+	for variantType != nil && variantType.Kind() == reflect.Pointer {
+		variantType = variantType.Elem()
+	}
+	return variantType
+}
+
+// variantPrototype returns a zero value of the model of a variant, as nested schemas are generated from.
+func (u Union) variantPrototype(name string) interface{} {
+	return reflect.New(u.variantType(name)).Elem().Interface()
+}
+
+// variantDescription returns the description of the nested attribute of a variant.
+func (u Union) variantDescription(name string) string {
+	return fmt.Sprintf("Settings of the %s variant. Exactly one of %s must be set.", name, strings.Join(u.variantNames(), ", "))
+}
+
+// validator returns the validator requiring exactly one variant to be set.
+func (u Union) validator() UnionValidator {
+	return UnionValidator{Variants: u.variantNames()}
+}
+
+// decodeVariant decodes a payload into the model of a variant. With strict, keys the model has no field for
+// fail the decoding.
+func (u Union) decodeVariant(name string, payload interface{}, strict bool) (interface{}, error) {
+	target := reflect.New(u.variantType(name))
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		Result:           target.Interface(),
+		ErrorUnused:      strict,
+		WeaklyTypedInput: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := decoder.Decode(payload); err != nil {
+		return nil, err
+	}
+	return target.Elem().Interface(), nil
+}
+
+// resolveVariant returns the name of the variant a value read from the API holds, and the value as the
+// model of that variant.
+func (u Union) resolveVariant(value reflect.Value) (string, interface{}, error) {
+	names := u.variantNames()
+	for _, name := range names {
+		if value.Type() == u.variantType(name) {
+			return name, value.Interface(), nil
+		}
+	}
+	payload := value.Interface()
+	if value.Kind() == reflect.Struct {
+		var decoded map[string]interface{}
+		if err := mapstructure.Decode(payload, &decoded); err != nil {
+			return "", nil, err
+		}
+		payload = decoded
+	}
+	payloadMap, ok := payload.(map[string]interface{})
+	if !ok {
+		return "", nil, fmt.Errorf("value of type %T matches none of the variants %s", value.Interface(), strings.Join(names, ", "))
+	}
+	if discriminator, ok := payloadMap[u.Discriminator]; ok && u.Discriminator != "" && discriminator != nil {
+		name := strcase.ToSnake(fmt.Sprintf("%v", discriminator))
+		if _, exists := u.Variants[name]; !exists {
+			return "", nil, fmt.Errorf("%s %q matches none of the variants %s", u.Discriminator, discriminator, strings.Join(names, ", "))
+		}
+		variant, err := u.decodeVariant(name, payloadMap, false)
+		return name, variant, err
+	}
+	for _, name := range names {
+		if variant, err := u.decodeVariant(name, payloadMap, true); err == nil {
+			return name, variant, nil
+		}
+	}
+	return "", nil, fmt.Errorf("value matches none of the variants %s", strings.Join(names, ", "))
+}
+
+// unionToAttr converts the value of a field tagged with OneOfTag to the object of its variants, in which
+// only the attribute of the variant the value holds is set.
+func unionToAttr(ctx context.Context, union Union, val interface{}, objectType types.ObjectType) (attr.Value, error) {
+	value := reflect.ValueOf(val)
+	for value.Kind() == reflect.Pointer || value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return types.ObjectNull(objectType.AttrTypes), nil
+		}
+		value = value.Elem()
+	}
+	if !value.IsValid() {
+		return types.ObjectNull(objectType.AttrTypes), nil
+	}
+	name, variant, err := union.resolveVariant(value)
+	if err != nil {
+		return nil, err
+	}
+	variantType, ok := objectType.AttrTypes[name]
+	if !ok {
+		return nil, fmt.Errorf("variant %s is not in the schema", name)
+	}
+	values := make(map[string]attr.Value, len(objectType.AttrTypes))
+	for attrName, attrType := range objectType.AttrTypes {
+		if values[attrName], err = getNullValue(attrType); err != nil {
+			return nil, err
+		}
+	}
+	if values[name], err = interfaceTypeToAttr(ctx, variant, variantType); err != nil {
+		return nil, withAttributePath(name, err)
+	}
+	objVal, diags := types.ObjectValue(objectType.AttrTypes, values)
+	if diags.HasError() {
+		return nil, fmt.Errorf("failed to convert object: %v", diags)
+	}
+	return objVal, nil
+}
+
+// unionFromObject converts the object of the variants of a field tagged with OneOfTag to the model of the
+// variant set in it, or nil when none is.
+func unionFromObject(union Union, obj types.Object) (interface{}, error) {
+	for _, name := range union.variantNames() {
+		variantObj, ok := obj.Attributes()[name].(types.Object)
+		if !ok || variantObj.IsNull() || variantObj.IsUnknown() {
+			continue
+		}
+		payload, err := objectToMap(variantObj, union.variantPrototype(name))
+		if err != nil {
+			return nil, withAttributePath(name, err)
+		}
+		variant, err := union.decodeVariant(name, payload, false)
+		if err != nil {
+			return nil, withAttributePath(name, err)
+		}
+		return variant, nil
+	}
+	return nil, nil
+}
+
+// fieldToAttr converts the value of a model field to the type of its attribute, converting fields tagged
+// with OneOfTag whose attribute is the object of their variants with unionToAttr.
+func fieldToAttr(ctx context.Context, field reflect.StructField, val interface{}, t attr.Type) (attr.Value, error) {
+	if union, ok := fieldUnion(field); ok && isType[types.ObjectType](t) {
+		objectType, err := asType[types.ObjectType](t)
+		if err != nil {
+			return nil, err
+		}
+		return unionToAttr(ctx, union, val, objectType)
+	}
+	return interfaceTypeToAttr(ctx, val, t)
+}
+
+// UnionValidator validates that exactly one variant of the object of a field tagged with OneOfTag is set.
+// Unknown variants count as set, so only configurations certain to be invalid fail.
+type UnionValidator struct {
+	Variants []string
+}
+
+// Description describes the validation.
+func (v UnionValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("Exactly one of %s must be set.", strings.Join(v.Variants, ", "))
+}
+
+// MarkdownDescription describes the validation in markdown.
+func (v UnionValidator) MarkdownDescription(ctx context.Context) string {
+	quoted := make([]string, len(v.Variants))
+	for i, name := range v.Variants {
+		quoted[i] = fmt.Sprintf("`%s`", name)
+	}
+	return fmt.Sprintf("Exactly one of %s must be set.", strings.Join(quoted, ", "))
+}
+
+// ValidateObject validates the object of the variants.
+func (v UnionValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	var set []string
+	unknown := false
+	for _, name := range v.Variants {
+		value, ok := req.ConfigValue.Attributes()[name]
+		switch {
+		case !ok || value.IsNull():
+		case value.IsUnknown():
+			unknown = true
+		default:
+			set = append(set, name)
+		}
+	}
+	switch {
+	case len(set) > 1:
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Attribute Combination",
+			fmt.Sprintf("Only one of %s can be set in %s, got %s.", strings.Join(v.Variants, ", "), req.Path, strings.Join(set, " and ")),
+		)
+	case len(set) == 0 && !unknown:
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Missing Attribute Configuration",
+			fmt.Sprintf("One of %s must be set in %s.", strings.Join(v.Variants, ", "), req.Path),
+		)
+	}
+}
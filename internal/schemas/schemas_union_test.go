@@ -0,0 +1,179 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+type testUnionAWSTarget struct {
+	AccountID string `json:"account_id" mapstructure:"account_id"`
+	Region    string `json:"region,omitempty" mapstructure:"region"`
+}
+
+type testUnionGCPTarget struct {
+	ProjectID string `json:"project_id" mapstructure:"project_id"`
+}
+
+type testUnionModel struct {
+	ID     string      `json:"id" mapstructure:"id"`
+	Target interface{} `json:"target,omitempty" mapstructure:"target" oneof:"test_union_target"`
+}
+
+func init() {
+	RegisterUnion("test_union_target", Union{
+		Variants: map[string]interface{}{
+			"aws": testUnionAWSTarget{},
+			"gcp": &testUnionGCPTarget{},
+		},
+		Discriminator: "provider",
+	})
+}
+
+func TestGenerateSchemaUnion(t *testing.T) {
+	t.Parallel()
+
+	generated := GenerateResourceSchemaFromStruct(&testUnionModel{}, nil, nil, ResourceSchemaOptions{})
+	target, ok := generated.Attributes["target"].(schema.SingleNestedAttribute)
+	if !ok {
+		t.Fatalf("expected target to be a single nested attribute, got %T", generated.Attributes["target"])
+	}
+	aws, ok := target.Attributes["aws"].(schema.SingleNestedAttribute)
+	if !ok || !aws.Optional {
+		t.Fatalf("expected an optional aws variant, got %#v", target.Attributes["aws"])
+	}
+	if _, ok := aws.Attributes["account_id"].(schema.StringAttribute); !ok {
+		t.Errorf("expected the aws variant to be typed from its model, got %v", aws.Attributes)
+	}
+	if _, ok := target.Attributes["gcp"].(schema.SingleNestedAttribute); !ok {
+		t.Errorf("expected a gcp variant from a pointer prototype, got %T", target.Attributes["gcp"])
+	}
+	if len(target.Validators) != 1 {
+		t.Errorf("expected the union validator, got %v", target.Validators)
+	}
+}
+
+func TestStructToStateObjectUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	generated := GenerateResourceSchemaFromStruct(&testUnionModel{}, nil, nil, ResourceSchemaOptions{})
+	schemaAttrs := ResourceSchemaToSchemaAttrTypes(generated)
+
+	tests := []struct {
+		name     string
+		target   interface{}
+		expected string
+	}{
+		{name: "variant model", target: testUnionAWSTarget{AccountID: "123"}, expected: "aws"},
+		{name: "variant model pointer", target: &testUnionGCPTarget{ProjectID: "p"}, expected: "gcp"},
+		{name: "discriminated payload", target: map[string]interface{}{"provider": "GCP", "project_id": "p"}, expected: "gcp"},
+		{name: "payload without discriminator", target: map[string]interface{}{"account_id": "123", "region": "us-east-1"}, expected: "aws"},
+		{name: "null", target: nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stateObj, err := StructToStateObject(ctx, &testUnionModel{ID: "1", Target: tt.target}, nil, nil, schemaAttrs, nil)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			target := stateObj.Attributes()["target"].(types.Object)
+			if tt.expected == "" {
+				if !target.IsNull() {
+					t.Errorf("expected a null target, got %v", target)
+				}
+				return
+			}
+			for name, value := range target.Attributes() {
+				if (name == tt.expected) == value.IsNull() {
+					t.Errorf("expected only %s to be set, got %v", tt.expected, target)
+				}
+			}
+		})
+	}
+
+	_, err := StructToStateObject(ctx, &testUnionModel{ID: "1", Target: map[string]interface{}{"provider": "azure"}}, nil, nil, schemaAttrs, nil)
+	if err == nil {
+		t.Error("expected an error for a discriminator matching no variant")
+	}
+}
+
+func TestStructFromPlanObjectUnion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	generated := GenerateResourceSchemaFromStruct(&testUnionModel{}, nil, nil, ResourceSchemaOptions{})
+	objectType := generated.Type().TerraformType(ctx).(tftypes.Object)
+	targetType := objectType.AttributeTypes["target"].(tftypes.Object)
+	awsType := targetType.AttributeTypes["aws"].(tftypes.Object)
+
+	raw := tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"id": tftypes.NewValue(tftypes.String, "1"),
+		"target": tftypes.NewValue(targetType, map[string]tftypes.Value{
+			"aws": tftypes.NewValue(awsType, map[string]tftypes.Value{
+				"account_id": tftypes.NewValue(tftypes.String, "123"),
+				"region":     tftypes.NewValue(tftypes.String, nil),
+			}),
+			"gcp": tftypes.NewValue(targetType.AttributeTypes["gcp"], nil),
+		}),
+	})
+	result, err := StructFromPlanObject(ctx, &tfsdk.Plan{Schema: generated, Raw: raw}, &testUnionModel{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	target, ok := result.(*testUnionModel).Target.(testUnionAWSTarget)
+	if !ok || target.AccountID != "123" {
+		t.Errorf("expected the aws variant model, got %#v", result.(*testUnionModel).Target)
+	}
+}
+
+func TestUnionValidator(t *testing.T) {
+	t.Parallel()
+
+	attrTypes := map[string]attr.Type{
+		"aws": types.ObjectType{AttrTypes: map[string]attr.Type{"account_id": types.StringType}},
+		"gcp": types.ObjectType{AttrTypes: map[string]attr.Type{"project_id": types.StringType}},
+	}
+	aws := types.ObjectValueMust(attrTypes["aws"].(types.ObjectType).AttrTypes, map[string]attr.Value{"account_id": types.StringValue("123")})
+	gcp := types.ObjectValueMust(attrTypes["gcp"].(types.ObjectType).AttrTypes, map[string]attr.Value{"project_id": types.StringValue("p")})
+	awsNull := types.ObjectNull(attrTypes["aws"].(types.ObjectType).AttrTypes)
+	gcpNull := types.ObjectNull(attrTypes["gcp"].(types.ObjectType).AttrTypes)
+	gcpUnknown := types.ObjectUnknown(attrTypes["gcp"].(types.ObjectType).AttrTypes)
+
+	tests := []struct {
+		name     string
+		value    types.Object
+		expected string
+	}{
+		{name: "one variant", value: types.ObjectValueMust(attrTypes, map[string]attr.Value{"aws": aws, "gcp": gcpNull})},
+		{name: "two variants", value: types.ObjectValueMust(attrTypes, map[string]attr.Value{"aws": aws, "gcp": gcp}), expected: "Invalid Attribute Combination"},
+		{name: "no variant", value: types.ObjectValueMust(attrTypes, map[string]attr.Value{"aws": awsNull, "gcp": gcpNull}), expected: "Missing Attribute Configuration"},
+		{name: "unknown variant", value: types.ObjectValueMust(attrTypes, map[string]attr.Value{"aws": awsNull, "gcp": gcpUnknown})},
+		{name: "null union", value: types.ObjectNull(attrTypes)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &validator.ObjectResponse{}
+			UnionValidator{Variants: []string{"aws", "gcp"}}.ValidateObject(context.Background(), validator.ObjectRequest{Path: path.Root("target"), ConfigValue: tt.value}, resp)
+			if tt.expected == "" {
+				if resp.Diagnostics.HasError() {
+					t.Errorf("expected no error, got %v", resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.ErrorsCount() != 1 || resp.Diagnostics.Errors()[0].Summary() != tt.expected {
+				t.Errorf("expected a %q error, got %v", tt.expected, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,126 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+)
+
+// UnorderedTag is the model field tag of a slice the API returns in no particular order, e.g.
+// `unordered:"true"` on the members of a group. The field is generated as a set rather than a list,
+// and its elements are sorted when converted to state, so a different order alone is never a change.
+const UnorderedTag = "unordered"
+
+// isUnordered reports whether a field is tagged with UnorderedTag.
+func isUnordered(field reflect.StructField) bool {
+	unordered, err := strconv.ParseBool(field.Tag.Get(UnorderedTag))
+	return err == nil && unordered
+}
+
+// hasUnorderedFields reports whether a type holds a field tagged with UnorderedTag, at any depth.
+func hasUnorderedFields(typ reflect.Type, visited map[reflect.Type]bool) bool {
+	for typ.Kind() == reflect.Pointer || typ.Kind() == reflect.Slice || typ.Kind() == reflect.Array || typ.Kind() == reflect.Map {
+		typ = typ.Elem()
+	}
+	if typ.Kind() != reflect.Struct || visited[typ] {
+		return false
+	}
+	visited[typ] = true
+	for i := range typ.NumField() {
+		field := typ.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		if (isUnordered(field) && field.Type.Kind() == reflect.Slice) || hasUnorderedFields(field.Type, visited) {
+			return true
+		}
+	}
+	return false
+}
+
+// sortUnordered returns a copy of input whose slices tagged with UnorderedTag are sorted by the JSON
+// encoding of their elements, or input itself when its type has no such slice.
+func sortUnordered(input interface{}) interface{} {
+	if input == nil || !hasUnorderedFields(reflect.TypeOf(input), map[reflect.Type]bool{}) {
+		return input
+	}
+	sorted := DeepCopy(input)
+	sortUnorderedValue(reflect.ValueOf(sorted), map[uintptr]bool{})
+	return sorted
+}
+
+// sortUnorderedValue sorts in place the unordered slices reachable from v. Elements are swapped within
+// the backing arrays of the slices, which does not require v to be addressable.
+func sortUnorderedValue(v reflect.Value, visited map[uintptr]bool) {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return
+		}
+		if v.Kind() == reflect.Pointer {
+			if visited[v.Pointer()] {
+				return
+			}
+			visited[v.Pointer()] = true
+		}
+		sortUnorderedValue(v.Elem(), visited)
+	case reflect.Slice, reflect.Array:
+		for i := range v.Len() {
+			sortUnorderedValue(v.Index(i), visited)
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			sortUnorderedValue(iter.Value(), visited)
+		}
+	case reflect.Struct:
+		typ := v.Type()
+		for i := range typ.NumField() {
+			field := typ.Field(i)
+			if field.PkgPath != "" {
+				continue
+			}
+			fieldVal := v.Field(i)
+			sortUnorderedValue(fieldVal, visited)
+			if isUnordered(field) && fieldVal.Kind() == reflect.Slice {
+				sortSlice(fieldVal)
+			}
+		}
+	default:
+	}
+}
+
+// sortSlice sorts the elements of a slice by their JSON encoding, falling back to their printed value
+// for elements JSON cannot encode.
+func sortSlice(slice reflect.Value) {
+	keys := make([]string, slice.Len())
+	for i := range slice.Len() {
+		elem := slice.Index(i).Interface()
+		encoded, err := json.Marshal(elem)
+		if err != nil {
+			keys[i] = fmt.Sprintf("%v", elem)
+			continue
+		}
+		keys[i] = string(encoded)
+	}
+	swap := reflect.Swapper(slice.Interface())
+	sort.Stable(sortedSlice{keys: keys, swap: swap})
+}
+
+// sortedSlice sorts a slice through its keys, swapping the keys along with the elements.
+type sortedSlice struct {
+	keys []string
+	swap func(i, j int)
+}
+
+func (s sortedSlice) Len() int           { return len(s.keys) }
+func (s sortedSlice) Less(i, j int) bool { return s.keys[i] < s.keys[j] }
+func (s sortedSlice) Swap(i, j int) {
+	s.keys[i], s.keys[j] = s.keys[j], s.keys[i]
+	s.swap(i, j)
+}
@@ -0,0 +1,76 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package schemas
+
+import (
+	"reflect"
+	"testing"
+
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+type testGroupMember struct {
+	Name  string   `json:"name" mapstructure:"name"`
+	Roles []string `json:"roles,omitempty" mapstructure:"roles" unordered:"true"`
+}
+
+type testGroup struct {
+	Name    string            `json:"name" mapstructure:"name" validate:"required"`
+	Members []testGroupMember `json:"members,omitempty" mapstructure:"members" unordered:"true"`
+	Tags    []string          `json:"tags,omitempty" mapstructure:"tags"`
+}
+
+func TestGenerateSchemaUnordered(t *testing.T) {
+	t.Parallel()
+
+	generated := GenerateResourceSchemaFromStruct(&testGroup{}, nil, nil, ResourceSchemaOptions{})
+	members, ok := generated.Attributes["members"].(schema.SetNestedAttribute)
+	if !ok {
+		t.Fatalf("expected the unordered members to be a set, got %T", generated.Attributes["members"])
+	}
+	if _, ok := members.NestedObject.Attributes["roles"].(schema.SetAttribute); !ok {
+		t.Errorf("expected the unordered roles to be a set, got %T", members.NestedObject.Attributes["roles"])
+	}
+	if _, ok := generated.Attributes["tags"].(schema.ListAttribute); !ok {
+		t.Errorf("expected the untagged tags to stay a list, got %T", generated.Attributes["tags"])
+	}
+
+	dataSource := GenerateDataSourceSchemaFromStruct(nil, &testGroup{}, nil, nil, nil)
+	if _, ok := dataSource.Attributes["members"].(datasourceschema.SetNestedAttribute); !ok {
+		t.Errorf("expected the data source members to be a set, got %T", dataSource.Attributes["members"])
+	}
+}
+
+func TestSortUnordered(t *testing.T) {
+	t.Parallel()
+
+	group := &testGroup{
+		Name: "admins",
+		Members: []testGroupMember{
+			{Name: "bob", Roles: []string{"write", "read"}},
+			{Name: "alice", Roles: []string{"read"}},
+		},
+		Tags: []string{"z", "a"},
+	}
+	sorted := sortUnordered(group).(*testGroup)
+	want := []testGroupMember{
+		{Name: "alice", Roles: []string{"read"}},
+		{Name: "bob", Roles: []string{"read", "write"}},
+	}
+	if !reflect.DeepEqual(sorted.Members, want) {
+		t.Errorf("expected the members and their roles sorted, got %+v", sorted.Members)
+	}
+	if !reflect.DeepEqual(sorted.Tags, []string{"z", "a"}) {
+		t.Errorf("expected the order of the untagged tags to be kept, got %v", sorted.Tags)
+	}
+	if group.Members[0].Name != "bob" || group.Members[0].Roles[0] != "write" {
+		t.Errorf("expected the input to be left unchanged, got %+v", group.Members)
+	}
+
+	untagged := &testFolder{Name: "root"}
+	if sortUnordered(untagged) != interface{}(untagged) {
+		t.Error("expected a value without unordered slices to be returned as is")
+	}
+}
@@ -5,11 +5,16 @@ package schemas
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
+	"math/big"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -76,6 +81,46 @@ func (d Int64Default) DefaultInt64(ctx context.Context, req defaults.Int64Reques
 	resp.PlanValue = types.Int64Value(d.Value)
 }
 
+// Float64Default is a default value for float64 attributes.
+type Float64Default struct {
+	Value float64
+}
+
+// Description returns a description of the default value.
+func (d Float64Default) Description(ctx context.Context) string {
+	return "Default value for float64 attribute"
+}
+
+// MarkdownDescription returns a markdown description of the default value.
+func (d Float64Default) MarkdownDescription(ctx context.Context) string {
+	return "Default value for **float64** attribute"
+}
+
+// DefaultFloat64 sets the default value for float64 attributes.
+func (d Float64Default) DefaultFloat64(ctx context.Context, req defaults.Float64Request, resp *defaults.Float64Response) {
+	resp.PlanValue = types.Float64Value(d.Value)
+}
+
+// NumberDefault is a default value for arbitrary-precision number attributes.
+type NumberDefault struct {
+	Value *big.Float
+}
+
+// Description returns a description of the default value.
+func (d NumberDefault) Description(ctx context.Context) string {
+	return "Default value for number attribute"
+}
+
+// MarkdownDescription returns a markdown description of the default value.
+func (d NumberDefault) MarkdownDescription(ctx context.Context) string {
+	return "Default value for **number** attribute"
+}
+
+// DefaultNumber sets the default value for number attributes.
+func (d NumberDefault) DefaultNumber(ctx context.Context, req defaults.NumberRequest, resp *defaults.NumberResponse) {
+	resp.PlanValue = types.NumberValue(d.Value)
+}
+
 // SetStringDefault is a default value for set of strings attributes.
 type SetStringDefault struct {
 	Values []string
@@ -220,6 +265,87 @@ func (d ListBoolDefault) DefaultList(ctx context.Context, req defaults.ListReque
 	resp.PlanValue = types.ListValueMust(types.BoolType, values)
 }
 
+// ListNestedDefault is a default value for lists of nested objects, decoded from the `default` tag.
+type ListNestedDefault struct {
+	Value types.List
+}
+
+// Description returns a description of the default value.
+func (d ListNestedDefault) Description(ctx context.Context) string {
+	return "Default value for list of objects attribute"
+}
+
+// MarkdownDescription returns a markdown description of the default value.
+func (d ListNestedDefault) MarkdownDescription(ctx context.Context) string {
+	return "Default value for **list of objects** attribute"
+}
+
+// DefaultList sets the default value for list attributes.
+func (d ListNestedDefault) DefaultList(ctx context.Context, req defaults.ListRequest, resp *defaults.ListResponse) {
+	resp.PlanValue = d.Value
+}
+
+// SetNestedDefault is a default value for sets of nested objects, decoded from the `default` tag.
+type SetNestedDefault struct {
+	Value types.Set
+}
+
+// Description returns a description of the default value.
+func (d SetNestedDefault) Description(ctx context.Context) string {
+	return "Default value for set of objects attribute"
+}
+
+// MarkdownDescription returns a markdown description of the default value.
+func (d SetNestedDefault) MarkdownDescription(ctx context.Context) string {
+	return "Default value for **set of objects** attribute"
+}
+
+// DefaultSet sets the default value for set attributes.
+func (d SetNestedDefault) DefaultSet(ctx context.Context, req defaults.SetRequest, resp *defaults.SetResponse) {
+	resp.PlanValue = d.Value
+}
+
+// MapNestedDefault is a default value for maps of nested objects, decoded from the `default` tag.
+type MapNestedDefault struct {
+	Value types.Map
+}
+
+// Description returns a description of the default value.
+func (d MapNestedDefault) Description(ctx context.Context) string {
+	return "Default value for map of objects attribute"
+}
+
+// MarkdownDescription returns a markdown description of the default value.
+func (d MapNestedDefault) MarkdownDescription(ctx context.Context) string {
+	return "Default value for **map of objects** attribute"
+}
+
+// DefaultMap sets the default value for map attributes.
+func (d MapNestedDefault) DefaultMap(ctx context.Context, req defaults.MapRequest, resp *defaults.MapResponse) {
+	resp.PlanValue = d.Value
+}
+
+// MapDefault is a default value for maps of simple values, e.g. a permission map defaulting to
+// least privilege, decoded from the `default` tag.
+type MapDefault struct {
+	Value types.Map
+}
+
+// Description returns a description of the default value.
+func (d MapDefault) Description(ctx context.Context) string {
+	return "Default value for map attribute"
+}
+
+// MarkdownDescription returns a markdown description of the default value.
+func (d MapDefault) MarkdownDescription(ctx context.Context) string {
+	return "Default value for **map** attribute"
+}
+
+// DefaultMap sets the default value for map attributes.
+func (d MapDefault) DefaultMap(ctx context.Context, req defaults.MapRequest, resp *defaults.MapResponse) {
+	resp.PlanValue = d.Value
+}
+
 // StringInChoicesValidator ensures a string is in the allowed choices.
 type StringInChoicesValidator struct {
 	Choices []string
@@ -343,6 +469,55 @@ func (v StringLengthValidator) ValidateString(ctx context.Context, req validator
 	}
 }
 
+// formatFloatBound renders an optional floating point bound for description messages.
+func formatFloatBound(v *float64) string {
+	if v == nil {
+		return "unbounded"
+	}
+	return fmt.Sprintf("%g", *v)
+}
+
+// Float64RangeValidator ensures a float64 value is within the optional [Min, Max] range (inclusive).
+// A nil bound means that side of the range is unbounded.
+type Float64RangeValidator struct {
+	Min *float64
+	Max *float64
+}
+
+// Description returns a description of the validator.
+func (v Float64RangeValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("Value must be between %s and %s (inclusive)", formatFloatBound(v.Min), formatFloatBound(v.Max))
+}
+
+// MarkdownDescription returns a markdown description of the validator.
+func (v Float64RangeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateFloat64 checks the configured value against the configured bounds.
+func (v Float64RangeValidator) ValidateFloat64(ctx context.Context, req validator.Float64Request, resp *validator.Float64Response) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	value := req.ConfigValue.ValueFloat64()
+	if v.Min != nil && value < *v.Min {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Value",
+			fmt.Sprintf("Value must be at least %g, got %g", *v.Min, value),
+		)
+		return
+	}
+	if v.Max != nil && value > *v.Max {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Value",
+			fmt.Sprintf("Value must be at most %g, got %g", *v.Max, value),
+		)
+		return
+	}
+}
+
 // ListSizeValidator ensures a list's element count is within the optional [Min, Max] range (inclusive).
 // A nil bound means that side of the range is unbounded.
 type ListSizeValidator struct {
@@ -506,3 +681,99 @@ func (v SliceInSetValidator) ValidateSet(ctx context.Context, req validator.SetR
 		}
 	}
 }
+
+// Base64Validator ensures a string is valid standard base64, as expected by attributes holding binary
+// content such as certificates and keys.
+type Base64Validator struct{}
+
+// Description returns a description of the validator.
+func (v Base64Validator) Description(ctx context.Context) string {
+	return "Value must be a base64 encoded string"
+}
+
+// MarkdownDescription returns a markdown description of the validator.
+func (v Base64Validator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString checks the configured string decodes as standard base64.
+func (v Base64Validator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	if _, err := base64.StdEncoding.DecodeString(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Base64 Value",
+			fmt.Sprintf("Value must be a base64 encoded string: %s", err.Error()),
+		)
+	}
+}
+
+// DurationValidator ensures a string is a positive Go duration, e.g. "30m" or "1h30m".
+type DurationValidator struct{}
+
+// Description returns a description of the validator.
+func (v DurationValidator) Description(ctx context.Context) string {
+	return "Value must be a positive duration, e.g. 30m"
+}
+
+// MarkdownDescription returns a markdown description of the validator.
+func (v DurationValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+// ValidateString checks the configured string parses as a positive duration.
+func (v DurationValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+	duration, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err == nil && duration <= 0 {
+		err = fmt.Errorf("duration must be positive, got %s", req.ConfigValue.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Duration",
+			fmt.Sprintf("Value must be a duration such as 30m or 1h30m: %s", err.Error()),
+		)
+	}
+}
+
+// AtLeastOneOfValidator ensures a data source configuration sets at least one of its lookup keys, so
+// reads are not sent with all-empty inputs returning ambiguous results. Empty strings count as unset.
+type AtLeastOneOfValidator struct {
+	Attributes []string
+}
+
+// Description returns a description of the validator.
+func (v AtLeastOneOfValidator) Description(ctx context.Context) string {
+	return fmt.Sprintf("At least one of %s must be set", strings.Join(v.Attributes, ", "))
+}
+
+// MarkdownDescription returns a markdown description of the validator.
+func (v AtLeastOneOfValidator) MarkdownDescription(ctx context.Context) string {
+	return fmt.Sprintf("At least one of `%s` must be set", strings.Join(v.Attributes, "`, `"))
+}
+
+// ValidateDataSource checks that one of the lookup keys is set or unknown in the configuration.
+func (v AtLeastOneOfValidator) ValidateDataSource(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	if req.Config.Raw.IsNull() || len(v.Attributes) == 0 {
+		return
+	}
+	for _, attribute := range v.Attributes {
+		var value attr.Value
+		if diags := req.Config.GetAttribute(ctx, path.Root(attribute), &value); diags.HasError() || value == nil || value.IsNull() {
+			continue
+		}
+		if stringValue, ok := value.(types.String); ok && !stringValue.IsUnknown() && stringValue.ValueString() == "" {
+			continue
+		}
+		return
+	}
+	resp.Diagnostics.AddError(
+		"Missing Lookup Key",
+		fmt.Sprintf("At least one of %s must be set to look up a single object.", strings.Join(v.Attributes, ", ")),
+	)
+}
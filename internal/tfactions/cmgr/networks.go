@@ -36,7 +36,8 @@ func init() {
 					tfactions.UpdateOperation: "update",
 					tfactions.DeleteOperation: "delete",
 				},
-				ImportID: "network_id",
+				ImportID:            "network_id",
+				ComputedOnlyOutputs: true,
 			},
 		},
 		DataSources: []*tfactions.IdsecServiceTerraformDataSourceActionDefinition{
@@ -30,7 +30,8 @@ func init() {
 				ActionsMappings: map[tfactions.IdsecServiceActionOperation]string{
 					tfactions.CreateOperation: "create", tfactions.ReadOperation: "get", tfactions.UpdateOperation: "update", tfactions.DeleteOperation: "delete",
 				},
-				ImportID: "pool_id:identifier_id",
+				ImportID:            "pool_id:identifier_id",
+				ComputedOnlyOutputs: true,
 			},
 		},
 		DataSources: []*tfactions.IdsecServiceTerraformDataSourceActionDefinition{
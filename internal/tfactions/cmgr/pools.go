@@ -23,6 +23,7 @@ func init() {
 					},
 					ExtraRequiredAttributes: []string{"assigned_network_ids"},
 					StateSchema:             &poolsmodels.IdsecCmgrPool{},
+					EmptyAsNullAttributes:   []string{"description"},
 				},
 				SupportedOperations: []tfactions.IdsecServiceActionOperation{
 					tfactions.CreateOperation, tfactions.ReadOperation, tfactions.UpdateOperation, tfactions.DeleteOperation, tfactions.StateOperation,
@@ -30,7 +31,9 @@ func init() {
 				ActionsMappings: map[tfactions.IdsecServiceActionOperation]string{
 					tfactions.CreateOperation: "create", tfactions.ReadOperation: "get", tfactions.UpdateOperation: "update", tfactions.DeleteOperation: "delete",
 				},
-				ImportID: "pool_id",
+				ImportID:                 "pool_id",
+				ComputedOnlyOutputs:      true,
+				KnownAfterApplyAllowlist: []string{"pool_id"},
 			},
 		},
 		DataSources: []*tfactions.IdsecServiceTerraformDataSourceActionDefinition{
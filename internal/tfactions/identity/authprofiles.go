@@ -34,6 +34,7 @@ func init() {
 					StateSchema: &authprofilesmodels.IdsecIdentityAuthProfile{},
 				},
 				DataSourceAction: "get",
+				LookupKeys:       []string{"auth_profile_id", "auth_profile_name"},
 			},
 		},
 	})
@@ -24,6 +24,7 @@ func init() {
 				SupportedOperations: []tfactions.IdsecServiceActionOperation{tfactions.CreateOperation, tfactions.ReadOperation, tfactions.UpdateOperation, tfactions.DeleteOperation, tfactions.StateOperation},
 				ActionsMappings:     map[tfactions.IdsecServiceActionOperation]string{tfactions.CreateOperation: "create", tfactions.ReadOperation: "get", tfactions.UpdateOperation: "update", tfactions.DeleteOperation: "delete"},
 				ImportID:            "role_id",
+				NamePrefixAttribute: "role_name",
 			},
 			{
 				IdsecServiceBaseTerraformActionDefinition: tfactions.IdsecServiceBaseTerraformActionDefinition{
@@ -36,6 +37,7 @@ func init() {
 				SupportedOperations: []tfactions.IdsecServiceActionOperation{tfactions.CreateOperation, tfactions.ReadOperation, tfactions.DeleteOperation, tfactions.StateOperation},
 				ActionsMappings:     map[tfactions.IdsecServiceActionOperation]string{tfactions.CreateOperation: "add-member", tfactions.ReadOperation: "get-member", tfactions.DeleteOperation: "remove-member"},
 				ImportID:            "role_id:member_id",
+				IDTemplate:          "{role_id}:{member_id}",
 			},
 			{
 				IdsecServiceBaseTerraformActionDefinition: tfactions.IdsecServiceBaseTerraformActionDefinition{
@@ -82,6 +84,7 @@ func init() {
 					ComputedAsSetAttributes: []string{"admin_rights"},
 				},
 				DataSourceAction: "get",
+				LookupKeys:       []string{"role_id", "role_name"},
 			},
 			{
 				IdsecServiceBaseTerraformActionDefinition: tfactions.IdsecServiceBaseTerraformActionDefinition{
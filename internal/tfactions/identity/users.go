@@ -37,6 +37,7 @@ func init() {
 				SupportedOperations: []tfactions.IdsecServiceActionOperation{tfactions.CreateOperation, tfactions.ReadOperation, tfactions.UpdateOperation, tfactions.DeleteOperation, tfactions.StateOperation},
 				ActionsMappings:     map[tfactions.IdsecServiceActionOperation]string{tfactions.CreateOperation: "upsert-attributes-schema", tfactions.ReadOperation: "attributes-schema", tfactions.UpdateOperation: "upsert-attributes-schema", tfactions.DeleteOperation: "delete-attributes-schema"},
 				ImportID:            tfactions.SingletonResourceImportDummyID,
+				BlockAttributes:     []string{"columns"},
 			},
 			{
 				IdsecServiceBaseTerraformActionDefinition: tfactions.IdsecServiceBaseTerraformActionDefinition{
@@ -59,6 +60,7 @@ func init() {
 					StateSchema: &usersmodels.IdsecIdentityUser{},
 				},
 				DataSourceAction: "get",
+				LookupKeys:       []string{"user_id", "username"},
 			},
 			{
 				IdsecServiceBaseTerraformActionDefinition: tfactions.IdsecServiceBaseTerraformActionDefinition{
@@ -87,6 +87,7 @@ func init() {
 					StateSchema: &webappsmodels.IdsecIdentityWebapp{},
 				},
 				DataSourceAction: "get",
+				LookupKeys:       []string{"webapp_id", "webapp_name"},
 			},
 			{
 				IdsecServiceBaseTerraformActionDefinition: tfactions.IdsecServiceBaseTerraformActionDefinition{
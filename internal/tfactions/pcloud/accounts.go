@@ -23,9 +23,14 @@ func init() {
 					SensitiveAttributes:     []string{"secret"},
 					StateSchema:             &accountsmodels.IdsecPCloudAccount{},
 				},
-				SupportedOperations: []tfactions.IdsecServiceActionOperation{tfactions.CreateOperation, tfactions.ReadOperation, tfactions.UpdateOperation, tfactions.DeleteOperation, tfactions.StateOperation},
-				ActionsMappings:     map[tfactions.IdsecServiceActionOperation]string{tfactions.CreateOperation: "create", tfactions.ReadOperation: "get", tfactions.UpdateOperation: "update", tfactions.DeleteOperation: "delete"},
-				ImportID:            "account_id",
+				SupportedOperations:       []tfactions.IdsecServiceActionOperation{tfactions.CreateOperation, tfactions.ReadOperation, tfactions.UpdateOperation, tfactions.DeleteOperation, tfactions.StateOperation},
+				ActionsMappings:           map[tfactions.IdsecServiceActionOperation]string{tfactions.CreateOperation: "create", tfactions.ReadOperation: "get", tfactions.UpdateOperation: "update", tfactions.DeleteOperation: "delete"},
+				ImportID:                  "account_id",
+				ReferenceAttributes:       map[string]string{"safe_name": "pcloud-safes.get.safe_id"},
+				ProviderDefaultAttributes: []string{"safe_name"},
+				PlanModifiers:             map[string][]string{"address": {"normalize_cidr"}},
+				CreatedAtAttribute:        "created_time",
+				LastModifiedAtAttribute:   "last_modified_time",
 			},
 		},
 		DataSources: []*tfactions.IdsecServiceTerraformDataSourceActionDefinition{
@@ -51,5 +56,31 @@ func init() {
 				DataSourceAction: "get-credentials",
 			},
 		},
+		Actions: []*tfactions.IdsecServiceTerraformImperativeActionDefinition{
+			{
+				IdsecServiceBaseTerraformActionDefinition: tfactions.IdsecServiceBaseTerraformActionDefinition{
+					IdsecServiceBaseActionDefinition: tfactions.IdsecServiceBaseActionDefinition{
+						ActionName: "pcloud-account-change-credentials", ActionDescription: "Privilege Cloud account credentials change action, immediately rotates the account credentials, based on the account ID.", ActionVersion: 1, Schemas: actions.ActionToSchemaMap,
+					},
+				},
+				InvokeAction: "change-credentials",
+			},
+			{
+				IdsecServiceBaseTerraformActionDefinition: tfactions.IdsecServiceBaseTerraformActionDefinition{
+					IdsecServiceBaseActionDefinition: tfactions.IdsecServiceBaseActionDefinition{
+						ActionName: "pcloud-account-verify-credentials", ActionDescription: "Privilege Cloud account credentials verification action, marks the account credentials for verification, based on the account ID.", ActionVersion: 1, Schemas: actions.ActionToSchemaMap,
+					},
+				},
+				InvokeAction: "verify-credentials",
+			},
+			{
+				IdsecServiceBaseTerraformActionDefinition: tfactions.IdsecServiceBaseTerraformActionDefinition{
+					IdsecServiceBaseActionDefinition: tfactions.IdsecServiceBaseActionDefinition{
+						ActionName: "pcloud-account-reconcile-credentials", ActionDescription: "Privilege Cloud account credentials reconciliation action, marks the account credentials for reconciliation, based on the account ID.", ActionVersion: 1, Schemas: actions.ActionToSchemaMap,
+					},
+				},
+				InvokeAction: "reconcile-credentials",
+			},
+		},
 	})
 }
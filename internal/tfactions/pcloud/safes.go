@@ -30,9 +30,15 @@ func init() {
 					},
 					StateSchema: &safesmodels.IdsecPCloudSafe{},
 				},
-				SupportedOperations: []tfactions.IdsecServiceActionOperation{tfactions.CreateOperation, tfactions.ReadOperation, tfactions.UpdateOperation, tfactions.DeleteOperation, tfactions.StateOperation},
-				ActionsMappings:     map[tfactions.IdsecServiceActionOperation]string{tfactions.CreateOperation: "create", tfactions.ReadOperation: "get", tfactions.UpdateOperation: "update", tfactions.DeleteOperation: "delete"},
-				ImportID:            "safe_id",
+				SupportedOperations:      []tfactions.IdsecServiceActionOperation{tfactions.CreateOperation, tfactions.ReadOperation, tfactions.UpdateOperation, tfactions.DeleteOperation, tfactions.StateOperation},
+				ActionsMappings:          map[tfactions.IdsecServiceActionOperation]string{tfactions.CreateOperation: "create", tfactions.ReadOperation: "get", tfactions.UpdateOperation: "update", tfactions.DeleteOperation: "delete"},
+				ImportID:                 "safe_id",
+				ListAction:               "list-by",
+				ListDisplayNameAttribute: "safe_name",
+				CreatedAtAttribute:       "creation_time",
+				LastModifiedAtAttribute:  "last_modification_time",
+				// The ID of a safe is its URL-encoded name, so safes can be looked up by name
+				UniqueNameAttributes: map[string]string{"safe_name": "pcloud-safes.get.safe_id"},
 			},
 			{
 				IdsecServiceBaseTerraformActionDefinition: tfactions.IdsecServiceBaseTerraformActionDefinition{
@@ -53,9 +59,12 @@ func init() {
 					},
 					StateSchema: &safesmodels.IdsecPCloudSafeMember{},
 				},
-				SupportedOperations: []tfactions.IdsecServiceActionOperation{tfactions.CreateOperation, tfactions.ReadOperation, tfactions.UpdateOperation, tfactions.DeleteOperation, tfactions.StateOperation},
-				ActionsMappings:     map[tfactions.IdsecServiceActionOperation]string{tfactions.CreateOperation: "add-member", tfactions.ReadOperation: "get-member", tfactions.UpdateOperation: "update-member", tfactions.DeleteOperation: "delete-member"},
-				ImportID:            "safe_id:member_name",
+				SupportedOperations:       []tfactions.IdsecServiceActionOperation{tfactions.CreateOperation, tfactions.ReadOperation, tfactions.UpdateOperation, tfactions.DeleteOperation, tfactions.StateOperation},
+				ActionsMappings:           map[tfactions.IdsecServiceActionOperation]string{tfactions.CreateOperation: "add-member", tfactions.ReadOperation: "get-member", tfactions.UpdateOperation: "update-member", tfactions.DeleteOperation: "delete-member"},
+				ImportID:                  "safe_id:member_name",
+				ReferenceAttributes:       map[string]string{"safe_id": "pcloud-safes.get"},
+				ProviderDefaultAttributes: []string{"safe_id"},
+				IDTemplate:                "{safe_id}:{member_name}",
 			},
 		},
 		DataSources: []*tfactions.IdsecServiceTerraformDataSourceActionDefinition{
@@ -40,11 +40,14 @@ func init() {
 						"targets.gcp_targets.role_package",
 					},
 				},
-				ReadSchemaPath:      "metadata",
-				DeleteSchemaPath:    "metadata",
-				SupportedOperations: []tfactions.IdsecServiceActionOperation{tfactions.CreateOperation, tfactions.ReadOperation, tfactions.UpdateOperation, tfactions.DeleteOperation, tfactions.StateOperation},
-				ActionsMappings:     map[tfactions.IdsecServiceActionOperation]string{tfactions.CreateOperation: "create-policy", tfactions.ReadOperation: "policy", tfactions.UpdateOperation: "update-policy", tfactions.DeleteOperation: "delete-policy"},
-				ImportID:            "metadata.policy_id",
+				ReadSchemaPath:           "metadata",
+				DeleteSchemaPath:         "metadata",
+				SupportedOperations:      []tfactions.IdsecServiceActionOperation{tfactions.CreateOperation, tfactions.ReadOperation, tfactions.UpdateOperation, tfactions.DeleteOperation, tfactions.StateOperation},
+				ActionsMappings:          map[tfactions.IdsecServiceActionOperation]string{tfactions.CreateOperation: "create-policy", tfactions.ReadOperation: "policy", tfactions.UpdateOperation: "update-policy", tfactions.DeleteOperation: "delete-policy"},
+				ImportID:                 "metadata.policy_id",
+				ListAction:               "list-policies-by",
+				ListDisplayNameAttribute: "metadata.name",
+				ConfigConstraints:        []tfactions.IdsecConfigConstraint{{Expression: "len(metadata.policy_tags) <= 20", Message: "A policy has at most 20 tags."}},
 			},
 		},
 		DataSources: []*tfactions.IdsecServiceTerraformDataSourceActionDefinition{
@@ -56,7 +59,8 @@ func init() {
 					StateSchema:             &cloudaccessmodels.IdsecPolicyCloudAccessCloudConsoleAccessPolicy{},
 					ComputedAsSetAttributes: []string{"days_of_the_week", "aws_account_targets", "aws_organization_targets", "azure_targets", "gcp_targets"},
 				},
-				DataSourceAction: "policy",
+				DataSourceAction:    "policy",
+				AttributeProjection: true,
 			},
 		},
 	})
@@ -31,11 +31,14 @@ func init() {
 						"targets.targets.group_name",
 					},
 				},
-				ReadSchemaPath:      "metadata",
-				DeleteSchemaPath:    "metadata",
-				SupportedOperations: []tfactions.IdsecServiceActionOperation{tfactions.CreateOperation, tfactions.ReadOperation, tfactions.UpdateOperation, tfactions.DeleteOperation, tfactions.StateOperation},
-				ActionsMappings:     map[tfactions.IdsecServiceActionOperation]string{tfactions.CreateOperation: "create-policy", tfactions.ReadOperation: "policy", tfactions.UpdateOperation: "update-policy", tfactions.DeleteOperation: "delete-policy"},
-				ImportID:            "metadata.policy_id",
+				ReadSchemaPath:           "metadata",
+				DeleteSchemaPath:         "metadata",
+				SupportedOperations:      []tfactions.IdsecServiceActionOperation{tfactions.CreateOperation, tfactions.ReadOperation, tfactions.UpdateOperation, tfactions.DeleteOperation, tfactions.StateOperation},
+				ActionsMappings:          map[tfactions.IdsecServiceActionOperation]string{tfactions.CreateOperation: "create-policy", tfactions.ReadOperation: "policy", tfactions.UpdateOperation: "update-policy", tfactions.DeleteOperation: "delete-policy"},
+				ImportID:                 "metadata.policy_id",
+				ListAction:               "list-policies-by",
+				ListDisplayNameAttribute: "metadata.name",
+				ConfigConstraints:        []tfactions.IdsecConfigConstraint{{Expression: "len(metadata.policy_tags) <= 20", Message: "A policy has at most 20 tags."}},
 			},
 		},
 		DataSources: []*tfactions.IdsecServiceTerraformDataSourceActionDefinition{
@@ -46,7 +49,8 @@ func init() {
 					},
 					StateSchema: &groupaccessmodels.IdsecPolicyGroupAccessPolicy{},
 				},
-				DataSourceAction: "policy",
+				DataSourceAction:    "policy",
+				AttributeProjection: true,
 			},
 		},
 	})
@@ -21,11 +21,14 @@ func init() {
 					StateSchema:             &policyvmmodels.IdsecPolicyVMAccessPolicy{},
 					ComputedAsSetAttributes: []string{"days_of_the_week"},
 				},
-				ReadSchemaPath:      "metadata",
-				DeleteSchemaPath:    "metadata",
-				SupportedOperations: []tfactions.IdsecServiceActionOperation{tfactions.CreateOperation, tfactions.ReadOperation, tfactions.UpdateOperation, tfactions.DeleteOperation, tfactions.StateOperation},
-				ActionsMappings:     map[tfactions.IdsecServiceActionOperation]string{tfactions.CreateOperation: "create-policy", tfactions.ReadOperation: "policy", tfactions.UpdateOperation: "update-policy", tfactions.DeleteOperation: "delete-policy"},
-				ImportID:            "metadata.policy_id",
+				ReadSchemaPath:           "metadata",
+				DeleteSchemaPath:         "metadata",
+				SupportedOperations:      []tfactions.IdsecServiceActionOperation{tfactions.CreateOperation, tfactions.ReadOperation, tfactions.UpdateOperation, tfactions.DeleteOperation, tfactions.StateOperation},
+				ActionsMappings:          map[tfactions.IdsecServiceActionOperation]string{tfactions.CreateOperation: "create-policy", tfactions.ReadOperation: "policy", tfactions.UpdateOperation: "update-policy", tfactions.DeleteOperation: "delete-policy"},
+				ImportID:                 "metadata.policy_id",
+				ListAction:               "list-policies-by",
+				ListDisplayNameAttribute: "metadata.name",
+				ConfigConstraints:        []tfactions.IdsecConfigConstraint{{Expression: "len(metadata.policy_tags) <= 20", Message: "A policy has at most 20 tags."}},
 			},
 		},
 		DataSources: []*tfactions.IdsecServiceTerraformDataSourceActionDefinition{
@@ -36,7 +39,8 @@ func init() {
 					},
 					StateSchema: &policyvmmodels.IdsecPolicyVMAccessPolicy{},
 				},
-				DataSourceAction: "policy",
+				DataSourceAction:    "policy",
+				AttributeProjection: true,
 			},
 		},
 	})
@@ -4,6 +4,8 @@
 package sia
 
 import (
+	"time"
+
 	"github.com/cyberark/idsec-sdk-golang/pkg/services/sia/access/actions"
 	accessmodels "github.com/cyberark/idsec-sdk-golang/pkg/services/sia/access/models"
 	tfactions "github.com/cyberark/terraform-provider-idsec/internal/actions"
@@ -46,6 +48,12 @@ func init() {
 					tfactions.UpdateOperation: "uninstall-connector",
 					tfactions.DeleteOperation: "uninstall-connector",
 				},
+				// Installing and uninstalling the connector run remotely on the target machine and take minutes.
+				OperationTimeouts: map[tfactions.IdsecServiceActionOperation]time.Duration{
+					tfactions.CreateOperation: 45 * time.Minute,
+					tfactions.UpdateOperation: 30 * time.Minute,
+					tfactions.DeleteOperation: 30 * time.Minute,
+				},
 			},
 			{
 				IdsecServiceBaseTerraformActionDefinition: tfactions.IdsecServiceBaseTerraformActionDefinition{
@@ -74,6 +82,12 @@ func init() {
 					tfactions.UpdateOperation: "uninstall-relay",
 					tfactions.DeleteOperation: "uninstall-relay",
 				},
+				// Installing and uninstalling the HTTPS relay run remotely on the target machine and take minutes.
+				OperationTimeouts: map[tfactions.IdsecServiceActionOperation]time.Duration{
+					tfactions.CreateOperation: 45 * time.Minute,
+					tfactions.UpdateOperation: 30 * time.Minute,
+					tfactions.DeleteOperation: 30 * time.Minute,
+				},
 			},
 		},
 		DataSources: []*tfactions.IdsecServiceTerraformDataSourceActionDefinition{
@@ -0,0 +1,128 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+// Package tftest renders mock providers for the Terraform test framework from the provider schema, so
+// modules using the provider can be unit tested with `terraform test` without access to a tenant.
+package tftest
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// indentUnit is the indentation of one level of HCL, as written by terraform fmt.
+const indentUnit = "  "
+
+// ResourceMockFile renders a .tftest.hcl file mocking the provider, with a mock_resource block giving
+// placeholder values to the computed attributes of the resource typeName, and a run block planning the
+// module under test with it.
+func ResourceMockFile(providerName string, typeName string, schema *tfprotov6.Schema) string {
+	var file strings.Builder
+	fmt.Fprintf(&file, "# Mocks %s for terraform test, so the module can be tested without a tenant.\n", typeName)
+	file.WriteString("# Generated by go run ./tools/tftest; add run blocks asserting on the module below.\n\n")
+	fmt.Fprintf(&file, "mock_provider %q {\n", providerName)
+	fmt.Fprintf(&file, "%smock_resource %q {\n", indentUnit, typeName)
+	fmt.Fprintf(&file, "%sdefaults = ", strings.Repeat(indentUnit, 2))
+	writeObject(&file, mockDefaults(schema), 2)
+	file.WriteString("\n")
+	fmt.Fprintf(&file, "%s}\n}\n\n", indentUnit)
+	fmt.Fprintf(&file, "run %q {\n%scommand = plan\n}\n", "plan_"+strings.TrimPrefix(typeName, providerName+"_"), indentUnit)
+	return file.String()
+}
+
+// mockDefaults returns the placeholder values of the computed top-level attributes of a schema, keyed by
+// attribute name. Terraform only applies mock values to computed attributes, and blocks are never
+// computed.
+func mockDefaults(schema *tfprotov6.Schema) map[string]mockValue {
+	defaults := map[string]mockValue{}
+	if schema == nil || schema.Block == nil {
+		return defaults
+	}
+	for _, attribute := range schema.Block.Attributes {
+		if !attribute.Computed || attribute.WriteOnly {
+			continue
+		}
+		defaults[attribute.Name] = placeholder(attribute.Name, attribute.ValueType())
+	}
+	return defaults
+}
+
+// mockValue is a placeholder value, either an HCL expression or, for objects, the values of its
+// attributes.
+type mockValue struct {
+	expression string
+	attributes map[string]mockValue
+}
+
+// placeholder returns the placeholder value of an attribute of the given type: its name for strings, so
+// the values are recognizable in assertions, and zero or empty values otherwise.
+func placeholder(name string, valueType tftypes.Type) mockValue {
+	switch {
+	case valueType == nil:
+		return mockValue{expression: "null"}
+	case valueType.Is(tftypes.String):
+		return mockValue{expression: fmt.Sprintf("%q", "mock-"+strings.ReplaceAll(name, "_", "-"))}
+	case valueType.Is(tftypes.Number):
+		return mockValue{expression: "0"}
+	case valueType.Is(tftypes.Bool):
+		return mockValue{expression: "false"}
+	case valueType.Is(tftypes.List{}), valueType.Is(tftypes.Set{}), valueType.Is(tftypes.Tuple{}):
+		return mockValue{expression: "[]"}
+	case valueType.Is(tftypes.Map{}):
+		return mockValue{expression: "{}"}
+	case valueType.Is(tftypes.Object{}):
+		attributes := map[string]mockValue{}
+		for attributeName, attributeType := range valueType.(tftypes.Object).AttributeTypes {
+			attributes[attributeName] = placeholder(attributeName, attributeType)
+		}
+		return mockValue{attributes: attributes}
+	default:
+		return mockValue{expression: "null"}
+	}
+}
+
+// writeObject writes an object as a multi-line HCL object at the given indentation level, with the
+// equal signs of consecutive single-line attributes aligned as terraform fmt aligns them.
+func writeObject(out *strings.Builder, attributes map[string]mockValue, level int) {
+	if len(attributes) == 0 {
+		out.WriteString("{}")
+		return
+	}
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	indent := strings.Repeat(indentUnit, level+1)
+	out.WriteString("{\n")
+	for start := 0; start < len(names); {
+		// A group of aligned attributes ends with an attribute whose value spans several lines.
+		end := start
+		for end < len(names) && attributes[names[end]].attributes == nil {
+			end++
+		}
+		if end < len(names) {
+			end++
+		}
+		width := 0
+		for _, name := range names[start:end] {
+			width = max(width, len(name))
+		}
+		for _, name := range names[start:end] {
+			value := attributes[name]
+			fmt.Fprintf(out, "%s%-*s = ", indent, width, name)
+			if value.attributes != nil {
+				writeObject(out, value.attributes, level+1)
+			} else {
+				out.WriteString(value.expression)
+			}
+			out.WriteString("\n")
+		}
+		start = end
+	}
+	out.WriteString(strings.Repeat(indentUnit, level) + "}")
+}
@@ -0,0 +1,79 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+package tftest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestResourceMockFile(t *testing.T) {
+	t.Parallel()
+
+	schema := &tfprotov6.Schema{Block: &tfprotov6.SchemaBlock{
+		Attributes: []*tfprotov6.SchemaAttribute{
+			{Name: "safe_name", Type: tftypes.String, Required: true},
+			{Name: "safe_id", Type: tftypes.String, Computed: true},
+			{Name: "description", Type: tftypes.String, Optional: true, Computed: true},
+			{Name: "number_of_days_retention", Type: tftypes.Number, Computed: true},
+			{Name: "olac_enabled", Type: tftypes.Bool, Computed: true},
+			{Name: "tags", Type: tftypes.Map{ElementType: tftypes.String}, Computed: true},
+			{Name: "members", Type: tftypes.List{ElementType: tftypes.String}, Computed: true},
+			{Name: "password", Type: tftypes.String, Optional: true, Computed: true, WriteOnly: true},
+			{Name: "creator", Computed: true, NestedType: &tfprotov6.SchemaObject{
+				Nesting: tfprotov6.SchemaObjectNestingModeSingle,
+				Attributes: []*tfprotov6.SchemaAttribute{
+					{Name: "id", Type: tftypes.String},
+					{Name: "name", Type: tftypes.String},
+				},
+			}},
+		},
+		BlockTypes: []*tfprotov6.SchemaNestedBlock{
+			{TypeName: "timeouts", Nesting: tfprotov6.SchemaNestedBlockNestingModeSingle, Block: &tfprotov6.SchemaBlock{}},
+		},
+	}}
+	want := `# Mocks idsec_pcloud_safe for terraform test, so the module can be tested without a tenant.
+# Generated by go run ./tools/tftest; add run blocks asserting on the module below.
+
+mock_provider "idsec" {
+  mock_resource "idsec_pcloud_safe" {
+    defaults = {
+      creator = {
+        id   = "mock-id"
+        name = "mock-name"
+      }
+      description              = "mock-description"
+      members                  = []
+      number_of_days_retention = 0
+      olac_enabled             = false
+      safe_id                  = "mock-safe-id"
+      tags                     = {}
+    }
+  }
+}
+
+run "plan_pcloud_safe" {
+  command = plan
+}
+`
+	if got := ResourceMockFile("idsec", "idsec_pcloud_safe", schema); got != want {
+		t.Errorf("unexpected mock file:\n%s\nexpected:\n%s", got, want)
+	}
+}
+
+func TestResourceMockFileWithoutComputedAttributes(t *testing.T) {
+	t.Parallel()
+
+	schema := &tfprotov6.Schema{Block: &tfprotov6.SchemaBlock{
+		Attributes: []*tfprotov6.SchemaAttribute{{Name: "enabled", Type: tftypes.Bool, Required: true}},
+	}}
+	got := ResourceMockFile("idsec", "idsec_sia_settings_ssh_recording", schema)
+	want := "  mock_resource \"idsec_sia_settings_ssh_recording\" {\n    defaults = {}\n  }\n"
+	if !strings.Contains(got, want) {
+		t.Errorf("expected empty defaults in:\n%s", got)
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"os"
 
 	"github.com/cyberark/idsec-sdk-golang/pkg/config"
+	"github.com/cyberark/terraform-provider-idsec/internal/profiling"
 	"github.com/cyberark/terraform-provider-idsec/internal/provider"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
@@ -37,6 +38,9 @@ func main() {
 	if debug || os.Getenv("TF_LOG") != "" {
 		config.EnableVerboseLogging("DEBUG")
 	}
+	if debug {
+		startProfiling()
+	}
 
 	err := providerserver.Serve(context.Background(), provider.NewIdsecProvider(
 		provider.IdsecProviderConfig{
@@ -50,3 +54,19 @@ func main() {
 		log.Fatal(err.Error())
 	}
 }
+
+// startProfiling exposes a localhost pprof endpoint and enables heap summaries in the provider logs,
+// so memory and CPU profiles can be attached to issue reports.
+func startProfiling() {
+	address := os.Getenv(profiling.IdsecPprofAddressEnvVar)
+	if address == "" {
+		address = profiling.DefaultAddress
+	}
+	listening, _, err := profiling.Serve(address)
+	if err != nil {
+		log.Printf("pprof endpoint not started: %s", err.Error())
+	} else {
+		log.Printf("pprof endpoint listening on http://%s/debug/pprof/", listening)
+	}
+	provider.EnableHeapSummaries(profiling.DefaultHeapSummaryInterval)
+}
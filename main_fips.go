@@ -0,0 +1,11 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+//go:build fips
+
+// The FIPS build of the provider, built with GOFIPS140=v1.0.0 go build -tags fips, runs with the Go
+// Cryptographic Module in FIPS 140-3 mode without setting GODEBUG.
+
+//go:debug fips140=on
+
+package main
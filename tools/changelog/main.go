@@ -0,0 +1,203 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+// Command changelog writes the CHANGELOG entries of a release from the diff of the provider schema.
+//
+// A schema snapshot is saved at each release:
+//
+//	go run ./tools/changelog snapshot -out schema-v1.2.0.json
+//
+// The entries of the next release list the resources, data sources, actions and attributes added,
+// deprecated and removed since the snapshot, and are added to the top of CHANGELOG.md:
+//
+//	go run ./tools/changelog generate -from schema-v1.2.0.json -version 1.3.0
+//
+// Entries describe schema changes only; bug fixes and behavior changes are still written by hand.
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/cyberark/terraform-provider-idsec/internal/provider"
+	"github.com/cyberark/terraform-provider-idsec/internal/schemadiff"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+const changelogHeader = "# Changelog\n"
+
+func main() {
+	log.SetFlags(0)
+	if len(os.Args) < 2 {
+		log.Fatal("usage: changelog snapshot|generate [flags]")
+	}
+	var err error
+	switch os.Args[1] {
+	case "snapshot":
+		err = runSnapshot(os.Args[2:])
+	case "generate":
+		err = runGenerate(os.Args[2:])
+	default:
+		err = fmt.Errorf("unknown command %q, expected snapshot or generate", os.Args[1])
+	}
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+}
+
+// runSnapshot writes the snapshot of the schema of the provider built from this tree.
+func runSnapshot(args []string) error {
+	flags := flag.NewFlagSet("snapshot", flag.ExitOnError)
+	out := flags.String("out", "schema.json", "file the snapshot is written to")
+	_ = flags.Parse(args)
+	snapshot, err := currentSnapshot()
+	if err != nil {
+		return err
+	}
+	return schemadiff.WriteSnapshot(*out, snapshot)
+}
+
+// runGenerate adds the entries of a release to the changelog, from the diff of a snapshot of the
+// previous release to the schema of this tree, or to another snapshot.
+func runGenerate(args []string) error {
+	flags := flag.NewFlagSet("generate", flag.ExitOnError)
+	from := flags.String("from", "", "snapshot of the previous release")
+	to := flags.String("to", "", "snapshot of the release, the schema of this tree when not set")
+	version := flags.String("version", "", "version of the release")
+	changelogPath := flags.String("changelog", "CHANGELOG.md", "changelog the entries are added to, - for stdout")
+	_ = flags.Parse(args)
+	if *from == "" || *version == "" {
+		return errors.New("generate requires -from and -version")
+	}
+	oldSnapshot, err := schemadiff.ReadSnapshot(*from)
+	if err != nil {
+		return err
+	}
+	var newSnapshot schemadiff.Snapshot
+	if *to != "" {
+		newSnapshot, err = schemadiff.ReadSnapshot(*to)
+	} else {
+		newSnapshot, err = currentSnapshot()
+	}
+	if err != nil {
+		return err
+	}
+	entries := renderEntries(*version, schemadiff.Compare(oldSnapshot, newSnapshot))
+	if *changelogPath == "-" {
+		fmt.Print(entries)
+		return nil
+	}
+	return prependEntries(*changelogPath, entries)
+}
+
+// currentSnapshot returns the snapshot of the schema of the provider built from this tree.
+func currentSnapshot() (schemadiff.Snapshot, error) {
+	server := providerserver.NewProtocol6(provider.NewIdsecProvider(provider.IdsecProviderConfig{Version: "dev"})())()
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		return schemadiff.Snapshot{}, err
+	}
+	for _, diagnostic := range resp.Diagnostics {
+		if diagnostic.Severity == tfprotov6.DiagnosticSeverityError {
+			return schemadiff.Snapshot{}, fmt.Errorf("%s: %s", diagnostic.Summary, diagnostic.Detail)
+		}
+	}
+	return schemadiff.FromProviderSchema(resp), nil
+}
+
+// renderEntries renders the changelog entries of a release.
+func renderEntries(version string, diff schemadiff.Diff) string {
+	var features, enhancements []string
+	for _, change := range diff.Added {
+		if change.Attribute == "" {
+			features = append(features, fmt.Sprintf("* **New %s:** `%s`", kindTitle(change.Kind), change.Name))
+		} else {
+			enhancements = append(enhancements, fmt.Sprintf("* %s: Add `%s` attribute", subject(change), change.Attribute))
+		}
+	}
+	var deprecations []string
+	for _, change := range diff.Deprecated {
+		if change.Attribute == "" {
+			deprecations = append(deprecations, fmt.Sprintf("* %s: The %s is deprecated", subject(change), kindNoun(change.Kind)))
+		} else {
+			deprecations = append(deprecations, fmt.Sprintf("* %s: The `%s` attribute is deprecated", subject(change), change.Attribute))
+		}
+	}
+	var breaking []string
+	for _, change := range diff.Removed {
+		if change.Attribute == "" {
+			breaking = append(breaking, fmt.Sprintf("* %s: The %s is removed", subject(change), kindNoun(change.Kind)))
+		} else {
+			breaking = append(breaking, fmt.Sprintf("* %s: The `%s` attribute is removed", subject(change), change.Attribute))
+		}
+	}
+	for _, change := range diff.NowRequired {
+		breaking = append(breaking, fmt.Sprintf("* %s: The `%s` attribute is now required", subject(change), change.Attribute))
+	}
+
+	var entries strings.Builder
+	fmt.Fprintf(&entries, "## %s\n", version)
+	for _, section := range []struct {
+		title string
+		lines []string
+	}{
+		{"BREAKING CHANGES", breaking},
+		{"FEATURES", features},
+		{"ENHANCEMENTS", enhancements},
+		{"DEPRECATIONS", deprecations},
+	} {
+		if len(section.lines) > 0 {
+			fmt.Fprintf(&entries, "\n%s:\n\n%s\n", section.title, strings.Join(section.lines, "\n"))
+		}
+	}
+	if diff.IsEmpty() {
+		entries.WriteString("\nNo schema changes.\n")
+	}
+	return entries.String()
+}
+
+// subject names the schema of a change, e.g. "resource/idsec_pcloud_safe" or "provider".
+func subject(change schemadiff.Change) string {
+	if change.Kind == schemadiff.KindProvider {
+		return change.Kind
+	}
+	return change.Kind + "/" + change.Name
+}
+
+func kindTitle(kind string) string {
+	switch kind {
+	case schemadiff.KindDataSource:
+		return "Data Source"
+	case schemadiff.KindResource:
+		return "Resource"
+	case schemadiff.KindAction:
+		return "Action"
+	default:
+		return "Provider"
+	}
+}
+
+func kindNoun(kind string) string {
+	return strings.ToLower(kindTitle(kind))
+}
+
+// prependEntries adds entries to the top of the changelog, below its title, creating it when missing.
+func prependEntries(path string, entries string) error {
+	content, err := os.ReadFile(path) // #nosec G304
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	body := strings.TrimPrefix(string(content), changelogHeader)
+	updated := changelogHeader + "\n" + entries
+	if body = strings.TrimLeft(body, "\n"); body != "" {
+		updated += "\n" + body
+	}
+	return os.WriteFile(path, []byte(updated), 0o644) // #nosec G306
+}
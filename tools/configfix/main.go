@@ -0,0 +1,140 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+// Command configfix rewrites the .tf files of Terraform configurations for the attributes renamed by
+// releases of the provider since the version they were written for:
+//
+//	go run ./tools/configfix -from 1.2.0 ./infra
+//
+// The attributes, nested blocks, object keys, ignore_changes entries and references of each module
+// directory are renamed in place, and the files changed are listed. With -check, nothing is written and
+// the command fails when a file needs fixing. The renames come from the release the command is built
+// from; -renames reads them from a JSON file instead.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/fs"
+	"log"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/cyberark/terraform-provider-idsec/internal/configfix"
+)
+
+func main() {
+	log.SetFlags(0)
+	from := flag.String("from", "", "provider version the configurations were written for, all renames apply when not set")
+	renamesPath := flag.String("renames", "", "JSON file of the renames to apply instead of the ones of this release")
+	check := flag.Bool("check", false, "fail instead of writing when a file needs fixing")
+	flag.Parse()
+
+	renames := configfix.Renames
+	if *renamesPath != "" {
+		content, err := os.ReadFile(*renamesPath)
+		if err != nil {
+			log.Fatal(err.Error())
+		}
+		renames = nil
+		if err := json.Unmarshal(content, &renames); err != nil {
+			log.Fatalf("%s: %s", *renamesPath, err.Error())
+		}
+	}
+	selected, err := configfix.Since(renames, *from)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	roots := flag.Args()
+	if len(roots) == 0 {
+		roots = []string{"."}
+	}
+	modules, err := moduleFiles(roots)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+
+	var outdated []string
+	for _, dir := range slices.Sorted(maps.Keys(modules)) {
+		changed, err := fixModule(modules[dir], selected, *check)
+		if err != nil {
+			log.Fatal(err.Error())
+		}
+		outdated = append(outdated, changed...)
+	}
+	for _, path := range outdated {
+		fmt.Println(path)
+	}
+	if *check && len(outdated) > 0 {
+		log.Fatalf("%d files use renamed attributes, run go run ./tools/configfix", len(outdated))
+	}
+}
+
+// moduleFiles returns the .tf files under roots, grouped by directory, as a directory is a module whose
+// files reference each other. The .terraform directories of installed modules are skipped.
+func moduleFiles(roots []string) (map[string][]string, error) {
+	modules := map[string][]string{}
+	for _, root := range roots {
+		err := filepath.WalkDir(root, func(path string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if entry.IsDir() && entry.Name() == ".terraform" {
+				return filepath.SkipDir
+			}
+			if !entry.IsDir() && strings.HasSuffix(path, ".tf") {
+				modules[filepath.Dir(path)] = append(modules[filepath.Dir(path)], path)
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return modules, nil
+}
+
+// fixModule applies the renames to the files of a module, returning the paths of the files changed, or
+// needing changes when check is set.
+func fixModule(files []string, renames []configfix.Rename, check bool) ([]string, error) {
+	contents := map[string][]byte{}
+	var declared []configfix.Declaration
+	for _, path := range files {
+		content, err := os.ReadFile(path) // #nosec G304
+		if err != nil {
+			return nil, err
+		}
+		declarations, err := configfix.Declarations(path, content)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		contents[path] = content
+		declared = append(declared, declarations...)
+	}
+	var changed []string
+	for _, path := range files {
+		fixed, changes, err := configfix.Fix(path, contents[path], renames, declared)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		if changes == 0 {
+			continue
+		}
+		changed = append(changed, path)
+		if check {
+			continue
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(path, fixed, info.Mode().Perm()); err != nil {
+			return nil, err
+		}
+	}
+	return changed, nil
+}
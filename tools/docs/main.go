@@ -0,0 +1,177 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+// Command docs generates the parts of the provider documentation derived from the action definitions.
+//
+// It writes the Import section of each resource page supporting import, with the import ID format, the
+// terraform import command, and import block examples by ID and, for resources with a resource identity,
+// by identity. The command is read from examples/resources/<type>/import.sh, which is regenerated from
+// the ImportID key spec when missing or not matching it.
+//
+// Run it from the repository root, or point it at the repository with -root from elsewhere, e.g. from
+// the sandbox of a build system:
+//
+//	go run ./tools/docs
+//	go run ./tools/docs -root /path/to/terraform-provider-idsec
+//
+// Without -root, the workspace of bazel run, BUILD_WORKSPACE_DIRECTORY, is used when set. Relative -docs
+// and -examples directories are resolved against the root.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/cyberark/terraform-provider-idsec/internal/provider"
+)
+
+const providerTypeName = "idsec"
+
+// resourceBlockPattern matches the resource block of an example, capturing its name.
+var resourceBlockPattern = regexp.MustCompile(`(?m)^resource\s+"[^"]+"\s+"([^"]+)"`)
+
+func main() {
+	root := flag.String("root", os.Getenv("BUILD_WORKSPACE_DIRECTORY"), "repository root the relative directories are resolved against, the current directory when not set")
+	docsDir := flag.String("docs", "docs", "directory of the generated documentation")
+	examplesDir := flag.String("examples", "examples", "directory of the documentation examples")
+	check := flag.Bool("check", false, "fail instead of writing when a file is out of date")
+	flag.Parse()
+
+	docs, examples := resolveDir(*root, *docsDir), resolveDir(*root, *examplesDir)
+	var outdated []string
+	for _, doc := range provider.ResourceImportDocs(providerTypeName) {
+		changed, err := generateImportDocs(doc, docs, examples, *check)
+		if err != nil {
+			log.Fatalf("%s: %s", doc.TypeName, err.Error())
+		}
+		outdated = append(outdated, changed...)
+	}
+	for _, path := range outdated {
+		fmt.Println(path)
+	}
+	if *check && len(outdated) > 0 {
+		log.Fatalf("%d documentation files are out of date, run go run ./tools/docs", len(outdated))
+	}
+}
+
+// resolveDir returns dir, given with either separator, as a path of the OS, relative to root unless it
+// is absolute.
+func resolveDir(root string, dir string) string {
+	dir = filepath.FromSlash(dir)
+	if root == "" || filepath.IsAbs(dir) {
+		return dir
+	}
+	return filepath.Join(root, dir)
+}
+
+// generateImportDocs writes the import example and the Import section of a documented resource, returning
+// the paths of the files changed, or out of date when check is set.
+func generateImportDocs(doc provider.ResourceImportDoc, docsDir string, examplesDir string, check bool) ([]string, error) {
+	page := filepath.Join(docsDir, "resources", strings.TrimPrefix(doc.TypeName, providerTypeName+"_")+".md")
+	content, err := os.ReadFile(page)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var changed []string
+	exampleDir := filepath.Join(examplesDir, "resources", doc.TypeName)
+	resourceName, importID := readImportExample(doc, exampleDir)
+	importScript := filepath.Join(exampleDir, "import.sh")
+	written, err := writeIfChanged(importScript, provider.ImportCommand(doc.TypeName+"."+resourceName, importID)+"\n", check)
+	if err != nil {
+		return nil, err
+	}
+	if written {
+		changed = append(changed, importScript)
+	}
+
+	section, err := doc.Markdown(resourceName, importID)
+	if err != nil {
+		return nil, err
+	}
+	written, err = writeIfChanged(page, replaceImportSection(strings.ReplaceAll(string(content), "\r\n", "\n"), section), check)
+	if err != nil {
+		return nil, err
+	}
+	if written {
+		changed = append(changed, page)
+	}
+	return changed, nil
+}
+
+// readImportExample returns the resource name and import ID of the import example of a resource. The
+// example of import.sh is kept when it matches the key spec, as its values are usually more telling
+// than placeholders. Otherwise the resource is named after the block of resource.tf.
+func readImportExample(doc provider.ResourceImportDoc, exampleDir string) (string, string) {
+	if script, err := os.ReadFile(filepath.Join(exampleDir, "import.sh")); err == nil {
+		scanner := bufio.NewScanner(bytes.NewReader(script))
+		for scanner.Scan() {
+			fields := strings.Fields(scanner.Text())
+			if len(fields) != 4 || fields[0] != "terraform" || fields[1] != "import" {
+				continue
+			}
+			typeName, resourceName, found := strings.Cut(fields[2], ".")
+			importID := strings.Trim(fields[3], `"'`)
+			if !found || typeName != doc.TypeName {
+				continue
+			}
+			if _, err := doc.ParseID(importID); err == nil {
+				return resourceName, importID
+			}
+		}
+	}
+	resourceName := "example"
+	if example, err := os.ReadFile(filepath.Join(exampleDir, "resource.tf")); err == nil {
+		if match := resourceBlockPattern.FindSubmatch(example); match != nil {
+			resourceName = string(match[1])
+		}
+	}
+	return resourceName, doc.ExampleID()
+}
+
+// replaceImportSection replaces the Import section of a page, which runs to the next second level
+// heading or to the end of the page, or appends it when the page has none.
+func replaceImportSection(page string, section string) string {
+	start := strings.Index(page, "\n## Import\n")
+	if start == -1 {
+		return strings.TrimRight(page, "\n") + "\n\n" + section
+	}
+	start++
+	end := len(page)
+	if next := strings.Index(page[start+len("## Import\n"):], "\n## "); next != -1 {
+		end = start + len("## Import\n") + next + 1
+		section += "\n"
+	}
+	return page[:start] + section + page[end:]
+}
+
+// writeIfChanged writes content to path unless it already holds it, and reports whether it differed.
+// Line endings are not compared, so files checked out with CRLF endings on Windows are not out of date.
+// With check set, nothing is written.
+func writeIfChanged(path string, content string, check bool) (bool, error) {
+	existing, err := os.ReadFile(path)
+	if err == nil && strings.ReplaceAll(string(existing), "\r\n", "\n") == content {
+		return false, nil
+	}
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return false, err
+	}
+	if check {
+		return true, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return false, err
+	}
+	return true, os.WriteFile(path, []byte(content), 0o644) // #nosec G306
+}
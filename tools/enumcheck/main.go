@@ -0,0 +1,81 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+// Command enumcheck reports the `choices` tags of the SDK models used by the provider that no longer
+// match the string constants the SDK declares for the same enum, so the validation of the schemas is
+// updated when the SDK is:
+//
+//	go run ./tools/enumcheck
+//
+// The SDK source is read from the module cache at the version of go.mod. The command fails when a
+// field drifted, listing the choices without a constant and the constants that are not choices.
+package main
+
+import (
+	"fmt"
+	"log"
+	"os/exec"
+	"reflect"
+	"strings"
+
+	"github.com/cyberark/terraform-provider-idsec/internal/actions"
+	"github.com/cyberark/terraform-provider-idsec/internal/enumcheck"
+
+	_ "github.com/cyberark/terraform-provider-idsec/internal/tfactions"
+)
+
+const sdkModulePath = "github.com/cyberark/idsec-sdk-golang"
+
+func main() {
+	log.SetFlags(0)
+
+	output, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", sdkModulePath).Output()
+	if err != nil {
+		log.Fatalf("locating %s: %s", sdkModulePath, err.Error())
+	}
+	groups, err := enumcheck.ConstGroups(strings.TrimSpace(string(output)), sdkModulePath)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	drifts := enumcheck.Check(enumcheck.ChoicesFields(modelTypes()...), groups)
+	for _, drift := range drifts {
+		fmt.Printf("%s.%s.%s (constants at %s):\n", drift.Field.Package, drift.Field.Struct, drift.Field.Name, drift.Group.Position)
+		if len(drift.Undeclared) > 0 {
+			fmt.Printf("  choices without a constant: %s\n", strings.Join(drift.Undeclared, ", "))
+		}
+		if len(drift.Unchoosable) > 0 {
+			fmt.Printf("  constants missing from the choices: %s\n", strings.Join(drift.Unchoosable, ", "))
+		}
+	}
+	if len(drifts) > 0 {
+		log.Fatalf("%d choices tags differ from the SDK constants", len(drifts))
+	}
+}
+
+// modelTypes returns the types of the input and state schemas of the registered resources, data
+// sources and actions.
+func modelTypes() []reflect.Type {
+	var types []reflect.Type
+	add := func(definition actions.IdsecServiceBaseTerraformActionDefinition) {
+		for _, schema := range definition.Schemas {
+			if schema != nil {
+				types = append(types, reflect.TypeOf(schema))
+			}
+		}
+		if definition.StateSchema != nil {
+			types = append(types, reflect.TypeOf(definition.StateSchema))
+		}
+	}
+	for _, config := range actions.AllTerraformConfigs() {
+		for _, resource := range config.Resources {
+			add(resource.IdsecServiceBaseTerraformActionDefinition)
+		}
+		for _, dataSource := range config.DataSources {
+			add(dataSource.IdsecServiceBaseTerraformActionDefinition)
+		}
+		for _, action := range config.Actions {
+			add(action.IdsecServiceBaseTerraformActionDefinition)
+		}
+	}
+	return types
+}
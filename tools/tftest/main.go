@@ -0,0 +1,80 @@
+// Copyright CyberArk. 2026
+// SPDX-License-Identifier: Apache-2.0
+
+// Command tftest writes Terraform test files mocking the provider, one per resource, so modules using
+// the provider can be unit tested with `terraform test` without access to a tenant.
+//
+// Each <type>.tftest.hcl file declares a mock provider giving placeholder values to the computed
+// attributes of the resource, and a run block planning the module with it. Copy the files of the
+// resources a module uses to its tests directory and add assertions to the run blocks:
+//
+//	go run ./tools/tftest -out tests -resources idsec_pcloud_safe,idsec_pcloud_safe_member
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/cyberark/terraform-provider-idsec/internal/provider"
+	"github.com/cyberark/terraform-provider-idsec/internal/tftest"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+)
+
+const providerTypeName = "idsec"
+
+func main() {
+	log.SetFlags(0)
+	out := flag.String("out", "tests", "directory the test files are written to")
+	resources := flag.String("resources", "", "comma-separated resource types to mock, all resources when not set")
+	flag.Parse()
+
+	schemas, err := resourceSchemas()
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	selected := slices.Sorted(maps.Keys(schemas))
+	if *resources != "" {
+		selected = strings.Split(*resources, ",")
+	}
+	if err := os.MkdirAll(*out, 0o755); err != nil {
+		log.Fatal(err.Error())
+	}
+	for _, typeName := range selected {
+		typeName = strings.TrimSpace(typeName)
+		schema, ok := schemas[typeName]
+		if !ok {
+			log.Fatalf("unknown resource type %q", typeName)
+		}
+		path := filepath.Join(*out, typeName+".tftest.hcl")
+		if err := os.WriteFile(path, []byte(tftest.ResourceMockFile(providerTypeName, typeName, schema)), 0o644); err != nil { // #nosec G306
+			log.Fatal(err.Error())
+		}
+		fmt.Println(path)
+	}
+}
+
+// resourceSchemas returns the schemas of the resources of the provider built from this tree, keyed by
+// type name.
+func resourceSchemas() (map[string]*tfprotov6.Schema, error) {
+	server := providerserver.NewProtocol6(provider.NewIdsecProvider(provider.IdsecProviderConfig{Version: "dev"})())()
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		return nil, err
+	}
+	for _, diagnostic := range resp.Diagnostics {
+		if diagnostic.Severity == tfprotov6.DiagnosticSeverityError {
+			return nil, errors.New(diagnostic.Summary + ": " + diagnostic.Detail)
+		}
+	}
+	return resp.ResourceSchemas, nil
+}